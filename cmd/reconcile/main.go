@@ -0,0 +1,77 @@
+// Command reconcile checks every SKU stock against its stock history, the sold order items and the Sold serials.
+//
+// Usage:
+//
+//	go run ./cmd/reconcile [--fix]
+//
+// With --fix, a corrective stock history entry is written for each stock that drifted from its history.
+// Sold and serial drifts are only reported. The command exits with status 1 when unfixed mismatches remain.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	"shopnexus-remastered/config"
+	"shopnexus-remastered/internal/client/pgxpool"
+	"shopnexus-remastered/internal/logger"
+	inventorybiz "shopnexus-remastered/internal/module/inventory/biz"
+	"shopnexus-remastered/internal/utils/pgutil"
+)
+
+func main() {
+	fix := flag.Bool("fix", false, "write corrective stock history entries for stocks drifted from their history")
+	flag.Parse()
+
+	logger.InitLogger()
+	cfg := config.GetConfig()
+
+	pool, err := pgxpool.New(pgxpool.Options{
+		Url:             cfg.Postgres.Url,
+		Host:            cfg.Postgres.Host,
+		Port:            cfg.Postgres.Port,
+		Username:        cfg.Postgres.Username,
+		Password:        cfg.Postgres.Password,
+		Database:        cfg.Postgres.Database,
+		MaxConnections:  cfg.Postgres.MaxConnections,
+		MaxConnIdleTime: cfg.Postgres.MaxConnIdleTime,
+	})
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer pool.Close()
+
+	biz := inventorybiz.NewInventoryBiz(pgutil.NewStorage(pool))
+	mismatches, err := biz.ReconcileStock(context.Background(), inventorybiz.ReconcileStockParams{
+		Fix: *fix,
+	})
+	if err != nil {
+		log.Fatalf("Failed to reconcile stock: %v", err)
+	}
+
+	if len(mismatches) == 0 {
+		fmt.Println("All stocks match their history, orders and serials")
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "STOCK\tSKU\tCURRENT\tHISTORY\tSOLD\tSOLD ITEMS\tPENDING ITEMS\tSOLD SERIALS\tFIXED\t")
+	unresolved := 0
+	for _, m := range mismatches {
+		fmt.Fprintf(w, "%d\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%t\t\n",
+			m.StockID, m.SkuID, m.CurrentStock, m.HistoryStock, m.Sold, m.SoldItems, m.PendingItems, m.SoldSerials, m.Fixed)
+		if (m.StockDrift() != 0 && !m.Fixed) || m.SoldDrift() != 0 || m.SerialDrift() != 0 {
+			unresolved++
+		}
+	}
+	w.Flush()
+
+	fmt.Printf("%d mismatched stocks, %d unresolved\n", len(mismatches), unresolved)
+	if unresolved > 0 {
+		os.Exit(1)
+	}
+}
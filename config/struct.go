@@ -9,11 +9,18 @@ type Config struct {
 	// Infrastructure components
 	Postgres Postgres `yaml:"postgres" mapstructure:"postgres" validate:"required"`
 	Redis    Redis    `yaml:"redis" mapstructure:"redis" validate:"required"`
+
+	// Payment platforms
+	Vnpay *Vnpay `yaml:"vnpay" mapstructure:"vnpay" validate:"omitempty"` // Nil to disable VNPay payments
+	Cod   Cod    `yaml:"cod" mapstructure:"cod"`
+
+	Promotion Promotion `yaml:"promotion" mapstructure:"promotion"`
 }
 
 type App struct {
-	Name string `yaml:"name" mapstructure:"name" validate:"required"`
-	JWT  JWT    `yaml:"jwt" mapstructure:"jwt" validate:"required"`
+	Name       string `yaml:"name" mapstructure:"name" validate:"required"`
+	JWT        JWT    `yaml:"jwt" mapstructure:"jwt" validate:"required"`
+	AdminToken string `yaml:"adminToken" mapstructure:"adminToken"` // Token the back office sends to manage system resources, empty to disable
 }
 
 type JWT struct {
@@ -51,3 +58,27 @@ type Redis struct {
 	Password string `yaml:"password" mapstructure:"password"`
 	DB       int    `yaml:"db" mapstructure:"db" validate:"gte=0"`
 }
+
+type Vnpay struct {
+	TmnCode       string `yaml:"tmnCode" mapstructure:"tmnCode" validate:"required"`
+	HashSecret    string `yaml:"hashSecret" mapstructure:"hashSecret" validate:"required"`
+	ReturnUrl     string `yaml:"returnUrl" mapstructure:"returnUrl" validate:"required,url"` // Where VNPay redirects the customer after paying
+	Production    bool   `yaml:"production" mapstructure:"production"`                       // Use the production endpoints instead of the sandbox
+	PayUrl        string `yaml:"payUrl" mapstructure:"payUrl" validate:"omitempty,url"`      // Overrides the payment page endpoint
+	ApiUrl        string `yaml:"apiUrl" mapstructure:"apiUrl" validate:"omitempty,url"`      // Overrides the merchant API endpoint
+	Locale        string `yaml:"locale" mapstructure:"locale" validate:"omitempty,oneof=vn en"`
+	CurrCode      string `yaml:"currCode" mapstructure:"currCode" validate:"omitempty,oneof=VND"`
+	ExpireMinutes int    `yaml:"expireMinutes" mapstructure:"expireMinutes" validate:"gte=0"` // How long the payment page stays valid
+	Timeout       int    `yaml:"timeout" mapstructure:"timeout" validate:"gte=0"`             // Merchant API timeout in seconds
+}
+
+type Cod struct {
+	CourierToken string `yaml:"courierToken" mapstructure:"courierToken"` // Token the courier sends to confirm cash collection, empty to disable
+}
+
+// Promotion sets how the discounts of vendors and of the platform combine on one price
+type Promotion struct {
+	MaxVendorDiscounts   int      `yaml:"maxVendorDiscounts" mapstructure:"maxVendorDiscounts" validate:"gte=0"`                             // Vendor discounts stacked on one price, 0 means 1
+	MaxPlatformDiscounts int      `yaml:"maxPlatformDiscounts" mapstructure:"maxPlatformDiscounts" validate:"gte=0"`                         // Platform discounts stacked on one price, 0 means 1
+	StackingOrder        []string `yaml:"stackingOrder" mapstructure:"stackingOrder" validate:"omitempty,unique,dive,oneof=Vendor Platform"` // Sources in the order they apply, each on the price left by the previous, empty means Vendor then Platform
+}
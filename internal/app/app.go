@@ -6,6 +6,9 @@ import (
 	"shopnexus-remastered/internal/module/account"
 	"shopnexus-remastered/internal/module/auth"
 	"shopnexus-remastered/internal/module/catalog"
+	"shopnexus-remastered/internal/module/inventory"
+	"shopnexus-remastered/internal/module/order"
+	"shopnexus-remastered/internal/module/promotion"
 
 	"go.uber.org/fx"
 )
@@ -17,12 +20,16 @@ var Module = fx.Module("main",
 		NewConfig,
 		NewDatabase,
 		NewEcho,
+		NewVnpayClient,
 	),
 
 	// Business modules
 	account.Module,
 	auth.Module,
 	catalog.Module,
+	inventory.Module,
+	order.Module,
+	promotion.Module,
 
 	// HTTP server
 	fx.Invoke(
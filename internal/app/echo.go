@@ -9,6 +9,9 @@ import (
 	accountecho "shopnexus-remastered/internal/module/account/transport/echo"
 	authecho "shopnexus-remastered/internal/module/auth/transport/echo"
 	catalogecho "shopnexus-remastered/internal/module/catalog/transport/echo"
+	inventoryecho "shopnexus-remastered/internal/module/inventory/transport/echo"
+	orderecho "shopnexus-remastered/internal/module/order/transport/echo"
+	promotionecho "shopnexus-remastered/internal/module/promotion/transport/echo"
 	"shopnexus-remastered/internal/module/shared/transport/echo/validator"
 
 	"github.com/labstack/echo/v4"
@@ -33,9 +36,12 @@ type RouteParams struct {
 	fx.In
 	Echo *echo.Echo
 
-	Account *accountecho.Handler
-	Auth    *authecho.Handler
-	Catalog *catalogecho.Handler
+	Account   *accountecho.Handler
+	Auth      *authecho.Handler
+	Catalog   *catalogecho.Handler
+	Inventory *inventoryecho.Handler
+	Order     *orderecho.Handler
+	Promotion *promotionecho.Handler
 	// Add more handlers as needed
 }
 
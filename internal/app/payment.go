@@ -0,0 +1,29 @@
+package app
+
+import (
+	"net/http"
+	"time"
+
+	"shopnexus-remastered/config"
+	"shopnexus-remastered/internal/client/vnpay"
+)
+
+// NewVnpayClient creates a new VNPay client, nil when VNPay is not configured
+func NewVnpayClient(cfg *config.Config) vnpay.Client {
+	if cfg.Vnpay == nil {
+		return nil
+	}
+	return vnpay.NewClient(vnpay.ClientOptions{
+		TmnCode:     cfg.Vnpay.TmnCode,
+		HashSecret:  cfg.Vnpay.HashSecret,
+		Production:  cfg.Vnpay.Production,
+		PayUrl:      cfg.Vnpay.PayUrl,
+		ApiUrl:      cfg.Vnpay.ApiUrl,
+		Locale:      cfg.Vnpay.Locale,
+		CurrCode:    cfg.Vnpay.CurrCode,
+		ExpireAfter: time.Duration(cfg.Vnpay.ExpireMinutes) * time.Minute,
+		HTTPClient: &http.Client{
+			Timeout: time.Duration(cfg.Vnpay.Timeout) * time.Second,
+		},
+	})
+}
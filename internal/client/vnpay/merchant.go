@@ -0,0 +1,186 @@
+package vnpay
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// ResponseCodeSuccess is the vnp_ResponseCode of a successful merchant API call
+	ResponseCodeSuccess = "00"
+	// ResponseCodeNotFound is the vnp_ResponseCode when VNPay has no transaction for the TxnRef
+	ResponseCodeNotFound = "91"
+
+	// TransactionStatusSuccess is the vnp_TransactionStatus of a completed transaction
+	TransactionStatusSuccess = "00"
+	// TransactionStatusPending is the vnp_TransactionStatus of a transaction not completed yet
+	TransactionStatusPending = "01"
+
+	refundTypeFull    = "02"
+	refundTypePartial = "03"
+
+	// merchantIpAddr is the vnp_IpAddr sent with merchant API calls
+	merchantIpAddr = defaultIpAddr
+)
+
+type QueryDRParams struct {
+	PaymentID       int64
+	Info            string
+	TransactionDate time.Time // vnp_CreateDate of the pay request
+}
+
+type RefundParams struct {
+	PaymentID       int64
+	Amount          int64
+	Full            bool // Refund the whole payment, otherwise a partial refund of Amount
+	TransactionNo   string
+	TransactionDate time.Time // vnp_CreateDate of the pay request
+	CreateBy        string    // Who requested the refund
+	Info            string
+}
+
+// TransactionResult is the response of a querydr or refund call
+type TransactionResult struct {
+	ResponseId        string `json:"vnp_ResponseId"`
+	Command           string `json:"vnp_Command"`
+	ResponseCode      string `json:"vnp_ResponseCode"`
+	Message           string `json:"vnp_Message"`
+	TmnCode           string `json:"vnp_TmnCode"`
+	TxnRef            string `json:"vnp_TxnRef"`
+	Amount            string `json:"vnp_Amount"`
+	BankCode          string `json:"vnp_BankCode"`
+	PayDate           string `json:"vnp_PayDate"`
+	TransactionNo     string `json:"vnp_TransactionNo"`
+	TransactionType   string `json:"vnp_TransactionType"`
+	TransactionStatus string `json:"vnp_TransactionStatus"`
+	OrderInfo         string `json:"vnp_OrderInfo"`
+	PromotionCode     string `json:"vnp_PromotionCode,omitempty"`
+	PromotionAmount   string `json:"vnp_PromotionAmount,omitempty"`
+	SecureHash        string `json:"vnp_SecureHash"`
+}
+
+// QueryDR asks VNPay for the status of the transaction of a payment
+func (c *ClientImpl) QueryDR(ctx context.Context, params QueryDRParams) (TransactionResult, error) {
+	req := map[string]string{
+		"vnp_RequestId":       newRequestID(),
+		"vnp_Version":         "2.1.0",
+		"vnp_Command":         "querydr",
+		"vnp_TmnCode":         c.tmnCode,
+		"vnp_TxnRef":          fmt.Sprintf("%d", params.PaymentID),
+		"vnp_OrderInfo":       params.Info,
+		"vnp_TransactionDate": formatTime(params.TransactionDate),
+		"vnp_CreateDate":      formatTime(time.Now()),
+		"vnp_IpAddr":          merchantIpAddr,
+	}
+	req["vnp_SecureHash"] = sign(joinFields(req,
+		"vnp_RequestId", "vnp_Version", "vnp_Command", "vnp_TmnCode", "vnp_TxnRef",
+		"vnp_TransactionDate", "vnp_CreateDate", "vnp_IpAddr", "vnp_OrderInfo",
+	), []byte(c.hashSecret))
+
+	var result TransactionResult
+	if err := c.callMerchantApi(ctx, req, &result); err != nil {
+		return result, err
+	}
+
+	if !verifySign(strings.Join([]string{
+		result.ResponseId, result.Command, result.ResponseCode, result.Message, result.TmnCode,
+		result.TxnRef, result.Amount, result.BankCode, result.PayDate, result.TransactionNo,
+		result.TransactionType, result.TransactionStatus, result.OrderInfo,
+		result.PromotionCode, result.PromotionAmount,
+	}, "|"), result.SecureHash, []byte(c.hashSecret)) {
+		return result, fmt.Errorf("querydr response hash mismatch")
+	}
+
+	return result, nil
+}
+
+// Refund refunds a successful payment, fully or partially
+func (c *ClientImpl) Refund(ctx context.Context, params RefundParams) (TransactionResult, error) {
+	transactionType := refundTypePartial
+	if params.Full {
+		transactionType = refundTypeFull
+	}
+
+	req := map[string]string{
+		"vnp_RequestId":       newRequestID(),
+		"vnp_Version":         "2.1.0",
+		"vnp_Command":         "refund",
+		"vnp_TmnCode":         c.tmnCode,
+		"vnp_TransactionType": transactionType,
+		"vnp_TxnRef":          fmt.Sprintf("%d", params.PaymentID),
+		"vnp_Amount":          fmt.Sprintf("%d", params.Amount*100),
+		"vnp_OrderInfo":       params.Info,
+		"vnp_TransactionNo":   params.TransactionNo,
+		"vnp_TransactionDate": formatTime(params.TransactionDate),
+		"vnp_CreateBy":        params.CreateBy,
+		"vnp_CreateDate":      formatTime(time.Now()),
+		"vnp_IpAddr":          merchantIpAddr,
+	}
+	req["vnp_SecureHash"] = sign(joinFields(req,
+		"vnp_RequestId", "vnp_Version", "vnp_Command", "vnp_TmnCode", "vnp_TransactionType",
+		"vnp_TxnRef", "vnp_Amount", "vnp_TransactionNo", "vnp_TransactionDate", "vnp_CreateBy",
+		"vnp_CreateDate", "vnp_IpAddr", "vnp_OrderInfo",
+	), []byte(c.hashSecret))
+
+	var result TransactionResult
+	if err := c.callMerchantApi(ctx, req, &result); err != nil {
+		return result, err
+	}
+
+	if !verifySign(strings.Join([]string{
+		result.ResponseId, result.Command, result.ResponseCode, result.Message, result.TmnCode,
+		result.TxnRef, result.Amount, result.BankCode, result.PayDate, result.TransactionNo,
+		result.TransactionType, result.TransactionStatus, result.OrderInfo,
+	}, "|"), result.SecureHash, []byte(c.hashSecret)) {
+		return result, fmt.Errorf("refund response hash mismatch")
+	}
+
+	return result, nil
+}
+
+// callMerchantApi posts a JSON request to the merchant API and decodes the JSON response
+func (c *ClientImpl) callMerchantApi(ctx context.Context, body map[string]string, result *TransactionResult) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.apiUrl, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("vnpay %s returned http status %d", body["vnp_Command"], resp.StatusCode)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(result)
+}
+
+// joinFields joins the values of the given keys with "|", the data format VNPay signs for merchant API calls
+func joinFields(data map[string]string, keys ...string) string {
+	values := make([]string, len(keys))
+	for i, k := range keys {
+		values[i] = data[k]
+	}
+	return strings.Join(values, "|")
+}
+
+// newRequestID generates a vnp_RequestId, which must be unique per merchant per day
+func newRequestID() string {
+	return strings.ReplaceAll(uuid.New().String(), "-", "")
+}
@@ -0,0 +1,221 @@
+package vnpay
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+const (
+	testTmnCode    = "TESTTMN1"
+	testHashSecret = "test-secret"
+)
+
+// requestSignFields are the fields VNPay signs for each merchant API command, in order
+var requestSignFields = map[string][]string{
+	"querydr": {
+		"vnp_RequestId", "vnp_Version", "vnp_Command", "vnp_TmnCode", "vnp_TxnRef",
+		"vnp_TransactionDate", "vnp_CreateDate", "vnp_IpAddr", "vnp_OrderInfo",
+	},
+	"refund": {
+		"vnp_RequestId", "vnp_Version", "vnp_Command", "vnp_TmnCode", "vnp_TransactionType",
+		"vnp_TxnRef", "vnp_Amount", "vnp_TransactionNo", "vnp_TransactionDate", "vnp_CreateBy",
+		"vnp_CreateDate", "vnp_IpAddr", "vnp_OrderInfo",
+	},
+}
+
+// signResponse signs a merchant API response the way VNPay does for the command
+func signResponse(command string, result TransactionResult) TransactionResult {
+	fields := []string{
+		result.ResponseId, result.Command, result.ResponseCode, result.Message, result.TmnCode,
+		result.TxnRef, result.Amount, result.BankCode, result.PayDate, result.TransactionNo,
+		result.TransactionType, result.TransactionStatus, result.OrderInfo,
+	}
+	if command == "querydr" {
+		fields = append(fields, result.PromotionCode, result.PromotionAmount)
+	}
+	result.SecureHash = sign(strings.Join(fields, "|"), []byte(testHashSecret))
+	return result
+}
+
+// newMerchantServer stands in for the VNPay merchant API: it rejects requests with a bad signature with a 400,
+// otherwise it answers with the response built by respond, or with status when it is not 200
+func newMerchantServer(t *testing.T, status int, respond func(req map[string]string) TransactionResult) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("decode request: %v", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		fields, ok := requestSignFields[req["vnp_Command"]]
+		if !ok {
+			t.Errorf("unexpected command %q", req["vnp_Command"])
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if want := sign(joinFields(req, fields...), []byte(testHashSecret)); req["vnp_SecureHash"] != want {
+			t.Errorf("request signature mismatch for %s", req["vnp_Command"])
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(respond(req))
+	}))
+}
+
+func newTestClient(apiUrl string) Client {
+	return NewClient(ClientOptions{
+		TmnCode:    testTmnCode,
+		HashSecret: testHashSecret,
+		ApiUrl:     apiUrl,
+	})
+}
+
+func TestQueryDR(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       int
+		responseCode string
+		tamper       bool
+		wantErr      bool
+	}{
+		{name: "success", status: http.StatusOK, responseCode: ResponseCodeSuccess},
+		{name: "not found", status: http.StatusOK, responseCode: ResponseCodeNotFound},
+		{name: "response hash mismatch", status: http.StatusOK, responseCode: ResponseCodeSuccess, tamper: true, wantErr: true},
+		{name: "http error", status: http.StatusInternalServerError, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newMerchantServer(t, tt.status, func(req map[string]string) TransactionResult {
+				result := TransactionResult{
+					ResponseId:   "resp-1",
+					Command:      req["vnp_Command"],
+					ResponseCode: tt.responseCode,
+					Message:      "QueryDR",
+					TmnCode:      req["vnp_TmnCode"],
+					TxnRef:       req["vnp_TxnRef"],
+				}
+				if tt.responseCode == ResponseCodeSuccess {
+					result.Amount = "1000000"
+					result.BankCode = "NCB"
+					result.PayDate = "20250522005239"
+					result.TransactionNo = "14971939"
+					result.TransactionType = "01"
+					result.TransactionStatus = TransactionStatusSuccess
+					result.OrderInfo = req["vnp_OrderInfo"]
+				}
+				result = signResponse("querydr", result)
+				if tt.tamper {
+					result.Amount = "1"
+				}
+				return result
+			})
+			defer server.Close()
+
+			result, err := newTestClient(server.URL).QueryDR(context.Background(), QueryDRParams{
+				PaymentID:       26,
+				Info:            "Payment for order 26",
+				TransactionDate: time.Now().Add(-time.Hour),
+			})
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("QueryDR() error = nil, want an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("QueryDR() error = %v", err)
+			}
+			if result.ResponseCode != tt.responseCode {
+				t.Errorf("ResponseCode = %q, want %q", result.ResponseCode, tt.responseCode)
+			}
+			if result.TxnRef != "26" {
+				t.Errorf("TxnRef = %q, want %q", result.TxnRef, "26")
+			}
+		})
+	}
+}
+
+func TestRefund(t *testing.T) {
+	tests := []struct {
+		name                string
+		full                bool
+		status              int
+		responseCode        string
+		tamper              bool
+		wantErr             bool
+		wantTransactionType string
+	}{
+		{name: "full refund", full: true, status: http.StatusOK, responseCode: ResponseCodeSuccess, wantTransactionType: refundTypeFull},
+		{name: "partial refund", status: http.StatusOK, responseCode: ResponseCodeSuccess, wantTransactionType: refundTypePartial},
+		{name: "not found", full: true, status: http.StatusOK, responseCode: ResponseCodeNotFound, wantTransactionType: refundTypeFull},
+		{name: "response hash mismatch", full: true, status: http.StatusOK, responseCode: ResponseCodeSuccess, tamper: true, wantErr: true, wantTransactionType: refundTypeFull},
+		{name: "http error", full: true, status: http.StatusBadGateway, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newMerchantServer(t, tt.status, func(req map[string]string) TransactionResult {
+				if req["vnp_TransactionType"] != tt.wantTransactionType {
+					t.Errorf("vnp_TransactionType = %q, want %q", req["vnp_TransactionType"], tt.wantTransactionType)
+				}
+				if req["vnp_Amount"] != "50000000" {
+					t.Errorf("vnp_Amount = %q, want %q", req["vnp_Amount"], "50000000")
+				}
+				result := signResponse("refund", TransactionResult{
+					ResponseId:        "resp-2",
+					Command:           req["vnp_Command"],
+					ResponseCode:      tt.responseCode,
+					Message:           "Refund",
+					TmnCode:           req["vnp_TmnCode"],
+					TxnRef:            req["vnp_TxnRef"],
+					Amount:            req["vnp_Amount"],
+					TransactionNo:     req["vnp_TransactionNo"],
+					TransactionType:   req["vnp_TransactionType"],
+					TransactionStatus: TransactionStatusSuccess,
+					OrderInfo:         req["vnp_OrderInfo"],
+				})
+				if tt.tamper {
+					result.TransactionStatus = TransactionStatusPending
+				}
+				return result
+			})
+			defer server.Close()
+
+			result, err := newTestClient(server.URL).Refund(context.Background(), RefundParams{
+				PaymentID:       26,
+				Amount:          500000,
+				Full:            tt.full,
+				TransactionNo:   "14971939",
+				TransactionDate: time.Now().Add(-time.Hour),
+				CreateBy:        "admin",
+				Info:            "Refund for order 26",
+			})
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Refund() error = nil, want an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Refund() error = %v", err)
+			}
+			if result.ResponseCode != tt.responseCode {
+				t.Errorf("ResponseCode = %q, want %q", result.ResponseCode, tt.responseCode)
+			}
+		})
+	}
+}
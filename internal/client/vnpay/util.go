@@ -10,9 +10,15 @@ import (
 	"time"
 )
 
-// formatTime formats time to string in format yyyyMMddHHmmss
+// timeLayout is the yyyyMMddHHmmss layout VNPay uses for all dates
+const timeLayout = "20060102150405"
+
+// vnpayLocation is the timezone of all VNPay dates (GMT+7)
+var vnpayLocation = time.FixedZone("GMT+7", 7*60*60)
+
+// formatTime formats time to string in format yyyyMMddHHmmss, in GMT+7
 func formatTime(t time.Time) string {
-	return t.Format("20060102150405")
+	return t.In(vnpayLocation).Format(timeLayout)
 }
 
 // sign generates a HMAC signature (SHA512) for the given message using the provided key
@@ -22,6 +28,11 @@ func sign(message string, key []byte) string {
 	return hex.EncodeToString(sig.Sum(nil))
 }
 
+// verifySign reports whether hash is the HMAC signature (SHA512) of the message, in constant time
+func verifySign(message, hash string, key []byte) bool {
+	return hmac.Equal([]byte(sign(message, key)), []byte(hash))
+}
+
 // buildSortedQuery builds a sorted query string from the input data.
 // Note: use "+" instead of " " or %20 for spaces, as VNPAY uses "+" for spaces in their hash calculation.
 func buildSortedQuery(inputData map[string]any) string {
@@ -4,67 +4,141 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 )
 
+// VNPay endpoints: the payment page and the merchant API (querydr, refund)
+const (
+	SandboxPayUrl    = "https://sandbox.vnpayment.vn/paymentv2/vpcpay.html"
+	SandboxApiUrl    = "https://sandbox.vnpayment.vn/merchant_webapi/api/transaction"
+	ProductionPayUrl = "https://pay.vnpay.vn/vpcpay.html"
+	ProductionApiUrl = "https://merchant.vnpay.vn/merchant_webapi/api/transaction"
+)
+
+const (
+	LocaleVietnamese = "vn"
+	LocaleEnglish    = "en"
+
+	// CurrencyVND is the only currency VNPay supports
+	CurrencyVND = "VND"
+
+	defaultExpireAfter = 30 * time.Minute
+	defaultIpAddr      = "127.0.0.1"
+)
+
 type ClientImpl struct {
-	tmnCode    string
-	hashSecret string
+	tmnCode     string
+	hashSecret  string
+	payUrl      string
+	apiUrl      string
+	locale      string
+	currCode    string
+	expireAfter time.Duration
+	httpClient  *http.Client
 }
 
 type Client interface {
 	CreateOrder(ctx context.Context, params CreateOrderParams) (url string, err error)
 	VerifyPayment(ctx context.Context, ipn map[string]any) error
+	QueryDR(ctx context.Context, params QueryDRParams) (TransactionResult, error)
+	Refund(ctx context.Context, params RefundParams) (TransactionResult, error)
 }
 
 type ClientOptions struct {
-	TmnCode    string
-	HashSecret string
+	TmnCode     string
+	HashSecret  string
+	Production  bool          // Use the production endpoints instead of the sandbox
+	PayUrl      string        // Overrides the payment page endpoint
+	ApiUrl      string        // Overrides the merchant API endpoint
+	Locale      string        // Default language of the payment page, defaults to LocaleVietnamese
+	CurrCode    string        // Defaults to CurrencyVND
+	ExpireAfter time.Duration // How long the payment page stays valid, defaults to 30 minutes
+	HTTPClient  *http.Client  // Defaults to http.DefaultClient
 }
 
 func NewClient(cfg ClientOptions) Client {
+	if cfg.PayUrl == "" {
+		cfg.PayUrl = SandboxPayUrl
+		if cfg.Production {
+			cfg.PayUrl = ProductionPayUrl
+		}
+	}
+	if cfg.ApiUrl == "" {
+		cfg.ApiUrl = SandboxApiUrl
+		if cfg.Production {
+			cfg.ApiUrl = ProductionApiUrl
+		}
+	}
+	if cfg.Locale == "" {
+		cfg.Locale = LocaleVietnamese
+	}
+	if cfg.CurrCode == "" {
+		cfg.CurrCode = CurrencyVND
+	}
+	if cfg.ExpireAfter <= 0 {
+		cfg.ExpireAfter = defaultExpireAfter
+	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = http.DefaultClient
+	}
+
 	return &ClientImpl{
-		tmnCode:    cfg.TmnCode,
-		hashSecret: cfg.HashSecret,
+		tmnCode:     cfg.TmnCode,
+		hashSecret:  cfg.HashSecret,
+		payUrl:      cfg.PayUrl,
+		apiUrl:      cfg.ApiUrl,
+		locale:      cfg.Locale,
+		currCode:    cfg.CurrCode,
+		expireAfter: cfg.ExpireAfter,
+		httpClient:  cfg.HTTPClient,
 	}
 }
 
 type CreateOrderParams struct {
-	PaymentID int64
-	Amount    int64
-	Info      string
-	ReturnUrl string
+	PaymentID  int64
+	Amount     int64
+	Info       string
+	ReturnUrl  string
+	CreateDate time.Time // Also the vnp_TransactionDate of later querydr and refund calls
+	IpAddr     string    // IP address of the customer, defaults to 127.0.0.1
+	Locale     string    // Overrides the client locale
+	BankCode   string    // Skips the payment method selection on the payment page, empty to let the customer choose
 }
 
-func (c *ClientImpl) CreateOrder(ctx context.Context, params CreateOrderParams) (url string, err error) {
-	// httpClient := &http.Client{}
-	req, err := http.NewRequest("GET", "https://sandbox.vnpayment.vn/paymentv2/vpcpay.html", nil)
-	if err != nil {
-		return "", err
+func (c *ClientImpl) CreateOrder(ctx context.Context, params CreateOrderParams) (string, error) {
+	ipAddr := params.IpAddr
+	if ipAddr == "" {
+		ipAddr = defaultIpAddr
+	}
+	locale := params.Locale
+	if locale == "" {
+		locale = c.locale
 	}
 
-	q := req.URL.Query()
-	q.Add("vnp_Version", "2.1.0")
-	q.Add("vnp_Command", "pay")
-	q.Add("vnp_TmnCode", c.tmnCode)
-	q.Add("vnp_Amount", fmt.Sprintf("%d", params.Amount*100))
-	// q.Add("vnp_BankCode", string(BankCodeVNPAYQR))
-	q.Add("vnp_CreateDate", formatTime(time.Now()))
-	q.Add("vnp_CurrCode", "VND")
-	q.Add("vnp_IpAddr", "192.168.1.1")
-	q.Add("vnp_Locale", "vn")
-	q.Add("vnp_OrderInfo", params.Info)
-	q.Add("vnp_OrderType", "billpayment")
-	q.Add("vnp_ReturnUrl", params.ReturnUrl)
-	q.Add("vnp_ExpireDate", formatTime(time.Now().Add(30*time.Minute)))
-	q.Add("vnp_TxnRef", fmt.Sprintf("%d", params.PaymentID))
-	// q.Add("vnp_SecureHashType", "HMACSHA512")
-
+	q := url.Values{}
+	q.Set("vnp_Version", "2.1.0")
+	q.Set("vnp_Command", "pay")
+	q.Set("vnp_TmnCode", c.tmnCode)
+	q.Set("vnp_Amount", fmt.Sprintf("%d", params.Amount*100))
+	if params.BankCode != "" {
+		q.Set("vnp_BankCode", params.BankCode)
+	}
+	q.Set("vnp_CreateDate", formatTime(params.CreateDate))
+	q.Set("vnp_CurrCode", c.currCode)
+	q.Set("vnp_IpAddr", ipAddr)
+	q.Set("vnp_Locale", locale)
+	q.Set("vnp_OrderInfo", params.Info)
+	q.Set("vnp_OrderType", "billpayment")
+	q.Set("vnp_ReturnUrl", params.ReturnUrl)
+	q.Set("vnp_ExpireDate", formatTime(params.CreateDate.Add(c.expireAfter)))
+	q.Set("vnp_TxnRef", fmt.Sprintf("%d", params.PaymentID))
+
+	// VNPay hashes the sorted, url encoded query without vnp_SecureHash, so sign exactly what we send
 	encodedQuery := q.Encode()
 	secureHash := sign(encodedQuery, []byte(c.hashSecret))
-	q.Add("vnp_SecureHash", secureHash)
 
-	return req.URL.String() + "?" + encodedQuery + "&vnp_SecureHash=" + secureHash, nil
+	return c.payUrl + "?" + encodedQuery + "&vnp_SecureHash=" + secureHash, nil
 }
 
 // type IPNReturn struct {
@@ -88,16 +162,12 @@ func (c *ClientImpl) VerifyPayment(ctx context.Context, ipn map[string]any) erro
 		return fmt.Errorf("missing or invalid vnp_SecureHash in IPN data")
 	}
 
-	// Remove the secure hash from the IPN data
+	// Remove the secure hash (and its type, if sent) from the IPN data
 	delete(ipn, "vnp_SecureHash")
+	delete(ipn, "vnp_SecureHashType")
 
-	hashData := buildSortedQuery(ipn)
-	fmt.Println("Hash data:", hashData)
-	hash := sign(hashData, []byte(c.hashSecret))
-
-	if hash != expectedHash {
-		fmt.Println("Hash mismatch:", expectedHash, hash)
-		return fmt.Errorf("hash mismatch: expected %s, got %s", expectedHash, hash)
+	if !verifySign(buildSortedQuery(ipn), expectedHash, []byte(c.hashSecret)) {
+		return fmt.Errorf("hash mismatch")
 	}
 
 	return nil
@@ -1225,6 +1225,8 @@ func (r iteratorForCreateDefaultInventoryStock) Values() ([]interface{}, error)
 	return []interface{}{
 		r.rows[0].RefType,
 		r.rows[0].RefID,
+		r.rows[0].Alert,
+		r.rows[0].DateAlerted,
 	}, nil
 }
 
@@ -1233,7 +1235,7 @@ func (r iteratorForCreateDefaultInventoryStock) Err() error {
 }
 
 func (q *Queries) CreateDefaultInventoryStock(ctx context.Context, arg []CreateDefaultInventoryStockParams) (int64, error) {
-	return q.db.CopyFrom(ctx, []string{"inventory", "stock"}, []string{"ref_type", "ref_id"}, &iteratorForCreateDefaultInventoryStock{rows: arg})
+	return q.db.CopyFrom(ctx, []string{"inventory", "stock"}, []string{"ref_type", "ref_id", "alert", "date_alerted"}, &iteratorForCreateDefaultInventoryStock{rows: arg})
 }
 
 // iteratorForCreateDefaultInventoryStockHistory implements pgx.CopyFromSource.
@@ -1258,6 +1260,8 @@ func (r iteratorForCreateDefaultInventoryStockHistory) Values() ([]interface{},
 	return []interface{}{
 		r.rows[0].StockID,
 		r.rows[0].Change,
+		r.rows[0].AccountID,
+		r.rows[0].Reason,
 	}, nil
 }
 
@@ -1266,7 +1270,43 @@ func (r iteratorForCreateDefaultInventoryStockHistory) Err() error {
 }
 
 func (q *Queries) CreateDefaultInventoryStockHistory(ctx context.Context, arg []CreateDefaultInventoryStockHistoryParams) (int64, error) {
-	return q.db.CopyFrom(ctx, []string{"inventory", "stock_history"}, []string{"stock_id", "change"}, &iteratorForCreateDefaultInventoryStockHistory{rows: arg})
+	return q.db.CopyFrom(ctx, []string{"inventory", "stock_history"}, []string{"stock_id", "change", "account_id", "reason"}, &iteratorForCreateDefaultInventoryStockHistory{rows: arg})
+}
+
+// iteratorForCreateDefaultInventoryStockReservation implements pgx.CopyFromSource.
+type iteratorForCreateDefaultInventoryStockReservation struct {
+	rows                 []CreateDefaultInventoryStockReservationParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCreateDefaultInventoryStockReservation) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCreateDefaultInventoryStockReservation) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].StockID,
+		r.rows[0].RefType,
+		r.rows[0].RefID,
+		r.rows[0].Quantity,
+		r.rows[0].DateExpired,
+	}, nil
+}
+
+func (r iteratorForCreateDefaultInventoryStockReservation) Err() error {
+	return nil
+}
+
+func (q *Queries) CreateDefaultInventoryStockReservation(ctx context.Context, arg []CreateDefaultInventoryStockReservationParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"inventory", "stock_reservation"}, []string{"stock_id", "ref_type", "ref_id", "quantity", "date_expired"}, &iteratorForCreateDefaultInventoryStockReservation{rows: arg})
 }
 
 // iteratorForCreateDefaultOrderBase implements pgx.CopyFromSource.
@@ -1413,6 +1453,9 @@ func (r iteratorForCreateDefaultOrderItem) Values() ([]interface{}, error) {
 		r.rows[0].OrderID,
 		r.rows[0].SkuID,
 		r.rows[0].Quantity,
+		r.rows[0].UnitPrice,
+		r.rows[0].Subtotal,
+		r.rows[0].Total,
 	}, nil
 }
 
@@ -1421,7 +1464,7 @@ func (r iteratorForCreateDefaultOrderItem) Err() error {
 }
 
 func (q *Queries) CreateDefaultOrderItem(ctx context.Context, arg []CreateDefaultOrderItemParams) (int64, error) {
-	return q.db.CopyFrom(ctx, []string{"order", "item"}, []string{"code", "order_id", "sku_id", "quantity"}, &iteratorForCreateDefaultOrderItem{rows: arg})
+	return q.db.CopyFrom(ctx, []string{"order", "item"}, []string{"code", "order_id", "sku_id", "quantity", "unit_price", "subtotal", "total"}, &iteratorForCreateDefaultOrderItem{rows: arg})
 }
 
 // iteratorForCreateDefaultOrderItemSerial implements pgx.CopyFromSource.
@@ -1554,6 +1597,7 @@ func (r iteratorForCreateDefaultOrderVnpay) Values() ([]interface{}, error) {
 		r.rows[0].ID,
 		r.rows[0].VnpAmount,
 		r.rows[0].VnpBankCode,
+		r.rows[0].VnpBankTranNo,
 		r.rows[0].VnpCardType,
 		r.rows[0].VnpOrderInfo,
 		r.rows[0].VnpPayDate,
@@ -1571,7 +1615,7 @@ func (r iteratorForCreateDefaultOrderVnpay) Err() error {
 }
 
 func (q *Queries) CreateDefaultOrderVnpay(ctx context.Context, arg []CreateDefaultOrderVnpayParams) (int64, error) {
-	return q.db.CopyFrom(ctx, []string{"order", "vnpay"}, []string{"id", "vnp_Amount", "vnp_BankCode", "vnp_CardType", "vnp_OrderInfo", "vnp_PayDate", "vnp_ResponseCode", "vnp_SecureHash", "vnp_TmnCode", "vnp_TransactionNo", "vnp_TransactionStatus", "vnp_TxnRef"}, &iteratorForCreateDefaultOrderVnpay{rows: arg})
+	return q.db.CopyFrom(ctx, []string{"order", "vnpay"}, []string{"id", "vnp_Amount", "vnp_BankCode", "vnp_BankTranNo", "vnp_CardType", "vnp_OrderInfo", "vnp_PayDate", "vnp_ResponseCode", "vnp_SecureHash", "vnp_TmnCode", "vnp_TransactionNo", "vnp_TransactionStatus", "vnp_TxnRef"}, &iteratorForCreateDefaultOrderVnpay{rows: arg})
 }
 
 // iteratorForCreateDefaultPromotionBase implements pgx.CopyFromSource.
@@ -1617,6 +1661,175 @@ func (q *Queries) CreateDefaultPromotionBase(ctx context.Context, arg []CreateDe
 	return q.db.CopyFrom(ctx, []string{"promotion", "base"}, []string{"code", "owner_id", "ref_type", "ref_id", "type", "title", "description", "date_ended", "schedule_tz", "schedule_start", "schedule_duration", "date_updated"}, &iteratorForCreateDefaultPromotionBase{rows: arg})
 }
 
+// iteratorForCreateDefaultPromotionBundle implements pgx.CopyFromSource.
+type iteratorForCreateDefaultPromotionBundle struct {
+	rows                 []CreateDefaultPromotionBundleParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCreateDefaultPromotionBundle) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCreateDefaultPromotionBundle) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].ID,
+		r.rows[0].Price,
+	}, nil
+}
+
+func (r iteratorForCreateDefaultPromotionBundle) Err() error {
+	return nil
+}
+
+func (q *Queries) CreateDefaultPromotionBundle(ctx context.Context, arg []CreateDefaultPromotionBundleParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"promotion", "bundle"}, []string{"id", "price"}, &iteratorForCreateDefaultPromotionBundle{rows: arg})
+}
+
+// iteratorForCreateDefaultPromotionBundleItem implements pgx.CopyFromSource.
+type iteratorForCreateDefaultPromotionBundleItem struct {
+	rows                 []CreateDefaultPromotionBundleItemParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCreateDefaultPromotionBundleItem) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCreateDefaultPromotionBundleItem) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].BundleID,
+		r.rows[0].SkuID,
+	}, nil
+}
+
+func (r iteratorForCreateDefaultPromotionBundleItem) Err() error {
+	return nil
+}
+
+func (q *Queries) CreateDefaultPromotionBundleItem(ctx context.Context, arg []CreateDefaultPromotionBundleItemParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"promotion", "bundle_item"}, []string{"bundle_id", "sku_id"}, &iteratorForCreateDefaultPromotionBundleItem{rows: arg})
+}
+
+// iteratorForCreateDefaultPromotionBuyXGetY implements pgx.CopyFromSource.
+type iteratorForCreateDefaultPromotionBuyXGetY struct {
+	rows                 []CreateDefaultPromotionBuyXGetYParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCreateDefaultPromotionBuyXGetY) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCreateDefaultPromotionBuyXGetY) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].ID,
+		r.rows[0].BuyQuantity,
+		r.rows[0].GetQuantity,
+	}, nil
+}
+
+func (r iteratorForCreateDefaultPromotionBuyXGetY) Err() error {
+	return nil
+}
+
+func (q *Queries) CreateDefaultPromotionBuyXGetY(ctx context.Context, arg []CreateDefaultPromotionBuyXGetYParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"promotion", "buy_x_get_y"}, []string{"id", "buy_quantity", "get_quantity"}, &iteratorForCreateDefaultPromotionBuyXGetY{rows: arg})
+}
+
+// iteratorForCreateDefaultPromotionCashback implements pgx.CopyFromSource.
+type iteratorForCreateDefaultPromotionCashback struct {
+	rows                 []CreateDefaultPromotionCashbackParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCreateDefaultPromotionCashback) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCreateDefaultPromotionCashback) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].ID,
+		r.rows[0].CashbackPercent,
+		r.rows[0].CashbackPrice,
+	}, nil
+}
+
+func (r iteratorForCreateDefaultPromotionCashback) Err() error {
+	return nil
+}
+
+func (q *Queries) CreateDefaultPromotionCashback(ctx context.Context, arg []CreateDefaultPromotionCashbackParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"promotion", "cashback"}, []string{"id", "cashback_percent", "cashback_price"}, &iteratorForCreateDefaultPromotionCashback{rows: arg})
+}
+
+// iteratorForCreateDefaultPromotionCashbackCredit implements pgx.CopyFromSource.
+type iteratorForCreateDefaultPromotionCashbackCredit struct {
+	rows                 []CreateDefaultPromotionCashbackCreditParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCreateDefaultPromotionCashbackCredit) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCreateDefaultPromotionCashbackCredit) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].AccountID,
+		r.rows[0].OrderID,
+		r.rows[0].PromotionID,
+		r.rows[0].Amount,
+	}, nil
+}
+
+func (r iteratorForCreateDefaultPromotionCashbackCredit) Err() error {
+	return nil
+}
+
+func (q *Queries) CreateDefaultPromotionCashbackCredit(ctx context.Context, arg []CreateDefaultPromotionCashbackCreditParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"promotion", "cashback_credit"}, []string{"account_id", "order_id", "promotion_id", "amount"}, &iteratorForCreateDefaultPromotionCashbackCredit{rows: arg})
+}
+
 // iteratorForCreateDefaultPromotionDiscount implements pgx.CopyFromSource.
 type iteratorForCreateDefaultPromotionDiscount struct {
 	rows                 []CreateDefaultPromotionDiscountParams
@@ -1652,6 +1865,72 @@ func (q *Queries) CreateDefaultPromotionDiscount(ctx context.Context, arg []Crea
 	return q.db.CopyFrom(ctx, []string{"promotion", "discount"}, []string{"id", "order_wide", "discount_percent", "discount_price"}, &iteratorForCreateDefaultPromotionDiscount{rows: arg})
 }
 
+// iteratorForCreateDefaultPromotionVoucher implements pgx.CopyFromSource.
+type iteratorForCreateDefaultPromotionVoucher struct {
+	rows                 []int64
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCreateDefaultPromotionVoucher) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCreateDefaultPromotionVoucher) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0],
+	}, nil
+}
+
+func (r iteratorForCreateDefaultPromotionVoucher) Err() error {
+	return nil
+}
+
+func (q *Queries) CreateDefaultPromotionVoucher(ctx context.Context, id []int64) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"promotion", "voucher"}, []string{"id"}, &iteratorForCreateDefaultPromotionVoucher{rows: id})
+}
+
+// iteratorForCreateDefaultPromotionVoucherRedemption implements pgx.CopyFromSource.
+type iteratorForCreateDefaultPromotionVoucherRedemption struct {
+	rows                 []CreateDefaultPromotionVoucherRedemptionParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCreateDefaultPromotionVoucherRedemption) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCreateDefaultPromotionVoucherRedemption) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].VoucherID,
+		r.rows[0].AccountID,
+		r.rows[0].OrderID,
+	}, nil
+}
+
+func (r iteratorForCreateDefaultPromotionVoucherRedemption) Err() error {
+	return nil
+}
+
+func (q *Queries) CreateDefaultPromotionVoucherRedemption(ctx context.Context, arg []CreateDefaultPromotionVoucherRedemptionParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"promotion", "voucher_redemption"}, []string{"voucher_id", "account_id", "order_id"}, &iteratorForCreateDefaultPromotionVoucherRedemption{rows: arg})
+}
+
 // iteratorForCreateDefaultSharedResource implements pgx.CopyFromSource.
 type iteratorForCreateDefaultSharedResource struct {
 	rows                 []CreateDefaultSharedResourceParams
@@ -1816,6 +2095,9 @@ func (r iteratorForCreateInventoryStock) Values() ([]interface{}, error) {
 		r.rows[0].RefID,
 		r.rows[0].CurrentStock,
 		r.rows[0].Sold,
+		r.rows[0].LowStockThreshold,
+		r.rows[0].Alert,
+		r.rows[0].DateAlerted,
 		r.rows[0].DateCreated,
 	}, nil
 }
@@ -1825,7 +2107,7 @@ func (r iteratorForCreateInventoryStock) Err() error {
 }
 
 func (q *Queries) CreateInventoryStock(ctx context.Context, arg []CreateInventoryStockParams) (int64, error) {
-	return q.db.CopyFrom(ctx, []string{"inventory", "stock"}, []string{"ref_type", "ref_id", "current_stock", "sold", "date_created"}, &iteratorForCreateInventoryStock{rows: arg})
+	return q.db.CopyFrom(ctx, []string{"inventory", "stock"}, []string{"ref_type", "ref_id", "current_stock", "sold", "low_stock_threshold", "alert", "date_alerted", "date_created"}, &iteratorForCreateInventoryStock{rows: arg})
 }
 
 // iteratorForCreateInventoryStockHistory implements pgx.CopyFromSource.
@@ -1850,6 +2132,8 @@ func (r iteratorForCreateInventoryStockHistory) Values() ([]interface{}, error)
 	return []interface{}{
 		r.rows[0].StockID,
 		r.rows[0].Change,
+		r.rows[0].AccountID,
+		r.rows[0].Reason,
 		r.rows[0].DateCreated,
 	}, nil
 }
@@ -1859,7 +2143,46 @@ func (r iteratorForCreateInventoryStockHistory) Err() error {
 }
 
 func (q *Queries) CreateInventoryStockHistory(ctx context.Context, arg []CreateInventoryStockHistoryParams) (int64, error) {
-	return q.db.CopyFrom(ctx, []string{"inventory", "stock_history"}, []string{"stock_id", "change", "date_created"}, &iteratorForCreateInventoryStockHistory{rows: arg})
+	return q.db.CopyFrom(ctx, []string{"inventory", "stock_history"}, []string{"stock_id", "change", "account_id", "reason", "date_created"}, &iteratorForCreateInventoryStockHistory{rows: arg})
+}
+
+// iteratorForCreateInventoryStockReservation implements pgx.CopyFromSource.
+type iteratorForCreateInventoryStockReservation struct {
+	rows                 []CreateInventoryStockReservationParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCreateInventoryStockReservation) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCreateInventoryStockReservation) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].StockID,
+		r.rows[0].RefType,
+		r.rows[0].RefID,
+		r.rows[0].Quantity,
+		r.rows[0].Status,
+		r.rows[0].DateExpired,
+		r.rows[0].DateCreated,
+		r.rows[0].DateUpdated,
+	}, nil
+}
+
+func (r iteratorForCreateInventoryStockReservation) Err() error {
+	return nil
+}
+
+func (q *Queries) CreateInventoryStockReservation(ctx context.Context, arg []CreateInventoryStockReservationParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"inventory", "stock_reservation"}, []string{"stock_id", "ref_type", "ref_id", "quantity", "status", "date_expired", "date_created", "date_updated"}, &iteratorForCreateInventoryStockReservation{rows: arg})
 }
 
 // iteratorForCreateOrderBase implements pgx.CopyFromSource.
@@ -2008,6 +2331,9 @@ func (r iteratorForCreateOrderItem) Values() ([]interface{}, error) {
 		r.rows[0].OrderID,
 		r.rows[0].SkuID,
 		r.rows[0].Quantity,
+		r.rows[0].UnitPrice,
+		r.rows[0].Subtotal,
+		r.rows[0].Total,
 	}, nil
 }
 
@@ -2016,7 +2342,7 @@ func (r iteratorForCreateOrderItem) Err() error {
 }
 
 func (q *Queries) CreateOrderItem(ctx context.Context, arg []CreateOrderItemParams) (int64, error) {
-	return q.db.CopyFrom(ctx, []string{"order", "item"}, []string{"code", "order_id", "sku_id", "quantity"}, &iteratorForCreateOrderItem{rows: arg})
+	return q.db.CopyFrom(ctx, []string{"order", "item"}, []string{"code", "order_id", "sku_id", "quantity", "unit_price", "subtotal", "total"}, &iteratorForCreateOrderItem{rows: arg})
 }
 
 // iteratorForCreateOrderItemSerial implements pgx.CopyFromSource.
@@ -2152,6 +2478,7 @@ func (r iteratorForCreateOrderVnpay) Values() ([]interface{}, error) {
 		r.rows[0].ID,
 		r.rows[0].VnpAmount,
 		r.rows[0].VnpBankCode,
+		r.rows[0].VnpBankTranNo,
 		r.rows[0].VnpCardType,
 		r.rows[0].VnpOrderInfo,
 		r.rows[0].VnpPayDate,
@@ -2169,7 +2496,7 @@ func (r iteratorForCreateOrderVnpay) Err() error {
 }
 
 func (q *Queries) CreateOrderVnpay(ctx context.Context, arg []CreateOrderVnpayParams) (int64, error) {
-	return q.db.CopyFrom(ctx, []string{"order", "vnpay"}, []string{"id", "vnp_Amount", "vnp_BankCode", "vnp_CardType", "vnp_OrderInfo", "vnp_PayDate", "vnp_ResponseCode", "vnp_SecureHash", "vnp_TmnCode", "vnp_TransactionNo", "vnp_TransactionStatus", "vnp_TxnRef"}, &iteratorForCreateOrderVnpay{rows: arg})
+	return q.db.CopyFrom(ctx, []string{"order", "vnpay"}, []string{"id", "vnp_Amount", "vnp_BankCode", "vnp_BankTranNo", "vnp_CardType", "vnp_OrderInfo", "vnp_PayDate", "vnp_ResponseCode", "vnp_SecureHash", "vnp_TmnCode", "vnp_TransactionNo", "vnp_TransactionStatus", "vnp_TxnRef"}, &iteratorForCreateOrderVnpay{rows: arg})
 }
 
 // iteratorForCreatePromotionBase implements pgx.CopyFromSource.
@@ -2200,6 +2527,7 @@ func (r iteratorForCreatePromotionBase) Values() ([]interface{}, error) {
 		r.rows[0].Title,
 		r.rows[0].Description,
 		r.rows[0].IsActive,
+		r.rows[0].Exclusive,
 		r.rows[0].DateStarted,
 		r.rows[0].DateEnded,
 		r.rows[0].ScheduleTz,
@@ -2215,7 +2543,182 @@ func (r iteratorForCreatePromotionBase) Err() error {
 }
 
 func (q *Queries) CreatePromotionBase(ctx context.Context, arg []CreatePromotionBaseParams) (int64, error) {
-	return q.db.CopyFrom(ctx, []string{"promotion", "base"}, []string{"code", "owner_id", "ref_type", "ref_id", "type", "title", "description", "is_active", "date_started", "date_ended", "schedule_tz", "schedule_start", "schedule_duration", "date_created", "date_updated"}, &iteratorForCreatePromotionBase{rows: arg})
+	return q.db.CopyFrom(ctx, []string{"promotion", "base"}, []string{"code", "owner_id", "ref_type", "ref_id", "type", "title", "description", "is_active", "exclusive", "date_started", "date_ended", "schedule_tz", "schedule_start", "schedule_duration", "date_created", "date_updated"}, &iteratorForCreatePromotionBase{rows: arg})
+}
+
+// iteratorForCreatePromotionBundle implements pgx.CopyFromSource.
+type iteratorForCreatePromotionBundle struct {
+	rows                 []CreatePromotionBundleParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCreatePromotionBundle) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCreatePromotionBundle) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].ID,
+		r.rows[0].Price,
+	}, nil
+}
+
+func (r iteratorForCreatePromotionBundle) Err() error {
+	return nil
+}
+
+func (q *Queries) CreatePromotionBundle(ctx context.Context, arg []CreatePromotionBundleParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"promotion", "bundle"}, []string{"id", "price"}, &iteratorForCreatePromotionBundle{rows: arg})
+}
+
+// iteratorForCreatePromotionBundleItem implements pgx.CopyFromSource.
+type iteratorForCreatePromotionBundleItem struct {
+	rows                 []CreatePromotionBundleItemParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCreatePromotionBundleItem) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCreatePromotionBundleItem) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].BundleID,
+		r.rows[0].SkuID,
+		r.rows[0].Quantity,
+	}, nil
+}
+
+func (r iteratorForCreatePromotionBundleItem) Err() error {
+	return nil
+}
+
+func (q *Queries) CreatePromotionBundleItem(ctx context.Context, arg []CreatePromotionBundleItemParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"promotion", "bundle_item"}, []string{"bundle_id", "sku_id", "quantity"}, &iteratorForCreatePromotionBundleItem{rows: arg})
+}
+
+// iteratorForCreatePromotionBuyXGetY implements pgx.CopyFromSource.
+type iteratorForCreatePromotionBuyXGetY struct {
+	rows                 []CreatePromotionBuyXGetYParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCreatePromotionBuyXGetY) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCreatePromotionBuyXGetY) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].ID,
+		r.rows[0].BuyQuantity,
+		r.rows[0].GetQuantity,
+	}, nil
+}
+
+func (r iteratorForCreatePromotionBuyXGetY) Err() error {
+	return nil
+}
+
+func (q *Queries) CreatePromotionBuyXGetY(ctx context.Context, arg []CreatePromotionBuyXGetYParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"promotion", "buy_x_get_y"}, []string{"id", "buy_quantity", "get_quantity"}, &iteratorForCreatePromotionBuyXGetY{rows: arg})
+}
+
+// iteratorForCreatePromotionCashback implements pgx.CopyFromSource.
+type iteratorForCreatePromotionCashback struct {
+	rows                 []CreatePromotionCashbackParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCreatePromotionCashback) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCreatePromotionCashback) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].ID,
+		r.rows[0].MinSpend,
+		r.rows[0].MaxCashback,
+		r.rows[0].CashbackPercent,
+		r.rows[0].CashbackPrice,
+	}, nil
+}
+
+func (r iteratorForCreatePromotionCashback) Err() error {
+	return nil
+}
+
+func (q *Queries) CreatePromotionCashback(ctx context.Context, arg []CreatePromotionCashbackParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"promotion", "cashback"}, []string{"id", "min_spend", "max_cashback", "cashback_percent", "cashback_price"}, &iteratorForCreatePromotionCashback{rows: arg})
+}
+
+// iteratorForCreatePromotionCashbackCredit implements pgx.CopyFromSource.
+type iteratorForCreatePromotionCashbackCredit struct {
+	rows                 []CreatePromotionCashbackCreditParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCreatePromotionCashbackCredit) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCreatePromotionCashbackCredit) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].AccountID,
+		r.rows[0].OrderID,
+		r.rows[0].PromotionID,
+		r.rows[0].Amount,
+		r.rows[0].Status,
+		r.rows[0].DateCreated,
+		r.rows[0].DateUpdated,
+	}, nil
+}
+
+func (r iteratorForCreatePromotionCashbackCredit) Err() error {
+	return nil
+}
+
+func (q *Queries) CreatePromotionCashbackCredit(ctx context.Context, arg []CreatePromotionCashbackCreditParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"promotion", "cashback_credit"}, []string{"account_id", "order_id", "promotion_id", "amount", "status", "date_created", "date_updated"}, &iteratorForCreatePromotionCashbackCredit{rows: arg})
 }
 
 // iteratorForCreatePromotionDiscount implements pgx.CopyFromSource.
@@ -2255,6 +2758,80 @@ func (q *Queries) CreatePromotionDiscount(ctx context.Context, arg []CreatePromo
 	return q.db.CopyFrom(ctx, []string{"promotion", "discount"}, []string{"id", "order_wide", "min_spend", "max_discount", "discount_percent", "discount_price"}, &iteratorForCreatePromotionDiscount{rows: arg})
 }
 
+// iteratorForCreatePromotionVoucher implements pgx.CopyFromSource.
+type iteratorForCreatePromotionVoucher struct {
+	rows                 []CreatePromotionVoucherParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCreatePromotionVoucher) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCreatePromotionVoucher) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].ID,
+		r.rows[0].UsageLimit,
+		r.rows[0].PerCustomerLimit,
+		r.rows[0].FirstOrderOnly,
+		r.rows[0].MinSpend,
+		r.rows[0].Used,
+	}, nil
+}
+
+func (r iteratorForCreatePromotionVoucher) Err() error {
+	return nil
+}
+
+func (q *Queries) CreatePromotionVoucher(ctx context.Context, arg []CreatePromotionVoucherParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"promotion", "voucher"}, []string{"id", "usage_limit", "per_customer_limit", "first_order_only", "min_spend", "used"}, &iteratorForCreatePromotionVoucher{rows: arg})
+}
+
+// iteratorForCreatePromotionVoucherRedemption implements pgx.CopyFromSource.
+type iteratorForCreatePromotionVoucherRedemption struct {
+	rows                 []CreatePromotionVoucherRedemptionParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCreatePromotionVoucherRedemption) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCreatePromotionVoucherRedemption) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].VoucherID,
+		r.rows[0].AccountID,
+		r.rows[0].OrderID,
+		r.rows[0].Status,
+		r.rows[0].DateCreated,
+		r.rows[0].DateUpdated,
+	}, nil
+}
+
+func (r iteratorForCreatePromotionVoucherRedemption) Err() error {
+	return nil
+}
+
+func (q *Queries) CreatePromotionVoucherRedemption(ctx context.Context, arg []CreatePromotionVoucherRedemptionParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"promotion", "voucher_redemption"}, []string{"voucher_id", "account_id", "order_id", "status", "date_created", "date_updated"}, &iteratorForCreatePromotionVoucherRedemption{rows: arg})
+}
+
 // iteratorForCreateSharedResource implements pgx.CopyFromSource.
 type iteratorForCreateSharedResource struct {
 	rows                 []CreateSharedResourceParams
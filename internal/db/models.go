@@ -368,6 +368,122 @@ func AllInventoryProductStatusValues() []InventoryProductStatus {
 	}
 }
 
+type InventoryReservationType string
+
+const (
+	InventoryReservationTypeCart  InventoryReservationType = "Cart"
+	InventoryReservationTypeOrder InventoryReservationType = "Order"
+)
+
+func (e *InventoryReservationType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = InventoryReservationType(s)
+	case string:
+		*e = InventoryReservationType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for InventoryReservationType: %T", src)
+	}
+	return nil
+}
+
+type NullInventoryReservationType struct {
+	InventoryReservationType InventoryReservationType `json:"inventory_reservation_type"`
+	Valid                    bool                     `json:"valid"` // Valid is true if InventoryReservationType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullInventoryReservationType) Scan(value interface{}) error {
+	if value == nil {
+		ns.InventoryReservationType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.InventoryReservationType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullInventoryReservationType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.InventoryReservationType), nil
+}
+
+func (e InventoryReservationType) Valid() bool {
+	switch e {
+	case InventoryReservationTypeCart,
+		InventoryReservationTypeOrder:
+		return true
+	}
+	return false
+}
+
+func AllInventoryReservationTypeValues() []InventoryReservationType {
+	return []InventoryReservationType{
+		InventoryReservationTypeCart,
+		InventoryReservationTypeOrder,
+	}
+}
+
+type InventoryStockAlert string
+
+const (
+	InventoryStockAlertLowStock   InventoryStockAlert = "LowStock"
+	InventoryStockAlertOutOfStock InventoryStockAlert = "OutOfStock"
+)
+
+func (e *InventoryStockAlert) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = InventoryStockAlert(s)
+	case string:
+		*e = InventoryStockAlert(s)
+	default:
+		return fmt.Errorf("unsupported scan type for InventoryStockAlert: %T", src)
+	}
+	return nil
+}
+
+type NullInventoryStockAlert struct {
+	InventoryStockAlert InventoryStockAlert `json:"inventory_stock_alert"`
+	Valid               bool                `json:"valid"` // Valid is true if InventoryStockAlert is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullInventoryStockAlert) Scan(value interface{}) error {
+	if value == nil {
+		ns.InventoryStockAlert, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.InventoryStockAlert.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullInventoryStockAlert) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.InventoryStockAlert), nil
+}
+
+func (e InventoryStockAlert) Valid() bool {
+	switch e {
+	case InventoryStockAlertLowStock,
+		InventoryStockAlertOutOfStock:
+		return true
+	}
+	return false
+}
+
+func AllInventoryStockAlertValues() []InventoryStockAlert {
+	return []InventoryStockAlert{
+		InventoryStockAlertLowStock,
+		InventoryStockAlertOutOfStock,
+	}
+}
+
 type InventoryStockType string
 
 const (
@@ -675,6 +791,7 @@ const (
 	PromotionRefTypeProductSku PromotionRefType = "ProductSku"
 	PromotionRefTypeCategory   PromotionRefType = "Category"
 	PromotionRefTypeBrand      PromotionRefType = "Brand"
+	PromotionRefTypeTag        PromotionRefType = "Tag"
 )
 
 func (e *PromotionRefType) Scan(src interface{}) error {
@@ -718,7 +835,8 @@ func (e PromotionRefType) Valid() bool {
 		PromotionRefTypeProductSpu,
 		PromotionRefTypeProductSku,
 		PromotionRefTypeCategory,
-		PromotionRefTypeBrand:
+		PromotionRefTypeBrand,
+		PromotionRefTypeTag:
 		return true
 	}
 	return false
@@ -731,6 +849,7 @@ func AllPromotionRefTypeValues() []PromotionRefType {
 		PromotionRefTypeProductSku,
 		PromotionRefTypeCategory,
 		PromotionRefTypeBrand,
+		PromotionRefTypeTag,
 	}
 }
 
@@ -1167,21 +1286,38 @@ type InventorySkuSerial struct {
 }
 
 type InventoryStock struct {
-	ID           int64              `json:"id"`
-	RefType      InventoryStockType `json:"ref_type"`
-	RefID        int64              `json:"ref_id"`
-	CurrentStock int64              `json:"current_stock"`
-	Sold         int64              `json:"sold"`
-	DateCreated  pgtype.Timestamptz `json:"date_created"`
+	ID                int64                   `json:"id"`
+	RefType           InventoryStockType      `json:"ref_type"`
+	RefID             int64                   `json:"ref_id"`
+	CurrentStock      int64                   `json:"current_stock"`
+	Sold              int64                   `json:"sold"`
+	LowStockThreshold int64                   `json:"low_stock_threshold"`
+	Alert             NullInventoryStockAlert `json:"alert"`
+	DateAlerted       pgtype.Timestamptz      `json:"date_alerted"`
+	DateCreated       pgtype.Timestamptz      `json:"date_created"`
 }
 
 type InventoryStockHistory struct {
 	ID          int64              `json:"id"`
 	StockID     int64              `json:"stock_id"`
 	Change      int64              `json:"change"`
+	AccountID   pgtype.Int8        `json:"account_id"`
+	Reason      pgtype.Text        `json:"reason"`
 	DateCreated pgtype.Timestamptz `json:"date_created"`
 }
 
+type InventoryStockReservation struct {
+	ID          int64                    `json:"id"`
+	StockID     int64                    `json:"stock_id"`
+	RefType     InventoryReservationType `json:"ref_type"`
+	RefID       int64                    `json:"ref_id"`
+	Quantity    int64                    `json:"quantity"`
+	Status      SharedStatus             `json:"status"`
+	DateExpired pgtype.Timestamptz       `json:"date_expired"`
+	DateCreated pgtype.Timestamptz       `json:"date_created"`
+	DateUpdated pgtype.Timestamptz       `json:"date_updated"`
+}
+
 type OrderBase struct {
 	ID            int64              `json:"id"`
 	Code          string             `json:"code"`
@@ -1224,11 +1360,14 @@ type OrderInvoiceItem struct {
 }
 
 type OrderItem struct {
-	ID       int64  `json:"id"`
-	Code     string `json:"code"`
-	OrderID  int64  `json:"order_id"`
-	SkuID    int64  `json:"sku_id"`
-	Quantity int64  `json:"quantity"`
+	ID        int64  `json:"id"`
+	Code      string `json:"code"`
+	OrderID   int64  `json:"order_id"`
+	SkuID     int64  `json:"sku_id"`
+	Quantity  int64  `json:"quantity"`
+	UnitPrice int64  `json:"unit_price"`
+	Subtotal  int64  `json:"subtotal"`
+	Total     int64  `json:"total"`
 }
 
 type OrderItemSerial struct {
@@ -1261,18 +1400,19 @@ type OrderRefundDispute struct {
 }
 
 type OrderVnpay struct {
-	ID                   int64  `json:"id"`
-	VnpAmount            string `json:"vnp_Amount"`
-	VnpBankCode          string `json:"vnp_BankCode"`
-	VnpCardType          string `json:"vnp_CardType"`
-	VnpOrderInfo         string `json:"vnp_OrderInfo"`
-	VnpPayDate           string `json:"vnp_PayDate"`
-	VnpResponseCode      string `json:"vnp_ResponseCode"`
-	VnpSecureHash        string `json:"vnp_SecureHash"`
-	VnpTmnCode           string `json:"vnp_TmnCode"`
-	VnpTransactionNo     string `json:"vnp_TransactionNo"`
-	VnpTransactionStatus string `json:"vnp_TransactionStatus"`
-	VnpTxnRef            string `json:"vnp_TxnRef"`
+	ID                   int64       `json:"id"`
+	VnpAmount            string      `json:"vnp_Amount"`
+	VnpBankCode          string      `json:"vnp_BankCode"`
+	VnpBankTranNo        pgtype.Text `json:"vnp_BankTranNo"`
+	VnpCardType          string      `json:"vnp_CardType"`
+	VnpOrderInfo         string      `json:"vnp_OrderInfo"`
+	VnpPayDate           string      `json:"vnp_PayDate"`
+	VnpResponseCode      string      `json:"vnp_ResponseCode"`
+	VnpSecureHash        string      `json:"vnp_SecureHash"`
+	VnpTmnCode           string      `json:"vnp_TmnCode"`
+	VnpTransactionNo     string      `json:"vnp_TransactionNo"`
+	VnpTransactionStatus string      `json:"vnp_TransactionStatus"`
+	VnpTxnRef            string      `json:"vnp_TxnRef"`
 }
 
 type PromotionBase struct {
@@ -1285,15 +1425,53 @@ type PromotionBase struct {
 	Title            string             `json:"title"`
 	Description      pgtype.Text        `json:"description"`
 	IsActive         bool               `json:"is_active"`
+	Exclusive        bool               `json:"exclusive"`
 	DateStarted      pgtype.Timestamptz `json:"date_started"`
 	DateEnded        pgtype.Timestamptz `json:"date_ended"`
 	ScheduleTz       pgtype.Text        `json:"schedule_tz"`
-	ScheduleStart    pgtype.Timestamptz `json:"schedule_start"`
+	ScheduleStart    pgtype.Text        `json:"schedule_start"`
 	ScheduleDuration pgtype.Int4        `json:"schedule_duration"`
 	DateCreated      pgtype.Timestamptz `json:"date_created"`
 	DateUpdated      pgtype.Timestamptz `json:"date_updated"`
 }
 
+type PromotionBundle struct {
+	ID    int64 `json:"id"`
+	Price int64 `json:"price"`
+}
+
+type PromotionBundleItem struct {
+	ID       int64 `json:"id"`
+	BundleID int64 `json:"bundle_id"`
+	SkuID    int64 `json:"sku_id"`
+	Quantity int64 `json:"quantity"`
+}
+
+type PromotionBuyXGetY struct {
+	ID          int64 `json:"id"`
+	BuyQuantity int64 `json:"buy_quantity"`
+	GetQuantity int64 `json:"get_quantity"`
+}
+
+type PromotionCashback struct {
+	ID              int64       `json:"id"`
+	MinSpend        int64       `json:"min_spend"`
+	MaxCashback     int64       `json:"max_cashback"`
+	CashbackPercent pgtype.Int4 `json:"cashback_percent"`
+	CashbackPrice   pgtype.Int8 `json:"cashback_price"`
+}
+
+type PromotionCashbackCredit struct {
+	ID          int64              `json:"id"`
+	AccountID   int64              `json:"account_id"`
+	OrderID     int64              `json:"order_id"`
+	PromotionID int64              `json:"promotion_id"`
+	Amount      int64              `json:"amount"`
+	Status      SharedStatus       `json:"status"`
+	DateCreated pgtype.Timestamptz `json:"date_created"`
+	DateUpdated pgtype.Timestamptz `json:"date_updated"`
+}
+
 type PromotionDiscount struct {
 	ID              int64       `json:"id"`
 	OrderWide       bool        `json:"order_wide"`
@@ -1303,6 +1481,25 @@ type PromotionDiscount struct {
 	DiscountPrice   pgtype.Int8 `json:"discount_price"`
 }
 
+type PromotionVoucher struct {
+	ID               int64 `json:"id"`
+	UsageLimit       int64 `json:"usage_limit"`
+	PerCustomerLimit int64 `json:"per_customer_limit"`
+	FirstOrderOnly   bool  `json:"first_order_only"`
+	MinSpend         int64 `json:"min_spend"`
+	Used             int64 `json:"used"`
+}
+
+type PromotionVoucherRedemption struct {
+	ID          int64              `json:"id"`
+	VoucherID   int64              `json:"voucher_id"`
+	AccountID   int64              `json:"account_id"`
+	OrderID     int64              `json:"order_id"`
+	Status      SharedStatus       `json:"status"`
+	DateCreated pgtype.Timestamptz `json:"date_created"`
+	DateUpdated pgtype.Timestamptz `json:"date_updated"`
+}
+
 type SharedResource struct {
 	ID        int64              `json:"id"`
 	MimeType  string             `json:"mime_type"`
@@ -7,6 +7,8 @@ package db
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const existsCartItems = `-- name: ExistsCartItems :one
@@ -21,3 +23,89 @@ func (q *Queries) ExistsCartItems(ctx context.Context, skuIds []int64) (bool, er
 	err := row.Scan(&exists)
 	return exists, err
 }
+
+const getOrderItemForUpdate = `-- name: GetOrderItemForUpdate :one
+SELECT id, code, order_id, sku_id, quantity
+FROM "order"."item"
+WHERE "id" = $1
+FOR UPDATE
+`
+
+// Locks the order item until the end of the transaction, so the refunds of the item are approved one at a time
+func (q *Queries) GetOrderItemForUpdate(ctx context.Context, id int64) (OrderItem, error) {
+	row := q.db.QueryRow(ctx, getOrderItemForUpdate, id)
+	var i OrderItem
+	err := row.Scan(
+		&i.ID,
+		&i.Code,
+		&i.OrderID,
+		&i.SkuID,
+		&i.Quantity,
+	)
+	return i, err
+}
+
+const updateOrderBaseStatus = `-- name: UpdateOrderBaseStatus :one
+UPDATE "order"."base"
+SET "status" = $1, "date_updated" = NOW()
+WHERE "id" = $2 AND "status" = $3
+RETURNING id, code, customer_id, payment_method, status, address, date_created, date_updated
+`
+
+type UpdateOrderBaseStatusParams struct {
+	NewStatus SharedStatus `json:"new_status"`
+	ID        int64        `json:"id"`
+	OldStatus SharedStatus `json:"old_status"`
+}
+
+func (q *Queries) UpdateOrderBaseStatus(ctx context.Context, arg UpdateOrderBaseStatusParams) (OrderBase, error) {
+	row := q.db.QueryRow(ctx, updateOrderBaseStatus, arg.NewStatus, arg.ID, arg.OldStatus)
+	var i OrderBase
+	err := row.Scan(
+		&i.ID,
+		&i.Code,
+		&i.CustomerID,
+		&i.PaymentMethod,
+		&i.Status,
+		&i.Address,
+		&i.DateCreated,
+		&i.DateUpdated,
+	)
+	return i, err
+}
+
+const updateOrderRefundStatus = `-- name: UpdateOrderRefundStatus :one
+UPDATE "order"."refund"
+SET "status" = $1, "reviewed_by_id" = $2
+WHERE "id" = $3 AND "status" = $4
+RETURNING id, code, order_item_id, reviewed_by_id, method, status, reason, address, date_created
+`
+
+type UpdateOrderRefundStatusParams struct {
+	NewStatus    SharedStatus `json:"new_status"`
+	ReviewedByID pgtype.Int8  `json:"reviewed_by_id"`
+	ID           int64        `json:"id"`
+	OldStatus    SharedStatus `json:"old_status"`
+}
+
+func (q *Queries) UpdateOrderRefundStatus(ctx context.Context, arg UpdateOrderRefundStatusParams) (OrderRefund, error) {
+	row := q.db.QueryRow(ctx, updateOrderRefundStatus,
+		arg.NewStatus,
+		arg.ReviewedByID,
+		arg.ID,
+		arg.OldStatus,
+	)
+	var i OrderRefund
+	err := row.Scan(
+		&i.ID,
+		&i.Code,
+		&i.OrderItemID,
+		&i.ReviewedByID,
+		&i.Method,
+		&i.Status,
+		&i.Reason,
+		&i.Address,
+		&i.DateCreated,
+	)
+	return i, err
+}
@@ -11,20 +11,75 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const cancelVoucherRedemption = `-- name: CancelVoucherRedemption :many
+UPDATE "promotion"."voucher_redemption"
+SET "status" = 'Canceled', "date_updated" = NOW()
+WHERE "order_id" = $1 AND "status" <> 'Canceled'
+RETURNING id, voucher_id, account_id, order_id, status, date_created, date_updated
+`
+
+func (q *Queries) CancelVoucherRedemption(ctx context.Context, orderID int64) ([]PromotionVoucherRedemption, error) {
+	rows, err := q.db.Query(ctx, cancelVoucherRedemption, orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PromotionVoucherRedemption{}
+	for rows.Next() {
+		var i PromotionVoucherRedemption
+		if err := rows.Scan(
+			&i.ID,
+			&i.VoucherID,
+			&i.AccountID,
+			&i.OrderID,
+			&i.Status,
+			&i.DateCreated,
+			&i.DateUpdated,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countOwnerPromotion = `-- name: CountOwnerPromotion :one
+SELECT COUNT(*)
+FROM "promotion"."base"
+WHERE "owner_id" IS NOT DISTINCT FROM $1
+  AND ("is_active" = ANY($2) OR $2 IS NULL)
+`
+
+type CountOwnerPromotionParams struct {
+	OwnerID  pgtype.Int8 `json:"owner_id"`
+	IsActive []bool      `json:"is_active"`
+}
+
+func (q *Queries) CountOwnerPromotion(ctx context.Context, arg CountOwnerPromotionParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countOwnerPromotion, arg.OwnerID, arg.IsActive)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const listActivePromotion = `-- name: ListActivePromotion :many
-SELECT id, code, owner_id, ref_type, ref_id, type, title, description, is_active, date_started, date_ended, schedule_tz, schedule_start, schedule_duration, date_created, date_updated
+SELECT id, code, owner_id, ref_type, ref_id, type, title, description, is_active, exclusive, date_started, date_ended, schedule_tz, schedule_start, schedule_duration, date_created, date_updated
 FROM promotion.base
 WHERE is_active = true
+  AND date_started <= NOW()
   AND (date_ended IS NULL OR date_ended > NOW())
-  AND ("ref_type" = ($1) OR $1 IS NULL)
+  AND ("ref_type" = ANY($1) OR $1 IS NULL)
   AND ("ref_id" = ANY($2) OR $2 IS NULL)
   AND ("type" = ANY($3) OR $3 IS NULL)
 `
 
 type ListActivePromotionParams struct {
-	RefType []NullPromotionRefType `json:"ref_type"`
-	RefID   pgtype.Int8            `json:"ref_id"`
-	Type    []PromotionType        `json:"type"`
+	RefType []PromotionRefType `json:"ref_type"`
+	RefID   []pgtype.Int8      `json:"ref_id"`
+	Type    []PromotionType    `json:"type"`
 }
 
 func (q *Queries) ListActivePromotion(ctx context.Context, arg ListActivePromotionParams) ([]PromotionBase, error) {
@@ -46,6 +101,7 @@ func (q *Queries) ListActivePromotion(ctx context.Context, arg ListActivePromoti
 			&i.Title,
 			&i.Description,
 			&i.IsActive,
+			&i.Exclusive,
 			&i.DateStarted,
 			&i.DateEnded,
 			&i.ScheduleTz,
@@ -63,3 +119,137 @@ func (q *Queries) ListActivePromotion(ctx context.Context, arg ListActivePromoti
 	}
 	return items, nil
 }
+
+const listOwnerPromotion = `-- name: ListOwnerPromotion :many
+SELECT id, code, owner_id, ref_type, ref_id, type, title, description, is_active, exclusive, date_started, date_ended, schedule_tz, schedule_start, schedule_duration, date_created, date_updated
+FROM "promotion"."base"
+WHERE "owner_id" IS NOT DISTINCT FROM $1
+  AND ("is_active" = ANY($2) OR $2 IS NULL)
+ORDER BY "id"
+LIMIT $4
+OFFSET $3
+`
+
+type ListOwnerPromotionParams struct {
+	OwnerID  pgtype.Int8 `json:"owner_id"`
+	IsActive []bool      `json:"is_active"`
+	Offset   pgtype.Int4 `json:"offset"`
+	Limit    pgtype.Int4 `json:"limit"`
+}
+
+func (q *Queries) ListOwnerPromotion(ctx context.Context, arg ListOwnerPromotionParams) ([]PromotionBase, error) {
+	rows, err := q.db.Query(ctx, listOwnerPromotion,
+		arg.OwnerID,
+		arg.IsActive,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PromotionBase{}
+	for rows.Next() {
+		var i PromotionBase
+		if err := rows.Scan(
+			&i.ID,
+			&i.Code,
+			&i.OwnerID,
+			&i.RefType,
+			&i.RefID,
+			&i.Type,
+			&i.Title,
+			&i.Description,
+			&i.IsActive,
+			&i.Exclusive,
+			&i.DateStarted,
+			&i.DateEnded,
+			&i.ScheduleTz,
+			&i.ScheduleStart,
+			&i.ScheduleDuration,
+			&i.DateCreated,
+			&i.DateUpdated,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const redeemPromotionVoucher = `-- name: RedeemPromotionVoucher :one
+UPDATE "promotion"."voucher"
+SET "used" = "used" + 1
+WHERE "id" = $1 AND ("usage_limit" = 0 OR "used" < "usage_limit")
+RETURNING id, usage_limit, per_customer_limit, first_order_only, min_spend, used
+`
+
+// Takes one use of the voucher, no row when its usage limit is reached. The row stays locked until the transaction ends.
+func (q *Queries) RedeemPromotionVoucher(ctx context.Context, id int64) (PromotionVoucher, error) {
+	row := q.db.QueryRow(ctx, redeemPromotionVoucher, id)
+	var i PromotionVoucher
+	err := row.Scan(
+		&i.ID,
+		&i.UsageLimit,
+		&i.PerCustomerLimit,
+		&i.FirstOrderOnly,
+		&i.MinSpend,
+		&i.Used,
+	)
+	return i, err
+}
+
+const releasePromotionVoucher = `-- name: ReleasePromotionVoucher :exec
+UPDATE "promotion"."voucher"
+SET "used" = "used" - 1
+WHERE "id" = $1 AND "used" > 0
+`
+
+func (q *Queries) ReleasePromotionVoucher(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, releasePromotionVoucher, id)
+	return err
+}
+
+const settleCashbackCredit = `-- name: SettleCashbackCredit :many
+UPDATE "promotion"."cashback_credit"
+SET "status" = $1, "date_updated" = NOW()
+WHERE "order_id" = $2 AND "status" = 'Pending'
+RETURNING id, account_id, order_id, promotion_id, amount, status, date_created, date_updated
+`
+
+type SettleCashbackCreditParams struct {
+	Status  SharedStatus `json:"status"`
+	OrderID int64        `json:"order_id"`
+}
+
+func (q *Queries) SettleCashbackCredit(ctx context.Context, arg SettleCashbackCreditParams) ([]PromotionCashbackCredit, error) {
+	rows, err := q.db.Query(ctx, settleCashbackCredit, arg.Status, arg.OrderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PromotionCashbackCredit{}
+	for rows.Next() {
+		var i PromotionCashbackCredit
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.OrderID,
+			&i.PromotionID,
+			&i.Amount,
+			&i.Status,
+			&i.DateCreated,
+			&i.DateUpdated,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
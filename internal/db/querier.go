@@ -11,6 +11,9 @@ import (
 )
 
 type Querier interface {
+	AdjustStock(ctx context.Context, arg AdjustStockParams) (InventoryStock, error)
+	CancelVoucherRedemption(ctx context.Context, orderID int64) ([]PromotionVoucherRedemption, error)
+	ClearStockAlert(ctx context.Context) error
 	CountAccountAddress(ctx context.Context, arg CountAccountAddressParams) (int64, error)
 	CountAccountBase(ctx context.Context, arg CountAccountBaseParams) (int64, error)
 	CountAccountCartItem(ctx context.Context, arg CountAccountCartItemParams) (int64, error)
@@ -30,6 +33,7 @@ type Querier interface {
 	CountInventorySkuSerial(ctx context.Context, arg CountInventorySkuSerialParams) (int64, error)
 	CountInventoryStock(ctx context.Context, arg CountInventoryStockParams) (int64, error)
 	CountInventoryStockHistory(ctx context.Context, arg CountInventoryStockHistoryParams) (int64, error)
+	CountInventoryStockReservation(ctx context.Context, arg CountInventoryStockReservationParams) (int64, error)
 	CountOrderBase(ctx context.Context, arg CountOrderBaseParams) (int64, error)
 	CountOrderInvoice(ctx context.Context, arg CountOrderInvoiceParams) (int64, error)
 	CountOrderInvoiceItem(ctx context.Context, arg CountOrderInvoiceItemParams) (int64, error)
@@ -38,8 +42,16 @@ type Querier interface {
 	CountOrderRefund(ctx context.Context, arg CountOrderRefundParams) (int64, error)
 	CountOrderRefundDispute(ctx context.Context, arg CountOrderRefundDisputeParams) (int64, error)
 	CountOrderVnpay(ctx context.Context, arg CountOrderVnpayParams) (int64, error)
+	CountOwnerPromotion(ctx context.Context, arg CountOwnerPromotionParams) (int64, error)
 	CountPromotionBase(ctx context.Context, arg CountPromotionBaseParams) (int64, error)
+	CountPromotionBundle(ctx context.Context, arg CountPromotionBundleParams) (int64, error)
+	CountPromotionBundleItem(ctx context.Context, arg CountPromotionBundleItemParams) (int64, error)
+	CountPromotionBuyXGetY(ctx context.Context, arg CountPromotionBuyXGetYParams) (int64, error)
+	CountPromotionCashback(ctx context.Context, arg CountPromotionCashbackParams) (int64, error)
+	CountPromotionCashbackCredit(ctx context.Context, arg CountPromotionCashbackCreditParams) (int64, error)
 	CountPromotionDiscount(ctx context.Context, arg CountPromotionDiscountParams) (int64, error)
+	CountPromotionVoucher(ctx context.Context, arg CountPromotionVoucherParams) (int64, error)
+	CountPromotionVoucherRedemption(ctx context.Context, arg CountPromotionVoucherRedemptionParams) (int64, error)
 	CountSharedResource(ctx context.Context, arg CountSharedResourceParams) (int64, error)
 	CountSystemEvent(ctx context.Context, arg CountSystemEventParams) (int64, error)
 	CountSystemSearchSync(ctx context.Context, arg CountSystemSearchSyncParams) (int64, error)
@@ -78,6 +90,7 @@ type Querier interface {
 	CreateDefaultInventorySkuSerial(ctx context.Context, arg []CreateDefaultInventorySkuSerialParams) (int64, error)
 	CreateDefaultInventoryStock(ctx context.Context, arg []CreateDefaultInventoryStockParams) (int64, error)
 	CreateDefaultInventoryStockHistory(ctx context.Context, arg []CreateDefaultInventoryStockHistoryParams) (int64, error)
+	CreateDefaultInventoryStockReservation(ctx context.Context, arg []CreateDefaultInventoryStockReservationParams) (int64, error)
 	CreateDefaultOrderBase(ctx context.Context, arg []CreateDefaultOrderBaseParams) (int64, error)
 	CreateDefaultOrderInvoice(ctx context.Context, arg []CreateDefaultOrderInvoiceParams) (int64, error)
 	CreateDefaultOrderInvoiceItem(ctx context.Context, arg []CreateDefaultOrderInvoiceItemParams) (int64, error)
@@ -87,13 +100,21 @@ type Querier interface {
 	CreateDefaultOrderRefundDispute(ctx context.Context, arg []CreateDefaultOrderRefundDisputeParams) (int64, error)
 	CreateDefaultOrderVnpay(ctx context.Context, arg []CreateDefaultOrderVnpayParams) (int64, error)
 	CreateDefaultPromotionBase(ctx context.Context, arg []CreateDefaultPromotionBaseParams) (int64, error)
+	CreateDefaultPromotionBundle(ctx context.Context, arg []CreateDefaultPromotionBundleParams) (int64, error)
+	CreateDefaultPromotionBundleItem(ctx context.Context, arg []CreateDefaultPromotionBundleItemParams) (int64, error)
+	CreateDefaultPromotionBuyXGetY(ctx context.Context, arg []CreateDefaultPromotionBuyXGetYParams) (int64, error)
+	CreateDefaultPromotionCashback(ctx context.Context, arg []CreateDefaultPromotionCashbackParams) (int64, error)
+	CreateDefaultPromotionCashbackCredit(ctx context.Context, arg []CreateDefaultPromotionCashbackCreditParams) (int64, error)
 	CreateDefaultPromotionDiscount(ctx context.Context, arg []CreateDefaultPromotionDiscountParams) (int64, error)
+	CreateDefaultPromotionVoucher(ctx context.Context, id []int64) (int64, error)
+	CreateDefaultPromotionVoucherRedemption(ctx context.Context, arg []CreateDefaultPromotionVoucherRedemptionParams) (int64, error)
 	CreateDefaultSharedResource(ctx context.Context, arg []CreateDefaultSharedResourceParams) (int64, error)
 	CreateDefaultSystemEvent(ctx context.Context, arg []CreateDefaultSystemEventParams) (int64, error)
 	CreateDefaultSystemSearchSync(ctx context.Context, name []string) (int64, error)
 	CreateInventorySkuSerial(ctx context.Context, arg []CreateInventorySkuSerialParams) (int64, error)
 	CreateInventoryStock(ctx context.Context, arg []CreateInventoryStockParams) (int64, error)
 	CreateInventoryStockHistory(ctx context.Context, arg []CreateInventoryStockHistoryParams) (int64, error)
+	CreateInventoryStockReservation(ctx context.Context, arg []CreateInventoryStockReservationParams) (int64, error)
 	CreateOrderBase(ctx context.Context, arg []CreateOrderBaseParams) (int64, error)
 	CreateOrderInvoice(ctx context.Context, arg []CreateOrderInvoiceParams) (int64, error)
 	CreateOrderInvoiceItem(ctx context.Context, arg []CreateOrderInvoiceItemParams) (int64, error)
@@ -103,7 +124,14 @@ type Querier interface {
 	CreateOrderRefundDispute(ctx context.Context, arg []CreateOrderRefundDisputeParams) (int64, error)
 	CreateOrderVnpay(ctx context.Context, arg []CreateOrderVnpayParams) (int64, error)
 	CreatePromotionBase(ctx context.Context, arg []CreatePromotionBaseParams) (int64, error)
+	CreatePromotionBundle(ctx context.Context, arg []CreatePromotionBundleParams) (int64, error)
+	CreatePromotionBundleItem(ctx context.Context, arg []CreatePromotionBundleItemParams) (int64, error)
+	CreatePromotionBuyXGetY(ctx context.Context, arg []CreatePromotionBuyXGetYParams) (int64, error)
+	CreatePromotionCashback(ctx context.Context, arg []CreatePromotionCashbackParams) (int64, error)
+	CreatePromotionCashbackCredit(ctx context.Context, arg []CreatePromotionCashbackCreditParams) (int64, error)
 	CreatePromotionDiscount(ctx context.Context, arg []CreatePromotionDiscountParams) (int64, error)
+	CreatePromotionVoucher(ctx context.Context, arg []CreatePromotionVoucherParams) (int64, error)
+	CreatePromotionVoucherRedemption(ctx context.Context, arg []CreatePromotionVoucherRedemptionParams) (int64, error)
 	CreateSharedResource(ctx context.Context, arg []CreateSharedResourceParams) (int64, error)
 	CreateSystemEvent(ctx context.Context, arg []CreateSystemEventParams) (int64, error)
 	CreateSystemSearchSync(ctx context.Context, arg []CreateSystemSearchSyncParams) (int64, error)
@@ -126,6 +154,7 @@ type Querier interface {
 	DeleteInventorySkuSerial(ctx context.Context, arg DeleteInventorySkuSerialParams) error
 	DeleteInventoryStock(ctx context.Context, arg DeleteInventoryStockParams) error
 	DeleteInventoryStockHistory(ctx context.Context, id pgtype.Int8) error
+	DeleteInventoryStockReservation(ctx context.Context, id pgtype.Int8) error
 	DeleteOrderBase(ctx context.Context, arg DeleteOrderBaseParams) error
 	DeleteOrderInvoice(ctx context.Context, arg DeleteOrderInvoiceParams) error
 	DeleteOrderInvoiceItem(ctx context.Context, id pgtype.Int8) error
@@ -135,7 +164,14 @@ type Querier interface {
 	DeleteOrderRefundDispute(ctx context.Context, arg DeleteOrderRefundDisputeParams) error
 	DeleteOrderVnpay(ctx context.Context, id pgtype.Int8) error
 	DeletePromotionBase(ctx context.Context, arg DeletePromotionBaseParams) error
+	DeletePromotionBundle(ctx context.Context, id pgtype.Int8) error
+	DeletePromotionBundleItem(ctx context.Context, arg DeletePromotionBundleItemParams) error
+	DeletePromotionBuyXGetY(ctx context.Context, id pgtype.Int8) error
+	DeletePromotionCashback(ctx context.Context, id pgtype.Int8) error
+	DeletePromotionCashbackCredit(ctx context.Context, arg DeletePromotionCashbackCreditParams) error
 	DeletePromotionDiscount(ctx context.Context, id pgtype.Int8) error
+	DeletePromotionVoucher(ctx context.Context, id pgtype.Int8) error
+	DeletePromotionVoucherRedemption(ctx context.Context, arg DeletePromotionVoucherRedemptionParams) error
 	DeleteSharedResource(ctx context.Context, id pgtype.Int8) error
 	DeleteSystemEvent(ctx context.Context, id pgtype.Int8) error
 	DeleteSystemSearchSync(ctx context.Context, id pgtype.Int8) error
@@ -159,6 +195,7 @@ type Querier interface {
 	ExistsInventorySkuSerial(ctx context.Context, arg ExistsInventorySkuSerialParams) (bool, error)
 	ExistsInventoryStock(ctx context.Context, arg ExistsInventoryStockParams) (bool, error)
 	ExistsInventoryStockHistory(ctx context.Context, arg ExistsInventoryStockHistoryParams) (bool, error)
+	ExistsInventoryStockReservation(ctx context.Context, arg ExistsInventoryStockReservationParams) (bool, error)
 	ExistsOrderBase(ctx context.Context, arg ExistsOrderBaseParams) (bool, error)
 	ExistsOrderInvoice(ctx context.Context, arg ExistsOrderInvoiceParams) (bool, error)
 	ExistsOrderInvoiceItem(ctx context.Context, arg ExistsOrderInvoiceItemParams) (bool, error)
@@ -168,10 +205,18 @@ type Querier interface {
 	ExistsOrderRefundDispute(ctx context.Context, arg ExistsOrderRefundDisputeParams) (bool, error)
 	ExistsOrderVnpay(ctx context.Context, arg ExistsOrderVnpayParams) (bool, error)
 	ExistsPromotionBase(ctx context.Context, arg ExistsPromotionBaseParams) (bool, error)
+	ExistsPromotionBundle(ctx context.Context, arg ExistsPromotionBundleParams) (bool, error)
+	ExistsPromotionBundleItem(ctx context.Context, arg ExistsPromotionBundleItemParams) (bool, error)
+	ExistsPromotionBuyXGetY(ctx context.Context, arg ExistsPromotionBuyXGetYParams) (bool, error)
+	ExistsPromotionCashback(ctx context.Context, arg ExistsPromotionCashbackParams) (bool, error)
+	ExistsPromotionCashbackCredit(ctx context.Context, arg ExistsPromotionCashbackCreditParams) (bool, error)
 	ExistsPromotionDiscount(ctx context.Context, arg ExistsPromotionDiscountParams) (bool, error)
+	ExistsPromotionVoucher(ctx context.Context, arg ExistsPromotionVoucherParams) (bool, error)
+	ExistsPromotionVoucherRedemption(ctx context.Context, arg ExistsPromotionVoucherRedemptionParams) (bool, error)
 	ExistsSharedResource(ctx context.Context, arg ExistsSharedResourceParams) (bool, error)
 	ExistsSystemEvent(ctx context.Context, arg ExistsSystemEventParams) (bool, error)
 	ExistsSystemSearchSync(ctx context.Context, arg ExistsSystemSearchSyncParams) (bool, error)
+	ExtendStockReservation(ctx context.Context, arg ExtendStockReservationParams) error
 	// ========================================
 	// Queries for table: account.address
 	// ========================================
@@ -251,6 +296,11 @@ type Querier interface {
 	// ========================================
 	GetInventoryStockHistory(ctx context.Context, id pgtype.Int8) (InventoryStockHistory, error)
 	// ========================================
+	// Queries for table: inventory.stock_reservation
+	// ========================================
+	GetInventoryStockReservation(ctx context.Context, id pgtype.Int8) (InventoryStockReservation, error)
+	GetLatestAccountIncomeHistory(ctx context.Context, accountID int64) (AccountIncomeHistory, error)
+	// ========================================
 	// Queries for table: order.base
 	// ========================================
 	GetOrderBase(ctx context.Context, arg GetOrderBaseParams) (OrderBase, error)
@@ -266,6 +316,8 @@ type Querier interface {
 	// Queries for table: order.item
 	// ========================================
 	GetOrderItem(ctx context.Context, arg GetOrderItemParams) (OrderItem, error)
+	// Locks the order item until the end of the transaction, so the refunds of the item are approved one at a time
+	GetOrderItemForUpdate(ctx context.Context, id int64) (OrderItem, error)
 	// ========================================
 	// Queries for table: order.item_serial
 	// ========================================
@@ -287,13 +339,42 @@ type Querier interface {
 	// ========================================
 	GetPromotionBase(ctx context.Context, arg GetPromotionBaseParams) (PromotionBase, error)
 	// ========================================
+	// Queries for table: promotion.bundle
+	// ========================================
+	GetPromotionBundle(ctx context.Context, id pgtype.Int8) (PromotionBundle, error)
+	// ========================================
+	// Queries for table: promotion.bundle_item
+	// ========================================
+	GetPromotionBundleItem(ctx context.Context, arg GetPromotionBundleItemParams) (PromotionBundleItem, error)
+	// ========================================
+	// Queries for table: promotion.buy_x_get_y
+	// ========================================
+	GetPromotionBuyXGetY(ctx context.Context, id pgtype.Int8) (PromotionBuyXGetY, error)
+	// ========================================
+	// Queries for table: promotion.cashback
+	// ========================================
+	GetPromotionCashback(ctx context.Context, id pgtype.Int8) (PromotionCashback, error)
+	// ========================================
+	// Queries for table: promotion.cashback_credit
+	// ========================================
+	GetPromotionCashbackCredit(ctx context.Context, arg GetPromotionCashbackCreditParams) (PromotionCashbackCredit, error)
+	// ========================================
 	// Queries for table: promotion.discount
 	// ========================================
 	GetPromotionDiscount(ctx context.Context, id pgtype.Int8) (PromotionDiscount, error)
 	// ========================================
+	// Queries for table: promotion.voucher
+	// ========================================
+	GetPromotionVoucher(ctx context.Context, id pgtype.Int8) (PromotionVoucher, error)
+	// ========================================
+	// Queries for table: promotion.voucher_redemption
+	// ========================================
+	GetPromotionVoucherRedemption(ctx context.Context, arg GetPromotionVoucherRedemptionParams) (PromotionVoucherRedemption, error)
+	// ========================================
 	// Queries for table: shared.resource
 	// ========================================
 	GetSharedResource(ctx context.Context, id pgtype.Int8) (SharedResource, error)
+	GetStockForUpdate(ctx context.Context, arg GetStockForUpdateParams) (InventoryStock, error)
 	// ========================================
 	// Queries for table: system.event
 	// ========================================
@@ -311,17 +392,24 @@ type Querier interface {
 	ListAccountProfile(ctx context.Context, arg ListAccountProfileParams) ([]AccountProfile, error)
 	ListAccountVendor(ctx context.Context, arg ListAccountVendorParams) ([]AccountVendor, error)
 	ListActivePromotion(ctx context.Context, arg ListActivePromotionParams) ([]PromotionBase, error)
+	ListAvailableSkuSerial(ctx context.Context, arg ListAvailableSkuSerialParams) ([]InventorySkuSerial, error)
 	ListCatalogBrand(ctx context.Context, arg ListCatalogBrandParams) ([]CatalogBrand, error)
 	ListCatalogCategory(ctx context.Context, arg ListCatalogCategoryParams) ([]CatalogCategory, error)
+	// The category then its parents up to the root. The depth limit ends a cycle left by a bad parent_id.
+	ListCatalogCategoryAncestor(ctx context.Context, id int64) ([]CatalogCategory, error)
+	// The category and every category under it. UNION skips the categories already reached, which ends a cycle.
+	ListCatalogCategoryDescendant(ctx context.Context, id int64) ([]CatalogCategory, error)
 	ListCatalogComment(ctx context.Context, arg ListCatalogCommentParams) ([]CatalogComment, error)
 	ListCatalogProductSku(ctx context.Context, arg ListCatalogProductSkuParams) ([]CatalogProductSku, error)
 	ListCatalogProductSkuAttribute(ctx context.Context, arg ListCatalogProductSkuAttributeParams) ([]CatalogProductSkuAttribute, error)
 	ListCatalogProductSpu(ctx context.Context, arg ListCatalogProductSpuParams) ([]CatalogProductSpu, error)
 	ListCatalogProductSpuTag(ctx context.Context, arg ListCatalogProductSpuTagParams) ([]CatalogProductSpuTag, error)
 	ListCatalogTag(ctx context.Context, arg ListCatalogTagParams) ([]CatalogTag, error)
+	ListExpiredStockReservation(ctx context.Context, arg ListExpiredStockReservationParams) ([]InventoryStockReservation, error)
 	ListInventorySkuSerial(ctx context.Context, arg ListInventorySkuSerialParams) ([]InventorySkuSerial, error)
 	ListInventoryStock(ctx context.Context, arg ListInventoryStockParams) ([]InventoryStock, error)
 	ListInventoryStockHistory(ctx context.Context, arg ListInventoryStockHistoryParams) ([]InventoryStockHistory, error)
+	ListInventoryStockReservation(ctx context.Context, arg ListInventoryStockReservationParams) ([]InventoryStockReservation, error)
 	ListOrderBase(ctx context.Context, arg ListOrderBaseParams) ([]OrderBase, error)
 	ListOrderInvoice(ctx context.Context, arg ListOrderInvoiceParams) ([]OrderInvoice, error)
 	ListOrderInvoiceItem(ctx context.Context, arg ListOrderInvoiceItemParams) ([]OrderInvoiceItem, error)
@@ -330,14 +418,32 @@ type Querier interface {
 	ListOrderRefund(ctx context.Context, arg ListOrderRefundParams) ([]OrderRefund, error)
 	ListOrderRefundDispute(ctx context.Context, arg ListOrderRefundDisputeParams) ([]OrderRefundDispute, error)
 	ListOrderVnpay(ctx context.Context, arg ListOrderVnpayParams) ([]OrderVnpay, error)
+	ListOwnerPromotion(ctx context.Context, arg ListOwnerPromotionParams) ([]PromotionBase, error)
 	ListPromotionBase(ctx context.Context, arg ListPromotionBaseParams) ([]PromotionBase, error)
+	ListPromotionBundle(ctx context.Context, arg ListPromotionBundleParams) ([]PromotionBundle, error)
+	ListPromotionBundleItem(ctx context.Context, arg ListPromotionBundleItemParams) ([]PromotionBundleItem, error)
+	ListPromotionBuyXGetY(ctx context.Context, arg ListPromotionBuyXGetYParams) ([]PromotionBuyXGetY, error)
+	ListPromotionCashback(ctx context.Context, arg ListPromotionCashbackParams) ([]PromotionCashback, error)
+	ListPromotionCashbackCredit(ctx context.Context, arg ListPromotionCashbackCreditParams) ([]PromotionCashbackCredit, error)
 	ListPromotionDiscount(ctx context.Context, arg ListPromotionDiscountParams) ([]PromotionDiscount, error)
+	ListPromotionVoucher(ctx context.Context, arg ListPromotionVoucherParams) ([]PromotionVoucher, error)
+	ListPromotionVoucherRedemption(ctx context.Context, arg ListPromotionVoucherRedemptionParams) ([]PromotionVoucherRedemption, error)
 	ListRating(ctx context.Context, arg ListRatingParams) ([]ListRatingRow, error)
 	ListSharedResource(ctx context.Context, arg ListSharedResourceParams) ([]SharedResource, error)
 	ListSharedResourceFirst(ctx context.Context, arg ListSharedResourceFirstParams) ([]ListSharedResourceFirstRow, error)
+	ListStockReconciliation(ctx context.Context, arg ListStockReconciliationParams) ([]ListStockReconciliationRow, error)
+	// Stocks that never changed were never stocked (e.g. created to hold a threshold), they are not alerted
+	ListStockToAlert(ctx context.Context, limit int32) ([]InventoryStock, error)
 	ListSystemEvent(ctx context.Context, arg ListSystemEventParams) ([]SystemEvent, error)
 	ListSystemSearchSync(ctx context.Context, arg ListSystemSearchSyncParams) ([]SystemSearchSync, error)
+	// Locks the whole category tree until the end of the transaction, so concurrent moves cannot create a cycle together
+	LockCatalogCategory(ctx context.Context) ([]CatalogCategory, error)
 	LowestPriceProductSku(ctx context.Context, spuID []int64) ([]LowestPriceProductSkuRow, error)
+	// Takes one use of the voucher, no row when its usage limit is reached. The row stays locked until the transaction ends.
+	RedeemPromotionVoucher(ctx context.Context, id int64) (PromotionVoucher, error)
+	ReleasePromotionVoucher(ctx context.Context, id int64) error
+	ReserveStock(ctx context.Context, arg ReserveStockParams) (InventoryStock, error)
+	SettleCashbackCredit(ctx context.Context, arg SettleCashbackCreditParams) ([]PromotionCashbackCredit, error)
 	UpdateAccountAddress(ctx context.Context, arg UpdateAccountAddressParams) (AccountAddress, error)
 	UpdateAccountBase(ctx context.Context, arg UpdateAccountBaseParams) (AccountBase, error)
 	UpdateAccountCartItem(ctx context.Context, arg UpdateAccountCartItemParams) (AccountCartItem, error)
@@ -355,19 +461,32 @@ type Querier interface {
 	UpdateCatalogProductSpuTag(ctx context.Context, arg UpdateCatalogProductSpuTagParams) (CatalogProductSpuTag, error)
 	UpdateCatalogTag(ctx context.Context, arg UpdateCatalogTagParams) (CatalogTag, error)
 	UpdateInventorySkuSerial(ctx context.Context, arg UpdateInventorySkuSerialParams) (InventorySkuSerial, error)
+	UpdateInventorySkuSerialStatus(ctx context.Context, arg UpdateInventorySkuSerialStatusParams) (InventorySkuSerial, error)
 	UpdateInventoryStock(ctx context.Context, arg UpdateInventoryStockParams) (InventoryStock, error)
 	UpdateInventoryStockHistory(ctx context.Context, arg UpdateInventoryStockHistoryParams) (InventoryStockHistory, error)
+	UpdateInventoryStockReservation(ctx context.Context, arg UpdateInventoryStockReservationParams) (InventoryStockReservation, error)
 	UpdateOrderBase(ctx context.Context, arg UpdateOrderBaseParams) (OrderBase, error)
+	UpdateOrderBaseStatus(ctx context.Context, arg UpdateOrderBaseStatusParams) (OrderBase, error)
 	UpdateOrderInvoice(ctx context.Context, arg UpdateOrderInvoiceParams) (OrderInvoice, error)
 	UpdateOrderInvoiceItem(ctx context.Context, arg UpdateOrderInvoiceItemParams) (OrderInvoiceItem, error)
 	UpdateOrderItem(ctx context.Context, arg UpdateOrderItemParams) (OrderItem, error)
 	UpdateOrderItemSerial(ctx context.Context, arg UpdateOrderItemSerialParams) (OrderItemSerial, error)
 	UpdateOrderRefund(ctx context.Context, arg UpdateOrderRefundParams) (OrderRefund, error)
 	UpdateOrderRefundDispute(ctx context.Context, arg UpdateOrderRefundDisputeParams) (OrderRefundDispute, error)
+	UpdateOrderRefundStatus(ctx context.Context, arg UpdateOrderRefundStatusParams) (OrderRefund, error)
 	UpdateOrderVnpay(ctx context.Context, arg UpdateOrderVnpayParams) (OrderVnpay, error)
 	UpdatePromotionBase(ctx context.Context, arg UpdatePromotionBaseParams) (PromotionBase, error)
+	UpdatePromotionBundle(ctx context.Context, arg UpdatePromotionBundleParams) (PromotionBundle, error)
+	UpdatePromotionBundleItem(ctx context.Context, arg UpdatePromotionBundleItemParams) (PromotionBundleItem, error)
+	UpdatePromotionBuyXGetY(ctx context.Context, arg UpdatePromotionBuyXGetYParams) (PromotionBuyXGetY, error)
+	UpdatePromotionCashback(ctx context.Context, arg UpdatePromotionCashbackParams) (PromotionCashback, error)
+	UpdatePromotionCashbackCredit(ctx context.Context, arg UpdatePromotionCashbackCreditParams) (PromotionCashbackCredit, error)
 	UpdatePromotionDiscount(ctx context.Context, arg UpdatePromotionDiscountParams) (PromotionDiscount, error)
+	UpdatePromotionVoucher(ctx context.Context, arg UpdatePromotionVoucherParams) (PromotionVoucher, error)
+	UpdatePromotionVoucherRedemption(ctx context.Context, arg UpdatePromotionVoucherRedemptionParams) (PromotionVoucherRedemption, error)
 	UpdateSharedResource(ctx context.Context, arg UpdateSharedResourceParams) (SharedResource, error)
+	UpdateSkuSerialStatus(ctx context.Context, arg UpdateSkuSerialStatusParams) error
+	UpdateStockReservationStatus(ctx context.Context, arg UpdateStockReservationStatusParams) (InventoryStockReservation, error)
 	UpdateSystemEvent(ctx context.Context, arg UpdateSystemEventParams) (SystemEvent, error)
 	UpdateSystemSearchSync(ctx context.Context, arg UpdateSystemSearchSyncParams) (SystemSearchSync, error)
 }
@@ -1081,29 +1081,43 @@ WHERE (
     ("sold" = ANY($11) OR $11 IS NULL) AND
     ("sold" >= $12 OR $12 IS NULL) AND
     ("sold" <= $13 OR $13 IS NULL) AND
-    ("date_created" = ANY($14) OR $14 IS NULL) AND
-    ("date_created" >= $15 OR $15 IS NULL) AND
-    ("date_created" <= $16 OR $16 IS NULL)
+    ("low_stock_threshold" = ANY($14) OR $14 IS NULL) AND
+    ("low_stock_threshold" >= $15 OR $15 IS NULL) AND
+    ("low_stock_threshold" <= $16 OR $16 IS NULL) AND
+    ("alert" = ANY($17) OR $17 IS NULL) AND
+    ("date_alerted" = ANY($18) OR $18 IS NULL) AND
+    ("date_alerted" >= $19 OR $19 IS NULL) AND
+    ("date_alerted" <= $20 OR $20 IS NULL) AND
+    ("date_created" = ANY($21) OR $21 IS NULL) AND
+    ("date_created" >= $22 OR $22 IS NULL) AND
+    ("date_created" <= $23 OR $23 IS NULL)
 )
 `
 
 type CountInventoryStockParams struct {
-	ID               []int64              `json:"id"`
-	IDFrom           pgtype.Int8          `json:"id_from"`
-	IDTo             pgtype.Int8          `json:"id_to"`
-	RefType          []InventoryStockType `json:"ref_type"`
-	RefID            []int64              `json:"ref_id"`
-	RefIDFrom        pgtype.Int8          `json:"ref_id_from"`
-	RefIDTo          pgtype.Int8          `json:"ref_id_to"`
-	CurrentStock     []int64              `json:"current_stock"`
-	CurrentStockFrom pgtype.Int8          `json:"current_stock_from"`
-	CurrentStockTo   pgtype.Int8          `json:"current_stock_to"`
-	Sold             []int64              `json:"sold"`
-	SoldFrom         pgtype.Int8          `json:"sold_from"`
-	SoldTo           pgtype.Int8          `json:"sold_to"`
-	DateCreated      []pgtype.Timestamptz `json:"date_created"`
-	DateCreatedFrom  pgtype.Timestamptz   `json:"date_created_from"`
-	DateCreatedTo    pgtype.Timestamptz   `json:"date_created_to"`
+	ID                    []int64                   `json:"id"`
+	IDFrom                pgtype.Int8               `json:"id_from"`
+	IDTo                  pgtype.Int8               `json:"id_to"`
+	RefType               []InventoryStockType      `json:"ref_type"`
+	RefID                 []int64                   `json:"ref_id"`
+	RefIDFrom             pgtype.Int8               `json:"ref_id_from"`
+	RefIDTo               pgtype.Int8               `json:"ref_id_to"`
+	CurrentStock          []int64                   `json:"current_stock"`
+	CurrentStockFrom      pgtype.Int8               `json:"current_stock_from"`
+	CurrentStockTo        pgtype.Int8               `json:"current_stock_to"`
+	Sold                  []int64                   `json:"sold"`
+	SoldFrom              pgtype.Int8               `json:"sold_from"`
+	SoldTo                pgtype.Int8               `json:"sold_to"`
+	LowStockThreshold     []int64                   `json:"low_stock_threshold"`
+	LowStockThresholdFrom pgtype.Int8               `json:"low_stock_threshold_from"`
+	LowStockThresholdTo   pgtype.Int8               `json:"low_stock_threshold_to"`
+	Alert                 []NullInventoryStockAlert `json:"alert"`
+	DateAlerted           []pgtype.Timestamptz      `json:"date_alerted"`
+	DateAlertedFrom       pgtype.Timestamptz        `json:"date_alerted_from"`
+	DateAlertedTo         pgtype.Timestamptz        `json:"date_alerted_to"`
+	DateCreated           []pgtype.Timestamptz      `json:"date_created"`
+	DateCreatedFrom       pgtype.Timestamptz        `json:"date_created_from"`
+	DateCreatedTo         pgtype.Timestamptz        `json:"date_created_to"`
 }
 
 func (q *Queries) CountInventoryStock(ctx context.Context, arg CountInventoryStockParams) (int64, error) {
@@ -1121,6 +1135,13 @@ func (q *Queries) CountInventoryStock(ctx context.Context, arg CountInventorySto
 		arg.Sold,
 		arg.SoldFrom,
 		arg.SoldTo,
+		arg.LowStockThreshold,
+		arg.LowStockThresholdFrom,
+		arg.LowStockThresholdTo,
+		arg.Alert,
+		arg.DateAlerted,
+		arg.DateAlertedFrom,
+		arg.DateAlertedTo,
 		arg.DateCreated,
 		arg.DateCreatedFrom,
 		arg.DateCreatedTo,
@@ -1143,9 +1164,13 @@ WHERE (
     ("change" = ANY($7) OR $7 IS NULL) AND
     ("change" >= $8 OR $8 IS NULL) AND
     ("change" <= $9 OR $9 IS NULL) AND
-    ("date_created" = ANY($10) OR $10 IS NULL) AND
-    ("date_created" >= $11 OR $11 IS NULL) AND
-    ("date_created" <= $12 OR $12 IS NULL)
+    ("account_id" = ANY($10) OR $10 IS NULL) AND
+    ("account_id" >= $11 OR $11 IS NULL) AND
+    ("account_id" <= $12 OR $12 IS NULL) AND
+    ("reason" = ANY($13) OR $13 IS NULL) AND
+    ("date_created" = ANY($14) OR $14 IS NULL) AND
+    ("date_created" >= $15 OR $15 IS NULL) AND
+    ("date_created" <= $16 OR $16 IS NULL)
 )
 `
 
@@ -1159,6 +1184,10 @@ type CountInventoryStockHistoryParams struct {
 	Change          []int64              `json:"change"`
 	ChangeFrom      pgtype.Int8          `json:"change_from"`
 	ChangeTo        pgtype.Int8          `json:"change_to"`
+	AccountID       []pgtype.Int8        `json:"account_id"`
+	AccountIDFrom   pgtype.Int8          `json:"account_id_from"`
+	AccountIDTo     pgtype.Int8          `json:"account_id_to"`
+	Reason          []pgtype.Text        `json:"reason"`
 	DateCreated     []pgtype.Timestamptz `json:"date_created"`
 	DateCreatedFrom pgtype.Timestamptz   `json:"date_created_from"`
 	DateCreatedTo   pgtype.Timestamptz   `json:"date_created_to"`
@@ -1175,9 +1204,100 @@ func (q *Queries) CountInventoryStockHistory(ctx context.Context, arg CountInven
 		arg.Change,
 		arg.ChangeFrom,
 		arg.ChangeTo,
+		arg.AccountID,
+		arg.AccountIDFrom,
+		arg.AccountIDTo,
+		arg.Reason,
+		arg.DateCreated,
+		arg.DateCreatedFrom,
+		arg.DateCreatedTo,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countInventoryStockReservation = `-- name: CountInventoryStockReservation :one
+SELECT COUNT(*)
+FROM "inventory"."stock_reservation"
+WHERE (
+    ("id" = ANY($1) OR $1 IS NULL) AND
+    ("id" >= $2 OR $2 IS NULL) AND
+    ("id" <= $3 OR $3 IS NULL) AND
+    ("stock_id" = ANY($4) OR $4 IS NULL) AND
+    ("stock_id" >= $5 OR $5 IS NULL) AND
+    ("stock_id" <= $6 OR $6 IS NULL) AND
+    ("ref_type" = ANY($7) OR $7 IS NULL) AND
+    ("ref_id" = ANY($8) OR $8 IS NULL) AND
+    ("ref_id" >= $9 OR $9 IS NULL) AND
+    ("ref_id" <= $10 OR $10 IS NULL) AND
+    ("quantity" = ANY($11) OR $11 IS NULL) AND
+    ("quantity" >= $12 OR $12 IS NULL) AND
+    ("quantity" <= $13 OR $13 IS NULL) AND
+    ("status" = ANY($14) OR $14 IS NULL) AND
+    ("date_expired" = ANY($15) OR $15 IS NULL) AND
+    ("date_expired" >= $16 OR $16 IS NULL) AND
+    ("date_expired" <= $17 OR $17 IS NULL) AND
+    ("date_created" = ANY($18) OR $18 IS NULL) AND
+    ("date_created" >= $19 OR $19 IS NULL) AND
+    ("date_created" <= $20 OR $20 IS NULL) AND
+    ("date_updated" = ANY($21) OR $21 IS NULL) AND
+    ("date_updated" >= $22 OR $22 IS NULL) AND
+    ("date_updated" <= $23 OR $23 IS NULL)
+)
+`
+
+type CountInventoryStockReservationParams struct {
+	ID              []int64                    `json:"id"`
+	IDFrom          pgtype.Int8                `json:"id_from"`
+	IDTo            pgtype.Int8                `json:"id_to"`
+	StockID         []int64                    `json:"stock_id"`
+	StockIDFrom     pgtype.Int8                `json:"stock_id_from"`
+	StockIDTo       pgtype.Int8                `json:"stock_id_to"`
+	RefType         []InventoryReservationType `json:"ref_type"`
+	RefID           []int64                    `json:"ref_id"`
+	RefIDFrom       pgtype.Int8                `json:"ref_id_from"`
+	RefIDTo         pgtype.Int8                `json:"ref_id_to"`
+	Quantity        []int64                    `json:"quantity"`
+	QuantityFrom    pgtype.Int8                `json:"quantity_from"`
+	QuantityTo      pgtype.Int8                `json:"quantity_to"`
+	Status          []SharedStatus             `json:"status"`
+	DateExpired     []pgtype.Timestamptz       `json:"date_expired"`
+	DateExpiredFrom pgtype.Timestamptz         `json:"date_expired_from"`
+	DateExpiredTo   pgtype.Timestamptz         `json:"date_expired_to"`
+	DateCreated     []pgtype.Timestamptz       `json:"date_created"`
+	DateCreatedFrom pgtype.Timestamptz         `json:"date_created_from"`
+	DateCreatedTo   pgtype.Timestamptz         `json:"date_created_to"`
+	DateUpdated     []pgtype.Timestamptz       `json:"date_updated"`
+	DateUpdatedFrom pgtype.Timestamptz         `json:"date_updated_from"`
+	DateUpdatedTo   pgtype.Timestamptz         `json:"date_updated_to"`
+}
+
+func (q *Queries) CountInventoryStockReservation(ctx context.Context, arg CountInventoryStockReservationParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countInventoryStockReservation,
+		arg.ID,
+		arg.IDFrom,
+		arg.IDTo,
+		arg.StockID,
+		arg.StockIDFrom,
+		arg.StockIDTo,
+		arg.RefType,
+		arg.RefID,
+		arg.RefIDFrom,
+		arg.RefIDTo,
+		arg.Quantity,
+		arg.QuantityFrom,
+		arg.QuantityTo,
+		arg.Status,
+		arg.DateExpired,
+		arg.DateExpiredFrom,
+		arg.DateExpiredTo,
 		arg.DateCreated,
 		arg.DateCreatedFrom,
 		arg.DateCreatedTo,
+		arg.DateUpdated,
+		arg.DateUpdatedFrom,
+		arg.DateUpdatedTo,
 	)
 	var count int64
 	err := row.Scan(&count)
@@ -1440,24 +1560,42 @@ WHERE (
     ("sku_id" <= $10 OR $10 IS NULL) AND
     ("quantity" = ANY($11) OR $11 IS NULL) AND
     ("quantity" >= $12 OR $12 IS NULL) AND
-    ("quantity" <= $13 OR $13 IS NULL)
+    ("quantity" <= $13 OR $13 IS NULL) AND
+    ("unit_price" = ANY($14) OR $14 IS NULL) AND
+    ("unit_price" >= $15 OR $15 IS NULL) AND
+    ("unit_price" <= $16 OR $16 IS NULL) AND
+    ("subtotal" = ANY($17) OR $17 IS NULL) AND
+    ("subtotal" >= $18 OR $18 IS NULL) AND
+    ("subtotal" <= $19 OR $19 IS NULL) AND
+    ("total" = ANY($20) OR $20 IS NULL) AND
+    ("total" >= $21 OR $21 IS NULL) AND
+    ("total" <= $22 OR $22 IS NULL)
 )
 `
 
 type CountOrderItemParams struct {
-	ID           []int64     `json:"id"`
-	IDFrom       pgtype.Int8 `json:"id_from"`
-	IDTo         pgtype.Int8 `json:"id_to"`
-	Code         []string    `json:"code"`
-	OrderID      []int64     `json:"order_id"`
-	OrderIDFrom  pgtype.Int8 `json:"order_id_from"`
-	OrderIDTo    pgtype.Int8 `json:"order_id_to"`
-	SkuID        []int64     `json:"sku_id"`
-	SkuIDFrom    pgtype.Int8 `json:"sku_id_from"`
-	SkuIDTo      pgtype.Int8 `json:"sku_id_to"`
-	Quantity     []int64     `json:"quantity"`
-	QuantityFrom pgtype.Int8 `json:"quantity_from"`
-	QuantityTo   pgtype.Int8 `json:"quantity_to"`
+	ID            []int64     `json:"id"`
+	IDFrom        pgtype.Int8 `json:"id_from"`
+	IDTo          pgtype.Int8 `json:"id_to"`
+	Code          []string    `json:"code"`
+	OrderID       []int64     `json:"order_id"`
+	OrderIDFrom   pgtype.Int8 `json:"order_id_from"`
+	OrderIDTo     pgtype.Int8 `json:"order_id_to"`
+	SkuID         []int64     `json:"sku_id"`
+	SkuIDFrom     pgtype.Int8 `json:"sku_id_from"`
+	SkuIDTo       pgtype.Int8 `json:"sku_id_to"`
+	Quantity      []int64     `json:"quantity"`
+	QuantityFrom  pgtype.Int8 `json:"quantity_from"`
+	QuantityTo    pgtype.Int8 `json:"quantity_to"`
+	UnitPrice     []int64     `json:"unit_price"`
+	UnitPriceFrom pgtype.Int8 `json:"unit_price_from"`
+	UnitPriceTo   pgtype.Int8 `json:"unit_price_to"`
+	Subtotal      []int64     `json:"subtotal"`
+	SubtotalFrom  pgtype.Int8 `json:"subtotal_from"`
+	SubtotalTo    pgtype.Int8 `json:"subtotal_to"`
+	Total         []int64     `json:"total"`
+	TotalFrom     pgtype.Int8 `json:"total_from"`
+	TotalTo       pgtype.Int8 `json:"total_to"`
 }
 
 func (q *Queries) CountOrderItem(ctx context.Context, arg CountOrderItemParams) (int64, error) {
@@ -1475,6 +1613,15 @@ func (q *Queries) CountOrderItem(ctx context.Context, arg CountOrderItemParams)
 		arg.Quantity,
 		arg.QuantityFrom,
 		arg.QuantityTo,
+		arg.UnitPrice,
+		arg.UnitPriceFrom,
+		arg.UnitPriceTo,
+		arg.Subtotal,
+		arg.SubtotalFrom,
+		arg.SubtotalTo,
+		arg.Total,
+		arg.TotalFrom,
+		arg.TotalTo,
 	)
 	var count int64
 	err := row.Scan(&count)
@@ -1698,24 +1845,23 @@ WHERE (
     ("ref_id" <= $11 OR $11 IS NULL) AND
     ("type" = ANY($12) OR $12 IS NULL) AND
     ("is_active" = ANY($13) OR $13 IS NULL) AND
-    ("date_started" = ANY($14) OR $14 IS NULL) AND
-    ("date_started" >= $15 OR $15 IS NULL) AND
-    ("date_started" <= $16 OR $16 IS NULL) AND
-    ("date_ended" = ANY($17) OR $17 IS NULL) AND
-    ("date_ended" >= $18 OR $18 IS NULL) AND
-    ("date_ended" <= $19 OR $19 IS NULL) AND
-    ("schedule_start" = ANY($20) OR $20 IS NULL) AND
-    ("schedule_start" >= $21 OR $21 IS NULL) AND
-    ("schedule_start" <= $22 OR $22 IS NULL) AND
-    ("schedule_duration" = ANY($23) OR $23 IS NULL) AND
-    ("schedule_duration" >= $24 OR $24 IS NULL) AND
-    ("schedule_duration" <= $25 OR $25 IS NULL) AND
-    ("date_created" = ANY($26) OR $26 IS NULL) AND
-    ("date_created" >= $27 OR $27 IS NULL) AND
-    ("date_created" <= $28 OR $28 IS NULL) AND
-    ("date_updated" = ANY($29) OR $29 IS NULL) AND
-    ("date_updated" >= $30 OR $30 IS NULL) AND
-    ("date_updated" <= $31 OR $31 IS NULL)
+    ("exclusive" = ANY($14) OR $14 IS NULL) AND
+    ("date_started" = ANY($15) OR $15 IS NULL) AND
+    ("date_started" >= $16 OR $16 IS NULL) AND
+    ("date_started" <= $17 OR $17 IS NULL) AND
+    ("date_ended" = ANY($18) OR $18 IS NULL) AND
+    ("date_ended" >= $19 OR $19 IS NULL) AND
+    ("date_ended" <= $20 OR $20 IS NULL) AND
+    ("schedule_start" = ANY($21) OR $21 IS NULL) AND
+    ("schedule_duration" = ANY($22) OR $22 IS NULL) AND
+    ("schedule_duration" >= $23 OR $23 IS NULL) AND
+    ("schedule_duration" <= $24 OR $24 IS NULL) AND
+    ("date_created" = ANY($25) OR $25 IS NULL) AND
+    ("date_created" >= $26 OR $26 IS NULL) AND
+    ("date_created" <= $27 OR $27 IS NULL) AND
+    ("date_updated" = ANY($28) OR $28 IS NULL) AND
+    ("date_updated" >= $29 OR $29 IS NULL) AND
+    ("date_updated" <= $30 OR $30 IS NULL)
 )
 `
 
@@ -1733,15 +1879,14 @@ type CountPromotionBaseParams struct {
 	RefIDTo              pgtype.Int8          `json:"ref_id_to"`
 	Type                 []PromotionType      `json:"type"`
 	IsActive             []bool               `json:"is_active"`
+	Exclusive            []bool               `json:"exclusive"`
 	DateStarted          []pgtype.Timestamptz `json:"date_started"`
 	DateStartedFrom      pgtype.Timestamptz   `json:"date_started_from"`
 	DateStartedTo        pgtype.Timestamptz   `json:"date_started_to"`
 	DateEnded            []pgtype.Timestamptz `json:"date_ended"`
 	DateEndedFrom        pgtype.Timestamptz   `json:"date_ended_from"`
 	DateEndedTo          pgtype.Timestamptz   `json:"date_ended_to"`
-	ScheduleStart        []pgtype.Timestamptz `json:"schedule_start"`
-	ScheduleStartFrom    pgtype.Timestamptz   `json:"schedule_start_from"`
-	ScheduleStartTo      pgtype.Timestamptz   `json:"schedule_start_to"`
+	ScheduleStart        []pgtype.Text        `json:"schedule_start"`
 	ScheduleDuration     []pgtype.Int4        `json:"schedule_duration"`
 	ScheduleDurationFrom pgtype.Int4          `json:"schedule_duration_from"`
 	ScheduleDurationTo   pgtype.Int4          `json:"schedule_duration_to"`
@@ -1768,6 +1913,7 @@ func (q *Queries) CountPromotionBase(ctx context.Context, arg CountPromotionBase
 		arg.RefIDTo,
 		arg.Type,
 		arg.IsActive,
+		arg.Exclusive,
 		arg.DateStarted,
 		arg.DateStartedFrom,
 		arg.DateStartedTo,
@@ -1775,8 +1921,6 @@ func (q *Queries) CountPromotionBase(ctx context.Context, arg CountPromotionBase
 		arg.DateEndedFrom,
 		arg.DateEndedTo,
 		arg.ScheduleStart,
-		arg.ScheduleStartFrom,
-		arg.ScheduleStartTo,
 		arg.ScheduleDuration,
 		arg.ScheduleDurationFrom,
 		arg.ScheduleDurationTo,
@@ -1792,423 +1936,846 @@ func (q *Queries) CountPromotionBase(ctx context.Context, arg CountPromotionBase
 	return count, err
 }
 
-const countPromotionDiscount = `-- name: CountPromotionDiscount :one
+const countPromotionBundle = `-- name: CountPromotionBundle :one
 SELECT COUNT(*)
-FROM "promotion"."discount"
+FROM "promotion"."bundle"
 WHERE (
     ("id" = ANY($1) OR $1 IS NULL) AND
     ("id" >= $2 OR $2 IS NULL) AND
     ("id" <= $3 OR $3 IS NULL) AND
-    ("order_wide" = ANY($4) OR $4 IS NULL) AND
-    ("min_spend" = ANY($5) OR $5 IS NULL) AND
-    ("min_spend" >= $6 OR $6 IS NULL) AND
-    ("min_spend" <= $7 OR $7 IS NULL) AND
-    ("max_discount" = ANY($8) OR $8 IS NULL) AND
-    ("max_discount" >= $9 OR $9 IS NULL) AND
-    ("max_discount" <= $10 OR $10 IS NULL) AND
-    ("discount_percent" = ANY($11) OR $11 IS NULL) AND
-    ("discount_percent" >= $12 OR $12 IS NULL) AND
-    ("discount_percent" <= $13 OR $13 IS NULL) AND
-    ("discount_price" = ANY($14) OR $14 IS NULL) AND
-    ("discount_price" >= $15 OR $15 IS NULL) AND
-    ("discount_price" <= $16 OR $16 IS NULL)
+    ("price" = ANY($4) OR $4 IS NULL) AND
+    ("price" >= $5 OR $5 IS NULL) AND
+    ("price" <= $6 OR $6 IS NULL)
 )
 `
 
-type CountPromotionDiscountParams struct {
-	ID                  []int64       `json:"id"`
-	IDFrom              pgtype.Int8   `json:"id_from"`
-	IDTo                pgtype.Int8   `json:"id_to"`
-	OrderWide           []bool        `json:"order_wide"`
-	MinSpend            []int64       `json:"min_spend"`
-	MinSpendFrom        pgtype.Int8   `json:"min_spend_from"`
-	MinSpendTo          pgtype.Int8   `json:"min_spend_to"`
-	MaxDiscount         []int64       `json:"max_discount"`
-	MaxDiscountFrom     pgtype.Int8   `json:"max_discount_from"`
-	MaxDiscountTo       pgtype.Int8   `json:"max_discount_to"`
-	DiscountPercent     []pgtype.Int4 `json:"discount_percent"`
-	DiscountPercentFrom pgtype.Int4   `json:"discount_percent_from"`
-	DiscountPercentTo   pgtype.Int4   `json:"discount_percent_to"`
-	DiscountPrice       []pgtype.Int8 `json:"discount_price"`
-	DiscountPriceFrom   pgtype.Int8   `json:"discount_price_from"`
-	DiscountPriceTo     pgtype.Int8   `json:"discount_price_to"`
+type CountPromotionBundleParams struct {
+	ID        []int64     `json:"id"`
+	IDFrom    pgtype.Int8 `json:"id_from"`
+	IDTo      pgtype.Int8 `json:"id_to"`
+	Price     []int64     `json:"price"`
+	PriceFrom pgtype.Int8 `json:"price_from"`
+	PriceTo   pgtype.Int8 `json:"price_to"`
 }
 
-func (q *Queries) CountPromotionDiscount(ctx context.Context, arg CountPromotionDiscountParams) (int64, error) {
-	row := q.db.QueryRow(ctx, countPromotionDiscount,
+func (q *Queries) CountPromotionBundle(ctx context.Context, arg CountPromotionBundleParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countPromotionBundle,
 		arg.ID,
 		arg.IDFrom,
 		arg.IDTo,
-		arg.OrderWide,
-		arg.MinSpend,
-		arg.MinSpendFrom,
-		arg.MinSpendTo,
-		arg.MaxDiscount,
-		arg.MaxDiscountFrom,
-		arg.MaxDiscountTo,
-		arg.DiscountPercent,
-		arg.DiscountPercentFrom,
-		arg.DiscountPercentTo,
-		arg.DiscountPrice,
-		arg.DiscountPriceFrom,
-		arg.DiscountPriceTo,
+		arg.Price,
+		arg.PriceFrom,
+		arg.PriceTo,
 	)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
 }
 
-const countSharedResource = `-- name: CountSharedResource :one
+const countPromotionBundleItem = `-- name: CountPromotionBundleItem :one
 SELECT COUNT(*)
-FROM "shared"."resource"
+FROM "promotion"."bundle_item"
 WHERE (
     ("id" = ANY($1) OR $1 IS NULL) AND
     ("id" >= $2 OR $2 IS NULL) AND
     ("id" <= $3 OR $3 IS NULL) AND
-    ("owner_id" = ANY($4) OR $4 IS NULL) AND
-    ("owner_id" >= $5 OR $5 IS NULL) AND
-    ("owner_id" <= $6 OR $6 IS NULL) AND
-    ("owner_type" = ANY($7) OR $7 IS NULL) AND
-    ("order" = ANY($8) OR $8 IS NULL) AND
-    ("order" >= $9 OR $9 IS NULL) AND
-    ("order" <= $10 OR $10 IS NULL)
+    ("bundle_id" = ANY($4) OR $4 IS NULL) AND
+    ("bundle_id" >= $5 OR $5 IS NULL) AND
+    ("bundle_id" <= $6 OR $6 IS NULL) AND
+    ("sku_id" = ANY($7) OR $7 IS NULL) AND
+    ("sku_id" >= $8 OR $8 IS NULL) AND
+    ("sku_id" <= $9 OR $9 IS NULL) AND
+    ("quantity" = ANY($10) OR $10 IS NULL) AND
+    ("quantity" >= $11 OR $11 IS NULL) AND
+    ("quantity" <= $12 OR $12 IS NULL)
 )
 `
 
-type CountSharedResourceParams struct {
-	ID          []int64              `json:"id"`
-	IDFrom      pgtype.Int8          `json:"id_from"`
-	IDTo        pgtype.Int8          `json:"id_to"`
-	OwnerID     []int64              `json:"owner_id"`
-	OwnerIDFrom pgtype.Int8          `json:"owner_id_from"`
-	OwnerIDTo   pgtype.Int8          `json:"owner_id_to"`
-	OwnerType   []SharedResourceType `json:"owner_type"`
-	Order       []int32              `json:"order"`
-	OrderFrom   pgtype.Int4          `json:"order_from"`
-	OrderTo     pgtype.Int4          `json:"order_to"`
+type CountPromotionBundleItemParams struct {
+	ID           []int64     `json:"id"`
+	IDFrom       pgtype.Int8 `json:"id_from"`
+	IDTo         pgtype.Int8 `json:"id_to"`
+	BundleID     []int64     `json:"bundle_id"`
+	BundleIDFrom pgtype.Int8 `json:"bundle_id_from"`
+	BundleIDTo   pgtype.Int8 `json:"bundle_id_to"`
+	SkuID        []int64     `json:"sku_id"`
+	SkuIDFrom    pgtype.Int8 `json:"sku_id_from"`
+	SkuIDTo      pgtype.Int8 `json:"sku_id_to"`
+	Quantity     []int64     `json:"quantity"`
+	QuantityFrom pgtype.Int8 `json:"quantity_from"`
+	QuantityTo   pgtype.Int8 `json:"quantity_to"`
 }
 
-func (q *Queries) CountSharedResource(ctx context.Context, arg CountSharedResourceParams) (int64, error) {
-	row := q.db.QueryRow(ctx, countSharedResource,
+func (q *Queries) CountPromotionBundleItem(ctx context.Context, arg CountPromotionBundleItemParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countPromotionBundleItem,
 		arg.ID,
 		arg.IDFrom,
 		arg.IDTo,
-		arg.OwnerID,
-		arg.OwnerIDFrom,
-		arg.OwnerIDTo,
-		arg.OwnerType,
-		arg.Order,
-		arg.OrderFrom,
-		arg.OrderTo,
+		arg.BundleID,
+		arg.BundleIDFrom,
+		arg.BundleIDTo,
+		arg.SkuID,
+		arg.SkuIDFrom,
+		arg.SkuIDTo,
+		arg.Quantity,
+		arg.QuantityFrom,
+		arg.QuantityTo,
 	)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
 }
 
-const countSystemEvent = `-- name: CountSystemEvent :one
+const countPromotionBuyXGetY = `-- name: CountPromotionBuyXGetY :one
 SELECT COUNT(*)
-FROM "system"."event"
+FROM "promotion"."buy_x_get_y"
 WHERE (
     ("id" = ANY($1) OR $1 IS NULL) AND
     ("id" >= $2 OR $2 IS NULL) AND
     ("id" <= $3 OR $3 IS NULL) AND
-    ("account_id" = ANY($4) OR $4 IS NULL) AND
-    ("account_id" >= $5 OR $5 IS NULL) AND
-    ("account_id" <= $6 OR $6 IS NULL) AND
-    ("aggregate_id" = ANY($7) OR $7 IS NULL) AND
-    ("aggregate_id" >= $8 OR $8 IS NULL) AND
-    ("aggregate_id" <= $9 OR $9 IS NULL) AND
-    ("aggregate_type" = ANY($10) OR $10 IS NULL) AND
-    ("event_type" = ANY($11) OR $11 IS NULL) AND
-    ("payload" = ANY($12) OR $12 IS NULL) AND
-    ("version" = ANY($13) OR $13 IS NULL) AND
-    ("version" >= $14 OR $14 IS NULL) AND
-    ("version" <= $15 OR $15 IS NULL) AND
-    ("date_created" = ANY($16) OR $16 IS NULL) AND
-    ("date_created" >= $17 OR $17 IS NULL) AND
-    ("date_created" <= $18 OR $18 IS NULL)
+    ("buy_quantity" = ANY($4) OR $4 IS NULL) AND
+    ("buy_quantity" >= $5 OR $5 IS NULL) AND
+    ("buy_quantity" <= $6 OR $6 IS NULL) AND
+    ("get_quantity" = ANY($7) OR $7 IS NULL) AND
+    ("get_quantity" >= $8 OR $8 IS NULL) AND
+    ("get_quantity" <= $9 OR $9 IS NULL)
 )
 `
 
-type CountSystemEventParams struct {
-	ID              []int64              `json:"id"`
-	IDFrom          pgtype.Int8          `json:"id_from"`
-	IDTo            pgtype.Int8          `json:"id_to"`
-	AccountID       []pgtype.Int8        `json:"account_id"`
-	AccountIDFrom   pgtype.Int8          `json:"account_id_from"`
-	AccountIDTo     pgtype.Int8          `json:"account_id_to"`
-	AggregateID     []int64              `json:"aggregate_id"`
-	AggregateIDFrom pgtype.Int8          `json:"aggregate_id_from"`
-	AggregateIDTo   pgtype.Int8          `json:"aggregate_id_to"`
-	AggregateType   []string             `json:"aggregate_type"`
-	EventType       []SystemEventType    `json:"event_type"`
-	Payload         [][]byte             `json:"payload"`
-	Version         []int64              `json:"version"`
-	VersionFrom     pgtype.Int8          `json:"version_from"`
-	VersionTo       pgtype.Int8          `json:"version_to"`
-	DateCreated     []pgtype.Timestamptz `json:"date_created"`
-	DateCreatedFrom pgtype.Timestamptz   `json:"date_created_from"`
-	DateCreatedTo   pgtype.Timestamptz   `json:"date_created_to"`
+type CountPromotionBuyXGetYParams struct {
+	ID              []int64     `json:"id"`
+	IDFrom          pgtype.Int8 `json:"id_from"`
+	IDTo            pgtype.Int8 `json:"id_to"`
+	BuyQuantity     []int64     `json:"buy_quantity"`
+	BuyQuantityFrom pgtype.Int8 `json:"buy_quantity_from"`
+	BuyQuantityTo   pgtype.Int8 `json:"buy_quantity_to"`
+	GetQuantity     []int64     `json:"get_quantity"`
+	GetQuantityFrom pgtype.Int8 `json:"get_quantity_from"`
+	GetQuantityTo   pgtype.Int8 `json:"get_quantity_to"`
 }
 
-func (q *Queries) CountSystemEvent(ctx context.Context, arg CountSystemEventParams) (int64, error) {
-	row := q.db.QueryRow(ctx, countSystemEvent,
+func (q *Queries) CountPromotionBuyXGetY(ctx context.Context, arg CountPromotionBuyXGetYParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countPromotionBuyXGetY,
 		arg.ID,
 		arg.IDFrom,
 		arg.IDTo,
-		arg.AccountID,
-		arg.AccountIDFrom,
-		arg.AccountIDTo,
-		arg.AggregateID,
-		arg.AggregateIDFrom,
-		arg.AggregateIDTo,
-		arg.AggregateType,
-		arg.EventType,
-		arg.Payload,
-		arg.Version,
-		arg.VersionFrom,
-		arg.VersionTo,
-		arg.DateCreated,
-		arg.DateCreatedFrom,
-		arg.DateCreatedTo,
+		arg.BuyQuantity,
+		arg.BuyQuantityFrom,
+		arg.BuyQuantityTo,
+		arg.GetQuantity,
+		arg.GetQuantityFrom,
+		arg.GetQuantityTo,
 	)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
 }
 
-const countSystemSearchSync = `-- name: CountSystemSearchSync :one
+const countPromotionCashback = `-- name: CountPromotionCashback :one
 SELECT COUNT(*)
-FROM "system"."search_sync"
+FROM "promotion"."cashback"
 WHERE (
     ("id" = ANY($1) OR $1 IS NULL) AND
     ("id" >= $2 OR $2 IS NULL) AND
     ("id" <= $3 OR $3 IS NULL) AND
-    ("name" = ANY($4) OR $4 IS NULL) AND
-    ("last_synced" = ANY($5) OR $5 IS NULL) AND
-    ("last_synced" >= $6 OR $6 IS NULL) AND
-    ("last_synced" <= $7 OR $7 IS NULL)
+    ("min_spend" = ANY($4) OR $4 IS NULL) AND
+    ("min_spend" >= $5 OR $5 IS NULL) AND
+    ("min_spend" <= $6 OR $6 IS NULL) AND
+    ("max_cashback" = ANY($7) OR $7 IS NULL) AND
+    ("max_cashback" >= $8 OR $8 IS NULL) AND
+    ("max_cashback" <= $9 OR $9 IS NULL) AND
+    ("cashback_percent" = ANY($10) OR $10 IS NULL) AND
+    ("cashback_percent" >= $11 OR $11 IS NULL) AND
+    ("cashback_percent" <= $12 OR $12 IS NULL) AND
+    ("cashback_price" = ANY($13) OR $13 IS NULL) AND
+    ("cashback_price" >= $14 OR $14 IS NULL) AND
+    ("cashback_price" <= $15 OR $15 IS NULL)
 )
 `
 
-type CountSystemSearchSyncParams struct {
-	ID             []int64              `json:"id"`
-	IDFrom         pgtype.Int8          `json:"id_from"`
-	IDTo           pgtype.Int8          `json:"id_to"`
-	Name           []string             `json:"name"`
-	LastSynced     []pgtype.Timestamptz `json:"last_synced"`
-	LastSyncedFrom pgtype.Timestamptz   `json:"last_synced_from"`
-	LastSyncedTo   pgtype.Timestamptz   `json:"last_synced_to"`
-}
-
-func (q *Queries) CountSystemSearchSync(ctx context.Context, arg CountSystemSearchSyncParams) (int64, error) {
-	row := q.db.QueryRow(ctx, countSystemSearchSync,
+type CountPromotionCashbackParams struct {
+	ID                  []int64       `json:"id"`
+	IDFrom              pgtype.Int8   `json:"id_from"`
+	IDTo                pgtype.Int8   `json:"id_to"`
+	MinSpend            []int64       `json:"min_spend"`
+	MinSpendFrom        pgtype.Int8   `json:"min_spend_from"`
+	MinSpendTo          pgtype.Int8   `json:"min_spend_to"`
+	MaxCashback         []int64       `json:"max_cashback"`
+	MaxCashbackFrom     pgtype.Int8   `json:"max_cashback_from"`
+	MaxCashbackTo       pgtype.Int8   `json:"max_cashback_to"`
+	CashbackPercent     []pgtype.Int4 `json:"cashback_percent"`
+	CashbackPercentFrom pgtype.Int4   `json:"cashback_percent_from"`
+	CashbackPercentTo   pgtype.Int4   `json:"cashback_percent_to"`
+	CashbackPrice       []pgtype.Int8 `json:"cashback_price"`
+	CashbackPriceFrom   pgtype.Int8   `json:"cashback_price_from"`
+	CashbackPriceTo     pgtype.Int8   `json:"cashback_price_to"`
+}
+
+func (q *Queries) CountPromotionCashback(ctx context.Context, arg CountPromotionCashbackParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countPromotionCashback,
 		arg.ID,
 		arg.IDFrom,
 		arg.IDTo,
-		arg.Name,
-		arg.LastSynced,
-		arg.LastSyncedFrom,
-		arg.LastSyncedTo,
+		arg.MinSpend,
+		arg.MinSpendFrom,
+		arg.MinSpendTo,
+		arg.MaxCashback,
+		arg.MaxCashbackFrom,
+		arg.MaxCashbackTo,
+		arg.CashbackPercent,
+		arg.CashbackPercentFrom,
+		arg.CashbackPercentTo,
+		arg.CashbackPrice,
+		arg.CashbackPriceFrom,
+		arg.CashbackPriceTo,
 	)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
 }
 
-type CreateAccountAddressParams struct {
-	Code          string             `json:"code"`
-	AccountID     int64              `json:"account_id"`
-	Type          AccountAddressType `json:"type"`
-	FullName      string             `json:"full_name"`
-	Phone         string             `json:"phone"`
-	PhoneVerified bool               `json:"phone_verified"`
-	AddressLine   string             `json:"address_line"`
-	City          string             `json:"city"`
-	StateProvince string             `json:"state_province"`
-	Country       string             `json:"country"`
-	DateCreated   pgtype.Timestamptz `json:"date_created"`
-	DateUpdated   pgtype.Timestamptz `json:"date_updated"`
-}
+const countPromotionCashbackCredit = `-- name: CountPromotionCashbackCredit :one
+SELECT COUNT(*)
+FROM "promotion"."cashback_credit"
+WHERE (
+    ("id" = ANY($1) OR $1 IS NULL) AND
+    ("id" >= $2 OR $2 IS NULL) AND
+    ("id" <= $3 OR $3 IS NULL) AND
+    ("account_id" = ANY($4) OR $4 IS NULL) AND
+    ("account_id" >= $5 OR $5 IS NULL) AND
+    ("account_id" <= $6 OR $6 IS NULL) AND
+    ("order_id" = ANY($7) OR $7 IS NULL) AND
+    ("order_id" >= $8 OR $8 IS NULL) AND
+    ("order_id" <= $9 OR $9 IS NULL) AND
+    ("promotion_id" = ANY($10) OR $10 IS NULL) AND
+    ("promotion_id" >= $11 OR $11 IS NULL) AND
+    ("promotion_id" <= $12 OR $12 IS NULL) AND
+    ("amount" = ANY($13) OR $13 IS NULL) AND
+    ("amount" >= $14 OR $14 IS NULL) AND
+    ("amount" <= $15 OR $15 IS NULL) AND
+    ("status" = ANY($16) OR $16 IS NULL) AND
+    ("date_created" = ANY($17) OR $17 IS NULL) AND
+    ("date_created" >= $18 OR $18 IS NULL) AND
+    ("date_created" <= $19 OR $19 IS NULL) AND
+    ("date_updated" = ANY($20) OR $20 IS NULL) AND
+    ("date_updated" >= $21 OR $21 IS NULL) AND
+    ("date_updated" <= $22 OR $22 IS NULL)
+)
+`
 
-type CreateAccountBaseParams struct {
-	Code        string             `json:"code"`
-	Type        AccountType        `json:"type"`
-	Status      AccountStatus      `json:"status"`
-	Phone       pgtype.Text        `json:"phone"`
-	Email       pgtype.Text        `json:"email"`
-	Username    pgtype.Text        `json:"username"`
-	Password    pgtype.Text        `json:"password"`
-	DateCreated pgtype.Timestamptz `json:"date_created"`
-	DateUpdated pgtype.Timestamptz `json:"date_updated"`
+type CountPromotionCashbackCreditParams struct {
+	ID              []int64              `json:"id"`
+	IDFrom          pgtype.Int8          `json:"id_from"`
+	IDTo            pgtype.Int8          `json:"id_to"`
+	AccountID       []int64              `json:"account_id"`
+	AccountIDFrom   pgtype.Int8          `json:"account_id_from"`
+	AccountIDTo     pgtype.Int8          `json:"account_id_to"`
+	OrderID         []int64              `json:"order_id"`
+	OrderIDFrom     pgtype.Int8          `json:"order_id_from"`
+	OrderIDTo       pgtype.Int8          `json:"order_id_to"`
+	PromotionID     []int64              `json:"promotion_id"`
+	PromotionIDFrom pgtype.Int8          `json:"promotion_id_from"`
+	PromotionIDTo   pgtype.Int8          `json:"promotion_id_to"`
+	Amount          []int64              `json:"amount"`
+	AmountFrom      pgtype.Int8          `json:"amount_from"`
+	AmountTo        pgtype.Int8          `json:"amount_to"`
+	Status          []SharedStatus       `json:"status"`
+	DateCreated     []pgtype.Timestamptz `json:"date_created"`
+	DateCreatedFrom pgtype.Timestamptz   `json:"date_created_from"`
+	DateCreatedTo   pgtype.Timestamptz   `json:"date_created_to"`
+	DateUpdated     []pgtype.Timestamptz `json:"date_updated"`
+	DateUpdatedFrom pgtype.Timestamptz   `json:"date_updated_from"`
+	DateUpdatedTo   pgtype.Timestamptz   `json:"date_updated_to"`
 }
 
-type CreateAccountCartItemParams struct {
-	CartID      int64              `json:"cart_id"`
-	SkuID       int64              `json:"sku_id"`
-	Quantity    int64              `json:"quantity"`
-	DateCreated pgtype.Timestamptz `json:"date_created"`
-	DateUpdated pgtype.Timestamptz `json:"date_updated"`
+func (q *Queries) CountPromotionCashbackCredit(ctx context.Context, arg CountPromotionCashbackCreditParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countPromotionCashbackCredit,
+		arg.ID,
+		arg.IDFrom,
+		arg.IDTo,
+		arg.AccountID,
+		arg.AccountIDFrom,
+		arg.AccountIDTo,
+		arg.OrderID,
+		arg.OrderIDFrom,
+		arg.OrderIDTo,
+		arg.PromotionID,
+		arg.PromotionIDFrom,
+		arg.PromotionIDTo,
+		arg.Amount,
+		arg.AmountFrom,
+		arg.AmountTo,
+		arg.Status,
+		arg.DateCreated,
+		arg.DateCreatedFrom,
+		arg.DateCreatedTo,
+		arg.DateUpdated,
+		arg.DateUpdatedFrom,
+		arg.DateUpdatedTo,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
 }
 
-type CreateAccountCustomerParams struct {
-	ID               int64              `json:"id"`
-	DefaultAddressID pgtype.Int8        `json:"default_address_id"`
-	DateCreated      pgtype.Timestamptz `json:"date_created"`
-	DateUpdated      pgtype.Timestamptz `json:"date_updated"`
-}
+const countPromotionDiscount = `-- name: CountPromotionDiscount :one
+SELECT COUNT(*)
+FROM "promotion"."discount"
+WHERE (
+    ("id" = ANY($1) OR $1 IS NULL) AND
+    ("id" >= $2 OR $2 IS NULL) AND
+    ("id" <= $3 OR $3 IS NULL) AND
+    ("order_wide" = ANY($4) OR $4 IS NULL) AND
+    ("min_spend" = ANY($5) OR $5 IS NULL) AND
+    ("min_spend" >= $6 OR $6 IS NULL) AND
+    ("min_spend" <= $7 OR $7 IS NULL) AND
+    ("max_discount" = ANY($8) OR $8 IS NULL) AND
+    ("max_discount" >= $9 OR $9 IS NULL) AND
+    ("max_discount" <= $10 OR $10 IS NULL) AND
+    ("discount_percent" = ANY($11) OR $11 IS NULL) AND
+    ("discount_percent" >= $12 OR $12 IS NULL) AND
+    ("discount_percent" <= $13 OR $13 IS NULL) AND
+    ("discount_price" = ANY($14) OR $14 IS NULL) AND
+    ("discount_price" >= $15 OR $15 IS NULL) AND
+    ("discount_price" <= $16 OR $16 IS NULL)
+)
+`
 
-type CreateAccountIncomeHistoryParams struct {
-	AccountID      int64              `json:"account_id"`
-	Type           string             `json:"type"`
-	Income         int64              `json:"income"`
-	CurrentBalance int64              `json:"current_balance"`
-	Note           pgtype.Text        `json:"note"`
-	DateCreated    pgtype.Timestamptz `json:"date_created"`
-	Hash           []byte             `json:"hash"`
-	PrevHash       []byte             `json:"prev_hash"`
+type CountPromotionDiscountParams struct {
+	ID                  []int64       `json:"id"`
+	IDFrom              pgtype.Int8   `json:"id_from"`
+	IDTo                pgtype.Int8   `json:"id_to"`
+	OrderWide           []bool        `json:"order_wide"`
+	MinSpend            []int64       `json:"min_spend"`
+	MinSpendFrom        pgtype.Int8   `json:"min_spend_from"`
+	MinSpendTo          pgtype.Int8   `json:"min_spend_to"`
+	MaxDiscount         []int64       `json:"max_discount"`
+	MaxDiscountFrom     pgtype.Int8   `json:"max_discount_from"`
+	MaxDiscountTo       pgtype.Int8   `json:"max_discount_to"`
+	DiscountPercent     []pgtype.Int4 `json:"discount_percent"`
+	DiscountPercentFrom pgtype.Int4   `json:"discount_percent_from"`
+	DiscountPercentTo   pgtype.Int4   `json:"discount_percent_to"`
+	DiscountPrice       []pgtype.Int8 `json:"discount_price"`
+	DiscountPriceFrom   pgtype.Int8   `json:"discount_price_from"`
+	DiscountPriceTo     pgtype.Int8   `json:"discount_price_to"`
 }
 
-type CreateAccountNotificationParams struct {
-	AccountID     int64              `json:"account_id"`
-	Type          string             `json:"type"`
-	Channel       string             `json:"channel"`
-	IsRead        bool               `json:"is_read"`
-	Content       string             `json:"content"`
-	DateCreated   pgtype.Timestamptz `json:"date_created"`
-	DateUpdated   pgtype.Timestamptz `json:"date_updated"`
-	DateSent      pgtype.Timestamptz `json:"date_sent"`
-	DateScheduled pgtype.Timestamptz `json:"date_scheduled"`
+func (q *Queries) CountPromotionDiscount(ctx context.Context, arg CountPromotionDiscountParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countPromotionDiscount,
+		arg.ID,
+		arg.IDFrom,
+		arg.IDTo,
+		arg.OrderWide,
+		arg.MinSpend,
+		arg.MinSpendFrom,
+		arg.MinSpendTo,
+		arg.MaxDiscount,
+		arg.MaxDiscountFrom,
+		arg.MaxDiscountTo,
+		arg.DiscountPercent,
+		arg.DiscountPercentFrom,
+		arg.DiscountPercentTo,
+		arg.DiscountPrice,
+		arg.DiscountPriceFrom,
+		arg.DiscountPriceTo,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
 }
 
-type CreateAccountProfileParams struct {
-	ID            int64              `json:"id"`
-	Gender        NullAccountGender  `json:"gender"`
-	Name          pgtype.Text        `json:"name"`
-	DateOfBirth   pgtype.Date        `json:"date_of_birth"`
-	AvatarRsID    pgtype.Int8        `json:"avatar_rs_id"`
-	EmailVerified bool               `json:"email_verified"`
-	PhoneVerified bool               `json:"phone_verified"`
-	DateCreated   pgtype.Timestamptz `json:"date_created"`
-	DateUpdated   pgtype.Timestamptz `json:"date_updated"`
-}
+const countPromotionVoucher = `-- name: CountPromotionVoucher :one
+SELECT COUNT(*)
+FROM "promotion"."voucher"
+WHERE (
+    ("id" = ANY($1) OR $1 IS NULL) AND
+    ("id" >= $2 OR $2 IS NULL) AND
+    ("id" <= $3 OR $3 IS NULL) AND
+    ("usage_limit" = ANY($4) OR $4 IS NULL) AND
+    ("usage_limit" >= $5 OR $5 IS NULL) AND
+    ("usage_limit" <= $6 OR $6 IS NULL) AND
+    ("per_customer_limit" = ANY($7) OR $7 IS NULL) AND
+    ("per_customer_limit" >= $8 OR $8 IS NULL) AND
+    ("per_customer_limit" <= $9 OR $9 IS NULL) AND
+    ("first_order_only" = ANY($10) OR $10 IS NULL) AND
+    ("min_spend" = ANY($11) OR $11 IS NULL) AND
+    ("min_spend" >= $12 OR $12 IS NULL) AND
+    ("min_spend" <= $13 OR $13 IS NULL) AND
+    ("used" = ANY($14) OR $14 IS NULL) AND
+    ("used" >= $15 OR $15 IS NULL) AND
+    ("used" <= $16 OR $16 IS NULL)
+)
+`
 
-type CreateAccountVendorParams struct {
-	ID          int64  `json:"id"`
-	Description string `json:"description"`
+type CountPromotionVoucherParams struct {
+	ID                   []int64     `json:"id"`
+	IDFrom               pgtype.Int8 `json:"id_from"`
+	IDTo                 pgtype.Int8 `json:"id_to"`
+	UsageLimit           []int64     `json:"usage_limit"`
+	UsageLimitFrom       pgtype.Int8 `json:"usage_limit_from"`
+	UsageLimitTo         pgtype.Int8 `json:"usage_limit_to"`
+	PerCustomerLimit     []int64     `json:"per_customer_limit"`
+	PerCustomerLimitFrom pgtype.Int8 `json:"per_customer_limit_from"`
+	PerCustomerLimitTo   pgtype.Int8 `json:"per_customer_limit_to"`
+	FirstOrderOnly       []bool      `json:"first_order_only"`
+	MinSpend             []int64     `json:"min_spend"`
+	MinSpendFrom         pgtype.Int8 `json:"min_spend_from"`
+	MinSpendTo           pgtype.Int8 `json:"min_spend_to"`
+	Used                 []int64     `json:"used"`
+	UsedFrom             pgtype.Int8 `json:"used_from"`
+	UsedTo               pgtype.Int8 `json:"used_to"`
+}
+
+func (q *Queries) CountPromotionVoucher(ctx context.Context, arg CountPromotionVoucherParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countPromotionVoucher,
+		arg.ID,
+		arg.IDFrom,
+		arg.IDTo,
+		arg.UsageLimit,
+		arg.UsageLimitFrom,
+		arg.UsageLimitTo,
+		arg.PerCustomerLimit,
+		arg.PerCustomerLimitFrom,
+		arg.PerCustomerLimitTo,
+		arg.FirstOrderOnly,
+		arg.MinSpend,
+		arg.MinSpendFrom,
+		arg.MinSpendTo,
+		arg.Used,
+		arg.UsedFrom,
+		arg.UsedTo,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
 }
 
-type CreateCatalogBrandParams struct {
-	Code        string `json:"code"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
-}
+const countPromotionVoucherRedemption = `-- name: CountPromotionVoucherRedemption :one
+SELECT COUNT(*)
+FROM "promotion"."voucher_redemption"
+WHERE (
+    ("id" = ANY($1) OR $1 IS NULL) AND
+    ("id" >= $2 OR $2 IS NULL) AND
+    ("id" <= $3 OR $3 IS NULL) AND
+    ("voucher_id" = ANY($4) OR $4 IS NULL) AND
+    ("voucher_id" >= $5 OR $5 IS NULL) AND
+    ("voucher_id" <= $6 OR $6 IS NULL) AND
+    ("account_id" = ANY($7) OR $7 IS NULL) AND
+    ("account_id" >= $8 OR $8 IS NULL) AND
+    ("account_id" <= $9 OR $9 IS NULL) AND
+    ("order_id" = ANY($10) OR $10 IS NULL) AND
+    ("order_id" >= $11 OR $11 IS NULL) AND
+    ("order_id" <= $12 OR $12 IS NULL) AND
+    ("status" = ANY($13) OR $13 IS NULL) AND
+    ("date_created" = ANY($14) OR $14 IS NULL) AND
+    ("date_created" >= $15 OR $15 IS NULL) AND
+    ("date_created" <= $16 OR $16 IS NULL) AND
+    ("date_updated" = ANY($17) OR $17 IS NULL) AND
+    ("date_updated" >= $18 OR $18 IS NULL) AND
+    ("date_updated" <= $19 OR $19 IS NULL)
+)
+`
 
-type CreateCatalogCategoryParams struct {
-	Name        string      `json:"name"`
-	Description string      `json:"description"`
-	ParentID    pgtype.Int8 `json:"parent_id"`
+type CountPromotionVoucherRedemptionParams struct {
+	ID              []int64              `json:"id"`
+	IDFrom          pgtype.Int8          `json:"id_from"`
+	IDTo            pgtype.Int8          `json:"id_to"`
+	VoucherID       []int64              `json:"voucher_id"`
+	VoucherIDFrom   pgtype.Int8          `json:"voucher_id_from"`
+	VoucherIDTo     pgtype.Int8          `json:"voucher_id_to"`
+	AccountID       []int64              `json:"account_id"`
+	AccountIDFrom   pgtype.Int8          `json:"account_id_from"`
+	AccountIDTo     pgtype.Int8          `json:"account_id_to"`
+	OrderID         []int64              `json:"order_id"`
+	OrderIDFrom     pgtype.Int8          `json:"order_id_from"`
+	OrderIDTo       pgtype.Int8          `json:"order_id_to"`
+	Status          []SharedStatus       `json:"status"`
+	DateCreated     []pgtype.Timestamptz `json:"date_created"`
+	DateCreatedFrom pgtype.Timestamptz   `json:"date_created_from"`
+	DateCreatedTo   pgtype.Timestamptz   `json:"date_created_to"`
+	DateUpdated     []pgtype.Timestamptz `json:"date_updated"`
+	DateUpdatedFrom pgtype.Timestamptz   `json:"date_updated_from"`
+	DateUpdatedTo   pgtype.Timestamptz   `json:"date_updated_to"`
 }
 
-type CreateCatalogCommentParams struct {
-	Code        string                `json:"code"`
-	AccountID   int64                 `json:"account_id"`
-	RefType     CatalogCommentRefType `json:"ref_type"`
-	RefID       int64                 `json:"ref_id"`
-	Body        string                `json:"body"`
-	Upvote      int64                 `json:"upvote"`
-	Downvote    int64                 `json:"downvote"`
-	Score       int32                 `json:"score"`
-	DateCreated pgtype.Timestamptz    `json:"date_created"`
-	DateUpdated pgtype.Timestamptz    `json:"date_updated"`
+func (q *Queries) CountPromotionVoucherRedemption(ctx context.Context, arg CountPromotionVoucherRedemptionParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countPromotionVoucherRedemption,
+		arg.ID,
+		arg.IDFrom,
+		arg.IDTo,
+		arg.VoucherID,
+		arg.VoucherIDFrom,
+		arg.VoucherIDTo,
+		arg.AccountID,
+		arg.AccountIDFrom,
+		arg.AccountIDTo,
+		arg.OrderID,
+		arg.OrderIDFrom,
+		arg.OrderIDTo,
+		arg.Status,
+		arg.DateCreated,
+		arg.DateCreatedFrom,
+		arg.DateCreatedTo,
+		arg.DateUpdated,
+		arg.DateUpdatedFrom,
+		arg.DateUpdatedTo,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
 }
 
-type CreateCatalogProductSkuParams struct {
-	Code        string             `json:"code"`
-	SpuID       int64              `json:"spu_id"`
-	Price       int64              `json:"price"`
-	CanCombine  bool               `json:"can_combine"`
-	DateCreated pgtype.Timestamptz `json:"date_created"`
-	DateDeleted pgtype.Timestamptz `json:"date_deleted"`
-}
+const countSharedResource = `-- name: CountSharedResource :one
+SELECT COUNT(*)
+FROM "shared"."resource"
+WHERE (
+    ("id" = ANY($1) OR $1 IS NULL) AND
+    ("id" >= $2 OR $2 IS NULL) AND
+    ("id" <= $3 OR $3 IS NULL) AND
+    ("owner_id" = ANY($4) OR $4 IS NULL) AND
+    ("owner_id" >= $5 OR $5 IS NULL) AND
+    ("owner_id" <= $6 OR $6 IS NULL) AND
+    ("owner_type" = ANY($7) OR $7 IS NULL) AND
+    ("order" = ANY($8) OR $8 IS NULL) AND
+    ("order" >= $9 OR $9 IS NULL) AND
+    ("order" <= $10 OR $10 IS NULL)
+)
+`
 
-type CreateCatalogProductSkuAttributeParams struct {
-	Code        string             `json:"code"`
-	SkuID       int64              `json:"sku_id"`
-	Name        string             `json:"name"`
-	Value       string             `json:"value"`
-	DateCreated pgtype.Timestamptz `json:"date_created"`
-	DateUpdated pgtype.Timestamptz `json:"date_updated"`
+type CountSharedResourceParams struct {
+	ID          []int64              `json:"id"`
+	IDFrom      pgtype.Int8          `json:"id_from"`
+	IDTo        pgtype.Int8          `json:"id_to"`
+	OwnerID     []int64              `json:"owner_id"`
+	OwnerIDFrom pgtype.Int8          `json:"owner_id_from"`
+	OwnerIDTo   pgtype.Int8          `json:"owner_id_to"`
+	OwnerType   []SharedResourceType `json:"owner_type"`
+	Order       []int32              `json:"order"`
+	OrderFrom   pgtype.Int4          `json:"order_from"`
+	OrderTo     pgtype.Int4          `json:"order_to"`
 }
 
-type CreateCatalogProductSpuParams struct {
-	Code             string             `json:"code"`
-	AccountID        int64              `json:"account_id"`
-	CategoryID       int64              `json:"category_id"`
-	BrandID          int64              `json:"brand_id"`
-	Name             string             `json:"name"`
-	Description      string             `json:"description"`
-	IsActive         bool               `json:"is_active"`
-	DateManufactured pgtype.Timestamptz `json:"date_manufactured"`
-	DateCreated      pgtype.Timestamptz `json:"date_created"`
-	DateUpdated      pgtype.Timestamptz `json:"date_updated"`
-	DateDeleted      pgtype.Timestamptz `json:"date_deleted"`
+func (q *Queries) CountSharedResource(ctx context.Context, arg CountSharedResourceParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countSharedResource,
+		arg.ID,
+		arg.IDFrom,
+		arg.IDTo,
+		arg.OwnerID,
+		arg.OwnerIDFrom,
+		arg.OwnerIDTo,
+		arg.OwnerType,
+		arg.Order,
+		arg.OrderFrom,
+		arg.OrderTo,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
 }
 
-type CreateCatalogProductSpuTagParams struct {
-	SpuID int64 `json:"spu_id"`
-	TagID int64 `json:"tag_id"`
-}
+const countSystemEvent = `-- name: CountSystemEvent :one
+SELECT COUNT(*)
+FROM "system"."event"
+WHERE (
+    ("id" = ANY($1) OR $1 IS NULL) AND
+    ("id" >= $2 OR $2 IS NULL) AND
+    ("id" <= $3 OR $3 IS NULL) AND
+    ("account_id" = ANY($4) OR $4 IS NULL) AND
+    ("account_id" >= $5 OR $5 IS NULL) AND
+    ("account_id" <= $6 OR $6 IS NULL) AND
+    ("aggregate_id" = ANY($7) OR $7 IS NULL) AND
+    ("aggregate_id" >= $8 OR $8 IS NULL) AND
+    ("aggregate_id" <= $9 OR $9 IS NULL) AND
+    ("aggregate_type" = ANY($10) OR $10 IS NULL) AND
+    ("event_type" = ANY($11) OR $11 IS NULL) AND
+    ("payload" = ANY($12) OR $12 IS NULL) AND
+    ("version" = ANY($13) OR $13 IS NULL) AND
+    ("version" >= $14 OR $14 IS NULL) AND
+    ("version" <= $15 OR $15 IS NULL) AND
+    ("date_created" = ANY($16) OR $16 IS NULL) AND
+    ("date_created" >= $17 OR $17 IS NULL) AND
+    ("date_created" <= $18 OR $18 IS NULL)
+)
+`
 
-type CreateCatalogTagParams struct {
-	Tag         string `json:"tag"`
-	Description string `json:"description"`
+type CountSystemEventParams struct {
+	ID              []int64              `json:"id"`
+	IDFrom          pgtype.Int8          `json:"id_from"`
+	IDTo            pgtype.Int8          `json:"id_to"`
+	AccountID       []pgtype.Int8        `json:"account_id"`
+	AccountIDFrom   pgtype.Int8          `json:"account_id_from"`
+	AccountIDTo     pgtype.Int8          `json:"account_id_to"`
+	AggregateID     []int64              `json:"aggregate_id"`
+	AggregateIDFrom pgtype.Int8          `json:"aggregate_id_from"`
+	AggregateIDTo   pgtype.Int8          `json:"aggregate_id_to"`
+	AggregateType   []string             `json:"aggregate_type"`
+	EventType       []SystemEventType    `json:"event_type"`
+	Payload         [][]byte             `json:"payload"`
+	Version         []int64              `json:"version"`
+	VersionFrom     pgtype.Int8          `json:"version_from"`
+	VersionTo       pgtype.Int8          `json:"version_to"`
+	DateCreated     []pgtype.Timestamptz `json:"date_created"`
+	DateCreatedFrom pgtype.Timestamptz   `json:"date_created_from"`
+	DateCreatedTo   pgtype.Timestamptz   `json:"date_created_to"`
 }
 
-type CreateDefaultAccountAddressParams struct {
-	Code          string `json:"code"`
-	AccountID     int64  `json:"account_id"`
-	FullName      string `json:"full_name"`
-	Phone         string `json:"phone"`
-	AddressLine   string `json:"address_line"`
-	City          string `json:"city"`
-	StateProvince string `json:"state_province"`
-	Country       string `json:"country"`
+func (q *Queries) CountSystemEvent(ctx context.Context, arg CountSystemEventParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countSystemEvent,
+		arg.ID,
+		arg.IDFrom,
+		arg.IDTo,
+		arg.AccountID,
+		arg.AccountIDFrom,
+		arg.AccountIDTo,
+		arg.AggregateID,
+		arg.AggregateIDFrom,
+		arg.AggregateIDTo,
+		arg.AggregateType,
+		arg.EventType,
+		arg.Payload,
+		arg.Version,
+		arg.VersionFrom,
+		arg.VersionTo,
+		arg.DateCreated,
+		arg.DateCreatedFrom,
+		arg.DateCreatedTo,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
 }
 
-type CreateDefaultAccountBaseParams struct {
-	Code     string      `json:"code"`
-	Type     AccountType `json:"type"`
-	Phone    pgtype.Text `json:"phone"`
-	Email    pgtype.Text `json:"email"`
-	Username pgtype.Text `json:"username"`
-	Password pgtype.Text `json:"password"`
-}
+const countSystemSearchSync = `-- name: CountSystemSearchSync :one
+SELECT COUNT(*)
+FROM "system"."search_sync"
+WHERE (
+    ("id" = ANY($1) OR $1 IS NULL) AND
+    ("id" >= $2 OR $2 IS NULL) AND
+    ("id" <= $3 OR $3 IS NULL) AND
+    ("name" = ANY($4) OR $4 IS NULL) AND
+    ("last_synced" = ANY($5) OR $5 IS NULL) AND
+    ("last_synced" >= $6 OR $6 IS NULL) AND
+    ("last_synced" <= $7 OR $7 IS NULL)
+)
+`
 
-type CreateDefaultAccountCartItemParams struct {
-	CartID   int64 `json:"cart_id"`
-	SkuID    int64 `json:"sku_id"`
-	Quantity int64 `json:"quantity"`
+type CountSystemSearchSyncParams struct {
+	ID             []int64              `json:"id"`
+	IDFrom         pgtype.Int8          `json:"id_from"`
+	IDTo           pgtype.Int8          `json:"id_to"`
+	Name           []string             `json:"name"`
+	LastSynced     []pgtype.Timestamptz `json:"last_synced"`
+	LastSyncedFrom pgtype.Timestamptz   `json:"last_synced_from"`
+	LastSyncedTo   pgtype.Timestamptz   `json:"last_synced_to"`
 }
 
-type CreateDefaultAccountCustomerParams struct {
-	ID               int64       `json:"id"`
-	DefaultAddressID pgtype.Int8 `json:"default_address_id"`
+func (q *Queries) CountSystemSearchSync(ctx context.Context, arg CountSystemSearchSyncParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countSystemSearchSync,
+		arg.ID,
+		arg.IDFrom,
+		arg.IDTo,
+		arg.Name,
+		arg.LastSynced,
+		arg.LastSyncedFrom,
+		arg.LastSyncedTo,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
 }
 
-type CreateDefaultAccountIncomeHistoryParams struct {
-	AccountID      int64       `json:"account_id"`
-	Type           string      `json:"type"`
-	Income         int64       `json:"income"`
-	CurrentBalance int64       `json:"current_balance"`
-	Note           pgtype.Text `json:"note"`
-	Hash           []byte      `json:"hash"`
-	PrevHash       []byte      `json:"prev_hash"`
+type CreateAccountAddressParams struct {
+	Code          string             `json:"code"`
+	AccountID     int64              `json:"account_id"`
+	Type          AccountAddressType `json:"type"`
+	FullName      string             `json:"full_name"`
+	Phone         string             `json:"phone"`
+	PhoneVerified bool               `json:"phone_verified"`
+	AddressLine   string             `json:"address_line"`
+	City          string             `json:"city"`
+	StateProvince string             `json:"state_province"`
+	Country       string             `json:"country"`
+	DateCreated   pgtype.Timestamptz `json:"date_created"`
+	DateUpdated   pgtype.Timestamptz `json:"date_updated"`
 }
 
-type CreateDefaultAccountNotificationParams struct {
-	AccountID     int64              `json:"account_id"`
+type CreateAccountBaseParams struct {
+	Code        string             `json:"code"`
+	Type        AccountType        `json:"type"`
+	Status      AccountStatus      `json:"status"`
+	Phone       pgtype.Text        `json:"phone"`
+	Email       pgtype.Text        `json:"email"`
+	Username    pgtype.Text        `json:"username"`
+	Password    pgtype.Text        `json:"password"`
+	DateCreated pgtype.Timestamptz `json:"date_created"`
+	DateUpdated pgtype.Timestamptz `json:"date_updated"`
+}
+
+type CreateAccountCartItemParams struct {
+	CartID      int64              `json:"cart_id"`
+	SkuID       int64              `json:"sku_id"`
+	Quantity    int64              `json:"quantity"`
+	DateCreated pgtype.Timestamptz `json:"date_created"`
+	DateUpdated pgtype.Timestamptz `json:"date_updated"`
+}
+
+type CreateAccountCustomerParams struct {
+	ID               int64              `json:"id"`
+	DefaultAddressID pgtype.Int8        `json:"default_address_id"`
+	DateCreated      pgtype.Timestamptz `json:"date_created"`
+	DateUpdated      pgtype.Timestamptz `json:"date_updated"`
+}
+
+type CreateAccountIncomeHistoryParams struct {
+	AccountID      int64              `json:"account_id"`
+	Type           string             `json:"type"`
+	Income         int64              `json:"income"`
+	CurrentBalance int64              `json:"current_balance"`
+	Note           pgtype.Text        `json:"note"`
+	DateCreated    pgtype.Timestamptz `json:"date_created"`
+	Hash           []byte             `json:"hash"`
+	PrevHash       []byte             `json:"prev_hash"`
+}
+
+type CreateAccountNotificationParams struct {
+	AccountID     int64              `json:"account_id"`
+	Type          string             `json:"type"`
+	Channel       string             `json:"channel"`
+	IsRead        bool               `json:"is_read"`
+	Content       string             `json:"content"`
+	DateCreated   pgtype.Timestamptz `json:"date_created"`
+	DateUpdated   pgtype.Timestamptz `json:"date_updated"`
+	DateSent      pgtype.Timestamptz `json:"date_sent"`
+	DateScheduled pgtype.Timestamptz `json:"date_scheduled"`
+}
+
+type CreateAccountProfileParams struct {
+	ID            int64              `json:"id"`
+	Gender        NullAccountGender  `json:"gender"`
+	Name          pgtype.Text        `json:"name"`
+	DateOfBirth   pgtype.Date        `json:"date_of_birth"`
+	AvatarRsID    pgtype.Int8        `json:"avatar_rs_id"`
+	EmailVerified bool               `json:"email_verified"`
+	PhoneVerified bool               `json:"phone_verified"`
+	DateCreated   pgtype.Timestamptz `json:"date_created"`
+	DateUpdated   pgtype.Timestamptz `json:"date_updated"`
+}
+
+type CreateAccountVendorParams struct {
+	ID          int64  `json:"id"`
+	Description string `json:"description"`
+}
+
+type CreateCatalogBrandParams struct {
+	Code        string `json:"code"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type CreateCatalogCategoryParams struct {
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	ParentID    pgtype.Int8 `json:"parent_id"`
+}
+
+type CreateCatalogCommentParams struct {
+	Code        string                `json:"code"`
+	AccountID   int64                 `json:"account_id"`
+	RefType     CatalogCommentRefType `json:"ref_type"`
+	RefID       int64                 `json:"ref_id"`
+	Body        string                `json:"body"`
+	Upvote      int64                 `json:"upvote"`
+	Downvote    int64                 `json:"downvote"`
+	Score       int32                 `json:"score"`
+	DateCreated pgtype.Timestamptz    `json:"date_created"`
+	DateUpdated pgtype.Timestamptz    `json:"date_updated"`
+}
+
+type CreateCatalogProductSkuParams struct {
+	Code        string             `json:"code"`
+	SpuID       int64              `json:"spu_id"`
+	Price       int64              `json:"price"`
+	CanCombine  bool               `json:"can_combine"`
+	DateCreated pgtype.Timestamptz `json:"date_created"`
+	DateDeleted pgtype.Timestamptz `json:"date_deleted"`
+}
+
+type CreateCatalogProductSkuAttributeParams struct {
+	Code        string             `json:"code"`
+	SkuID       int64              `json:"sku_id"`
+	Name        string             `json:"name"`
+	Value       string             `json:"value"`
+	DateCreated pgtype.Timestamptz `json:"date_created"`
+	DateUpdated pgtype.Timestamptz `json:"date_updated"`
+}
+
+type CreateCatalogProductSpuParams struct {
+	Code             string             `json:"code"`
+	AccountID        int64              `json:"account_id"`
+	CategoryID       int64              `json:"category_id"`
+	BrandID          int64              `json:"brand_id"`
+	Name             string             `json:"name"`
+	Description      string             `json:"description"`
+	IsActive         bool               `json:"is_active"`
+	DateManufactured pgtype.Timestamptz `json:"date_manufactured"`
+	DateCreated      pgtype.Timestamptz `json:"date_created"`
+	DateUpdated      pgtype.Timestamptz `json:"date_updated"`
+	DateDeleted      pgtype.Timestamptz `json:"date_deleted"`
+}
+
+type CreateCatalogProductSpuTagParams struct {
+	SpuID int64 `json:"spu_id"`
+	TagID int64 `json:"tag_id"`
+}
+
+type CreateCatalogTagParams struct {
+	Tag         string `json:"tag"`
+	Description string `json:"description"`
+}
+
+type CreateDefaultAccountAddressParams struct {
+	Code          string `json:"code"`
+	AccountID     int64  `json:"account_id"`
+	FullName      string `json:"full_name"`
+	Phone         string `json:"phone"`
+	AddressLine   string `json:"address_line"`
+	City          string `json:"city"`
+	StateProvince string `json:"state_province"`
+	Country       string `json:"country"`
+}
+
+type CreateDefaultAccountBaseParams struct {
+	Code     string      `json:"code"`
+	Type     AccountType `json:"type"`
+	Phone    pgtype.Text `json:"phone"`
+	Email    pgtype.Text `json:"email"`
+	Username pgtype.Text `json:"username"`
+	Password pgtype.Text `json:"password"`
+}
+
+type CreateDefaultAccountCartItemParams struct {
+	CartID   int64 `json:"cart_id"`
+	SkuID    int64 `json:"sku_id"`
+	Quantity int64 `json:"quantity"`
+}
+
+type CreateDefaultAccountCustomerParams struct {
+	ID               int64       `json:"id"`
+	DefaultAddressID pgtype.Int8 `json:"default_address_id"`
+}
+
+type CreateDefaultAccountIncomeHistoryParams struct {
+	AccountID      int64       `json:"account_id"`
+	Type           string      `json:"type"`
+	Income         int64       `json:"income"`
+	CurrentBalance int64       `json:"current_balance"`
+	Note           pgtype.Text `json:"note"`
+	Hash           []byte      `json:"hash"`
+	PrevHash       []byte      `json:"prev_hash"`
+}
+
+type CreateDefaultAccountNotificationParams struct {
+	AccountID     int64              `json:"account_id"`
 	Type          string             `json:"type"`
 	Channel       string             `json:"channel"`
 	Content       string             `json:"content"`
@@ -2280,13 +2847,25 @@ type CreateDefaultInventorySkuSerialParams struct {
 }
 
 type CreateDefaultInventoryStockParams struct {
-	RefType InventoryStockType `json:"ref_type"`
-	RefID   int64              `json:"ref_id"`
+	RefType     InventoryStockType      `json:"ref_type"`
+	RefID       int64                   `json:"ref_id"`
+	Alert       NullInventoryStockAlert `json:"alert"`
+	DateAlerted pgtype.Timestamptz      `json:"date_alerted"`
 }
 
 type CreateDefaultInventoryStockHistoryParams struct {
-	StockID int64 `json:"stock_id"`
-	Change  int64 `json:"change"`
+	StockID   int64       `json:"stock_id"`
+	Change    int64       `json:"change"`
+	AccountID pgtype.Int8 `json:"account_id"`
+	Reason    pgtype.Text `json:"reason"`
+}
+
+type CreateDefaultInventoryStockReservationParams struct {
+	StockID     int64                    `json:"stock_id"`
+	RefType     InventoryReservationType `json:"ref_type"`
+	RefID       int64                    `json:"ref_id"`
+	Quantity    int64                    `json:"quantity"`
+	DateExpired pgtype.Timestamptz       `json:"date_expired"`
 }
 
 type CreateDefaultOrderBaseParams struct {
@@ -2326,10 +2905,13 @@ type CreateDefaultOrderInvoiceItemParams struct {
 }
 
 type CreateDefaultOrderItemParams struct {
-	Code     string `json:"code"`
-	OrderID  int64  `json:"order_id"`
-	SkuID    int64  `json:"sku_id"`
-	Quantity int64  `json:"quantity"`
+	Code      string `json:"code"`
+	OrderID   int64  `json:"order_id"`
+	SkuID     int64  `json:"sku_id"`
+	Quantity  int64  `json:"quantity"`
+	UnitPrice int64  `json:"unit_price"`
+	Subtotal  int64  `json:"subtotal"`
+	Total     int64  `json:"total"`
 }
 
 type CreateDefaultOrderItemSerialParams struct {
@@ -2356,18 +2938,19 @@ type CreateDefaultOrderRefundDisputeParams struct {
 }
 
 type CreateDefaultOrderVnpayParams struct {
-	ID                   int64  `json:"id"`
-	VnpAmount            string `json:"vnp_Amount"`
-	VnpBankCode          string `json:"vnp_BankCode"`
-	VnpCardType          string `json:"vnp_CardType"`
-	VnpOrderInfo         string `json:"vnp_OrderInfo"`
-	VnpPayDate           string `json:"vnp_PayDate"`
-	VnpResponseCode      string `json:"vnp_ResponseCode"`
-	VnpSecureHash        string `json:"vnp_SecureHash"`
-	VnpTmnCode           string `json:"vnp_TmnCode"`
-	VnpTransactionNo     string `json:"vnp_TransactionNo"`
-	VnpTransactionStatus string `json:"vnp_TransactionStatus"`
-	VnpTxnRef            string `json:"vnp_TxnRef"`
+	ID                   int64       `json:"id"`
+	VnpAmount            string      `json:"vnp_Amount"`
+	VnpBankCode          string      `json:"vnp_BankCode"`
+	VnpBankTranNo        pgtype.Text `json:"vnp_BankTranNo"`
+	VnpCardType          string      `json:"vnp_CardType"`
+	VnpOrderInfo         string      `json:"vnp_OrderInfo"`
+	VnpPayDate           string      `json:"vnp_PayDate"`
+	VnpResponseCode      string      `json:"vnp_ResponseCode"`
+	VnpSecureHash        string      `json:"vnp_SecureHash"`
+	VnpTmnCode           string      `json:"vnp_TmnCode"`
+	VnpTransactionNo     string      `json:"vnp_TransactionNo"`
+	VnpTransactionStatus string      `json:"vnp_TransactionStatus"`
+	VnpTxnRef            string      `json:"vnp_TxnRef"`
 }
 
 type CreateDefaultPromotionBaseParams struct {
@@ -2380,11 +2963,40 @@ type CreateDefaultPromotionBaseParams struct {
 	Description      pgtype.Text        `json:"description"`
 	DateEnded        pgtype.Timestamptz `json:"date_ended"`
 	ScheduleTz       pgtype.Text        `json:"schedule_tz"`
-	ScheduleStart    pgtype.Timestamptz `json:"schedule_start"`
+	ScheduleStart    pgtype.Text        `json:"schedule_start"`
 	ScheduleDuration pgtype.Int4        `json:"schedule_duration"`
 	DateUpdated      pgtype.Timestamptz `json:"date_updated"`
 }
 
+type CreateDefaultPromotionBundleParams struct {
+	ID    int64 `json:"id"`
+	Price int64 `json:"price"`
+}
+
+type CreateDefaultPromotionBundleItemParams struct {
+	BundleID int64 `json:"bundle_id"`
+	SkuID    int64 `json:"sku_id"`
+}
+
+type CreateDefaultPromotionBuyXGetYParams struct {
+	ID          int64 `json:"id"`
+	BuyQuantity int64 `json:"buy_quantity"`
+	GetQuantity int64 `json:"get_quantity"`
+}
+
+type CreateDefaultPromotionCashbackParams struct {
+	ID              int64       `json:"id"`
+	CashbackPercent pgtype.Int4 `json:"cashback_percent"`
+	CashbackPrice   pgtype.Int8 `json:"cashback_price"`
+}
+
+type CreateDefaultPromotionCashbackCreditParams struct {
+	AccountID   int64 `json:"account_id"`
+	OrderID     int64 `json:"order_id"`
+	PromotionID int64 `json:"promotion_id"`
+	Amount      int64 `json:"amount"`
+}
+
 type CreateDefaultPromotionDiscountParams struct {
 	ID              int64       `json:"id"`
 	OrderWide       bool        `json:"order_wide"`
@@ -2392,6 +3004,12 @@ type CreateDefaultPromotionDiscountParams struct {
 	DiscountPrice   pgtype.Int8 `json:"discount_price"`
 }
 
+type CreateDefaultPromotionVoucherRedemptionParams struct {
+	VoucherID int64 `json:"voucher_id"`
+	AccountID int64 `json:"account_id"`
+	OrderID   int64 `json:"order_id"`
+}
+
 type CreateDefaultSharedResourceParams struct {
 	MimeType  string             `json:"mime_type"`
 	OwnerID   int64              `json:"owner_id"`
@@ -2417,19 +3035,35 @@ type CreateInventorySkuSerialParams struct {
 }
 
 type CreateInventoryStockParams struct {
-	RefType      InventoryStockType `json:"ref_type"`
-	RefID        int64              `json:"ref_id"`
-	CurrentStock int64              `json:"current_stock"`
-	Sold         int64              `json:"sold"`
-	DateCreated  pgtype.Timestamptz `json:"date_created"`
+	RefType           InventoryStockType      `json:"ref_type"`
+	RefID             int64                   `json:"ref_id"`
+	CurrentStock      int64                   `json:"current_stock"`
+	Sold              int64                   `json:"sold"`
+	LowStockThreshold int64                   `json:"low_stock_threshold"`
+	Alert             NullInventoryStockAlert `json:"alert"`
+	DateAlerted       pgtype.Timestamptz      `json:"date_alerted"`
+	DateCreated       pgtype.Timestamptz      `json:"date_created"`
 }
 
 type CreateInventoryStockHistoryParams struct {
 	StockID     int64              `json:"stock_id"`
 	Change      int64              `json:"change"`
+	AccountID   pgtype.Int8        `json:"account_id"`
+	Reason      pgtype.Text        `json:"reason"`
 	DateCreated pgtype.Timestamptz `json:"date_created"`
 }
 
+type CreateInventoryStockReservationParams struct {
+	StockID     int64                    `json:"stock_id"`
+	RefType     InventoryReservationType `json:"ref_type"`
+	RefID       int64                    `json:"ref_id"`
+	Quantity    int64                    `json:"quantity"`
+	Status      SharedStatus             `json:"status"`
+	DateExpired pgtype.Timestamptz       `json:"date_expired"`
+	DateCreated pgtype.Timestamptz       `json:"date_created"`
+	DateUpdated pgtype.Timestamptz       `json:"date_updated"`
+}
+
 type CreateOrderBaseParams struct {
 	Code          string             `json:"code"`
 	CustomerID    int64              `json:"customer_id"`
@@ -2469,10 +3103,13 @@ type CreateOrderInvoiceItemParams struct {
 }
 
 type CreateOrderItemParams struct {
-	Code     string `json:"code"`
-	OrderID  int64  `json:"order_id"`
-	SkuID    int64  `json:"sku_id"`
-	Quantity int64  `json:"quantity"`
+	Code      string `json:"code"`
+	OrderID   int64  `json:"order_id"`
+	SkuID     int64  `json:"sku_id"`
+	Quantity  int64  `json:"quantity"`
+	UnitPrice int64  `json:"unit_price"`
+	Subtotal  int64  `json:"subtotal"`
+	Total     int64  `json:"total"`
 }
 
 type CreateOrderItemSerialParams struct {
@@ -2502,18 +3139,19 @@ type CreateOrderRefundDisputeParams struct {
 }
 
 type CreateOrderVnpayParams struct {
-	ID                   int64  `json:"id"`
-	VnpAmount            string `json:"vnp_Amount"`
-	VnpBankCode          string `json:"vnp_BankCode"`
-	VnpCardType          string `json:"vnp_CardType"`
-	VnpOrderInfo         string `json:"vnp_OrderInfo"`
-	VnpPayDate           string `json:"vnp_PayDate"`
-	VnpResponseCode      string `json:"vnp_ResponseCode"`
-	VnpSecureHash        string `json:"vnp_SecureHash"`
-	VnpTmnCode           string `json:"vnp_TmnCode"`
-	VnpTransactionNo     string `json:"vnp_TransactionNo"`
-	VnpTransactionStatus string `json:"vnp_TransactionStatus"`
-	VnpTxnRef            string `json:"vnp_TxnRef"`
+	ID                   int64       `json:"id"`
+	VnpAmount            string      `json:"vnp_Amount"`
+	VnpBankCode          string      `json:"vnp_BankCode"`
+	VnpBankTranNo        pgtype.Text `json:"vnp_BankTranNo"`
+	VnpCardType          string      `json:"vnp_CardType"`
+	VnpOrderInfo         string      `json:"vnp_OrderInfo"`
+	VnpPayDate           string      `json:"vnp_PayDate"`
+	VnpResponseCode      string      `json:"vnp_ResponseCode"`
+	VnpSecureHash        string      `json:"vnp_SecureHash"`
+	VnpTmnCode           string      `json:"vnp_TmnCode"`
+	VnpTransactionNo     string      `json:"vnp_TransactionNo"`
+	VnpTransactionStatus string      `json:"vnp_TransactionStatus"`
+	VnpTxnRef            string      `json:"vnp_TxnRef"`
 }
 
 type CreatePromotionBaseParams struct {
@@ -2525,15 +3163,51 @@ type CreatePromotionBaseParams struct {
 	Title            string             `json:"title"`
 	Description      pgtype.Text        `json:"description"`
 	IsActive         bool               `json:"is_active"`
+	Exclusive        bool               `json:"exclusive"`
 	DateStarted      pgtype.Timestamptz `json:"date_started"`
 	DateEnded        pgtype.Timestamptz `json:"date_ended"`
 	ScheduleTz       pgtype.Text        `json:"schedule_tz"`
-	ScheduleStart    pgtype.Timestamptz `json:"schedule_start"`
+	ScheduleStart    pgtype.Text        `json:"schedule_start"`
 	ScheduleDuration pgtype.Int4        `json:"schedule_duration"`
 	DateCreated      pgtype.Timestamptz `json:"date_created"`
 	DateUpdated      pgtype.Timestamptz `json:"date_updated"`
 }
 
+type CreatePromotionBundleParams struct {
+	ID    int64 `json:"id"`
+	Price int64 `json:"price"`
+}
+
+type CreatePromotionBundleItemParams struct {
+	BundleID int64 `json:"bundle_id"`
+	SkuID    int64 `json:"sku_id"`
+	Quantity int64 `json:"quantity"`
+}
+
+type CreatePromotionBuyXGetYParams struct {
+	ID          int64 `json:"id"`
+	BuyQuantity int64 `json:"buy_quantity"`
+	GetQuantity int64 `json:"get_quantity"`
+}
+
+type CreatePromotionCashbackParams struct {
+	ID              int64       `json:"id"`
+	MinSpend        int64       `json:"min_spend"`
+	MaxCashback     int64       `json:"max_cashback"`
+	CashbackPercent pgtype.Int4 `json:"cashback_percent"`
+	CashbackPrice   pgtype.Int8 `json:"cashback_price"`
+}
+
+type CreatePromotionCashbackCreditParams struct {
+	AccountID   int64              `json:"account_id"`
+	OrderID     int64              `json:"order_id"`
+	PromotionID int64              `json:"promotion_id"`
+	Amount      int64              `json:"amount"`
+	Status      SharedStatus       `json:"status"`
+	DateCreated pgtype.Timestamptz `json:"date_created"`
+	DateUpdated pgtype.Timestamptz `json:"date_updated"`
+}
+
 type CreatePromotionDiscountParams struct {
 	ID              int64       `json:"id"`
 	OrderWide       bool        `json:"order_wide"`
@@ -2543,6 +3217,24 @@ type CreatePromotionDiscountParams struct {
 	DiscountPrice   pgtype.Int8 `json:"discount_price"`
 }
 
+type CreatePromotionVoucherParams struct {
+	ID               int64 `json:"id"`
+	UsageLimit       int64 `json:"usage_limit"`
+	PerCustomerLimit int64 `json:"per_customer_limit"`
+	FirstOrderOnly   bool  `json:"first_order_only"`
+	MinSpend         int64 `json:"min_spend"`
+	Used             int64 `json:"used"`
+}
+
+type CreatePromotionVoucherRedemptionParams struct {
+	VoucherID   int64              `json:"voucher_id"`
+	AccountID   int64              `json:"account_id"`
+	OrderID     int64              `json:"order_id"`
+	Status      SharedStatus       `json:"status"`
+	DateCreated pgtype.Timestamptz `json:"date_created"`
+	DateUpdated pgtype.Timestamptz `json:"date_updated"`
+}
+
 type CreateSharedResourceParams struct {
 	MimeType  string             `json:"mime_type"`
 	OwnerID   int64              `json:"owner_id"`
@@ -2843,6 +3535,16 @@ func (q *Queries) DeleteInventoryStockHistory(ctx context.Context, id pgtype.Int
 	return err
 }
 
+const deleteInventoryStockReservation = `-- name: DeleteInventoryStockReservation :exec
+DELETE FROM "inventory"."stock_reservation"
+WHERE ("id" = $1)
+`
+
+func (q *Queries) DeleteInventoryStockReservation(ctx context.Context, id pgtype.Int8) error {
+	_, err := q.db.Exec(ctx, deleteInventoryStockReservation, id)
+	return err
+}
+
 const deleteOrderBase = `-- name: DeleteOrderBase :exec
 DELETE FROM "order"."base"
 WHERE ("id" = $1) OR ("code" = $2)
@@ -2970,43 +3672,131 @@ func (q *Queries) DeletePromotionBase(ctx context.Context, arg DeletePromotionBa
 	return err
 }
 
-const deletePromotionDiscount = `-- name: DeletePromotionDiscount :exec
-DELETE FROM "promotion"."discount"
+const deletePromotionBundle = `-- name: DeletePromotionBundle :exec
+DELETE FROM "promotion"."bundle"
 WHERE ("id" = $1)
 `
 
-func (q *Queries) DeletePromotionDiscount(ctx context.Context, id pgtype.Int8) error {
-	_, err := q.db.Exec(ctx, deletePromotionDiscount, id)
+func (q *Queries) DeletePromotionBundle(ctx context.Context, id pgtype.Int8) error {
+	_, err := q.db.Exec(ctx, deletePromotionBundle, id)
 	return err
 }
 
-const deleteSharedResource = `-- name: DeleteSharedResource :exec
-DELETE FROM "shared"."resource"
-WHERE ("id" = $1)
+const deletePromotionBundleItem = `-- name: DeletePromotionBundleItem :exec
+DELETE FROM "promotion"."bundle_item"
+WHERE ("id" = $1) OR ("bundle_id" = $2 AND "sku_id" = $3)
 `
 
-func (q *Queries) DeleteSharedResource(ctx context.Context, id pgtype.Int8) error {
-	_, err := q.db.Exec(ctx, deleteSharedResource, id)
+type DeletePromotionBundleItemParams struct {
+	ID       pgtype.Int8 `json:"id"`
+	BundleID pgtype.Int8 `json:"bundle_id"`
+	SkuID    pgtype.Int8 `json:"sku_id"`
+}
+
+func (q *Queries) DeletePromotionBundleItem(ctx context.Context, arg DeletePromotionBundleItemParams) error {
+	_, err := q.db.Exec(ctx, deletePromotionBundleItem, arg.ID, arg.BundleID, arg.SkuID)
 	return err
 }
 
-const deleteSystemEvent = `-- name: DeleteSystemEvent :exec
-DELETE FROM "system"."event"
+const deletePromotionBuyXGetY = `-- name: DeletePromotionBuyXGetY :exec
+DELETE FROM "promotion"."buy_x_get_y"
 WHERE ("id" = $1)
 `
 
-func (q *Queries) DeleteSystemEvent(ctx context.Context, id pgtype.Int8) error {
-	_, err := q.db.Exec(ctx, deleteSystemEvent, id)
+func (q *Queries) DeletePromotionBuyXGetY(ctx context.Context, id pgtype.Int8) error {
+	_, err := q.db.Exec(ctx, deletePromotionBuyXGetY, id)
 	return err
 }
 
-const deleteSystemSearchSync = `-- name: DeleteSystemSearchSync :exec
-DELETE FROM "system"."search_sync"
+const deletePromotionCashback = `-- name: DeletePromotionCashback :exec
+DELETE FROM "promotion"."cashback"
 WHERE ("id" = $1)
 `
 
-func (q *Queries) DeleteSystemSearchSync(ctx context.Context, id pgtype.Int8) error {
-	_, err := q.db.Exec(ctx, deleteSystemSearchSync, id)
+func (q *Queries) DeletePromotionCashback(ctx context.Context, id pgtype.Int8) error {
+	_, err := q.db.Exec(ctx, deletePromotionCashback, id)
+	return err
+}
+
+const deletePromotionCashbackCredit = `-- name: DeletePromotionCashbackCredit :exec
+DELETE FROM "promotion"."cashback_credit"
+WHERE ("id" = $1) OR ("order_id" = $2 AND "promotion_id" = $3)
+`
+
+type DeletePromotionCashbackCreditParams struct {
+	ID          pgtype.Int8 `json:"id"`
+	OrderID     pgtype.Int8 `json:"order_id"`
+	PromotionID pgtype.Int8 `json:"promotion_id"`
+}
+
+func (q *Queries) DeletePromotionCashbackCredit(ctx context.Context, arg DeletePromotionCashbackCreditParams) error {
+	_, err := q.db.Exec(ctx, deletePromotionCashbackCredit, arg.ID, arg.OrderID, arg.PromotionID)
+	return err
+}
+
+const deletePromotionDiscount = `-- name: DeletePromotionDiscount :exec
+DELETE FROM "promotion"."discount"
+WHERE ("id" = $1)
+`
+
+func (q *Queries) DeletePromotionDiscount(ctx context.Context, id pgtype.Int8) error {
+	_, err := q.db.Exec(ctx, deletePromotionDiscount, id)
+	return err
+}
+
+const deletePromotionVoucher = `-- name: DeletePromotionVoucher :exec
+DELETE FROM "promotion"."voucher"
+WHERE ("id" = $1)
+`
+
+func (q *Queries) DeletePromotionVoucher(ctx context.Context, id pgtype.Int8) error {
+	_, err := q.db.Exec(ctx, deletePromotionVoucher, id)
+	return err
+}
+
+const deletePromotionVoucherRedemption = `-- name: DeletePromotionVoucherRedemption :exec
+DELETE FROM "promotion"."voucher_redemption"
+WHERE ("id" = $1) OR ("voucher_id" = $2 AND "order_id" = $3)
+`
+
+type DeletePromotionVoucherRedemptionParams struct {
+	ID        pgtype.Int8 `json:"id"`
+	VoucherID pgtype.Int8 `json:"voucher_id"`
+	OrderID   pgtype.Int8 `json:"order_id"`
+}
+
+func (q *Queries) DeletePromotionVoucherRedemption(ctx context.Context, arg DeletePromotionVoucherRedemptionParams) error {
+	_, err := q.db.Exec(ctx, deletePromotionVoucherRedemption, arg.ID, arg.VoucherID, arg.OrderID)
+	return err
+}
+
+const deleteSharedResource = `-- name: DeleteSharedResource :exec
+DELETE FROM "shared"."resource"
+WHERE ("id" = $1)
+`
+
+func (q *Queries) DeleteSharedResource(ctx context.Context, id pgtype.Int8) error {
+	_, err := q.db.Exec(ctx, deleteSharedResource, id)
+	return err
+}
+
+const deleteSystemEvent = `-- name: DeleteSystemEvent :exec
+DELETE FROM "system"."event"
+WHERE ("id" = $1)
+`
+
+func (q *Queries) DeleteSystemEvent(ctx context.Context, id pgtype.Int8) error {
+	_, err := q.db.Exec(ctx, deleteSystemEvent, id)
+	return err
+}
+
+const deleteSystemSearchSync = `-- name: DeleteSystemSearchSync :exec
+DELETE FROM "system"."search_sync"
+WHERE ("id" = $1)
+`
+
+func (q *Queries) DeleteSystemSearchSync(ctx context.Context, id pgtype.Int8) error {
+	_, err := q.db.Exec(ctx, deleteSystemSearchSync, id)
 	return err
 }
 
@@ -4115,30 +4905,44 @@ WHERE (
     ("sold" = ANY($11) OR $11 IS NULL) AND
     ("sold" >= $12 OR $12 IS NULL) AND
     ("sold" <= $13 OR $13 IS NULL) AND
-    ("date_created" = ANY($14) OR $14 IS NULL) AND
-    ("date_created" >= $15 OR $15 IS NULL) AND
-    ("date_created" <= $16 OR $16 IS NULL)
+    ("low_stock_threshold" = ANY($14) OR $14 IS NULL) AND
+    ("low_stock_threshold" >= $15 OR $15 IS NULL) AND
+    ("low_stock_threshold" <= $16 OR $16 IS NULL) AND
+    ("alert" = ANY($17) OR $17 IS NULL) AND
+    ("date_alerted" = ANY($18) OR $18 IS NULL) AND
+    ("date_alerted" >= $19 OR $19 IS NULL) AND
+    ("date_alerted" <= $20 OR $20 IS NULL) AND
+    ("date_created" = ANY($21) OR $21 IS NULL) AND
+    ("date_created" >= $22 OR $22 IS NULL) AND
+    ("date_created" <= $23 OR $23 IS NULL)
 )
 ) as exists
 `
 
 type ExistsInventoryStockParams struct {
-	ID               []int64              `json:"id"`
-	IDFrom           pgtype.Int8          `json:"id_from"`
-	IDTo             pgtype.Int8          `json:"id_to"`
-	RefType          []InventoryStockType `json:"ref_type"`
-	RefID            []int64              `json:"ref_id"`
-	RefIDFrom        pgtype.Int8          `json:"ref_id_from"`
-	RefIDTo          pgtype.Int8          `json:"ref_id_to"`
-	CurrentStock     []int64              `json:"current_stock"`
-	CurrentStockFrom pgtype.Int8          `json:"current_stock_from"`
-	CurrentStockTo   pgtype.Int8          `json:"current_stock_to"`
-	Sold             []int64              `json:"sold"`
-	SoldFrom         pgtype.Int8          `json:"sold_from"`
-	SoldTo           pgtype.Int8          `json:"sold_to"`
-	DateCreated      []pgtype.Timestamptz `json:"date_created"`
-	DateCreatedFrom  pgtype.Timestamptz   `json:"date_created_from"`
-	DateCreatedTo    pgtype.Timestamptz   `json:"date_created_to"`
+	ID                    []int64                   `json:"id"`
+	IDFrom                pgtype.Int8               `json:"id_from"`
+	IDTo                  pgtype.Int8               `json:"id_to"`
+	RefType               []InventoryStockType      `json:"ref_type"`
+	RefID                 []int64                   `json:"ref_id"`
+	RefIDFrom             pgtype.Int8               `json:"ref_id_from"`
+	RefIDTo               pgtype.Int8               `json:"ref_id_to"`
+	CurrentStock          []int64                   `json:"current_stock"`
+	CurrentStockFrom      pgtype.Int8               `json:"current_stock_from"`
+	CurrentStockTo        pgtype.Int8               `json:"current_stock_to"`
+	Sold                  []int64                   `json:"sold"`
+	SoldFrom              pgtype.Int8               `json:"sold_from"`
+	SoldTo                pgtype.Int8               `json:"sold_to"`
+	LowStockThreshold     []int64                   `json:"low_stock_threshold"`
+	LowStockThresholdFrom pgtype.Int8               `json:"low_stock_threshold_from"`
+	LowStockThresholdTo   pgtype.Int8               `json:"low_stock_threshold_to"`
+	Alert                 []NullInventoryStockAlert `json:"alert"`
+	DateAlerted           []pgtype.Timestamptz      `json:"date_alerted"`
+	DateAlertedFrom       pgtype.Timestamptz        `json:"date_alerted_from"`
+	DateAlertedTo         pgtype.Timestamptz        `json:"date_alerted_to"`
+	DateCreated           []pgtype.Timestamptz      `json:"date_created"`
+	DateCreatedFrom       pgtype.Timestamptz        `json:"date_created_from"`
+	DateCreatedTo         pgtype.Timestamptz        `json:"date_created_to"`
 }
 
 func (q *Queries) ExistsInventoryStock(ctx context.Context, arg ExistsInventoryStockParams) (bool, error) {
@@ -4156,6 +4960,13 @@ func (q *Queries) ExistsInventoryStock(ctx context.Context, arg ExistsInventoryS
 		arg.Sold,
 		arg.SoldFrom,
 		arg.SoldTo,
+		arg.LowStockThreshold,
+		arg.LowStockThresholdFrom,
+		arg.LowStockThresholdTo,
+		arg.Alert,
+		arg.DateAlerted,
+		arg.DateAlertedFrom,
+		arg.DateAlertedTo,
 		arg.DateCreated,
 		arg.DateCreatedFrom,
 		arg.DateCreatedTo,
@@ -4179,9 +4990,13 @@ WHERE (
     ("change" = ANY($7) OR $7 IS NULL) AND
     ("change" >= $8 OR $8 IS NULL) AND
     ("change" <= $9 OR $9 IS NULL) AND
-    ("date_created" = ANY($10) OR $10 IS NULL) AND
-    ("date_created" >= $11 OR $11 IS NULL) AND
-    ("date_created" <= $12 OR $12 IS NULL)
+    ("account_id" = ANY($10) OR $10 IS NULL) AND
+    ("account_id" >= $11 OR $11 IS NULL) AND
+    ("account_id" <= $12 OR $12 IS NULL) AND
+    ("reason" = ANY($13) OR $13 IS NULL) AND
+    ("date_created" = ANY($14) OR $14 IS NULL) AND
+    ("date_created" >= $15 OR $15 IS NULL) AND
+    ("date_created" <= $16 OR $16 IS NULL)
 )
 ) as exists
 `
@@ -4196,6 +5011,10 @@ type ExistsInventoryStockHistoryParams struct {
 	Change          []int64              `json:"change"`
 	ChangeFrom      pgtype.Int8          `json:"change_from"`
 	ChangeTo        pgtype.Int8          `json:"change_to"`
+	AccountID       []pgtype.Int8        `json:"account_id"`
+	AccountIDFrom   pgtype.Int8          `json:"account_id_from"`
+	AccountIDTo     pgtype.Int8          `json:"account_id_to"`
+	Reason          []pgtype.Text        `json:"reason"`
 	DateCreated     []pgtype.Timestamptz `json:"date_created"`
 	DateCreatedFrom pgtype.Timestamptz   `json:"date_created_from"`
 	DateCreatedTo   pgtype.Timestamptz   `json:"date_created_to"`
@@ -4212,9 +5031,102 @@ func (q *Queries) ExistsInventoryStockHistory(ctx context.Context, arg ExistsInv
 		arg.Change,
 		arg.ChangeFrom,
 		arg.ChangeTo,
+		arg.AccountID,
+		arg.AccountIDFrom,
+		arg.AccountIDTo,
+		arg.Reason,
+		arg.DateCreated,
+		arg.DateCreatedFrom,
+		arg.DateCreatedTo,
+	)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const existsInventoryStockReservation = `-- name: ExistsInventoryStockReservation :one
+SELECT EXISTS (
+SELECT 1
+FROM "inventory"."stock_reservation"
+WHERE (
+    ("id" = ANY($1) OR $1 IS NULL) AND
+    ("id" >= $2 OR $2 IS NULL) AND
+    ("id" <= $3 OR $3 IS NULL) AND
+    ("stock_id" = ANY($4) OR $4 IS NULL) AND
+    ("stock_id" >= $5 OR $5 IS NULL) AND
+    ("stock_id" <= $6 OR $6 IS NULL) AND
+    ("ref_type" = ANY($7) OR $7 IS NULL) AND
+    ("ref_id" = ANY($8) OR $8 IS NULL) AND
+    ("ref_id" >= $9 OR $9 IS NULL) AND
+    ("ref_id" <= $10 OR $10 IS NULL) AND
+    ("quantity" = ANY($11) OR $11 IS NULL) AND
+    ("quantity" >= $12 OR $12 IS NULL) AND
+    ("quantity" <= $13 OR $13 IS NULL) AND
+    ("status" = ANY($14) OR $14 IS NULL) AND
+    ("date_expired" = ANY($15) OR $15 IS NULL) AND
+    ("date_expired" >= $16 OR $16 IS NULL) AND
+    ("date_expired" <= $17 OR $17 IS NULL) AND
+    ("date_created" = ANY($18) OR $18 IS NULL) AND
+    ("date_created" >= $19 OR $19 IS NULL) AND
+    ("date_created" <= $20 OR $20 IS NULL) AND
+    ("date_updated" = ANY($21) OR $21 IS NULL) AND
+    ("date_updated" >= $22 OR $22 IS NULL) AND
+    ("date_updated" <= $23 OR $23 IS NULL)
+)
+) as exists
+`
+
+type ExistsInventoryStockReservationParams struct {
+	ID              []int64                    `json:"id"`
+	IDFrom          pgtype.Int8                `json:"id_from"`
+	IDTo            pgtype.Int8                `json:"id_to"`
+	StockID         []int64                    `json:"stock_id"`
+	StockIDFrom     pgtype.Int8                `json:"stock_id_from"`
+	StockIDTo       pgtype.Int8                `json:"stock_id_to"`
+	RefType         []InventoryReservationType `json:"ref_type"`
+	RefID           []int64                    `json:"ref_id"`
+	RefIDFrom       pgtype.Int8                `json:"ref_id_from"`
+	RefIDTo         pgtype.Int8                `json:"ref_id_to"`
+	Quantity        []int64                    `json:"quantity"`
+	QuantityFrom    pgtype.Int8                `json:"quantity_from"`
+	QuantityTo      pgtype.Int8                `json:"quantity_to"`
+	Status          []SharedStatus             `json:"status"`
+	DateExpired     []pgtype.Timestamptz       `json:"date_expired"`
+	DateExpiredFrom pgtype.Timestamptz         `json:"date_expired_from"`
+	DateExpiredTo   pgtype.Timestamptz         `json:"date_expired_to"`
+	DateCreated     []pgtype.Timestamptz       `json:"date_created"`
+	DateCreatedFrom pgtype.Timestamptz         `json:"date_created_from"`
+	DateCreatedTo   pgtype.Timestamptz         `json:"date_created_to"`
+	DateUpdated     []pgtype.Timestamptz       `json:"date_updated"`
+	DateUpdatedFrom pgtype.Timestamptz         `json:"date_updated_from"`
+	DateUpdatedTo   pgtype.Timestamptz         `json:"date_updated_to"`
+}
+
+func (q *Queries) ExistsInventoryStockReservation(ctx context.Context, arg ExistsInventoryStockReservationParams) (bool, error) {
+	row := q.db.QueryRow(ctx, existsInventoryStockReservation,
+		arg.ID,
+		arg.IDFrom,
+		arg.IDTo,
+		arg.StockID,
+		arg.StockIDFrom,
+		arg.StockIDTo,
+		arg.RefType,
+		arg.RefID,
+		arg.RefIDFrom,
+		arg.RefIDTo,
+		arg.Quantity,
+		arg.QuantityFrom,
+		arg.QuantityTo,
+		arg.Status,
+		arg.DateExpired,
+		arg.DateExpiredFrom,
+		arg.DateExpiredTo,
 		arg.DateCreated,
 		arg.DateCreatedFrom,
 		arg.DateCreatedTo,
+		arg.DateUpdated,
+		arg.DateUpdatedFrom,
+		arg.DateUpdatedTo,
 	)
 	var exists bool
 	err := row.Scan(&exists)
@@ -4484,25 +5396,43 @@ WHERE (
     ("sku_id" <= $10 OR $10 IS NULL) AND
     ("quantity" = ANY($11) OR $11 IS NULL) AND
     ("quantity" >= $12 OR $12 IS NULL) AND
-    ("quantity" <= $13 OR $13 IS NULL)
+    ("quantity" <= $13 OR $13 IS NULL) AND
+    ("unit_price" = ANY($14) OR $14 IS NULL) AND
+    ("unit_price" >= $15 OR $15 IS NULL) AND
+    ("unit_price" <= $16 OR $16 IS NULL) AND
+    ("subtotal" = ANY($17) OR $17 IS NULL) AND
+    ("subtotal" >= $18 OR $18 IS NULL) AND
+    ("subtotal" <= $19 OR $19 IS NULL) AND
+    ("total" = ANY($20) OR $20 IS NULL) AND
+    ("total" >= $21 OR $21 IS NULL) AND
+    ("total" <= $22 OR $22 IS NULL)
 )
 ) as exists
 `
 
 type ExistsOrderItemParams struct {
-	ID           []int64     `json:"id"`
-	IDFrom       pgtype.Int8 `json:"id_from"`
-	IDTo         pgtype.Int8 `json:"id_to"`
-	Code         []string    `json:"code"`
-	OrderID      []int64     `json:"order_id"`
-	OrderIDFrom  pgtype.Int8 `json:"order_id_from"`
-	OrderIDTo    pgtype.Int8 `json:"order_id_to"`
-	SkuID        []int64     `json:"sku_id"`
-	SkuIDFrom    pgtype.Int8 `json:"sku_id_from"`
-	SkuIDTo      pgtype.Int8 `json:"sku_id_to"`
-	Quantity     []int64     `json:"quantity"`
-	QuantityFrom pgtype.Int8 `json:"quantity_from"`
-	QuantityTo   pgtype.Int8 `json:"quantity_to"`
+	ID            []int64     `json:"id"`
+	IDFrom        pgtype.Int8 `json:"id_from"`
+	IDTo          pgtype.Int8 `json:"id_to"`
+	Code          []string    `json:"code"`
+	OrderID       []int64     `json:"order_id"`
+	OrderIDFrom   pgtype.Int8 `json:"order_id_from"`
+	OrderIDTo     pgtype.Int8 `json:"order_id_to"`
+	SkuID         []int64     `json:"sku_id"`
+	SkuIDFrom     pgtype.Int8 `json:"sku_id_from"`
+	SkuIDTo       pgtype.Int8 `json:"sku_id_to"`
+	Quantity      []int64     `json:"quantity"`
+	QuantityFrom  pgtype.Int8 `json:"quantity_from"`
+	QuantityTo    pgtype.Int8 `json:"quantity_to"`
+	UnitPrice     []int64     `json:"unit_price"`
+	UnitPriceFrom pgtype.Int8 `json:"unit_price_from"`
+	UnitPriceTo   pgtype.Int8 `json:"unit_price_to"`
+	Subtotal      []int64     `json:"subtotal"`
+	SubtotalFrom  pgtype.Int8 `json:"subtotal_from"`
+	SubtotalTo    pgtype.Int8 `json:"subtotal_to"`
+	Total         []int64     `json:"total"`
+	TotalFrom     pgtype.Int8 `json:"total_from"`
+	TotalTo       pgtype.Int8 `json:"total_to"`
 }
 
 func (q *Queries) ExistsOrderItem(ctx context.Context, arg ExistsOrderItemParams) (bool, error) {
@@ -4520,6 +5450,15 @@ func (q *Queries) ExistsOrderItem(ctx context.Context, arg ExistsOrderItemParams
 		arg.Quantity,
 		arg.QuantityFrom,
 		arg.QuantityTo,
+		arg.UnitPrice,
+		arg.UnitPriceFrom,
+		arg.UnitPriceTo,
+		arg.Subtotal,
+		arg.SubtotalFrom,
+		arg.SubtotalTo,
+		arg.Total,
+		arg.TotalFrom,
+		arg.TotalTo,
 	)
 	var exists bool
 	err := row.Scan(&exists)
@@ -4752,24 +5691,23 @@ WHERE (
     ("ref_id" <= $11 OR $11 IS NULL) AND
     ("type" = ANY($12) OR $12 IS NULL) AND
     ("is_active" = ANY($13) OR $13 IS NULL) AND
-    ("date_started" = ANY($14) OR $14 IS NULL) AND
-    ("date_started" >= $15 OR $15 IS NULL) AND
-    ("date_started" <= $16 OR $16 IS NULL) AND
-    ("date_ended" = ANY($17) OR $17 IS NULL) AND
-    ("date_ended" >= $18 OR $18 IS NULL) AND
-    ("date_ended" <= $19 OR $19 IS NULL) AND
-    ("schedule_start" = ANY($20) OR $20 IS NULL) AND
-    ("schedule_start" >= $21 OR $21 IS NULL) AND
-    ("schedule_start" <= $22 OR $22 IS NULL) AND
-    ("schedule_duration" = ANY($23) OR $23 IS NULL) AND
-    ("schedule_duration" >= $24 OR $24 IS NULL) AND
-    ("schedule_duration" <= $25 OR $25 IS NULL) AND
-    ("date_created" = ANY($26) OR $26 IS NULL) AND
-    ("date_created" >= $27 OR $27 IS NULL) AND
-    ("date_created" <= $28 OR $28 IS NULL) AND
-    ("date_updated" = ANY($29) OR $29 IS NULL) AND
-    ("date_updated" >= $30 OR $30 IS NULL) AND
-    ("date_updated" <= $31 OR $31 IS NULL)
+    ("exclusive" = ANY($14) OR $14 IS NULL) AND
+    ("date_started" = ANY($15) OR $15 IS NULL) AND
+    ("date_started" >= $16 OR $16 IS NULL) AND
+    ("date_started" <= $17 OR $17 IS NULL) AND
+    ("date_ended" = ANY($18) OR $18 IS NULL) AND
+    ("date_ended" >= $19 OR $19 IS NULL) AND
+    ("date_ended" <= $20 OR $20 IS NULL) AND
+    ("schedule_start" = ANY($21) OR $21 IS NULL) AND
+    ("schedule_duration" = ANY($22) OR $22 IS NULL) AND
+    ("schedule_duration" >= $23 OR $23 IS NULL) AND
+    ("schedule_duration" <= $24 OR $24 IS NULL) AND
+    ("date_created" = ANY($25) OR $25 IS NULL) AND
+    ("date_created" >= $26 OR $26 IS NULL) AND
+    ("date_created" <= $27 OR $27 IS NULL) AND
+    ("date_updated" = ANY($28) OR $28 IS NULL) AND
+    ("date_updated" >= $29 OR $29 IS NULL) AND
+    ("date_updated" <= $30 OR $30 IS NULL)
 )
 ) as exists
 `
@@ -4788,15 +5726,14 @@ type ExistsPromotionBaseParams struct {
 	RefIDTo              pgtype.Int8          `json:"ref_id_to"`
 	Type                 []PromotionType      `json:"type"`
 	IsActive             []bool               `json:"is_active"`
+	Exclusive            []bool               `json:"exclusive"`
 	DateStarted          []pgtype.Timestamptz `json:"date_started"`
 	DateStartedFrom      pgtype.Timestamptz   `json:"date_started_from"`
 	DateStartedTo        pgtype.Timestamptz   `json:"date_started_to"`
 	DateEnded            []pgtype.Timestamptz `json:"date_ended"`
 	DateEndedFrom        pgtype.Timestamptz   `json:"date_ended_from"`
 	DateEndedTo          pgtype.Timestamptz   `json:"date_ended_to"`
-	ScheduleStart        []pgtype.Timestamptz `json:"schedule_start"`
-	ScheduleStartFrom    pgtype.Timestamptz   `json:"schedule_start_from"`
-	ScheduleStartTo      pgtype.Timestamptz   `json:"schedule_start_to"`
+	ScheduleStart        []pgtype.Text        `json:"schedule_start"`
 	ScheduleDuration     []pgtype.Int4        `json:"schedule_duration"`
 	ScheduleDurationFrom pgtype.Int4          `json:"schedule_duration_from"`
 	ScheduleDurationTo   pgtype.Int4          `json:"schedule_duration_to"`
@@ -4823,6 +5760,7 @@ func (q *Queries) ExistsPromotionBase(ctx context.Context, arg ExistsPromotionBa
 		arg.RefIDTo,
 		arg.Type,
 		arg.IsActive,
+		arg.Exclusive,
 		arg.DateStarted,
 		arg.DateStartedFrom,
 		arg.DateStartedTo,
@@ -4830,8 +5768,6 @@ func (q *Queries) ExistsPromotionBase(ctx context.Context, arg ExistsPromotionBa
 		arg.DateEndedFrom,
 		arg.DateEndedTo,
 		arg.ScheduleStart,
-		arg.ScheduleStartFrom,
-		arg.ScheduleStartTo,
 		arg.ScheduleDuration,
 		arg.ScheduleDurationFrom,
 		arg.ScheduleDurationTo,
@@ -4847,128 +5783,216 @@ func (q *Queries) ExistsPromotionBase(ctx context.Context, arg ExistsPromotionBa
 	return exists, err
 }
 
-const existsPromotionDiscount = `-- name: ExistsPromotionDiscount :one
+const existsPromotionBundle = `-- name: ExistsPromotionBundle :one
 SELECT EXISTS (
 SELECT 1
-FROM "promotion"."discount"
+FROM "promotion"."bundle"
 WHERE (
     ("id" = ANY($1) OR $1 IS NULL) AND
     ("id" >= $2 OR $2 IS NULL) AND
     ("id" <= $3 OR $3 IS NULL) AND
-    ("order_wide" = ANY($4) OR $4 IS NULL) AND
-    ("min_spend" = ANY($5) OR $5 IS NULL) AND
-    ("min_spend" >= $6 OR $6 IS NULL) AND
-    ("min_spend" <= $7 OR $7 IS NULL) AND
-    ("max_discount" = ANY($8) OR $8 IS NULL) AND
-    ("max_discount" >= $9 OR $9 IS NULL) AND
-    ("max_discount" <= $10 OR $10 IS NULL) AND
-    ("discount_percent" = ANY($11) OR $11 IS NULL) AND
-    ("discount_percent" >= $12 OR $12 IS NULL) AND
-    ("discount_percent" <= $13 OR $13 IS NULL) AND
-    ("discount_price" = ANY($14) OR $14 IS NULL) AND
-    ("discount_price" >= $15 OR $15 IS NULL) AND
-    ("discount_price" <= $16 OR $16 IS NULL)
+    ("price" = ANY($4) OR $4 IS NULL) AND
+    ("price" >= $5 OR $5 IS NULL) AND
+    ("price" <= $6 OR $6 IS NULL)
 )
 ) as exists
 `
 
-type ExistsPromotionDiscountParams struct {
-	ID                  []int64       `json:"id"`
-	IDFrom              pgtype.Int8   `json:"id_from"`
-	IDTo                pgtype.Int8   `json:"id_to"`
-	OrderWide           []bool        `json:"order_wide"`
-	MinSpend            []int64       `json:"min_spend"`
-	MinSpendFrom        pgtype.Int8   `json:"min_spend_from"`
-	MinSpendTo          pgtype.Int8   `json:"min_spend_to"`
-	MaxDiscount         []int64       `json:"max_discount"`
-	MaxDiscountFrom     pgtype.Int8   `json:"max_discount_from"`
-	MaxDiscountTo       pgtype.Int8   `json:"max_discount_to"`
-	DiscountPercent     []pgtype.Int4 `json:"discount_percent"`
-	DiscountPercentFrom pgtype.Int4   `json:"discount_percent_from"`
-	DiscountPercentTo   pgtype.Int4   `json:"discount_percent_to"`
-	DiscountPrice       []pgtype.Int8 `json:"discount_price"`
-	DiscountPriceFrom   pgtype.Int8   `json:"discount_price_from"`
-	DiscountPriceTo     pgtype.Int8   `json:"discount_price_to"`
+type ExistsPromotionBundleParams struct {
+	ID        []int64     `json:"id"`
+	IDFrom    pgtype.Int8 `json:"id_from"`
+	IDTo      pgtype.Int8 `json:"id_to"`
+	Price     []int64     `json:"price"`
+	PriceFrom pgtype.Int8 `json:"price_from"`
+	PriceTo   pgtype.Int8 `json:"price_to"`
 }
 
-func (q *Queries) ExistsPromotionDiscount(ctx context.Context, arg ExistsPromotionDiscountParams) (bool, error) {
-	row := q.db.QueryRow(ctx, existsPromotionDiscount,
+func (q *Queries) ExistsPromotionBundle(ctx context.Context, arg ExistsPromotionBundleParams) (bool, error) {
+	row := q.db.QueryRow(ctx, existsPromotionBundle,
 		arg.ID,
 		arg.IDFrom,
 		arg.IDTo,
-		arg.OrderWide,
-		arg.MinSpend,
-		arg.MinSpendFrom,
-		arg.MinSpendTo,
-		arg.MaxDiscount,
-		arg.MaxDiscountFrom,
-		arg.MaxDiscountTo,
-		arg.DiscountPercent,
-		arg.DiscountPercentFrom,
-		arg.DiscountPercentTo,
-		arg.DiscountPrice,
-		arg.DiscountPriceFrom,
-		arg.DiscountPriceTo,
+		arg.Price,
+		arg.PriceFrom,
+		arg.PriceTo,
 	)
 	var exists bool
 	err := row.Scan(&exists)
 	return exists, err
 }
 
-const existsSharedResource = `-- name: ExistsSharedResource :one
+const existsPromotionBundleItem = `-- name: ExistsPromotionBundleItem :one
 SELECT EXISTS (
 SELECT 1
-FROM "shared"."resource"
+FROM "promotion"."bundle_item"
 WHERE (
     ("id" = ANY($1) OR $1 IS NULL) AND
     ("id" >= $2 OR $2 IS NULL) AND
     ("id" <= $3 OR $3 IS NULL) AND
-    ("owner_id" = ANY($4) OR $4 IS NULL) AND
-    ("owner_id" >= $5 OR $5 IS NULL) AND
-    ("owner_id" <= $6 OR $6 IS NULL) AND
-    ("owner_type" = ANY($7) OR $7 IS NULL) AND
-    ("order" = ANY($8) OR $8 IS NULL) AND
-    ("order" >= $9 OR $9 IS NULL) AND
-    ("order" <= $10 OR $10 IS NULL)
+    ("bundle_id" = ANY($4) OR $4 IS NULL) AND
+    ("bundle_id" >= $5 OR $5 IS NULL) AND
+    ("bundle_id" <= $6 OR $6 IS NULL) AND
+    ("sku_id" = ANY($7) OR $7 IS NULL) AND
+    ("sku_id" >= $8 OR $8 IS NULL) AND
+    ("sku_id" <= $9 OR $9 IS NULL) AND
+    ("quantity" = ANY($10) OR $10 IS NULL) AND
+    ("quantity" >= $11 OR $11 IS NULL) AND
+    ("quantity" <= $12 OR $12 IS NULL)
 )
 ) as exists
 `
 
-type ExistsSharedResourceParams struct {
-	ID          []int64              `json:"id"`
-	IDFrom      pgtype.Int8          `json:"id_from"`
-	IDTo        pgtype.Int8          `json:"id_to"`
-	OwnerID     []int64              `json:"owner_id"`
-	OwnerIDFrom pgtype.Int8          `json:"owner_id_from"`
-	OwnerIDTo   pgtype.Int8          `json:"owner_id_to"`
-	OwnerType   []SharedResourceType `json:"owner_type"`
-	Order       []int32              `json:"order"`
-	OrderFrom   pgtype.Int4          `json:"order_from"`
-	OrderTo     pgtype.Int4          `json:"order_to"`
+type ExistsPromotionBundleItemParams struct {
+	ID           []int64     `json:"id"`
+	IDFrom       pgtype.Int8 `json:"id_from"`
+	IDTo         pgtype.Int8 `json:"id_to"`
+	BundleID     []int64     `json:"bundle_id"`
+	BundleIDFrom pgtype.Int8 `json:"bundle_id_from"`
+	BundleIDTo   pgtype.Int8 `json:"bundle_id_to"`
+	SkuID        []int64     `json:"sku_id"`
+	SkuIDFrom    pgtype.Int8 `json:"sku_id_from"`
+	SkuIDTo      pgtype.Int8 `json:"sku_id_to"`
+	Quantity     []int64     `json:"quantity"`
+	QuantityFrom pgtype.Int8 `json:"quantity_from"`
+	QuantityTo   pgtype.Int8 `json:"quantity_to"`
 }
 
-func (q *Queries) ExistsSharedResource(ctx context.Context, arg ExistsSharedResourceParams) (bool, error) {
-	row := q.db.QueryRow(ctx, existsSharedResource,
+func (q *Queries) ExistsPromotionBundleItem(ctx context.Context, arg ExistsPromotionBundleItemParams) (bool, error) {
+	row := q.db.QueryRow(ctx, existsPromotionBundleItem,
 		arg.ID,
 		arg.IDFrom,
 		arg.IDTo,
-		arg.OwnerID,
-		arg.OwnerIDFrom,
-		arg.OwnerIDTo,
-		arg.OwnerType,
-		arg.Order,
-		arg.OrderFrom,
-		arg.OrderTo,
+		arg.BundleID,
+		arg.BundleIDFrom,
+		arg.BundleIDTo,
+		arg.SkuID,
+		arg.SkuIDFrom,
+		arg.SkuIDTo,
+		arg.Quantity,
+		arg.QuantityFrom,
+		arg.QuantityTo,
 	)
 	var exists bool
 	err := row.Scan(&exists)
 	return exists, err
 }
 
-const existsSystemEvent = `-- name: ExistsSystemEvent :one
+const existsPromotionBuyXGetY = `-- name: ExistsPromotionBuyXGetY :one
 SELECT EXISTS (
 SELECT 1
-FROM "system"."event"
+FROM "promotion"."buy_x_get_y"
+WHERE (
+    ("id" = ANY($1) OR $1 IS NULL) AND
+    ("id" >= $2 OR $2 IS NULL) AND
+    ("id" <= $3 OR $3 IS NULL) AND
+    ("buy_quantity" = ANY($4) OR $4 IS NULL) AND
+    ("buy_quantity" >= $5 OR $5 IS NULL) AND
+    ("buy_quantity" <= $6 OR $6 IS NULL) AND
+    ("get_quantity" = ANY($7) OR $7 IS NULL) AND
+    ("get_quantity" >= $8 OR $8 IS NULL) AND
+    ("get_quantity" <= $9 OR $9 IS NULL)
+)
+) as exists
+`
+
+type ExistsPromotionBuyXGetYParams struct {
+	ID              []int64     `json:"id"`
+	IDFrom          pgtype.Int8 `json:"id_from"`
+	IDTo            pgtype.Int8 `json:"id_to"`
+	BuyQuantity     []int64     `json:"buy_quantity"`
+	BuyQuantityFrom pgtype.Int8 `json:"buy_quantity_from"`
+	BuyQuantityTo   pgtype.Int8 `json:"buy_quantity_to"`
+	GetQuantity     []int64     `json:"get_quantity"`
+	GetQuantityFrom pgtype.Int8 `json:"get_quantity_from"`
+	GetQuantityTo   pgtype.Int8 `json:"get_quantity_to"`
+}
+
+func (q *Queries) ExistsPromotionBuyXGetY(ctx context.Context, arg ExistsPromotionBuyXGetYParams) (bool, error) {
+	row := q.db.QueryRow(ctx, existsPromotionBuyXGetY,
+		arg.ID,
+		arg.IDFrom,
+		arg.IDTo,
+		arg.BuyQuantity,
+		arg.BuyQuantityFrom,
+		arg.BuyQuantityTo,
+		arg.GetQuantity,
+		arg.GetQuantityFrom,
+		arg.GetQuantityTo,
+	)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const existsPromotionCashback = `-- name: ExistsPromotionCashback :one
+SELECT EXISTS (
+SELECT 1
+FROM "promotion"."cashback"
+WHERE (
+    ("id" = ANY($1) OR $1 IS NULL) AND
+    ("id" >= $2 OR $2 IS NULL) AND
+    ("id" <= $3 OR $3 IS NULL) AND
+    ("min_spend" = ANY($4) OR $4 IS NULL) AND
+    ("min_spend" >= $5 OR $5 IS NULL) AND
+    ("min_spend" <= $6 OR $6 IS NULL) AND
+    ("max_cashback" = ANY($7) OR $7 IS NULL) AND
+    ("max_cashback" >= $8 OR $8 IS NULL) AND
+    ("max_cashback" <= $9 OR $9 IS NULL) AND
+    ("cashback_percent" = ANY($10) OR $10 IS NULL) AND
+    ("cashback_percent" >= $11 OR $11 IS NULL) AND
+    ("cashback_percent" <= $12 OR $12 IS NULL) AND
+    ("cashback_price" = ANY($13) OR $13 IS NULL) AND
+    ("cashback_price" >= $14 OR $14 IS NULL) AND
+    ("cashback_price" <= $15 OR $15 IS NULL)
+)
+) as exists
+`
+
+type ExistsPromotionCashbackParams struct {
+	ID                  []int64       `json:"id"`
+	IDFrom              pgtype.Int8   `json:"id_from"`
+	IDTo                pgtype.Int8   `json:"id_to"`
+	MinSpend            []int64       `json:"min_spend"`
+	MinSpendFrom        pgtype.Int8   `json:"min_spend_from"`
+	MinSpendTo          pgtype.Int8   `json:"min_spend_to"`
+	MaxCashback         []int64       `json:"max_cashback"`
+	MaxCashbackFrom     pgtype.Int8   `json:"max_cashback_from"`
+	MaxCashbackTo       pgtype.Int8   `json:"max_cashback_to"`
+	CashbackPercent     []pgtype.Int4 `json:"cashback_percent"`
+	CashbackPercentFrom pgtype.Int4   `json:"cashback_percent_from"`
+	CashbackPercentTo   pgtype.Int4   `json:"cashback_percent_to"`
+	CashbackPrice       []pgtype.Int8 `json:"cashback_price"`
+	CashbackPriceFrom   pgtype.Int8   `json:"cashback_price_from"`
+	CashbackPriceTo     pgtype.Int8   `json:"cashback_price_to"`
+}
+
+func (q *Queries) ExistsPromotionCashback(ctx context.Context, arg ExistsPromotionCashbackParams) (bool, error) {
+	row := q.db.QueryRow(ctx, existsPromotionCashback,
+		arg.ID,
+		arg.IDFrom,
+		arg.IDTo,
+		arg.MinSpend,
+		arg.MinSpendFrom,
+		arg.MinSpendTo,
+		arg.MaxCashback,
+		arg.MaxCashbackFrom,
+		arg.MaxCashbackTo,
+		arg.CashbackPercent,
+		arg.CashbackPercentFrom,
+		arg.CashbackPercentTo,
+		arg.CashbackPrice,
+		arg.CashbackPriceFrom,
+		arg.CashbackPriceTo,
+	)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const existsPromotionCashbackCredit = `-- name: ExistsPromotionCashbackCredit :one
+SELECT EXISTS (
+SELECT 1
+FROM "promotion"."cashback_credit"
 WHERE (
     ("id" = ANY($1) OR $1 IS NULL) AND
     ("id" >= $2 OR $2 IS NULL) AND
@@ -4976,247 +6000,596 @@ WHERE (
     ("account_id" = ANY($4) OR $4 IS NULL) AND
     ("account_id" >= $5 OR $5 IS NULL) AND
     ("account_id" <= $6 OR $6 IS NULL) AND
-    ("aggregate_id" = ANY($7) OR $7 IS NULL) AND
-    ("aggregate_id" >= $8 OR $8 IS NULL) AND
-    ("aggregate_id" <= $9 OR $9 IS NULL) AND
-    ("aggregate_type" = ANY($10) OR $10 IS NULL) AND
-    ("event_type" = ANY($11) OR $11 IS NULL) AND
-    ("payload" = ANY($12) OR $12 IS NULL) AND
-    ("version" = ANY($13) OR $13 IS NULL) AND
-    ("version" >= $14 OR $14 IS NULL) AND
-    ("version" <= $15 OR $15 IS NULL) AND
-    ("date_created" = ANY($16) OR $16 IS NULL) AND
-    ("date_created" >= $17 OR $17 IS NULL) AND
-    ("date_created" <= $18 OR $18 IS NULL)
+    ("order_id" = ANY($7) OR $7 IS NULL) AND
+    ("order_id" >= $8 OR $8 IS NULL) AND
+    ("order_id" <= $9 OR $9 IS NULL) AND
+    ("promotion_id" = ANY($10) OR $10 IS NULL) AND
+    ("promotion_id" >= $11 OR $11 IS NULL) AND
+    ("promotion_id" <= $12 OR $12 IS NULL) AND
+    ("amount" = ANY($13) OR $13 IS NULL) AND
+    ("amount" >= $14 OR $14 IS NULL) AND
+    ("amount" <= $15 OR $15 IS NULL) AND
+    ("status" = ANY($16) OR $16 IS NULL) AND
+    ("date_created" = ANY($17) OR $17 IS NULL) AND
+    ("date_created" >= $18 OR $18 IS NULL) AND
+    ("date_created" <= $19 OR $19 IS NULL) AND
+    ("date_updated" = ANY($20) OR $20 IS NULL) AND
+    ("date_updated" >= $21 OR $21 IS NULL) AND
+    ("date_updated" <= $22 OR $22 IS NULL)
 )
 ) as exists
 `
 
-type ExistsSystemEventParams struct {
+type ExistsPromotionCashbackCreditParams struct {
 	ID              []int64              `json:"id"`
 	IDFrom          pgtype.Int8          `json:"id_from"`
 	IDTo            pgtype.Int8          `json:"id_to"`
-	AccountID       []pgtype.Int8        `json:"account_id"`
+	AccountID       []int64              `json:"account_id"`
 	AccountIDFrom   pgtype.Int8          `json:"account_id_from"`
 	AccountIDTo     pgtype.Int8          `json:"account_id_to"`
-	AggregateID     []int64              `json:"aggregate_id"`
-	AggregateIDFrom pgtype.Int8          `json:"aggregate_id_from"`
-	AggregateIDTo   pgtype.Int8          `json:"aggregate_id_to"`
-	AggregateType   []string             `json:"aggregate_type"`
-	EventType       []SystemEventType    `json:"event_type"`
-	Payload         [][]byte             `json:"payload"`
-	Version         []int64              `json:"version"`
-	VersionFrom     pgtype.Int8          `json:"version_from"`
-	VersionTo       pgtype.Int8          `json:"version_to"`
+	OrderID         []int64              `json:"order_id"`
+	OrderIDFrom     pgtype.Int8          `json:"order_id_from"`
+	OrderIDTo       pgtype.Int8          `json:"order_id_to"`
+	PromotionID     []int64              `json:"promotion_id"`
+	PromotionIDFrom pgtype.Int8          `json:"promotion_id_from"`
+	PromotionIDTo   pgtype.Int8          `json:"promotion_id_to"`
+	Amount          []int64              `json:"amount"`
+	AmountFrom      pgtype.Int8          `json:"amount_from"`
+	AmountTo        pgtype.Int8          `json:"amount_to"`
+	Status          []SharedStatus       `json:"status"`
 	DateCreated     []pgtype.Timestamptz `json:"date_created"`
 	DateCreatedFrom pgtype.Timestamptz   `json:"date_created_from"`
 	DateCreatedTo   pgtype.Timestamptz   `json:"date_created_to"`
+	DateUpdated     []pgtype.Timestamptz `json:"date_updated"`
+	DateUpdatedFrom pgtype.Timestamptz   `json:"date_updated_from"`
+	DateUpdatedTo   pgtype.Timestamptz   `json:"date_updated_to"`
 }
 
-func (q *Queries) ExistsSystemEvent(ctx context.Context, arg ExistsSystemEventParams) (bool, error) {
-	row := q.db.QueryRow(ctx, existsSystemEvent,
+func (q *Queries) ExistsPromotionCashbackCredit(ctx context.Context, arg ExistsPromotionCashbackCreditParams) (bool, error) {
+	row := q.db.QueryRow(ctx, existsPromotionCashbackCredit,
 		arg.ID,
 		arg.IDFrom,
 		arg.IDTo,
 		arg.AccountID,
 		arg.AccountIDFrom,
 		arg.AccountIDTo,
-		arg.AggregateID,
-		arg.AggregateIDFrom,
-		arg.AggregateIDTo,
-		arg.AggregateType,
-		arg.EventType,
-		arg.Payload,
-		arg.Version,
-		arg.VersionFrom,
-		arg.VersionTo,
+		arg.OrderID,
+		arg.OrderIDFrom,
+		arg.OrderIDTo,
+		arg.PromotionID,
+		arg.PromotionIDFrom,
+		arg.PromotionIDTo,
+		arg.Amount,
+		arg.AmountFrom,
+		arg.AmountTo,
+		arg.Status,
 		arg.DateCreated,
 		arg.DateCreatedFrom,
 		arg.DateCreatedTo,
+		arg.DateUpdated,
+		arg.DateUpdatedFrom,
+		arg.DateUpdatedTo,
 	)
 	var exists bool
 	err := row.Scan(&exists)
 	return exists, err
 }
 
-const existsSystemSearchSync = `-- name: ExistsSystemSearchSync :one
+const existsPromotionDiscount = `-- name: ExistsPromotionDiscount :one
 SELECT EXISTS (
 SELECT 1
-FROM "system"."search_sync"
+FROM "promotion"."discount"
 WHERE (
     ("id" = ANY($1) OR $1 IS NULL) AND
     ("id" >= $2 OR $2 IS NULL) AND
     ("id" <= $3 OR $3 IS NULL) AND
-    ("name" = ANY($4) OR $4 IS NULL) AND
-    ("last_synced" = ANY($5) OR $5 IS NULL) AND
-    ("last_synced" >= $6 OR $6 IS NULL) AND
-    ("last_synced" <= $7 OR $7 IS NULL)
+    ("order_wide" = ANY($4) OR $4 IS NULL) AND
+    ("min_spend" = ANY($5) OR $5 IS NULL) AND
+    ("min_spend" >= $6 OR $6 IS NULL) AND
+    ("min_spend" <= $7 OR $7 IS NULL) AND
+    ("max_discount" = ANY($8) OR $8 IS NULL) AND
+    ("max_discount" >= $9 OR $9 IS NULL) AND
+    ("max_discount" <= $10 OR $10 IS NULL) AND
+    ("discount_percent" = ANY($11) OR $11 IS NULL) AND
+    ("discount_percent" >= $12 OR $12 IS NULL) AND
+    ("discount_percent" <= $13 OR $13 IS NULL) AND
+    ("discount_price" = ANY($14) OR $14 IS NULL) AND
+    ("discount_price" >= $15 OR $15 IS NULL) AND
+    ("discount_price" <= $16 OR $16 IS NULL)
 )
 ) as exists
 `
 
-type ExistsSystemSearchSyncParams struct {
-	ID             []int64              `json:"id"`
-	IDFrom         pgtype.Int8          `json:"id_from"`
-	IDTo           pgtype.Int8          `json:"id_to"`
-	Name           []string             `json:"name"`
-	LastSynced     []pgtype.Timestamptz `json:"last_synced"`
-	LastSyncedFrom pgtype.Timestamptz   `json:"last_synced_from"`
-	LastSyncedTo   pgtype.Timestamptz   `json:"last_synced_to"`
+type ExistsPromotionDiscountParams struct {
+	ID                  []int64       `json:"id"`
+	IDFrom              pgtype.Int8   `json:"id_from"`
+	IDTo                pgtype.Int8   `json:"id_to"`
+	OrderWide           []bool        `json:"order_wide"`
+	MinSpend            []int64       `json:"min_spend"`
+	MinSpendFrom        pgtype.Int8   `json:"min_spend_from"`
+	MinSpendTo          pgtype.Int8   `json:"min_spend_to"`
+	MaxDiscount         []int64       `json:"max_discount"`
+	MaxDiscountFrom     pgtype.Int8   `json:"max_discount_from"`
+	MaxDiscountTo       pgtype.Int8   `json:"max_discount_to"`
+	DiscountPercent     []pgtype.Int4 `json:"discount_percent"`
+	DiscountPercentFrom pgtype.Int4   `json:"discount_percent_from"`
+	DiscountPercentTo   pgtype.Int4   `json:"discount_percent_to"`
+	DiscountPrice       []pgtype.Int8 `json:"discount_price"`
+	DiscountPriceFrom   pgtype.Int8   `json:"discount_price_from"`
+	DiscountPriceTo     pgtype.Int8   `json:"discount_price_to"`
 }
 
-func (q *Queries) ExistsSystemSearchSync(ctx context.Context, arg ExistsSystemSearchSyncParams) (bool, error) {
-	row := q.db.QueryRow(ctx, existsSystemSearchSync,
+func (q *Queries) ExistsPromotionDiscount(ctx context.Context, arg ExistsPromotionDiscountParams) (bool, error) {
+	row := q.db.QueryRow(ctx, existsPromotionDiscount,
 		arg.ID,
 		arg.IDFrom,
 		arg.IDTo,
-		arg.Name,
-		arg.LastSynced,
-		arg.LastSyncedFrom,
-		arg.LastSyncedTo,
+		arg.OrderWide,
+		arg.MinSpend,
+		arg.MinSpendFrom,
+		arg.MinSpendTo,
+		arg.MaxDiscount,
+		arg.MaxDiscountFrom,
+		arg.MaxDiscountTo,
+		arg.DiscountPercent,
+		arg.DiscountPercentFrom,
+		arg.DiscountPercentTo,
+		arg.DiscountPrice,
+		arg.DiscountPriceFrom,
+		arg.DiscountPriceTo,
 	)
 	var exists bool
 	err := row.Scan(&exists)
 	return exists, err
 }
 
-const getAccountAddress = `-- name: GetAccountAddress :one
-
-
-
-SELECT id, code, account_id, type, full_name, phone, phone_verified, address_line, city, state_province, country, date_created, date_updated
-FROM "account"."address"
-WHERE ("id" = $1) OR ("code" = $2)
+const existsPromotionVoucher = `-- name: ExistsPromotionVoucher :one
+SELECT EXISTS (
+SELECT 1
+FROM "promotion"."voucher"
+WHERE (
+    ("id" = ANY($1) OR $1 IS NULL) AND
+    ("id" >= $2 OR $2 IS NULL) AND
+    ("id" <= $3 OR $3 IS NULL) AND
+    ("usage_limit" = ANY($4) OR $4 IS NULL) AND
+    ("usage_limit" >= $5 OR $5 IS NULL) AND
+    ("usage_limit" <= $6 OR $6 IS NULL) AND
+    ("per_customer_limit" = ANY($7) OR $7 IS NULL) AND
+    ("per_customer_limit" >= $8 OR $8 IS NULL) AND
+    ("per_customer_limit" <= $9 OR $9 IS NULL) AND
+    ("first_order_only" = ANY($10) OR $10 IS NULL) AND
+    ("min_spend" = ANY($11) OR $11 IS NULL) AND
+    ("min_spend" >= $12 OR $12 IS NULL) AND
+    ("min_spend" <= $13 OR $13 IS NULL) AND
+    ("used" = ANY($14) OR $14 IS NULL) AND
+    ("used" >= $15 OR $15 IS NULL) AND
+    ("used" <= $16 OR $16 IS NULL)
+)
+) as exists
 `
 
-type GetAccountAddressParams struct {
-	ID   pgtype.Int8 `json:"id"`
-	Code pgtype.Text `json:"code"`
-}
-
-// ========================================
-// Queries for table: account.address
-// ========================================
-func (q *Queries) GetAccountAddress(ctx context.Context, arg GetAccountAddressParams) (AccountAddress, error) {
-	row := q.db.QueryRow(ctx, getAccountAddress, arg.ID, arg.Code)
-	var i AccountAddress
-	err := row.Scan(
-		&i.ID,
-		&i.Code,
-		&i.AccountID,
-		&i.Type,
-		&i.FullName,
-		&i.Phone,
-		&i.PhoneVerified,
-		&i.AddressLine,
-		&i.City,
-		&i.StateProvince,
-		&i.Country,
-		&i.DateCreated,
-		&i.DateUpdated,
+type ExistsPromotionVoucherParams struct {
+	ID                   []int64     `json:"id"`
+	IDFrom               pgtype.Int8 `json:"id_from"`
+	IDTo                 pgtype.Int8 `json:"id_to"`
+	UsageLimit           []int64     `json:"usage_limit"`
+	UsageLimitFrom       pgtype.Int8 `json:"usage_limit_from"`
+	UsageLimitTo         pgtype.Int8 `json:"usage_limit_to"`
+	PerCustomerLimit     []int64     `json:"per_customer_limit"`
+	PerCustomerLimitFrom pgtype.Int8 `json:"per_customer_limit_from"`
+	PerCustomerLimitTo   pgtype.Int8 `json:"per_customer_limit_to"`
+	FirstOrderOnly       []bool      `json:"first_order_only"`
+	MinSpend             []int64     `json:"min_spend"`
+	MinSpendFrom         pgtype.Int8 `json:"min_spend_from"`
+	MinSpendTo           pgtype.Int8 `json:"min_spend_to"`
+	Used                 []int64     `json:"used"`
+	UsedFrom             pgtype.Int8 `json:"used_from"`
+	UsedTo               pgtype.Int8 `json:"used_to"`
+}
+
+func (q *Queries) ExistsPromotionVoucher(ctx context.Context, arg ExistsPromotionVoucherParams) (bool, error) {
+	row := q.db.QueryRow(ctx, existsPromotionVoucher,
+		arg.ID,
+		arg.IDFrom,
+		arg.IDTo,
+		arg.UsageLimit,
+		arg.UsageLimitFrom,
+		arg.UsageLimitTo,
+		arg.PerCustomerLimit,
+		arg.PerCustomerLimitFrom,
+		arg.PerCustomerLimitTo,
+		arg.FirstOrderOnly,
+		arg.MinSpend,
+		arg.MinSpendFrom,
+		arg.MinSpendTo,
+		arg.Used,
+		arg.UsedFrom,
+		arg.UsedTo,
 	)
-	return i, err
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
 }
 
-const getAccountBase = `-- name: GetAccountBase :one
-
-
-
-
-
-SELECT id, code, type, status, phone, email, username, password, date_created, date_updated
-FROM "account"."base"
-WHERE ("id" = $1) OR ("code" = $2) OR ("phone" = $3) OR ("email" = $4) OR ("username" = $5)
+const existsPromotionVoucherRedemption = `-- name: ExistsPromotionVoucherRedemption :one
+SELECT EXISTS (
+SELECT 1
+FROM "promotion"."voucher_redemption"
+WHERE (
+    ("id" = ANY($1) OR $1 IS NULL) AND
+    ("id" >= $2 OR $2 IS NULL) AND
+    ("id" <= $3 OR $3 IS NULL) AND
+    ("voucher_id" = ANY($4) OR $4 IS NULL) AND
+    ("voucher_id" >= $5 OR $5 IS NULL) AND
+    ("voucher_id" <= $6 OR $6 IS NULL) AND
+    ("account_id" = ANY($7) OR $7 IS NULL) AND
+    ("account_id" >= $8 OR $8 IS NULL) AND
+    ("account_id" <= $9 OR $9 IS NULL) AND
+    ("order_id" = ANY($10) OR $10 IS NULL) AND
+    ("order_id" >= $11 OR $11 IS NULL) AND
+    ("order_id" <= $12 OR $12 IS NULL) AND
+    ("status" = ANY($13) OR $13 IS NULL) AND
+    ("date_created" = ANY($14) OR $14 IS NULL) AND
+    ("date_created" >= $15 OR $15 IS NULL) AND
+    ("date_created" <= $16 OR $16 IS NULL) AND
+    ("date_updated" = ANY($17) OR $17 IS NULL) AND
+    ("date_updated" >= $18 OR $18 IS NULL) AND
+    ("date_updated" <= $19 OR $19 IS NULL)
+)
+) as exists
 `
 
-type GetAccountBaseParams struct {
-	ID       pgtype.Int8 `json:"id"`
-	Code     pgtype.Text `json:"code"`
-	Phone    pgtype.Text `json:"phone"`
-	Email    pgtype.Text `json:"email"`
-	Username pgtype.Text `json:"username"`
-}
-
-// Code generated by tool/main.go. DO NOT EDIT.
-// This file contains all queries for the database schema.
-// ========================================
-// Queries for table: account.base
-// ========================================
-func (q *Queries) GetAccountBase(ctx context.Context, arg GetAccountBaseParams) (AccountBase, error) {
-	row := q.db.QueryRow(ctx, getAccountBase,
+type ExistsPromotionVoucherRedemptionParams struct {
+	ID              []int64              `json:"id"`
+	IDFrom          pgtype.Int8          `json:"id_from"`
+	IDTo            pgtype.Int8          `json:"id_to"`
+	VoucherID       []int64              `json:"voucher_id"`
+	VoucherIDFrom   pgtype.Int8          `json:"voucher_id_from"`
+	VoucherIDTo     pgtype.Int8          `json:"voucher_id_to"`
+	AccountID       []int64              `json:"account_id"`
+	AccountIDFrom   pgtype.Int8          `json:"account_id_from"`
+	AccountIDTo     pgtype.Int8          `json:"account_id_to"`
+	OrderID         []int64              `json:"order_id"`
+	OrderIDFrom     pgtype.Int8          `json:"order_id_from"`
+	OrderIDTo       pgtype.Int8          `json:"order_id_to"`
+	Status          []SharedStatus       `json:"status"`
+	DateCreated     []pgtype.Timestamptz `json:"date_created"`
+	DateCreatedFrom pgtype.Timestamptz   `json:"date_created_from"`
+	DateCreatedTo   pgtype.Timestamptz   `json:"date_created_to"`
+	DateUpdated     []pgtype.Timestamptz `json:"date_updated"`
+	DateUpdatedFrom pgtype.Timestamptz   `json:"date_updated_from"`
+	DateUpdatedTo   pgtype.Timestamptz   `json:"date_updated_to"`
+}
+
+func (q *Queries) ExistsPromotionVoucherRedemption(ctx context.Context, arg ExistsPromotionVoucherRedemptionParams) (bool, error) {
+	row := q.db.QueryRow(ctx, existsPromotionVoucherRedemption,
 		arg.ID,
-		arg.Code,
-		arg.Phone,
-		arg.Email,
-		arg.Username,
+		arg.IDFrom,
+		arg.IDTo,
+		arg.VoucherID,
+		arg.VoucherIDFrom,
+		arg.VoucherIDTo,
+		arg.AccountID,
+		arg.AccountIDFrom,
+		arg.AccountIDTo,
+		arg.OrderID,
+		arg.OrderIDFrom,
+		arg.OrderIDTo,
+		arg.Status,
+		arg.DateCreated,
+		arg.DateCreatedFrom,
+		arg.DateCreatedTo,
+		arg.DateUpdated,
+		arg.DateUpdatedFrom,
+		arg.DateUpdatedTo,
 	)
-	var i AccountBase
-	err := row.Scan(
-		&i.ID,
-		&i.Code,
-		&i.Type,
-		&i.Status,
-		&i.Phone,
-		&i.Email,
-		&i.Username,
-		&i.Password,
-		&i.DateCreated,
-		&i.DateUpdated,
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const existsSharedResource = `-- name: ExistsSharedResource :one
+SELECT EXISTS (
+SELECT 1
+FROM "shared"."resource"
+WHERE (
+    ("id" = ANY($1) OR $1 IS NULL) AND
+    ("id" >= $2 OR $2 IS NULL) AND
+    ("id" <= $3 OR $3 IS NULL) AND
+    ("owner_id" = ANY($4) OR $4 IS NULL) AND
+    ("owner_id" >= $5 OR $5 IS NULL) AND
+    ("owner_id" <= $6 OR $6 IS NULL) AND
+    ("owner_type" = ANY($7) OR $7 IS NULL) AND
+    ("order" = ANY($8) OR $8 IS NULL) AND
+    ("order" >= $9 OR $9 IS NULL) AND
+    ("order" <= $10 OR $10 IS NULL)
+)
+) as exists
+`
+
+type ExistsSharedResourceParams struct {
+	ID          []int64              `json:"id"`
+	IDFrom      pgtype.Int8          `json:"id_from"`
+	IDTo        pgtype.Int8          `json:"id_to"`
+	OwnerID     []int64              `json:"owner_id"`
+	OwnerIDFrom pgtype.Int8          `json:"owner_id_from"`
+	OwnerIDTo   pgtype.Int8          `json:"owner_id_to"`
+	OwnerType   []SharedResourceType `json:"owner_type"`
+	Order       []int32              `json:"order"`
+	OrderFrom   pgtype.Int4          `json:"order_from"`
+	OrderTo     pgtype.Int4          `json:"order_to"`
+}
+
+func (q *Queries) ExistsSharedResource(ctx context.Context, arg ExistsSharedResourceParams) (bool, error) {
+	row := q.db.QueryRow(ctx, existsSharedResource,
+		arg.ID,
+		arg.IDFrom,
+		arg.IDTo,
+		arg.OwnerID,
+		arg.OwnerIDFrom,
+		arg.OwnerIDTo,
+		arg.OwnerType,
+		arg.Order,
+		arg.OrderFrom,
+		arg.OrderTo,
 	)
-	return i, err
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
 }
 
-const getAccountCartItem = `-- name: GetAccountCartItem :one
+const existsSystemEvent = `-- name: ExistsSystemEvent :one
+SELECT EXISTS (
+SELECT 1
+FROM "system"."event"
+WHERE (
+    ("id" = ANY($1) OR $1 IS NULL) AND
+    ("id" >= $2 OR $2 IS NULL) AND
+    ("id" <= $3 OR $3 IS NULL) AND
+    ("account_id" = ANY($4) OR $4 IS NULL) AND
+    ("account_id" >= $5 OR $5 IS NULL) AND
+    ("account_id" <= $6 OR $6 IS NULL) AND
+    ("aggregate_id" = ANY($7) OR $7 IS NULL) AND
+    ("aggregate_id" >= $8 OR $8 IS NULL) AND
+    ("aggregate_id" <= $9 OR $9 IS NULL) AND
+    ("aggregate_type" = ANY($10) OR $10 IS NULL) AND
+    ("event_type" = ANY($11) OR $11 IS NULL) AND
+    ("payload" = ANY($12) OR $12 IS NULL) AND
+    ("version" = ANY($13) OR $13 IS NULL) AND
+    ("version" >= $14 OR $14 IS NULL) AND
+    ("version" <= $15 OR $15 IS NULL) AND
+    ("date_created" = ANY($16) OR $16 IS NULL) AND
+    ("date_created" >= $17 OR $17 IS NULL) AND
+    ("date_created" <= $18 OR $18 IS NULL)
+)
+) as exists
+`
 
+type ExistsSystemEventParams struct {
+	ID              []int64              `json:"id"`
+	IDFrom          pgtype.Int8          `json:"id_from"`
+	IDTo            pgtype.Int8          `json:"id_to"`
+	AccountID       []pgtype.Int8        `json:"account_id"`
+	AccountIDFrom   pgtype.Int8          `json:"account_id_from"`
+	AccountIDTo     pgtype.Int8          `json:"account_id_to"`
+	AggregateID     []int64              `json:"aggregate_id"`
+	AggregateIDFrom pgtype.Int8          `json:"aggregate_id_from"`
+	AggregateIDTo   pgtype.Int8          `json:"aggregate_id_to"`
+	AggregateType   []string             `json:"aggregate_type"`
+	EventType       []SystemEventType    `json:"event_type"`
+	Payload         [][]byte             `json:"payload"`
+	Version         []int64              `json:"version"`
+	VersionFrom     pgtype.Int8          `json:"version_from"`
+	VersionTo       pgtype.Int8          `json:"version_to"`
+	DateCreated     []pgtype.Timestamptz `json:"date_created"`
+	DateCreatedFrom pgtype.Timestamptz   `json:"date_created_from"`
+	DateCreatedTo   pgtype.Timestamptz   `json:"date_created_to"`
+}
 
+func (q *Queries) ExistsSystemEvent(ctx context.Context, arg ExistsSystemEventParams) (bool, error) {
+	row := q.db.QueryRow(ctx, existsSystemEvent,
+		arg.ID,
+		arg.IDFrom,
+		arg.IDTo,
+		arg.AccountID,
+		arg.AccountIDFrom,
+		arg.AccountIDTo,
+		arg.AggregateID,
+		arg.AggregateIDFrom,
+		arg.AggregateIDTo,
+		arg.AggregateType,
+		arg.EventType,
+		arg.Payload,
+		arg.Version,
+		arg.VersionFrom,
+		arg.VersionTo,
+		arg.DateCreated,
+		arg.DateCreatedFrom,
+		arg.DateCreatedTo,
+	)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
 
-SELECT id, cart_id, sku_id, quantity, date_created, date_updated
-FROM "account"."cart_item"
-WHERE ("id" = $1) OR ("cart_id" = $2 AND "sku_id" = $3)
+const existsSystemSearchSync = `-- name: ExistsSystemSearchSync :one
+SELECT EXISTS (
+SELECT 1
+FROM "system"."search_sync"
+WHERE (
+    ("id" = ANY($1) OR $1 IS NULL) AND
+    ("id" >= $2 OR $2 IS NULL) AND
+    ("id" <= $3 OR $3 IS NULL) AND
+    ("name" = ANY($4) OR $4 IS NULL) AND
+    ("last_synced" = ANY($5) OR $5 IS NULL) AND
+    ("last_synced" >= $6 OR $6 IS NULL) AND
+    ("last_synced" <= $7 OR $7 IS NULL)
+)
+) as exists
 `
 
-type GetAccountCartItemParams struct {
-	ID     pgtype.Int8 `json:"id"`
-	CartID pgtype.Int8 `json:"cart_id"`
-	SkuID  pgtype.Int8 `json:"sku_id"`
+type ExistsSystemSearchSyncParams struct {
+	ID             []int64              `json:"id"`
+	IDFrom         pgtype.Int8          `json:"id_from"`
+	IDTo           pgtype.Int8          `json:"id_to"`
+	Name           []string             `json:"name"`
+	LastSynced     []pgtype.Timestamptz `json:"last_synced"`
+	LastSyncedFrom pgtype.Timestamptz   `json:"last_synced_from"`
+	LastSyncedTo   pgtype.Timestamptz   `json:"last_synced_to"`
 }
 
-// ========================================
-// Queries for table: account.cart_item
-// ========================================
-func (q *Queries) GetAccountCartItem(ctx context.Context, arg GetAccountCartItemParams) (AccountCartItem, error) {
-	row := q.db.QueryRow(ctx, getAccountCartItem, arg.ID, arg.CartID, arg.SkuID)
-	var i AccountCartItem
-	err := row.Scan(
-		&i.ID,
-		&i.CartID,
-		&i.SkuID,
-		&i.Quantity,
-		&i.DateCreated,
-		&i.DateUpdated,
+func (q *Queries) ExistsSystemSearchSync(ctx context.Context, arg ExistsSystemSearchSyncParams) (bool, error) {
+	row := q.db.QueryRow(ctx, existsSystemSearchSync,
+		arg.ID,
+		arg.IDFrom,
+		arg.IDTo,
+		arg.Name,
+		arg.LastSynced,
+		arg.LastSyncedFrom,
+		arg.LastSyncedTo,
 	)
-	return i, err
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
 }
 
-const getAccountCustomer = `-- name: GetAccountCustomer :one
+const getAccountAddress = `-- name: GetAccountAddress :one
 
 
 
-SELECT id, default_address_id, date_created, date_updated
-FROM "account"."customer"
-WHERE ("id" = $1)
+SELECT id, code, account_id, type, full_name, phone, phone_verified, address_line, city, state_province, country, date_created, date_updated
+FROM "account"."address"
+WHERE ("id" = $1) OR ("code" = $2)
 `
 
+type GetAccountAddressParams struct {
+	ID   pgtype.Int8 `json:"id"`
+	Code pgtype.Text `json:"code"`
+}
+
 // ========================================
-// Queries for table: account.customer
+// Queries for table: account.address
 // ========================================
-func (q *Queries) GetAccountCustomer(ctx context.Context, id pgtype.Int8) (AccountCustomer, error) {
-	row := q.db.QueryRow(ctx, getAccountCustomer, id)
-	var i AccountCustomer
+func (q *Queries) GetAccountAddress(ctx context.Context, arg GetAccountAddressParams) (AccountAddress, error) {
+	row := q.db.QueryRow(ctx, getAccountAddress, arg.ID, arg.Code)
+	var i AccountAddress
 	err := row.Scan(
 		&i.ID,
-		&i.DefaultAddressID,
-		&i.DateCreated,
+		&i.Code,
+		&i.AccountID,
+		&i.Type,
+		&i.FullName,
+		&i.Phone,
+		&i.PhoneVerified,
+		&i.AddressLine,
+		&i.City,
+		&i.StateProvince,
+		&i.Country,
+		&i.DateCreated,
+		&i.DateUpdated,
+	)
+	return i, err
+}
+
+const getAccountBase = `-- name: GetAccountBase :one
+
+
+
+
+
+SELECT id, code, type, status, phone, email, username, password, date_created, date_updated
+FROM "account"."base"
+WHERE ("id" = $1) OR ("code" = $2) OR ("phone" = $3) OR ("email" = $4) OR ("username" = $5)
+`
+
+type GetAccountBaseParams struct {
+	ID       pgtype.Int8 `json:"id"`
+	Code     pgtype.Text `json:"code"`
+	Phone    pgtype.Text `json:"phone"`
+	Email    pgtype.Text `json:"email"`
+	Username pgtype.Text `json:"username"`
+}
+
+// Code generated by tool/main.go. DO NOT EDIT.
+// This file contains all queries for the database schema.
+// ========================================
+// Queries for table: account.base
+// ========================================
+func (q *Queries) GetAccountBase(ctx context.Context, arg GetAccountBaseParams) (AccountBase, error) {
+	row := q.db.QueryRow(ctx, getAccountBase,
+		arg.ID,
+		arg.Code,
+		arg.Phone,
+		arg.Email,
+		arg.Username,
+	)
+	var i AccountBase
+	err := row.Scan(
+		&i.ID,
+		&i.Code,
+		&i.Type,
+		&i.Status,
+		&i.Phone,
+		&i.Email,
+		&i.Username,
+		&i.Password,
+		&i.DateCreated,
+		&i.DateUpdated,
+	)
+	return i, err
+}
+
+const getAccountCartItem = `-- name: GetAccountCartItem :one
+
+
+
+SELECT id, cart_id, sku_id, quantity, date_created, date_updated
+FROM "account"."cart_item"
+WHERE ("id" = $1) OR ("cart_id" = $2 AND "sku_id" = $3)
+`
+
+type GetAccountCartItemParams struct {
+	ID     pgtype.Int8 `json:"id"`
+	CartID pgtype.Int8 `json:"cart_id"`
+	SkuID  pgtype.Int8 `json:"sku_id"`
+}
+
+// ========================================
+// Queries for table: account.cart_item
+// ========================================
+func (q *Queries) GetAccountCartItem(ctx context.Context, arg GetAccountCartItemParams) (AccountCartItem, error) {
+	row := q.db.QueryRow(ctx, getAccountCartItem, arg.ID, arg.CartID, arg.SkuID)
+	var i AccountCartItem
+	err := row.Scan(
+		&i.ID,
+		&i.CartID,
+		&i.SkuID,
+		&i.Quantity,
+		&i.DateCreated,
+		&i.DateUpdated,
+	)
+	return i, err
+}
+
+const getAccountCustomer = `-- name: GetAccountCustomer :one
+
+
+
+SELECT id, default_address_id, date_created, date_updated
+FROM "account"."customer"
+WHERE ("id" = $1)
+`
+
+// ========================================
+// Queries for table: account.customer
+// ========================================
+func (q *Queries) GetAccountCustomer(ctx context.Context, id pgtype.Int8) (AccountCustomer, error) {
+	row := q.db.QueryRow(ctx, getAccountCustomer, id)
+	var i AccountCustomer
+	err := row.Scan(
+		&i.ID,
+		&i.DefaultAddressID,
+		&i.DateCreated,
 		&i.DateUpdated,
 	)
 	return i, err
@@ -5617,7 +6990,7 @@ const getInventoryStock = `-- name: GetInventoryStock :one
 
 
 
-SELECT id, ref_type, ref_id, current_stock, sold, date_created
+SELECT id, ref_type, ref_id, current_stock, sold, low_stock_threshold, alert, date_alerted, date_created
 FROM "inventory"."stock"
 WHERE ("id" = $1) OR ("ref_id" = $2 AND "ref_type" = $3)
 `
@@ -5640,6 +7013,9 @@ func (q *Queries) GetInventoryStock(ctx context.Context, arg GetInventoryStockPa
 		&i.RefID,
 		&i.CurrentStock,
 		&i.Sold,
+		&i.LowStockThreshold,
+		&i.Alert,
+		&i.DateAlerted,
 		&i.DateCreated,
 	)
 	return i, err
@@ -5649,7 +7025,7 @@ const getInventoryStockHistory = `-- name: GetInventoryStockHistory :one
 
 
 
-SELECT id, stock_id, change, date_created
+SELECT id, stock_id, change, account_id, reason, date_created
 FROM "inventory"."stock_history"
 WHERE ("id" = $1)
 `
@@ -5664,7 +7040,38 @@ func (q *Queries) GetInventoryStockHistory(ctx context.Context, id pgtype.Int8)
 		&i.ID,
 		&i.StockID,
 		&i.Change,
+		&i.AccountID,
+		&i.Reason,
+		&i.DateCreated,
+	)
+	return i, err
+}
+
+const getInventoryStockReservation = `-- name: GetInventoryStockReservation :one
+
+
+
+SELECT id, stock_id, ref_type, ref_id, quantity, status, date_expired, date_created, date_updated
+FROM "inventory"."stock_reservation"
+WHERE ("id" = $1)
+`
+
+// ========================================
+// Queries for table: inventory.stock_reservation
+// ========================================
+func (q *Queries) GetInventoryStockReservation(ctx context.Context, id pgtype.Int8) (InventoryStockReservation, error) {
+	row := q.db.QueryRow(ctx, getInventoryStockReservation, id)
+	var i InventoryStockReservation
+	err := row.Scan(
+		&i.ID,
+		&i.StockID,
+		&i.RefType,
+		&i.RefID,
+		&i.Quantity,
+		&i.Status,
+		&i.DateExpired,
 		&i.DateCreated,
+		&i.DateUpdated,
 	)
 	return i, err
 }
@@ -5776,7 +7183,7 @@ const getOrderItem = `-- name: GetOrderItem :one
 
 
 
-SELECT id, code, order_id, sku_id, quantity
+SELECT id, code, order_id, sku_id, quantity, unit_price, subtotal, total
 FROM "order"."item"
 WHERE ("id" = $1) OR ("code" = $2)
 `
@@ -5798,6 +7205,9 @@ func (q *Queries) GetOrderItem(ctx context.Context, arg GetOrderItemParams) (Ord
 		&i.OrderID,
 		&i.SkuID,
 		&i.Quantity,
+		&i.UnitPrice,
+		&i.Subtotal,
+		&i.Total,
 	)
 	return i, err
 }
@@ -5898,7 +7308,7 @@ const getOrderVnpay = `-- name: GetOrderVnpay :one
 
 
 
-SELECT id, "vnp_Amount", "vnp_BankCode", "vnp_CardType", "vnp_OrderInfo", "vnp_PayDate", "vnp_ResponseCode", "vnp_SecureHash", "vnp_TmnCode", "vnp_TransactionNo", "vnp_TransactionStatus", "vnp_TxnRef"
+SELECT id, "vnp_Amount", "vnp_BankCode", "vnp_BankTranNo", "vnp_CardType", "vnp_OrderInfo", "vnp_PayDate", "vnp_ResponseCode", "vnp_SecureHash", "vnp_TmnCode", "vnp_TransactionNo", "vnp_TransactionStatus", "vnp_TxnRef"
 FROM "order"."vnpay"
 WHERE ("id" = $1)
 `
@@ -5913,6 +7323,7 @@ func (q *Queries) GetOrderVnpay(ctx context.Context, id pgtype.Int8) (OrderVnpay
 		&i.ID,
 		&i.VnpAmount,
 		&i.VnpBankCode,
+		&i.VnpBankTranNo,
 		&i.VnpCardType,
 		&i.VnpOrderInfo,
 		&i.VnpPayDate,
@@ -5930,7 +7341,7 @@ const getPromotionBase = `-- name: GetPromotionBase :one
 
 
 
-SELECT id, code, owner_id, ref_type, ref_id, type, title, description, is_active, date_started, date_ended, schedule_tz, schedule_start, schedule_duration, date_created, date_updated
+SELECT id, code, owner_id, ref_type, ref_id, type, title, description, is_active, exclusive, date_started, date_ended, schedule_tz, schedule_start, schedule_duration, date_created, date_updated
 FROM "promotion"."base"
 WHERE ("id" = $1) OR ("code" = $2)
 `
@@ -5956,6 +7367,7 @@ func (q *Queries) GetPromotionBase(ctx context.Context, arg GetPromotionBasePara
 		&i.Title,
 		&i.Description,
 		&i.IsActive,
+		&i.Exclusive,
 		&i.DateStarted,
 		&i.DateEnded,
 		&i.ScheduleTz,
@@ -5967,6 +7379,133 @@ func (q *Queries) GetPromotionBase(ctx context.Context, arg GetPromotionBasePara
 	return i, err
 }
 
+const getPromotionBundle = `-- name: GetPromotionBundle :one
+
+
+
+SELECT id, price
+FROM "promotion"."bundle"
+WHERE ("id" = $1)
+`
+
+// ========================================
+// Queries for table: promotion.bundle
+// ========================================
+func (q *Queries) GetPromotionBundle(ctx context.Context, id pgtype.Int8) (PromotionBundle, error) {
+	row := q.db.QueryRow(ctx, getPromotionBundle, id)
+	var i PromotionBundle
+	err := row.Scan(&i.ID, &i.Price)
+	return i, err
+}
+
+const getPromotionBundleItem = `-- name: GetPromotionBundleItem :one
+
+
+
+SELECT id, bundle_id, sku_id, quantity
+FROM "promotion"."bundle_item"
+WHERE ("id" = $1) OR ("bundle_id" = $2 AND "sku_id" = $3)
+`
+
+type GetPromotionBundleItemParams struct {
+	ID       pgtype.Int8 `json:"id"`
+	BundleID pgtype.Int8 `json:"bundle_id"`
+	SkuID    pgtype.Int8 `json:"sku_id"`
+}
+
+// ========================================
+// Queries for table: promotion.bundle_item
+// ========================================
+func (q *Queries) GetPromotionBundleItem(ctx context.Context, arg GetPromotionBundleItemParams) (PromotionBundleItem, error) {
+	row := q.db.QueryRow(ctx, getPromotionBundleItem, arg.ID, arg.BundleID, arg.SkuID)
+	var i PromotionBundleItem
+	err := row.Scan(
+		&i.ID,
+		&i.BundleID,
+		&i.SkuID,
+		&i.Quantity,
+	)
+	return i, err
+}
+
+const getPromotionBuyXGetY = `-- name: GetPromotionBuyXGetY :one
+
+
+
+SELECT id, buy_quantity, get_quantity
+FROM "promotion"."buy_x_get_y"
+WHERE ("id" = $1)
+`
+
+// ========================================
+// Queries for table: promotion.buy_x_get_y
+// ========================================
+func (q *Queries) GetPromotionBuyXGetY(ctx context.Context, id pgtype.Int8) (PromotionBuyXGetY, error) {
+	row := q.db.QueryRow(ctx, getPromotionBuyXGetY, id)
+	var i PromotionBuyXGetY
+	err := row.Scan(&i.ID, &i.BuyQuantity, &i.GetQuantity)
+	return i, err
+}
+
+const getPromotionCashback = `-- name: GetPromotionCashback :one
+
+
+
+SELECT id, min_spend, max_cashback, cashback_percent, cashback_price
+FROM "promotion"."cashback"
+WHERE ("id" = $1)
+`
+
+// ========================================
+// Queries for table: promotion.cashback
+// ========================================
+func (q *Queries) GetPromotionCashback(ctx context.Context, id pgtype.Int8) (PromotionCashback, error) {
+	row := q.db.QueryRow(ctx, getPromotionCashback, id)
+	var i PromotionCashback
+	err := row.Scan(
+		&i.ID,
+		&i.MinSpend,
+		&i.MaxCashback,
+		&i.CashbackPercent,
+		&i.CashbackPrice,
+	)
+	return i, err
+}
+
+const getPromotionCashbackCredit = `-- name: GetPromotionCashbackCredit :one
+
+
+
+SELECT id, account_id, order_id, promotion_id, amount, status, date_created, date_updated
+FROM "promotion"."cashback_credit"
+WHERE ("id" = $1) OR ("order_id" = $2 AND "promotion_id" = $3)
+`
+
+type GetPromotionCashbackCreditParams struct {
+	ID          pgtype.Int8 `json:"id"`
+	OrderID     pgtype.Int8 `json:"order_id"`
+	PromotionID pgtype.Int8 `json:"promotion_id"`
+}
+
+// ========================================
+// Queries for table: promotion.cashback_credit
+// ========================================
+func (q *Queries) GetPromotionCashbackCredit(ctx context.Context, arg GetPromotionCashbackCreditParams) (PromotionCashbackCredit, error) {
+	row := q.db.QueryRow(ctx, getPromotionCashbackCredit, arg.ID, arg.OrderID, arg.PromotionID)
+	var i PromotionCashbackCredit
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.OrderID,
+		&i.PromotionID,
+		&i.Amount,
+		&i.Status,
+		&i.DateCreated,
+		&i.DateUpdated,
+	)
+	return i, err
+}
+
 const getPromotionDiscount = `-- name: GetPromotionDiscount :one
 
 
@@ -5993,6 +7532,65 @@ func (q *Queries) GetPromotionDiscount(ctx context.Context, id pgtype.Int8) (Pro
 	return i, err
 }
 
+const getPromotionVoucher = `-- name: GetPromotionVoucher :one
+
+
+
+SELECT id, usage_limit, per_customer_limit, first_order_only, min_spend, used
+FROM "promotion"."voucher"
+WHERE ("id" = $1)
+`
+
+// ========================================
+// Queries for table: promotion.voucher
+// ========================================
+func (q *Queries) GetPromotionVoucher(ctx context.Context, id pgtype.Int8) (PromotionVoucher, error) {
+	row := q.db.QueryRow(ctx, getPromotionVoucher, id)
+	var i PromotionVoucher
+	err := row.Scan(
+		&i.ID,
+		&i.UsageLimit,
+		&i.PerCustomerLimit,
+		&i.FirstOrderOnly,
+		&i.MinSpend,
+		&i.Used,
+	)
+	return i, err
+}
+
+const getPromotionVoucherRedemption = `-- name: GetPromotionVoucherRedemption :one
+
+
+
+SELECT id, voucher_id, account_id, order_id, status, date_created, date_updated
+FROM "promotion"."voucher_redemption"
+WHERE ("id" = $1) OR ("voucher_id" = $2 AND "order_id" = $3)
+`
+
+type GetPromotionVoucherRedemptionParams struct {
+	ID        pgtype.Int8 `json:"id"`
+	VoucherID pgtype.Int8 `json:"voucher_id"`
+	OrderID   pgtype.Int8 `json:"order_id"`
+}
+
+// ========================================
+// Queries for table: promotion.voucher_redemption
+// ========================================
+func (q *Queries) GetPromotionVoucherRedemption(ctx context.Context, arg GetPromotionVoucherRedemptionParams) (PromotionVoucherRedemption, error) {
+	row := q.db.QueryRow(ctx, getPromotionVoucherRedemption, arg.ID, arg.VoucherID, arg.OrderID)
+	var i PromotionVoucherRedemption
+	err := row.Scan(
+		&i.ID,
+		&i.VoucherID,
+		&i.AccountID,
+		&i.OrderID,
+		&i.Status,
+		&i.DateCreated,
+		&i.DateUpdated,
+	)
+	return i, err
+}
+
 const getSharedResource = `-- name: GetSharedResource :one
 
 
@@ -6097,22 +7695,625 @@ LIMIT $23
 OFFSET $22
 `
 
-type ListAccountAddressParams struct {
+type ListAccountAddressParams struct {
+	ID              []int64              `json:"id"`
+	IDFrom          pgtype.Int8          `json:"id_from"`
+	IDTo            pgtype.Int8          `json:"id_to"`
+	Code            []string             `json:"code"`
+	AccountID       []int64              `json:"account_id"`
+	AccountIDFrom   pgtype.Int8          `json:"account_id_from"`
+	AccountIDTo     pgtype.Int8          `json:"account_id_to"`
+	Type            []AccountAddressType `json:"type"`
+	FullName        []string             `json:"full_name"`
+	Phone           []string             `json:"phone"`
+	PhoneVerified   []bool               `json:"phone_verified"`
+	AddressLine     []string             `json:"address_line"`
+	City            []string             `json:"city"`
+	StateProvince   []string             `json:"state_province"`
+	Country         []string             `json:"country"`
+	DateCreated     []pgtype.Timestamptz `json:"date_created"`
+	DateCreatedFrom pgtype.Timestamptz   `json:"date_created_from"`
+	DateCreatedTo   pgtype.Timestamptz   `json:"date_created_to"`
+	DateUpdated     []pgtype.Timestamptz `json:"date_updated"`
+	DateUpdatedFrom pgtype.Timestamptz   `json:"date_updated_from"`
+	DateUpdatedTo   pgtype.Timestamptz   `json:"date_updated_to"`
+	Offset          pgtype.Int4          `json:"offset"`
+	Limit           pgtype.Int4          `json:"limit"`
+}
+
+func (q *Queries) ListAccountAddress(ctx context.Context, arg ListAccountAddressParams) ([]AccountAddress, error) {
+	rows, err := q.db.Query(ctx, listAccountAddress,
+		arg.ID,
+		arg.IDFrom,
+		arg.IDTo,
+		arg.Code,
+		arg.AccountID,
+		arg.AccountIDFrom,
+		arg.AccountIDTo,
+		arg.Type,
+		arg.FullName,
+		arg.Phone,
+		arg.PhoneVerified,
+		arg.AddressLine,
+		arg.City,
+		arg.StateProvince,
+		arg.Country,
+		arg.DateCreated,
+		arg.DateCreatedFrom,
+		arg.DateCreatedTo,
+		arg.DateUpdated,
+		arg.DateUpdatedFrom,
+		arg.DateUpdatedTo,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AccountAddress{}
+	for rows.Next() {
+		var i AccountAddress
+		if err := rows.Scan(
+			&i.ID,
+			&i.Code,
+			&i.AccountID,
+			&i.Type,
+			&i.FullName,
+			&i.Phone,
+			&i.PhoneVerified,
+			&i.AddressLine,
+			&i.City,
+			&i.StateProvince,
+			&i.Country,
+			&i.DateCreated,
+			&i.DateUpdated,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAccountBase = `-- name: ListAccountBase :many
+SELECT id, code, type, status, phone, email, username, password, date_created, date_updated
+FROM "account"."base"
+WHERE (
+    ("id" = ANY($1) OR $1 IS NULL) AND
+    ("id" >= $2 OR $2 IS NULL) AND
+    ("id" <= $3 OR $3 IS NULL) AND
+    ("code" = ANY($4) OR $4 IS NULL) AND
+    ("type" = ANY($5) OR $5 IS NULL) AND
+    ("status" = ANY($6) OR $6 IS NULL) AND
+    ("phone" = ANY($7) OR $7 IS NULL) AND
+    ("email" = ANY($8) OR $8 IS NULL) AND
+    ("username" = ANY($9) OR $9 IS NULL) AND
+    ("password" = ANY($10) OR $10 IS NULL) AND
+    ("date_created" = ANY($11) OR $11 IS NULL) AND
+    ("date_created" >= $12 OR $12 IS NULL) AND
+    ("date_created" <= $13 OR $13 IS NULL) AND
+    ("date_updated" = ANY($14) OR $14 IS NULL) AND
+    ("date_updated" >= $15 OR $15 IS NULL) AND
+    ("date_updated" <= $16 OR $16 IS NULL)
+)
+ORDER BY "id"
+LIMIT $18
+OFFSET $17
+`
+
+type ListAccountBaseParams struct {
+	ID              []int64              `json:"id"`
+	IDFrom          pgtype.Int8          `json:"id_from"`
+	IDTo            pgtype.Int8          `json:"id_to"`
+	Code            []string             `json:"code"`
+	Type            []AccountType        `json:"type"`
+	Status          []AccountStatus      `json:"status"`
+	Phone           []pgtype.Text        `json:"phone"`
+	Email           []pgtype.Text        `json:"email"`
+	Username        []pgtype.Text        `json:"username"`
+	Password        []pgtype.Text        `json:"password"`
+	DateCreated     []pgtype.Timestamptz `json:"date_created"`
+	DateCreatedFrom pgtype.Timestamptz   `json:"date_created_from"`
+	DateCreatedTo   pgtype.Timestamptz   `json:"date_created_to"`
+	DateUpdated     []pgtype.Timestamptz `json:"date_updated"`
+	DateUpdatedFrom pgtype.Timestamptz   `json:"date_updated_from"`
+	DateUpdatedTo   pgtype.Timestamptz   `json:"date_updated_to"`
+	Offset          pgtype.Int4          `json:"offset"`
+	Limit           pgtype.Int4          `json:"limit"`
+}
+
+func (q *Queries) ListAccountBase(ctx context.Context, arg ListAccountBaseParams) ([]AccountBase, error) {
+	rows, err := q.db.Query(ctx, listAccountBase,
+		arg.ID,
+		arg.IDFrom,
+		arg.IDTo,
+		arg.Code,
+		arg.Type,
+		arg.Status,
+		arg.Phone,
+		arg.Email,
+		arg.Username,
+		arg.Password,
+		arg.DateCreated,
+		arg.DateCreatedFrom,
+		arg.DateCreatedTo,
+		arg.DateUpdated,
+		arg.DateUpdatedFrom,
+		arg.DateUpdatedTo,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AccountBase{}
+	for rows.Next() {
+		var i AccountBase
+		if err := rows.Scan(
+			&i.ID,
+			&i.Code,
+			&i.Type,
+			&i.Status,
+			&i.Phone,
+			&i.Email,
+			&i.Username,
+			&i.Password,
+			&i.DateCreated,
+			&i.DateUpdated,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAccountCartItem = `-- name: ListAccountCartItem :many
+SELECT id, cart_id, sku_id, quantity, date_created, date_updated
+FROM "account"."cart_item"
+WHERE (
+    ("id" = ANY($1) OR $1 IS NULL) AND
+    ("id" >= $2 OR $2 IS NULL) AND
+    ("id" <= $3 OR $3 IS NULL) AND
+    ("cart_id" = ANY($4) OR $4 IS NULL) AND
+    ("cart_id" >= $5 OR $5 IS NULL) AND
+    ("cart_id" <= $6 OR $6 IS NULL) AND
+    ("sku_id" = ANY($7) OR $7 IS NULL) AND
+    ("sku_id" >= $8 OR $8 IS NULL) AND
+    ("sku_id" <= $9 OR $9 IS NULL) AND
+    ("quantity" = ANY($10) OR $10 IS NULL) AND
+    ("quantity" >= $11 OR $11 IS NULL) AND
+    ("quantity" <= $12 OR $12 IS NULL) AND
+    ("date_created" = ANY($13) OR $13 IS NULL) AND
+    ("date_created" >= $14 OR $14 IS NULL) AND
+    ("date_created" <= $15 OR $15 IS NULL) AND
+    ("date_updated" = ANY($16) OR $16 IS NULL) AND
+    ("date_updated" >= $17 OR $17 IS NULL) AND
+    ("date_updated" <= $18 OR $18 IS NULL)
+)
+ORDER BY "id"
+LIMIT $20
+OFFSET $19
+`
+
+type ListAccountCartItemParams struct {
+	ID              []int64              `json:"id"`
+	IDFrom          pgtype.Int8          `json:"id_from"`
+	IDTo            pgtype.Int8          `json:"id_to"`
+	CartID          []int64              `json:"cart_id"`
+	CartIDFrom      pgtype.Int8          `json:"cart_id_from"`
+	CartIDTo        pgtype.Int8          `json:"cart_id_to"`
+	SkuID           []int64              `json:"sku_id"`
+	SkuIDFrom       pgtype.Int8          `json:"sku_id_from"`
+	SkuIDTo         pgtype.Int8          `json:"sku_id_to"`
+	Quantity        []int64              `json:"quantity"`
+	QuantityFrom    pgtype.Int8          `json:"quantity_from"`
+	QuantityTo      pgtype.Int8          `json:"quantity_to"`
+	DateCreated     []pgtype.Timestamptz `json:"date_created"`
+	DateCreatedFrom pgtype.Timestamptz   `json:"date_created_from"`
+	DateCreatedTo   pgtype.Timestamptz   `json:"date_created_to"`
+	DateUpdated     []pgtype.Timestamptz `json:"date_updated"`
+	DateUpdatedFrom pgtype.Timestamptz   `json:"date_updated_from"`
+	DateUpdatedTo   pgtype.Timestamptz   `json:"date_updated_to"`
+	Offset          pgtype.Int4          `json:"offset"`
+	Limit           pgtype.Int4          `json:"limit"`
+}
+
+func (q *Queries) ListAccountCartItem(ctx context.Context, arg ListAccountCartItemParams) ([]AccountCartItem, error) {
+	rows, err := q.db.Query(ctx, listAccountCartItem,
+		arg.ID,
+		arg.IDFrom,
+		arg.IDTo,
+		arg.CartID,
+		arg.CartIDFrom,
+		arg.CartIDTo,
+		arg.SkuID,
+		arg.SkuIDFrom,
+		arg.SkuIDTo,
+		arg.Quantity,
+		arg.QuantityFrom,
+		arg.QuantityTo,
+		arg.DateCreated,
+		arg.DateCreatedFrom,
+		arg.DateCreatedTo,
+		arg.DateUpdated,
+		arg.DateUpdatedFrom,
+		arg.DateUpdatedTo,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AccountCartItem{}
+	for rows.Next() {
+		var i AccountCartItem
+		if err := rows.Scan(
+			&i.ID,
+			&i.CartID,
+			&i.SkuID,
+			&i.Quantity,
+			&i.DateCreated,
+			&i.DateUpdated,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAccountCustomer = `-- name: ListAccountCustomer :many
+SELECT id, default_address_id, date_created, date_updated
+FROM "account"."customer"
+WHERE (
+    ("id" = ANY($1) OR $1 IS NULL) AND
+    ("id" >= $2 OR $2 IS NULL) AND
+    ("id" <= $3 OR $3 IS NULL) AND
+    ("default_address_id" = ANY($4) OR $4 IS NULL) AND
+    ("default_address_id" >= $5 OR $5 IS NULL) AND
+    ("default_address_id" <= $6 OR $6 IS NULL) AND
+    ("date_created" = ANY($7) OR $7 IS NULL) AND
+    ("date_created" >= $8 OR $8 IS NULL) AND
+    ("date_created" <= $9 OR $9 IS NULL) AND
+    ("date_updated" = ANY($10) OR $10 IS NULL) AND
+    ("date_updated" >= $11 OR $11 IS NULL) AND
+    ("date_updated" <= $12 OR $12 IS NULL)
+)
+ORDER BY "id"
+LIMIT $14
+OFFSET $13
+`
+
+type ListAccountCustomerParams struct {
+	ID                   []int64              `json:"id"`
+	IDFrom               pgtype.Int8          `json:"id_from"`
+	IDTo                 pgtype.Int8          `json:"id_to"`
+	DefaultAddressID     []pgtype.Int8        `json:"default_address_id"`
+	DefaultAddressIDFrom pgtype.Int8          `json:"default_address_id_from"`
+	DefaultAddressIDTo   pgtype.Int8          `json:"default_address_id_to"`
+	DateCreated          []pgtype.Timestamptz `json:"date_created"`
+	DateCreatedFrom      pgtype.Timestamptz   `json:"date_created_from"`
+	DateCreatedTo        pgtype.Timestamptz   `json:"date_created_to"`
+	DateUpdated          []pgtype.Timestamptz `json:"date_updated"`
+	DateUpdatedFrom      pgtype.Timestamptz   `json:"date_updated_from"`
+	DateUpdatedTo        pgtype.Timestamptz   `json:"date_updated_to"`
+	Offset               pgtype.Int4          `json:"offset"`
+	Limit                pgtype.Int4          `json:"limit"`
+}
+
+func (q *Queries) ListAccountCustomer(ctx context.Context, arg ListAccountCustomerParams) ([]AccountCustomer, error) {
+	rows, err := q.db.Query(ctx, listAccountCustomer,
+		arg.ID,
+		arg.IDFrom,
+		arg.IDTo,
+		arg.DefaultAddressID,
+		arg.DefaultAddressIDFrom,
+		arg.DefaultAddressIDTo,
+		arg.DateCreated,
+		arg.DateCreatedFrom,
+		arg.DateCreatedTo,
+		arg.DateUpdated,
+		arg.DateUpdatedFrom,
+		arg.DateUpdatedTo,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AccountCustomer{}
+	for rows.Next() {
+		var i AccountCustomer
+		if err := rows.Scan(
+			&i.ID,
+			&i.DefaultAddressID,
+			&i.DateCreated,
+			&i.DateUpdated,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAccountIncomeHistory = `-- name: ListAccountIncomeHistory :many
+SELECT id, account_id, type, income, current_balance, note, date_created, hash, prev_hash
+FROM "account"."income_history"
+WHERE (
+    ("id" = ANY($1) OR $1 IS NULL) AND
+    ("id" >= $2 OR $2 IS NULL) AND
+    ("id" <= $3 OR $3 IS NULL) AND
+    ("account_id" = ANY($4) OR $4 IS NULL) AND
+    ("account_id" >= $5 OR $5 IS NULL) AND
+    ("account_id" <= $6 OR $6 IS NULL) AND
+    ("type" = ANY($7) OR $7 IS NULL) AND
+    ("income" = ANY($8) OR $8 IS NULL) AND
+    ("income" >= $9 OR $9 IS NULL) AND
+    ("income" <= $10 OR $10 IS NULL) AND
+    ("current_balance" = ANY($11) OR $11 IS NULL) AND
+    ("current_balance" >= $12 OR $12 IS NULL) AND
+    ("current_balance" <= $13 OR $13 IS NULL) AND
+    ("note" = ANY($14) OR $14 IS NULL) AND
+    ("date_created" = ANY($15) OR $15 IS NULL) AND
+    ("date_created" >= $16 OR $16 IS NULL) AND
+    ("date_created" <= $17 OR $17 IS NULL) AND
+    ("hash" = ANY($18) OR $18 IS NULL) AND
+    ("prev_hash" = ANY($19) OR $19 IS NULL)
+)
+ORDER BY "id"
+LIMIT $21
+OFFSET $20
+`
+
+type ListAccountIncomeHistoryParams struct {
+	ID                 []int64              `json:"id"`
+	IDFrom             pgtype.Int8          `json:"id_from"`
+	IDTo               pgtype.Int8          `json:"id_to"`
+	AccountID          []int64              `json:"account_id"`
+	AccountIDFrom      pgtype.Int8          `json:"account_id_from"`
+	AccountIDTo        pgtype.Int8          `json:"account_id_to"`
+	Type               []string             `json:"type"`
+	Income             []int64              `json:"income"`
+	IncomeFrom         pgtype.Int8          `json:"income_from"`
+	IncomeTo           pgtype.Int8          `json:"income_to"`
+	CurrentBalance     []int64              `json:"current_balance"`
+	CurrentBalanceFrom pgtype.Int8          `json:"current_balance_from"`
+	CurrentBalanceTo   pgtype.Int8          `json:"current_balance_to"`
+	Note               []pgtype.Text        `json:"note"`
+	DateCreated        []pgtype.Timestamptz `json:"date_created"`
+	DateCreatedFrom    pgtype.Timestamptz   `json:"date_created_from"`
+	DateCreatedTo      pgtype.Timestamptz   `json:"date_created_to"`
+	Hash               [][]byte             `json:"hash"`
+	PrevHash           [][]byte             `json:"prev_hash"`
+	Offset             pgtype.Int4          `json:"offset"`
+	Limit              pgtype.Int4          `json:"limit"`
+}
+
+func (q *Queries) ListAccountIncomeHistory(ctx context.Context, arg ListAccountIncomeHistoryParams) ([]AccountIncomeHistory, error) {
+	rows, err := q.db.Query(ctx, listAccountIncomeHistory,
+		arg.ID,
+		arg.IDFrom,
+		arg.IDTo,
+		arg.AccountID,
+		arg.AccountIDFrom,
+		arg.AccountIDTo,
+		arg.Type,
+		arg.Income,
+		arg.IncomeFrom,
+		arg.IncomeTo,
+		arg.CurrentBalance,
+		arg.CurrentBalanceFrom,
+		arg.CurrentBalanceTo,
+		arg.Note,
+		arg.DateCreated,
+		arg.DateCreatedFrom,
+		arg.DateCreatedTo,
+		arg.Hash,
+		arg.PrevHash,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AccountIncomeHistory{}
+	for rows.Next() {
+		var i AccountIncomeHistory
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Type,
+			&i.Income,
+			&i.CurrentBalance,
+			&i.Note,
+			&i.DateCreated,
+			&i.Hash,
+			&i.PrevHash,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAccountNotification = `-- name: ListAccountNotification :many
+SELECT id, account_id, type, channel, is_read, content, date_created, date_updated, date_sent, date_scheduled
+FROM "account"."notification"
+WHERE (
+    ("id" = ANY($1) OR $1 IS NULL) AND
+    ("id" >= $2 OR $2 IS NULL) AND
+    ("id" <= $3 OR $3 IS NULL) AND
+    ("account_id" = ANY($4) OR $4 IS NULL) AND
+    ("account_id" >= $5 OR $5 IS NULL) AND
+    ("account_id" <= $6 OR $6 IS NULL) AND
+    ("type" = ANY($7) OR $7 IS NULL) AND
+    ("channel" = ANY($8) OR $8 IS NULL) AND
+    ("is_read" = ANY($9) OR $9 IS NULL) AND
+    ("date_created" = ANY($10) OR $10 IS NULL) AND
+    ("date_created" >= $11 OR $11 IS NULL) AND
+    ("date_created" <= $12 OR $12 IS NULL) AND
+    ("date_updated" = ANY($13) OR $13 IS NULL) AND
+    ("date_updated" >= $14 OR $14 IS NULL) AND
+    ("date_updated" <= $15 OR $15 IS NULL) AND
+    ("date_sent" = ANY($16) OR $16 IS NULL) AND
+    ("date_sent" >= $17 OR $17 IS NULL) AND
+    ("date_sent" <= $18 OR $18 IS NULL) AND
+    ("date_scheduled" = ANY($19) OR $19 IS NULL) AND
+    ("date_scheduled" >= $20 OR $20 IS NULL) AND
+    ("date_scheduled" <= $21 OR $21 IS NULL)
+)
+ORDER BY "id"
+LIMIT $23
+OFFSET $22
+`
+
+type ListAccountNotificationParams struct {
+	ID                []int64              `json:"id"`
+	IDFrom            pgtype.Int8          `json:"id_from"`
+	IDTo              pgtype.Int8          `json:"id_to"`
+	AccountID         []int64              `json:"account_id"`
+	AccountIDFrom     pgtype.Int8          `json:"account_id_from"`
+	AccountIDTo       pgtype.Int8          `json:"account_id_to"`
+	Type              []string             `json:"type"`
+	Channel           []string             `json:"channel"`
+	IsRead            []bool               `json:"is_read"`
+	DateCreated       []pgtype.Timestamptz `json:"date_created"`
+	DateCreatedFrom   pgtype.Timestamptz   `json:"date_created_from"`
+	DateCreatedTo     pgtype.Timestamptz   `json:"date_created_to"`
+	DateUpdated       []pgtype.Timestamptz `json:"date_updated"`
+	DateUpdatedFrom   pgtype.Timestamptz   `json:"date_updated_from"`
+	DateUpdatedTo     pgtype.Timestamptz   `json:"date_updated_to"`
+	DateSent          []pgtype.Timestamptz `json:"date_sent"`
+	DateSentFrom      pgtype.Timestamptz   `json:"date_sent_from"`
+	DateSentTo        pgtype.Timestamptz   `json:"date_sent_to"`
+	DateScheduled     []pgtype.Timestamptz `json:"date_scheduled"`
+	DateScheduledFrom pgtype.Timestamptz   `json:"date_scheduled_from"`
+	DateScheduledTo   pgtype.Timestamptz   `json:"date_scheduled_to"`
+	Offset            pgtype.Int4          `json:"offset"`
+	Limit             pgtype.Int4          `json:"limit"`
+}
+
+func (q *Queries) ListAccountNotification(ctx context.Context, arg ListAccountNotificationParams) ([]AccountNotification, error) {
+	rows, err := q.db.Query(ctx, listAccountNotification,
+		arg.ID,
+		arg.IDFrom,
+		arg.IDTo,
+		arg.AccountID,
+		arg.AccountIDFrom,
+		arg.AccountIDTo,
+		arg.Type,
+		arg.Channel,
+		arg.IsRead,
+		arg.DateCreated,
+		arg.DateCreatedFrom,
+		arg.DateCreatedTo,
+		arg.DateUpdated,
+		arg.DateUpdatedFrom,
+		arg.DateUpdatedTo,
+		arg.DateSent,
+		arg.DateSentFrom,
+		arg.DateSentTo,
+		arg.DateScheduled,
+		arg.DateScheduledFrom,
+		arg.DateScheduledTo,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AccountNotification{}
+	for rows.Next() {
+		var i AccountNotification
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Type,
+			&i.Channel,
+			&i.IsRead,
+			&i.Content,
+			&i.DateCreated,
+			&i.DateUpdated,
+			&i.DateSent,
+			&i.DateScheduled,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAccountProfile = `-- name: ListAccountProfile :many
+SELECT id, gender, name, date_of_birth, avatar_rs_id, email_verified, phone_verified, date_created, date_updated
+FROM "account"."profile"
+WHERE (
+    ("id" = ANY($1) OR $1 IS NULL) AND
+    ("id" >= $2 OR $2 IS NULL) AND
+    ("id" <= $3 OR $3 IS NULL) AND
+    ("gender" = ANY($4) OR $4 IS NULL) AND
+    ("name" = ANY($5) OR $5 IS NULL) AND
+    ("date_of_birth" = ANY($6) OR $6 IS NULL) AND
+    ("date_of_birth" >= $7 OR $7 IS NULL) AND
+    ("date_of_birth" <= $8 OR $8 IS NULL) AND
+    ("avatar_rs_id" = ANY($9) OR $9 IS NULL) AND
+    ("avatar_rs_id" >= $10 OR $10 IS NULL) AND
+    ("avatar_rs_id" <= $11 OR $11 IS NULL) AND
+    ("email_verified" = ANY($12) OR $12 IS NULL) AND
+    ("phone_verified" = ANY($13) OR $13 IS NULL) AND
+    ("date_created" = ANY($14) OR $14 IS NULL) AND
+    ("date_created" >= $15 OR $15 IS NULL) AND
+    ("date_created" <= $16 OR $16 IS NULL) AND
+    ("date_updated" = ANY($17) OR $17 IS NULL) AND
+    ("date_updated" >= $18 OR $18 IS NULL) AND
+    ("date_updated" <= $19 OR $19 IS NULL)
+)
+ORDER BY "id"
+LIMIT $21
+OFFSET $20
+`
+
+type ListAccountProfileParams struct {
 	ID              []int64              `json:"id"`
 	IDFrom          pgtype.Int8          `json:"id_from"`
 	IDTo            pgtype.Int8          `json:"id_to"`
-	Code            []string             `json:"code"`
-	AccountID       []int64              `json:"account_id"`
-	AccountIDFrom   pgtype.Int8          `json:"account_id_from"`
-	AccountIDTo     pgtype.Int8          `json:"account_id_to"`
-	Type            []AccountAddressType `json:"type"`
-	FullName        []string             `json:"full_name"`
-	Phone           []string             `json:"phone"`
+	Gender          []NullAccountGender  `json:"gender"`
+	Name            []pgtype.Text        `json:"name"`
+	DateOfBirth     []pgtype.Date        `json:"date_of_birth"`
+	DateOfBirthFrom pgtype.Date          `json:"date_of_birth_from"`
+	DateOfBirthTo   pgtype.Date          `json:"date_of_birth_to"`
+	AvatarRsID      []pgtype.Int8        `json:"avatar_rs_id"`
+	AvatarRsIDFrom  pgtype.Int8          `json:"avatar_rs_id_from"`
+	AvatarRsIDTo    pgtype.Int8          `json:"avatar_rs_id_to"`
+	EmailVerified   []bool               `json:"email_verified"`
 	PhoneVerified   []bool               `json:"phone_verified"`
-	AddressLine     []string             `json:"address_line"`
-	City            []string             `json:"city"`
-	StateProvince   []string             `json:"state_province"`
-	Country         []string             `json:"country"`
 	DateCreated     []pgtype.Timestamptz `json:"date_created"`
 	DateCreatedFrom pgtype.Timestamptz   `json:"date_created_from"`
 	DateCreatedTo   pgtype.Timestamptz   `json:"date_created_to"`
@@ -6123,23 +8324,21 @@ type ListAccountAddressParams struct {
 	Limit           pgtype.Int4          `json:"limit"`
 }
 
-func (q *Queries) ListAccountAddress(ctx context.Context, arg ListAccountAddressParams) ([]AccountAddress, error) {
-	rows, err := q.db.Query(ctx, listAccountAddress,
+func (q *Queries) ListAccountProfile(ctx context.Context, arg ListAccountProfileParams) ([]AccountProfile, error) {
+	rows, err := q.db.Query(ctx, listAccountProfile,
 		arg.ID,
 		arg.IDFrom,
 		arg.IDTo,
-		arg.Code,
-		arg.AccountID,
-		arg.AccountIDFrom,
-		arg.AccountIDTo,
-		arg.Type,
-		arg.FullName,
-		arg.Phone,
+		arg.Gender,
+		arg.Name,
+		arg.DateOfBirth,
+		arg.DateOfBirthFrom,
+		arg.DateOfBirthTo,
+		arg.AvatarRsID,
+		arg.AvatarRsIDFrom,
+		arg.AvatarRsIDTo,
+		arg.EmailVerified,
 		arg.PhoneVerified,
-		arg.AddressLine,
-		arg.City,
-		arg.StateProvince,
-		arg.Country,
 		arg.DateCreated,
 		arg.DateCreatedFrom,
 		arg.DateCreatedTo,
@@ -6153,21 +8352,17 @@ func (q *Queries) ListAccountAddress(ctx context.Context, arg ListAccountAddress
 		return nil, err
 	}
 	defer rows.Close()
-	items := []AccountAddress{}
+	items := []AccountProfile{}
 	for rows.Next() {
-		var i AccountAddress
+		var i AccountProfile
 		if err := rows.Scan(
 			&i.ID,
-			&i.Code,
-			&i.AccountID,
-			&i.Type,
-			&i.FullName,
-			&i.Phone,
+			&i.Gender,
+			&i.Name,
+			&i.DateOfBirth,
+			&i.AvatarRsID,
+			&i.EmailVerified,
 			&i.PhoneVerified,
-			&i.AddressLine,
-			&i.City,
-			&i.StateProvince,
-			&i.Country,
 			&i.DateCreated,
 			&i.DateUpdated,
 		); err != nil {
@@ -6181,71 +8376,85 @@ func (q *Queries) ListAccountAddress(ctx context.Context, arg ListAccountAddress
 	return items, nil
 }
 
-const listAccountBase = `-- name: ListAccountBase :many
-SELECT id, code, type, status, phone, email, username, password, date_created, date_updated
-FROM "account"."base"
+const listAccountVendor = `-- name: ListAccountVendor :many
+SELECT id, description
+FROM "account"."vendor"
 WHERE (
     ("id" = ANY($1) OR $1 IS NULL) AND
     ("id" >= $2 OR $2 IS NULL) AND
     ("id" <= $3 OR $3 IS NULL) AND
-    ("code" = ANY($4) OR $4 IS NULL) AND
-    ("type" = ANY($5) OR $5 IS NULL) AND
-    ("status" = ANY($6) OR $6 IS NULL) AND
-    ("phone" = ANY($7) OR $7 IS NULL) AND
-    ("email" = ANY($8) OR $8 IS NULL) AND
-    ("username" = ANY($9) OR $9 IS NULL) AND
-    ("password" = ANY($10) OR $10 IS NULL) AND
-    ("date_created" = ANY($11) OR $11 IS NULL) AND
-    ("date_created" >= $12 OR $12 IS NULL) AND
-    ("date_created" <= $13 OR $13 IS NULL) AND
-    ("date_updated" = ANY($14) OR $14 IS NULL) AND
-    ("date_updated" >= $15 OR $15 IS NULL) AND
-    ("date_updated" <= $16 OR $16 IS NULL)
+    ("description" = ANY($4) OR $4 IS NULL)
 )
 ORDER BY "id"
-LIMIT $18
-OFFSET $17
+LIMIT $6
+OFFSET $5
 `
 
-type ListAccountBaseParams struct {
-	ID              []int64              `json:"id"`
-	IDFrom          pgtype.Int8          `json:"id_from"`
-	IDTo            pgtype.Int8          `json:"id_to"`
-	Code            []string             `json:"code"`
-	Type            []AccountType        `json:"type"`
-	Status          []AccountStatus      `json:"status"`
-	Phone           []pgtype.Text        `json:"phone"`
-	Email           []pgtype.Text        `json:"email"`
-	Username        []pgtype.Text        `json:"username"`
-	Password        []pgtype.Text        `json:"password"`
-	DateCreated     []pgtype.Timestamptz `json:"date_created"`
-	DateCreatedFrom pgtype.Timestamptz   `json:"date_created_from"`
-	DateCreatedTo   pgtype.Timestamptz   `json:"date_created_to"`
-	DateUpdated     []pgtype.Timestamptz `json:"date_updated"`
-	DateUpdatedFrom pgtype.Timestamptz   `json:"date_updated_from"`
-	DateUpdatedTo   pgtype.Timestamptz   `json:"date_updated_to"`
-	Offset          pgtype.Int4          `json:"offset"`
-	Limit           pgtype.Int4          `json:"limit"`
+type ListAccountVendorParams struct {
+	ID          []int64     `json:"id"`
+	IDFrom      pgtype.Int8 `json:"id_from"`
+	IDTo        pgtype.Int8 `json:"id_to"`
+	Description []string    `json:"description"`
+	Offset      pgtype.Int4 `json:"offset"`
+	Limit       pgtype.Int4 `json:"limit"`
 }
 
-func (q *Queries) ListAccountBase(ctx context.Context, arg ListAccountBaseParams) ([]AccountBase, error) {
-	rows, err := q.db.Query(ctx, listAccountBase,
+func (q *Queries) ListAccountVendor(ctx context.Context, arg ListAccountVendorParams) ([]AccountVendor, error) {
+	rows, err := q.db.Query(ctx, listAccountVendor,
+		arg.ID,
+		arg.IDFrom,
+		arg.IDTo,
+		arg.Description,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AccountVendor{}
+	for rows.Next() {
+		var i AccountVendor
+		if err := rows.Scan(&i.ID, &i.Description); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCatalogBrand = `-- name: ListCatalogBrand :many
+SELECT id, code, name, description
+FROM "catalog"."brand"
+WHERE (
+    ("id" = ANY($1) OR $1 IS NULL) AND
+    ("id" >= $2 OR $2 IS NULL) AND
+    ("id" <= $3 OR $3 IS NULL) AND
+    ("code" = ANY($4) OR $4 IS NULL)
+)
+ORDER BY "id"
+LIMIT $6
+OFFSET $5
+`
+
+type ListCatalogBrandParams struct {
+	ID     []int64     `json:"id"`
+	IDFrom pgtype.Int8 `json:"id_from"`
+	IDTo   pgtype.Int8 `json:"id_to"`
+	Code   []string    `json:"code"`
+	Offset pgtype.Int4 `json:"offset"`
+	Limit  pgtype.Int4 `json:"limit"`
+}
+
+func (q *Queries) ListCatalogBrand(ctx context.Context, arg ListCatalogBrandParams) ([]CatalogBrand, error) {
+	rows, err := q.db.Query(ctx, listCatalogBrand,
 		arg.ID,
 		arg.IDFrom,
 		arg.IDTo,
 		arg.Code,
-		arg.Type,
-		arg.Status,
-		arg.Phone,
-		arg.Email,
-		arg.Username,
-		arg.Password,
-		arg.DateCreated,
-		arg.DateCreatedFrom,
-		arg.DateCreatedTo,
-		arg.DateUpdated,
-		arg.DateUpdatedFrom,
-		arg.DateUpdatedTo,
 		arg.Offset,
 		arg.Limit,
 	)
@@ -6253,20 +8462,14 @@ func (q *Queries) ListAccountBase(ctx context.Context, arg ListAccountBaseParams
 		return nil, err
 	}
 	defer rows.Close()
-	items := []AccountBase{}
+	items := []CatalogBrand{}
 	for rows.Next() {
-		var i AccountBase
+		var i CatalogBrand
 		if err := rows.Scan(
 			&i.ID,
 			&i.Code,
-			&i.Type,
-			&i.Status,
-			&i.Phone,
-			&i.Email,
-			&i.Username,
-			&i.Password,
-			&i.DateCreated,
-			&i.DateUpdated,
+			&i.Name,
+			&i.Description,
 		); err != nil {
 			return nil, err
 		}
@@ -6278,77 +8481,44 @@ func (q *Queries) ListAccountBase(ctx context.Context, arg ListAccountBaseParams
 	return items, nil
 }
 
-const listAccountCartItem = `-- name: ListAccountCartItem :many
-SELECT id, cart_id, sku_id, quantity, date_created, date_updated
-FROM "account"."cart_item"
+const listCatalogCategory = `-- name: ListCatalogCategory :many
+SELECT id, name, description, parent_id
+FROM "catalog"."category"
 WHERE (
     ("id" = ANY($1) OR $1 IS NULL) AND
     ("id" >= $2 OR $2 IS NULL) AND
     ("id" <= $3 OR $3 IS NULL) AND
-    ("cart_id" = ANY($4) OR $4 IS NULL) AND
-    ("cart_id" >= $5 OR $5 IS NULL) AND
-    ("cart_id" <= $6 OR $6 IS NULL) AND
-    ("sku_id" = ANY($7) OR $7 IS NULL) AND
-    ("sku_id" >= $8 OR $8 IS NULL) AND
-    ("sku_id" <= $9 OR $9 IS NULL) AND
-    ("quantity" = ANY($10) OR $10 IS NULL) AND
-    ("quantity" >= $11 OR $11 IS NULL) AND
-    ("quantity" <= $12 OR $12 IS NULL) AND
-    ("date_created" = ANY($13) OR $13 IS NULL) AND
-    ("date_created" >= $14 OR $14 IS NULL) AND
-    ("date_created" <= $15 OR $15 IS NULL) AND
-    ("date_updated" = ANY($16) OR $16 IS NULL) AND
-    ("date_updated" >= $17 OR $17 IS NULL) AND
-    ("date_updated" <= $18 OR $18 IS NULL)
+    ("name" = ANY($4) OR $4 IS NULL) AND
+    ("parent_id" = ANY($5) OR $5 IS NULL) AND
+    ("parent_id" >= $6 OR $6 IS NULL) AND
+    ("parent_id" <= $7 OR $7 IS NULL)
 )
 ORDER BY "id"
-LIMIT $20
-OFFSET $19
+LIMIT $9
+OFFSET $8
 `
 
-type ListAccountCartItemParams struct {
-	ID              []int64              `json:"id"`
-	IDFrom          pgtype.Int8          `json:"id_from"`
-	IDTo            pgtype.Int8          `json:"id_to"`
-	CartID          []int64              `json:"cart_id"`
-	CartIDFrom      pgtype.Int8          `json:"cart_id_from"`
-	CartIDTo        pgtype.Int8          `json:"cart_id_to"`
-	SkuID           []int64              `json:"sku_id"`
-	SkuIDFrom       pgtype.Int8          `json:"sku_id_from"`
-	SkuIDTo         pgtype.Int8          `json:"sku_id_to"`
-	Quantity        []int64              `json:"quantity"`
-	QuantityFrom    pgtype.Int8          `json:"quantity_from"`
-	QuantityTo      pgtype.Int8          `json:"quantity_to"`
-	DateCreated     []pgtype.Timestamptz `json:"date_created"`
-	DateCreatedFrom pgtype.Timestamptz   `json:"date_created_from"`
-	DateCreatedTo   pgtype.Timestamptz   `json:"date_created_to"`
-	DateUpdated     []pgtype.Timestamptz `json:"date_updated"`
-	DateUpdatedFrom pgtype.Timestamptz   `json:"date_updated_from"`
-	DateUpdatedTo   pgtype.Timestamptz   `json:"date_updated_to"`
-	Offset          pgtype.Int4          `json:"offset"`
-	Limit           pgtype.Int4          `json:"limit"`
+type ListCatalogCategoryParams struct {
+	ID           []int64       `json:"id"`
+	IDFrom       pgtype.Int8   `json:"id_from"`
+	IDTo         pgtype.Int8   `json:"id_to"`
+	Name         []string      `json:"name"`
+	ParentID     []pgtype.Int8 `json:"parent_id"`
+	ParentIDFrom pgtype.Int8   `json:"parent_id_from"`
+	ParentIDTo   pgtype.Int8   `json:"parent_id_to"`
+	Offset       pgtype.Int4   `json:"offset"`
+	Limit        pgtype.Int4   `json:"limit"`
 }
 
-func (q *Queries) ListAccountCartItem(ctx context.Context, arg ListAccountCartItemParams) ([]AccountCartItem, error) {
-	rows, err := q.db.Query(ctx, listAccountCartItem,
+func (q *Queries) ListCatalogCategory(ctx context.Context, arg ListCatalogCategoryParams) ([]CatalogCategory, error) {
+	rows, err := q.db.Query(ctx, listCatalogCategory,
 		arg.ID,
 		arg.IDFrom,
 		arg.IDTo,
-		arg.CartID,
-		arg.CartIDFrom,
-		arg.CartIDTo,
-		arg.SkuID,
-		arg.SkuIDFrom,
-		arg.SkuIDTo,
-		arg.Quantity,
-		arg.QuantityFrom,
-		arg.QuantityTo,
-		arg.DateCreated,
-		arg.DateCreatedFrom,
-		arg.DateCreatedTo,
-		arg.DateUpdated,
-		arg.DateUpdatedFrom,
-		arg.DateUpdatedTo,
+		arg.Name,
+		arg.ParentID,
+		arg.ParentIDFrom,
+		arg.ParentIDTo,
 		arg.Offset,
 		arg.Limit,
 	)
@@ -6356,16 +8526,14 @@ func (q *Queries) ListAccountCartItem(ctx context.Context, arg ListAccountCartIt
 		return nil, err
 	}
 	defer rows.Close()
-	items := []AccountCartItem{}
+	items := []CatalogCategory{}
 	for rows.Next() {
-		var i AccountCartItem
+		var i CatalogCategory
 		if err := rows.Scan(
 			&i.ID,
-			&i.CartID,
-			&i.SkuID,
-			&i.Quantity,
-			&i.DateCreated,
-			&i.DateUpdated,
+			&i.Name,
+			&i.Description,
+			&i.ParentID,
 		); err != nil {
 			return nil, err
 		}
@@ -6377,53 +8545,95 @@ func (q *Queries) ListAccountCartItem(ctx context.Context, arg ListAccountCartIt
 	return items, nil
 }
 
-const listAccountCustomer = `-- name: ListAccountCustomer :many
-SELECT id, default_address_id, date_created, date_updated
-FROM "account"."customer"
+const listCatalogComment = `-- name: ListCatalogComment :many
+SELECT id, code, account_id, ref_type, ref_id, body, upvote, downvote, score, date_created, date_updated
+FROM "catalog"."comment"
 WHERE (
     ("id" = ANY($1) OR $1 IS NULL) AND
     ("id" >= $2 OR $2 IS NULL) AND
     ("id" <= $3 OR $3 IS NULL) AND
-    ("default_address_id" = ANY($4) OR $4 IS NULL) AND
-    ("default_address_id" >= $5 OR $5 IS NULL) AND
-    ("default_address_id" <= $6 OR $6 IS NULL) AND
-    ("date_created" = ANY($7) OR $7 IS NULL) AND
-    ("date_created" >= $8 OR $8 IS NULL) AND
-    ("date_created" <= $9 OR $9 IS NULL) AND
-    ("date_updated" = ANY($10) OR $10 IS NULL) AND
-    ("date_updated" >= $11 OR $11 IS NULL) AND
-    ("date_updated" <= $12 OR $12 IS NULL)
+    ("code" = ANY($4) OR $4 IS NULL) AND
+    ("account_id" = ANY($5) OR $5 IS NULL) AND
+    ("account_id" >= $6 OR $6 IS NULL) AND
+    ("account_id" <= $7 OR $7 IS NULL) AND
+    ("ref_type" = ANY($8) OR $8 IS NULL) AND
+    ("ref_id" = ANY($9) OR $9 IS NULL) AND
+    ("ref_id" >= $10 OR $10 IS NULL) AND
+    ("ref_id" <= $11 OR $11 IS NULL) AND
+    ("upvote" = ANY($12) OR $12 IS NULL) AND
+    ("upvote" >= $13 OR $13 IS NULL) AND
+    ("upvote" <= $14 OR $14 IS NULL) AND
+    ("downvote" = ANY($15) OR $15 IS NULL) AND
+    ("downvote" >= $16 OR $16 IS NULL) AND
+    ("downvote" <= $17 OR $17 IS NULL) AND
+    ("score" = ANY($18) OR $18 IS NULL) AND
+    ("score" >= $19 OR $19 IS NULL) AND
+    ("score" <= $20 OR $20 IS NULL) AND
+    ("date_created" = ANY($21) OR $21 IS NULL) AND
+    ("date_created" >= $22 OR $22 IS NULL) AND
+    ("date_created" <= $23 OR $23 IS NULL) AND
+    ("date_updated" = ANY($24) OR $24 IS NULL) AND
+    ("date_updated" >= $25 OR $25 IS NULL) AND
+    ("date_updated" <= $26 OR $26 IS NULL)
 )
 ORDER BY "id"
-LIMIT $14
-OFFSET $13
+LIMIT $28
+OFFSET $27
 `
 
-type ListAccountCustomerParams struct {
-	ID                   []int64              `json:"id"`
-	IDFrom               pgtype.Int8          `json:"id_from"`
-	IDTo                 pgtype.Int8          `json:"id_to"`
-	DefaultAddressID     []pgtype.Int8        `json:"default_address_id"`
-	DefaultAddressIDFrom pgtype.Int8          `json:"default_address_id_from"`
-	DefaultAddressIDTo   pgtype.Int8          `json:"default_address_id_to"`
-	DateCreated          []pgtype.Timestamptz `json:"date_created"`
-	DateCreatedFrom      pgtype.Timestamptz   `json:"date_created_from"`
-	DateCreatedTo        pgtype.Timestamptz   `json:"date_created_to"`
-	DateUpdated          []pgtype.Timestamptz `json:"date_updated"`
-	DateUpdatedFrom      pgtype.Timestamptz   `json:"date_updated_from"`
-	DateUpdatedTo        pgtype.Timestamptz   `json:"date_updated_to"`
-	Offset               pgtype.Int4          `json:"offset"`
-	Limit                pgtype.Int4          `json:"limit"`
+type ListCatalogCommentParams struct {
+	ID              []int64                 `json:"id"`
+	IDFrom          pgtype.Int8             `json:"id_from"`
+	IDTo            pgtype.Int8             `json:"id_to"`
+	Code            []string                `json:"code"`
+	AccountID       []int64                 `json:"account_id"`
+	AccountIDFrom   pgtype.Int8             `json:"account_id_from"`
+	AccountIDTo     pgtype.Int8             `json:"account_id_to"`
+	RefType         []CatalogCommentRefType `json:"ref_type"`
+	RefID           []int64                 `json:"ref_id"`
+	RefIDFrom       pgtype.Int8             `json:"ref_id_from"`
+	RefIDTo         pgtype.Int8             `json:"ref_id_to"`
+	Upvote          []int64                 `json:"upvote"`
+	UpvoteFrom      pgtype.Int8             `json:"upvote_from"`
+	UpvoteTo        pgtype.Int8             `json:"upvote_to"`
+	Downvote        []int64                 `json:"downvote"`
+	DownvoteFrom    pgtype.Int8             `json:"downvote_from"`
+	DownvoteTo      pgtype.Int8             `json:"downvote_to"`
+	Score           []int32                 `json:"score"`
+	ScoreFrom       pgtype.Int4             `json:"score_from"`
+	ScoreTo         pgtype.Int4             `json:"score_to"`
+	DateCreated     []pgtype.Timestamptz    `json:"date_created"`
+	DateCreatedFrom pgtype.Timestamptz      `json:"date_created_from"`
+	DateCreatedTo   pgtype.Timestamptz      `json:"date_created_to"`
+	DateUpdated     []pgtype.Timestamptz    `json:"date_updated"`
+	DateUpdatedFrom pgtype.Timestamptz      `json:"date_updated_from"`
+	DateUpdatedTo   pgtype.Timestamptz      `json:"date_updated_to"`
+	Offset          pgtype.Int4             `json:"offset"`
+	Limit           pgtype.Int4             `json:"limit"`
 }
 
-func (q *Queries) ListAccountCustomer(ctx context.Context, arg ListAccountCustomerParams) ([]AccountCustomer, error) {
-	rows, err := q.db.Query(ctx, listAccountCustomer,
+func (q *Queries) ListCatalogComment(ctx context.Context, arg ListCatalogCommentParams) ([]CatalogComment, error) {
+	rows, err := q.db.Query(ctx, listCatalogComment,
 		arg.ID,
 		arg.IDFrom,
 		arg.IDTo,
-		arg.DefaultAddressID,
-		arg.DefaultAddressIDFrom,
-		arg.DefaultAddressIDTo,
+		arg.Code,
+		arg.AccountID,
+		arg.AccountIDFrom,
+		arg.AccountIDTo,
+		arg.RefType,
+		arg.RefID,
+		arg.RefIDFrom,
+		arg.RefIDTo,
+		arg.Upvote,
+		arg.UpvoteFrom,
+		arg.UpvoteTo,
+		arg.Downvote,
+		arg.DownvoteFrom,
+		arg.DownvoteTo,
+		arg.Score,
+		arg.ScoreFrom,
+		arg.ScoreTo,
 		arg.DateCreated,
 		arg.DateCreatedFrom,
 		arg.DateCreatedTo,
@@ -6437,12 +8647,19 @@ func (q *Queries) ListAccountCustomer(ctx context.Context, arg ListAccountCustom
 		return nil, err
 	}
 	defer rows.Close()
-	items := []AccountCustomer{}
+	items := []CatalogComment{}
 	for rows.Next() {
-		var i AccountCustomer
+		var i CatalogComment
 		if err := rows.Scan(
 			&i.ID,
-			&i.DefaultAddressID,
+			&i.Code,
+			&i.AccountID,
+			&i.RefType,
+			&i.RefID,
+			&i.Body,
+			&i.Upvote,
+			&i.Downvote,
+			&i.Score,
 			&i.DateCreated,
 			&i.DateUpdated,
 		); err != nil {
@@ -6456,80 +8673,74 @@ func (q *Queries) ListAccountCustomer(ctx context.Context, arg ListAccountCustom
 	return items, nil
 }
 
-const listAccountIncomeHistory = `-- name: ListAccountIncomeHistory :many
-SELECT id, account_id, type, income, current_balance, note, date_created, hash, prev_hash
-FROM "account"."income_history"
+const listCatalogProductSku = `-- name: ListCatalogProductSku :many
+SELECT id, code, spu_id, price, can_combine, date_created, date_deleted
+FROM "catalog"."product_sku"
 WHERE (
     ("id" = ANY($1) OR $1 IS NULL) AND
     ("id" >= $2 OR $2 IS NULL) AND
     ("id" <= $3 OR $3 IS NULL) AND
-    ("account_id" = ANY($4) OR $4 IS NULL) AND
-    ("account_id" >= $5 OR $5 IS NULL) AND
-    ("account_id" <= $6 OR $6 IS NULL) AND
-    ("type" = ANY($7) OR $7 IS NULL) AND
-    ("income" = ANY($8) OR $8 IS NULL) AND
-    ("income" >= $9 OR $9 IS NULL) AND
-    ("income" <= $10 OR $10 IS NULL) AND
-    ("current_balance" = ANY($11) OR $11 IS NULL) AND
-    ("current_balance" >= $12 OR $12 IS NULL) AND
-    ("current_balance" <= $13 OR $13 IS NULL) AND
-    ("note" = ANY($14) OR $14 IS NULL) AND
-    ("date_created" = ANY($15) OR $15 IS NULL) AND
-    ("date_created" >= $16 OR $16 IS NULL) AND
-    ("date_created" <= $17 OR $17 IS NULL) AND
-    ("hash" = ANY($18) OR $18 IS NULL) AND
-    ("prev_hash" = ANY($19) OR $19 IS NULL)
+    ("code" = ANY($4) OR $4 IS NULL) AND
+    ("spu_id" = ANY($5) OR $5 IS NULL) AND
+    ("spu_id" >= $6 OR $6 IS NULL) AND
+    ("spu_id" <= $7 OR $7 IS NULL) AND
+    ("price" = ANY($8) OR $8 IS NULL) AND
+    ("price" >= $9 OR $9 IS NULL) AND
+    ("price" <= $10 OR $10 IS NULL) AND
+    ("can_combine" = ANY($11) OR $11 IS NULL) AND
+    ("date_created" = ANY($12) OR $12 IS NULL) AND
+    ("date_created" >= $13 OR $13 IS NULL) AND
+    ("date_created" <= $14 OR $14 IS NULL) AND
+    ("date_deleted" = ANY($15) OR $15 IS NULL) AND
+    ("date_deleted" >= $16 OR $16 IS NULL) AND
+    ("date_deleted" <= $17 OR $17 IS NULL)
 )
 ORDER BY "id"
-LIMIT $21
-OFFSET $20
+LIMIT $19
+OFFSET $18
 `
 
-type ListAccountIncomeHistoryParams struct {
-	ID                 []int64              `json:"id"`
-	IDFrom             pgtype.Int8          `json:"id_from"`
-	IDTo               pgtype.Int8          `json:"id_to"`
-	AccountID          []int64              `json:"account_id"`
-	AccountIDFrom      pgtype.Int8          `json:"account_id_from"`
-	AccountIDTo        pgtype.Int8          `json:"account_id_to"`
-	Type               []string             `json:"type"`
-	Income             []int64              `json:"income"`
-	IncomeFrom         pgtype.Int8          `json:"income_from"`
-	IncomeTo           pgtype.Int8          `json:"income_to"`
-	CurrentBalance     []int64              `json:"current_balance"`
-	CurrentBalanceFrom pgtype.Int8          `json:"current_balance_from"`
-	CurrentBalanceTo   pgtype.Int8          `json:"current_balance_to"`
-	Note               []pgtype.Text        `json:"note"`
-	DateCreated        []pgtype.Timestamptz `json:"date_created"`
-	DateCreatedFrom    pgtype.Timestamptz   `json:"date_created_from"`
-	DateCreatedTo      pgtype.Timestamptz   `json:"date_created_to"`
-	Hash               [][]byte             `json:"hash"`
-	PrevHash           [][]byte             `json:"prev_hash"`
-	Offset             pgtype.Int4          `json:"offset"`
-	Limit              pgtype.Int4          `json:"limit"`
+type ListCatalogProductSkuParams struct {
+	ID              []int64              `json:"id"`
+	IDFrom          pgtype.Int8          `json:"id_from"`
+	IDTo            pgtype.Int8          `json:"id_to"`
+	Code            []string             `json:"code"`
+	SpuID           []int64              `json:"spu_id"`
+	SpuIDFrom       pgtype.Int8          `json:"spu_id_from"`
+	SpuIDTo         pgtype.Int8          `json:"spu_id_to"`
+	Price           []int64              `json:"price"`
+	PriceFrom       pgtype.Int8          `json:"price_from"`
+	PriceTo         pgtype.Int8          `json:"price_to"`
+	CanCombine      []bool               `json:"can_combine"`
+	DateCreated     []pgtype.Timestamptz `json:"date_created"`
+	DateCreatedFrom pgtype.Timestamptz   `json:"date_created_from"`
+	DateCreatedTo   pgtype.Timestamptz   `json:"date_created_to"`
+	DateDeleted     []pgtype.Timestamptz `json:"date_deleted"`
+	DateDeletedFrom pgtype.Timestamptz   `json:"date_deleted_from"`
+	DateDeletedTo   pgtype.Timestamptz   `json:"date_deleted_to"`
+	Offset          pgtype.Int4          `json:"offset"`
+	Limit           pgtype.Int4          `json:"limit"`
 }
 
-func (q *Queries) ListAccountIncomeHistory(ctx context.Context, arg ListAccountIncomeHistoryParams) ([]AccountIncomeHistory, error) {
-	rows, err := q.db.Query(ctx, listAccountIncomeHistory,
+func (q *Queries) ListCatalogProductSku(ctx context.Context, arg ListCatalogProductSkuParams) ([]CatalogProductSku, error) {
+	rows, err := q.db.Query(ctx, listCatalogProductSku,
 		arg.ID,
 		arg.IDFrom,
 		arg.IDTo,
-		arg.AccountID,
-		arg.AccountIDFrom,
-		arg.AccountIDTo,
-		arg.Type,
-		arg.Income,
-		arg.IncomeFrom,
-		arg.IncomeTo,
-		arg.CurrentBalance,
-		arg.CurrentBalanceFrom,
-		arg.CurrentBalanceTo,
-		arg.Note,
+		arg.Code,
+		arg.SpuID,
+		arg.SpuIDFrom,
+		arg.SpuIDTo,
+		arg.Price,
+		arg.PriceFrom,
+		arg.PriceTo,
+		arg.CanCombine,
 		arg.DateCreated,
 		arg.DateCreatedFrom,
 		arg.DateCreatedTo,
-		arg.Hash,
-		arg.PrevHash,
+		arg.DateDeleted,
+		arg.DateDeletedFrom,
+		arg.DateDeletedTo,
 		arg.Offset,
 		arg.Limit,
 	)
@@ -6537,19 +8748,17 @@ func (q *Queries) ListAccountIncomeHistory(ctx context.Context, arg ListAccountI
 		return nil, err
 	}
 	defer rows.Close()
-	items := []AccountIncomeHistory{}
+	items := []CatalogProductSku{}
 	for rows.Next() {
-		var i AccountIncomeHistory
+		var i CatalogProductSku
 		if err := rows.Scan(
 			&i.ID,
-			&i.AccountID,
-			&i.Type,
-			&i.Income,
-			&i.CurrentBalance,
-			&i.Note,
+			&i.Code,
+			&i.SpuID,
+			&i.Price,
+			&i.CanCombine,
 			&i.DateCreated,
-			&i.Hash,
-			&i.PrevHash,
+			&i.DateDeleted,
 		); err != nil {
 			return nil, err
 		}
@@ -6561,86 +8770,68 @@ func (q *Queries) ListAccountIncomeHistory(ctx context.Context, arg ListAccountI
 	return items, nil
 }
 
-const listAccountNotification = `-- name: ListAccountNotification :many
-SELECT id, account_id, type, channel, is_read, content, date_created, date_updated, date_sent, date_scheduled
-FROM "account"."notification"
+const listCatalogProductSkuAttribute = `-- name: ListCatalogProductSkuAttribute :many
+SELECT id, code, sku_id, name, value, date_created, date_updated
+FROM "catalog"."product_sku_attribute"
 WHERE (
     ("id" = ANY($1) OR $1 IS NULL) AND
     ("id" >= $2 OR $2 IS NULL) AND
     ("id" <= $3 OR $3 IS NULL) AND
-    ("account_id" = ANY($4) OR $4 IS NULL) AND
-    ("account_id" >= $5 OR $5 IS NULL) AND
-    ("account_id" <= $6 OR $6 IS NULL) AND
-    ("type" = ANY($7) OR $7 IS NULL) AND
-    ("channel" = ANY($8) OR $8 IS NULL) AND
-    ("is_read" = ANY($9) OR $9 IS NULL) AND
+    ("code" = ANY($4) OR $4 IS NULL) AND
+    ("sku_id" = ANY($5) OR $5 IS NULL) AND
+    ("sku_id" >= $6 OR $6 IS NULL) AND
+    ("sku_id" <= $7 OR $7 IS NULL) AND
+    ("name" = ANY($8) OR $8 IS NULL) AND
+    ("value" = ANY($9) OR $9 IS NULL) AND
     ("date_created" = ANY($10) OR $10 IS NULL) AND
     ("date_created" >= $11 OR $11 IS NULL) AND
     ("date_created" <= $12 OR $12 IS NULL) AND
     ("date_updated" = ANY($13) OR $13 IS NULL) AND
     ("date_updated" >= $14 OR $14 IS NULL) AND
-    ("date_updated" <= $15 OR $15 IS NULL) AND
-    ("date_sent" = ANY($16) OR $16 IS NULL) AND
-    ("date_sent" >= $17 OR $17 IS NULL) AND
-    ("date_sent" <= $18 OR $18 IS NULL) AND
-    ("date_scheduled" = ANY($19) OR $19 IS NULL) AND
-    ("date_scheduled" >= $20 OR $20 IS NULL) AND
-    ("date_scheduled" <= $21 OR $21 IS NULL)
+    ("date_updated" <= $15 OR $15 IS NULL)
 )
 ORDER BY "id"
-LIMIT $23
-OFFSET $22
+LIMIT $17
+OFFSET $16
 `
 
-type ListAccountNotificationParams struct {
-	ID                []int64              `json:"id"`
-	IDFrom            pgtype.Int8          `json:"id_from"`
-	IDTo              pgtype.Int8          `json:"id_to"`
-	AccountID         []int64              `json:"account_id"`
-	AccountIDFrom     pgtype.Int8          `json:"account_id_from"`
-	AccountIDTo       pgtype.Int8          `json:"account_id_to"`
-	Type              []string             `json:"type"`
-	Channel           []string             `json:"channel"`
-	IsRead            []bool               `json:"is_read"`
-	DateCreated       []pgtype.Timestamptz `json:"date_created"`
-	DateCreatedFrom   pgtype.Timestamptz   `json:"date_created_from"`
-	DateCreatedTo     pgtype.Timestamptz   `json:"date_created_to"`
-	DateUpdated       []pgtype.Timestamptz `json:"date_updated"`
-	DateUpdatedFrom   pgtype.Timestamptz   `json:"date_updated_from"`
-	DateUpdatedTo     pgtype.Timestamptz   `json:"date_updated_to"`
-	DateSent          []pgtype.Timestamptz `json:"date_sent"`
-	DateSentFrom      pgtype.Timestamptz   `json:"date_sent_from"`
-	DateSentTo        pgtype.Timestamptz   `json:"date_sent_to"`
-	DateScheduled     []pgtype.Timestamptz `json:"date_scheduled"`
-	DateScheduledFrom pgtype.Timestamptz   `json:"date_scheduled_from"`
-	DateScheduledTo   pgtype.Timestamptz   `json:"date_scheduled_to"`
-	Offset            pgtype.Int4          `json:"offset"`
-	Limit             pgtype.Int4          `json:"limit"`
+type ListCatalogProductSkuAttributeParams struct {
+	ID              []int64              `json:"id"`
+	IDFrom          pgtype.Int8          `json:"id_from"`
+	IDTo            pgtype.Int8          `json:"id_to"`
+	Code            []string             `json:"code"`
+	SkuID           []int64              `json:"sku_id"`
+	SkuIDFrom       pgtype.Int8          `json:"sku_id_from"`
+	SkuIDTo         pgtype.Int8          `json:"sku_id_to"`
+	Name            []string             `json:"name"`
+	Value           []string             `json:"value"`
+	DateCreated     []pgtype.Timestamptz `json:"date_created"`
+	DateCreatedFrom pgtype.Timestamptz   `json:"date_created_from"`
+	DateCreatedTo   pgtype.Timestamptz   `json:"date_created_to"`
+	DateUpdated     []pgtype.Timestamptz `json:"date_updated"`
+	DateUpdatedFrom pgtype.Timestamptz   `json:"date_updated_from"`
+	DateUpdatedTo   pgtype.Timestamptz   `json:"date_updated_to"`
+	Offset          pgtype.Int4          `json:"offset"`
+	Limit           pgtype.Int4          `json:"limit"`
 }
 
-func (q *Queries) ListAccountNotification(ctx context.Context, arg ListAccountNotificationParams) ([]AccountNotification, error) {
-	rows, err := q.db.Query(ctx, listAccountNotification,
+func (q *Queries) ListCatalogProductSkuAttribute(ctx context.Context, arg ListCatalogProductSkuAttributeParams) ([]CatalogProductSkuAttribute, error) {
+	rows, err := q.db.Query(ctx, listCatalogProductSkuAttribute,
 		arg.ID,
 		arg.IDFrom,
 		arg.IDTo,
-		arg.AccountID,
-		arg.AccountIDFrom,
-		arg.AccountIDTo,
-		arg.Type,
-		arg.Channel,
-		arg.IsRead,
+		arg.Code,
+		arg.SkuID,
+		arg.SkuIDFrom,
+		arg.SkuIDTo,
+		arg.Name,
+		arg.Value,
 		arg.DateCreated,
 		arg.DateCreatedFrom,
 		arg.DateCreatedTo,
 		arg.DateUpdated,
 		arg.DateUpdatedFrom,
 		arg.DateUpdatedTo,
-		arg.DateSent,
-		arg.DateSentFrom,
-		arg.DateSentTo,
-		arg.DateScheduled,
-		arg.DateScheduledFrom,
-		arg.DateScheduledTo,
 		arg.Offset,
 		arg.Limit,
 	)
@@ -6648,20 +8839,17 @@ func (q *Queries) ListAccountNotification(ctx context.Context, arg ListAccountNo
 		return nil, err
 	}
 	defer rows.Close()
-	items := []AccountNotification{}
+	items := []CatalogProductSkuAttribute{}
 	for rows.Next() {
-		var i AccountNotification
+		var i CatalogProductSkuAttribute
 		if err := rows.Scan(
 			&i.ID,
-			&i.AccountID,
-			&i.Type,
-			&i.Channel,
-			&i.IsRead,
-			&i.Content,
+			&i.Code,
+			&i.SkuID,
+			&i.Name,
+			&i.Value,
 			&i.DateCreated,
 			&i.DateUpdated,
-			&i.DateSent,
-			&i.DateScheduled,
 		); err != nil {
 			return nil, err
 		}
@@ -6673,80 +8861,101 @@ func (q *Queries) ListAccountNotification(ctx context.Context, arg ListAccountNo
 	return items, nil
 }
 
-const listAccountProfile = `-- name: ListAccountProfile :many
-SELECT id, gender, name, date_of_birth, avatar_rs_id, email_verified, phone_verified, date_created, date_updated
-FROM "account"."profile"
+const listCatalogProductSpu = `-- name: ListCatalogProductSpu :many
+SELECT id, code, account_id, category_id, brand_id, name, description, is_active, date_manufactured, date_created, date_updated, date_deleted
+FROM "catalog"."product_spu"
 WHERE (
     ("id" = ANY($1) OR $1 IS NULL) AND
     ("id" >= $2 OR $2 IS NULL) AND
     ("id" <= $3 OR $3 IS NULL) AND
-    ("gender" = ANY($4) OR $4 IS NULL) AND
-    ("name" = ANY($5) OR $5 IS NULL) AND
-    ("date_of_birth" = ANY($6) OR $6 IS NULL) AND
-    ("date_of_birth" >= $7 OR $7 IS NULL) AND
-    ("date_of_birth" <= $8 OR $8 IS NULL) AND
-    ("avatar_rs_id" = ANY($9) OR $9 IS NULL) AND
-    ("avatar_rs_id" >= $10 OR $10 IS NULL) AND
-    ("avatar_rs_id" <= $11 OR $11 IS NULL) AND
-    ("email_verified" = ANY($12) OR $12 IS NULL) AND
-    ("phone_verified" = ANY($13) OR $13 IS NULL) AND
-    ("date_created" = ANY($14) OR $14 IS NULL) AND
-    ("date_created" >= $15 OR $15 IS NULL) AND
-    ("date_created" <= $16 OR $16 IS NULL) AND
-    ("date_updated" = ANY($17) OR $17 IS NULL) AND
-    ("date_updated" >= $18 OR $18 IS NULL) AND
-    ("date_updated" <= $19 OR $19 IS NULL)
+    ("code" = ANY($4) OR $4 IS NULL) AND
+    ("account_id" = ANY($5) OR $5 IS NULL) AND
+    ("account_id" >= $6 OR $6 IS NULL) AND
+    ("account_id" <= $7 OR $7 IS NULL) AND
+    ("category_id" = ANY($8) OR $8 IS NULL) AND
+    ("category_id" >= $9 OR $9 IS NULL) AND
+    ("category_id" <= $10 OR $10 IS NULL) AND
+    ("brand_id" = ANY($11) OR $11 IS NULL) AND
+    ("brand_id" >= $12 OR $12 IS NULL) AND
+    ("brand_id" <= $13 OR $13 IS NULL) AND
+    ("is_active" = ANY($14) OR $14 IS NULL) AND
+    ("date_manufactured" = ANY($15) OR $15 IS NULL) AND
+    ("date_manufactured" >= $16 OR $16 IS NULL) AND
+    ("date_manufactured" <= $17 OR $17 IS NULL) AND
+    ("date_created" = ANY($18) OR $18 IS NULL) AND
+    ("date_created" >= $19 OR $19 IS NULL) AND
+    ("date_created" <= $20 OR $20 IS NULL) AND
+    ("date_updated" = ANY($21) OR $21 IS NULL) AND
+    ("date_updated" >= $22 OR $22 IS NULL) AND
+    ("date_updated" <= $23 OR $23 IS NULL) AND
+    ("date_deleted" = ANY($24) OR $24 IS NULL) AND
+    ("date_deleted" >= $25 OR $25 IS NULL) AND
+    ("date_deleted" <= $26 OR $26 IS NULL)
 )
 ORDER BY "id"
-LIMIT $21
-OFFSET $20
+LIMIT $28
+OFFSET $27
 `
 
-type ListAccountProfileParams struct {
-	ID              []int64              `json:"id"`
-	IDFrom          pgtype.Int8          `json:"id_from"`
-	IDTo            pgtype.Int8          `json:"id_to"`
-	Gender          []NullAccountGender  `json:"gender"`
-	Name            []pgtype.Text        `json:"name"`
-	DateOfBirth     []pgtype.Date        `json:"date_of_birth"`
-	DateOfBirthFrom pgtype.Date          `json:"date_of_birth_from"`
-	DateOfBirthTo   pgtype.Date          `json:"date_of_birth_to"`
-	AvatarRsID      []pgtype.Int8        `json:"avatar_rs_id"`
-	AvatarRsIDFrom  pgtype.Int8          `json:"avatar_rs_id_from"`
-	AvatarRsIDTo    pgtype.Int8          `json:"avatar_rs_id_to"`
-	EmailVerified   []bool               `json:"email_verified"`
-	PhoneVerified   []bool               `json:"phone_verified"`
-	DateCreated     []pgtype.Timestamptz `json:"date_created"`
-	DateCreatedFrom pgtype.Timestamptz   `json:"date_created_from"`
-	DateCreatedTo   pgtype.Timestamptz   `json:"date_created_to"`
-	DateUpdated     []pgtype.Timestamptz `json:"date_updated"`
-	DateUpdatedFrom pgtype.Timestamptz   `json:"date_updated_from"`
-	DateUpdatedTo   pgtype.Timestamptz   `json:"date_updated_to"`
-	Offset          pgtype.Int4          `json:"offset"`
-	Limit           pgtype.Int4          `json:"limit"`
+type ListCatalogProductSpuParams struct {
+	ID                   []int64              `json:"id"`
+	IDFrom               pgtype.Int8          `json:"id_from"`
+	IDTo                 pgtype.Int8          `json:"id_to"`
+	Code                 []string             `json:"code"`
+	AccountID            []int64              `json:"account_id"`
+	AccountIDFrom        pgtype.Int8          `json:"account_id_from"`
+	AccountIDTo          pgtype.Int8          `json:"account_id_to"`
+	CategoryID           []int64              `json:"category_id"`
+	CategoryIDFrom       pgtype.Int8          `json:"category_id_from"`
+	CategoryIDTo         pgtype.Int8          `json:"category_id_to"`
+	BrandID              []int64              `json:"brand_id"`
+	BrandIDFrom          pgtype.Int8          `json:"brand_id_from"`
+	BrandIDTo            pgtype.Int8          `json:"brand_id_to"`
+	IsActive             []bool               `json:"is_active"`
+	DateManufactured     []pgtype.Timestamptz `json:"date_manufactured"`
+	DateManufacturedFrom pgtype.Timestamptz   `json:"date_manufactured_from"`
+	DateManufacturedTo   pgtype.Timestamptz   `json:"date_manufactured_to"`
+	DateCreated          []pgtype.Timestamptz `json:"date_created"`
+	DateCreatedFrom      pgtype.Timestamptz   `json:"date_created_from"`
+	DateCreatedTo        pgtype.Timestamptz   `json:"date_created_to"`
+	DateUpdated          []pgtype.Timestamptz `json:"date_updated"`
+	DateUpdatedFrom      pgtype.Timestamptz   `json:"date_updated_from"`
+	DateUpdatedTo        pgtype.Timestamptz   `json:"date_updated_to"`
+	DateDeleted          []pgtype.Timestamptz `json:"date_deleted"`
+	DateDeletedFrom      pgtype.Timestamptz   `json:"date_deleted_from"`
+	DateDeletedTo        pgtype.Timestamptz   `json:"date_deleted_to"`
+	Offset               pgtype.Int4          `json:"offset"`
+	Limit                pgtype.Int4          `json:"limit"`
 }
 
-func (q *Queries) ListAccountProfile(ctx context.Context, arg ListAccountProfileParams) ([]AccountProfile, error) {
-	rows, err := q.db.Query(ctx, listAccountProfile,
+func (q *Queries) ListCatalogProductSpu(ctx context.Context, arg ListCatalogProductSpuParams) ([]CatalogProductSpu, error) {
+	rows, err := q.db.Query(ctx, listCatalogProductSpu,
 		arg.ID,
 		arg.IDFrom,
 		arg.IDTo,
-		arg.Gender,
-		arg.Name,
-		arg.DateOfBirth,
-		arg.DateOfBirthFrom,
-		arg.DateOfBirthTo,
-		arg.AvatarRsID,
-		arg.AvatarRsIDFrom,
-		arg.AvatarRsIDTo,
-		arg.EmailVerified,
-		arg.PhoneVerified,
+		arg.Code,
+		arg.AccountID,
+		arg.AccountIDFrom,
+		arg.AccountIDTo,
+		arg.CategoryID,
+		arg.CategoryIDFrom,
+		arg.CategoryIDTo,
+		arg.BrandID,
+		arg.BrandIDFrom,
+		arg.BrandIDTo,
+		arg.IsActive,
+		arg.DateManufactured,
+		arg.DateManufacturedFrom,
+		arg.DateManufacturedTo,
 		arg.DateCreated,
 		arg.DateCreatedFrom,
 		arg.DateCreatedTo,
 		arg.DateUpdated,
 		arg.DateUpdatedFrom,
 		arg.DateUpdatedTo,
+		arg.DateDeleted,
+		arg.DateDeletedFrom,
+		arg.DateDeletedTo,
 		arg.Offset,
 		arg.Limit,
 	)
@@ -6754,19 +8963,22 @@ func (q *Queries) ListAccountProfile(ctx context.Context, arg ListAccountProfile
 		return nil, err
 	}
 	defer rows.Close()
-	items := []AccountProfile{}
+	items := []CatalogProductSpu{}
 	for rows.Next() {
-		var i AccountProfile
+		var i CatalogProductSpu
 		if err := rows.Scan(
 			&i.ID,
-			&i.Gender,
+			&i.Code,
+			&i.AccountID,
+			&i.CategoryID,
+			&i.BrandID,
 			&i.Name,
-			&i.DateOfBirth,
-			&i.AvatarRsID,
-			&i.EmailVerified,
-			&i.PhoneVerified,
+			&i.Description,
+			&i.IsActive,
+			&i.DateManufactured,
 			&i.DateCreated,
 			&i.DateUpdated,
+			&i.DateDeleted,
 		); err != nil {
 			return nil, err
 		}
@@ -6778,35 +8990,50 @@ func (q *Queries) ListAccountProfile(ctx context.Context, arg ListAccountProfile
 	return items, nil
 }
 
-const listAccountVendor = `-- name: ListAccountVendor :many
-SELECT id, description
-FROM "account"."vendor"
+const listCatalogProductSpuTag = `-- name: ListCatalogProductSpuTag :many
+SELECT id, spu_id, tag_id
+FROM "catalog"."product_spu_tag"
 WHERE (
     ("id" = ANY($1) OR $1 IS NULL) AND
     ("id" >= $2 OR $2 IS NULL) AND
     ("id" <= $3 OR $3 IS NULL) AND
-    ("description" = ANY($4) OR $4 IS NULL)
+    ("spu_id" = ANY($4) OR $4 IS NULL) AND
+    ("spu_id" >= $5 OR $5 IS NULL) AND
+    ("spu_id" <= $6 OR $6 IS NULL) AND
+    ("tag_id" = ANY($7) OR $7 IS NULL) AND
+    ("tag_id" >= $8 OR $8 IS NULL) AND
+    ("tag_id" <= $9 OR $9 IS NULL)
 )
 ORDER BY "id"
-LIMIT $6
-OFFSET $5
+LIMIT $11
+OFFSET $10
 `
 
-type ListAccountVendorParams struct {
-	ID          []int64     `json:"id"`
-	IDFrom      pgtype.Int8 `json:"id_from"`
-	IDTo        pgtype.Int8 `json:"id_to"`
-	Description []string    `json:"description"`
-	Offset      pgtype.Int4 `json:"offset"`
-	Limit       pgtype.Int4 `json:"limit"`
+type ListCatalogProductSpuTagParams struct {
+	ID        []int64     `json:"id"`
+	IDFrom    pgtype.Int8 `json:"id_from"`
+	IDTo      pgtype.Int8 `json:"id_to"`
+	SpuID     []int64     `json:"spu_id"`
+	SpuIDFrom pgtype.Int8 `json:"spu_id_from"`
+	SpuIDTo   pgtype.Int8 `json:"spu_id_to"`
+	TagID     []int64     `json:"tag_id"`
+	TagIDFrom pgtype.Int8 `json:"tag_id_from"`
+	TagIDTo   pgtype.Int8 `json:"tag_id_to"`
+	Offset    pgtype.Int4 `json:"offset"`
+	Limit     pgtype.Int4 `json:"limit"`
 }
 
-func (q *Queries) ListAccountVendor(ctx context.Context, arg ListAccountVendorParams) ([]AccountVendor, error) {
-	rows, err := q.db.Query(ctx, listAccountVendor,
+func (q *Queries) ListCatalogProductSpuTag(ctx context.Context, arg ListCatalogProductSpuTagParams) ([]CatalogProductSpuTag, error) {
+	rows, err := q.db.Query(ctx, listCatalogProductSpuTag,
 		arg.ID,
 		arg.IDFrom,
 		arg.IDTo,
-		arg.Description,
+		arg.SpuID,
+		arg.SpuIDFrom,
+		arg.SpuIDTo,
+		arg.TagID,
+		arg.TagIDFrom,
+		arg.TagIDTo,
 		arg.Offset,
 		arg.Limit,
 	)
@@ -6814,10 +9041,10 @@ func (q *Queries) ListAccountVendor(ctx context.Context, arg ListAccountVendorPa
 		return nil, err
 	}
 	defer rows.Close()
-	items := []AccountVendor{}
+	items := []CatalogProductSpuTag{}
 	for rows.Next() {
-		var i AccountVendor
-		if err := rows.Scan(&i.ID, &i.Description); err != nil {
+		var i CatalogProductSpuTag
+		if err := rows.Scan(&i.ID, &i.SpuID, &i.TagID); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -6828,99 +9055,35 @@ func (q *Queries) ListAccountVendor(ctx context.Context, arg ListAccountVendorPa
 	return items, nil
 }
 
-const listCatalogBrand = `-- name: ListCatalogBrand :many
-SELECT id, code, name, description
-FROM "catalog"."brand"
+const listCatalogTag = `-- name: ListCatalogTag :many
+SELECT id, tag, description
+FROM "catalog"."tag"
 WHERE (
     ("id" = ANY($1) OR $1 IS NULL) AND
     ("id" >= $2 OR $2 IS NULL) AND
     ("id" <= $3 OR $3 IS NULL) AND
-    ("code" = ANY($4) OR $4 IS NULL)
+    ("tag" = ANY($4) OR $4 IS NULL)
 )
 ORDER BY "id"
 LIMIT $6
 OFFSET $5
 `
 
-type ListCatalogBrandParams struct {
+type ListCatalogTagParams struct {
 	ID     []int64     `json:"id"`
 	IDFrom pgtype.Int8 `json:"id_from"`
 	IDTo   pgtype.Int8 `json:"id_to"`
-	Code   []string    `json:"code"`
+	Tag    []string    `json:"tag"`
 	Offset pgtype.Int4 `json:"offset"`
 	Limit  pgtype.Int4 `json:"limit"`
 }
 
-func (q *Queries) ListCatalogBrand(ctx context.Context, arg ListCatalogBrandParams) ([]CatalogBrand, error) {
-	rows, err := q.db.Query(ctx, listCatalogBrand,
-		arg.ID,
-		arg.IDFrom,
-		arg.IDTo,
-		arg.Code,
-		arg.Offset,
-		arg.Limit,
-	)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	items := []CatalogBrand{}
-	for rows.Next() {
-		var i CatalogBrand
-		if err := rows.Scan(
-			&i.ID,
-			&i.Code,
-			&i.Name,
-			&i.Description,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
-const listCatalogCategory = `-- name: ListCatalogCategory :many
-SELECT id, name, description, parent_id
-FROM "catalog"."category"
-WHERE (
-    ("id" = ANY($1) OR $1 IS NULL) AND
-    ("id" >= $2 OR $2 IS NULL) AND
-    ("id" <= $3 OR $3 IS NULL) AND
-    ("name" = ANY($4) OR $4 IS NULL) AND
-    ("parent_id" = ANY($5) OR $5 IS NULL) AND
-    ("parent_id" >= $6 OR $6 IS NULL) AND
-    ("parent_id" <= $7 OR $7 IS NULL)
-)
-ORDER BY "id"
-LIMIT $9
-OFFSET $8
-`
-
-type ListCatalogCategoryParams struct {
-	ID           []int64       `json:"id"`
-	IDFrom       pgtype.Int8   `json:"id_from"`
-	IDTo         pgtype.Int8   `json:"id_to"`
-	Name         []string      `json:"name"`
-	ParentID     []pgtype.Int8 `json:"parent_id"`
-	ParentIDFrom pgtype.Int8   `json:"parent_id_from"`
-	ParentIDTo   pgtype.Int8   `json:"parent_id_to"`
-	Offset       pgtype.Int4   `json:"offset"`
-	Limit        pgtype.Int4   `json:"limit"`
-}
-
-func (q *Queries) ListCatalogCategory(ctx context.Context, arg ListCatalogCategoryParams) ([]CatalogCategory, error) {
-	rows, err := q.db.Query(ctx, listCatalogCategory,
+func (q *Queries) ListCatalogTag(ctx context.Context, arg ListCatalogTagParams) ([]CatalogTag, error) {
+	rows, err := q.db.Query(ctx, listCatalogTag,
 		arg.ID,
 		arg.IDFrom,
 		arg.IDTo,
-		arg.Name,
-		arg.ParentID,
-		arg.ParentIDFrom,
-		arg.ParentIDTo,
+		arg.Tag,
 		arg.Offset,
 		arg.Limit,
 	)
@@ -6928,15 +9091,10 @@ func (q *Queries) ListCatalogCategory(ctx context.Context, arg ListCatalogCatego
 		return nil, err
 	}
 	defer rows.Close()
-	items := []CatalogCategory{}
+	items := []CatalogTag{}
 	for rows.Next() {
-		var i CatalogCategory
-		if err := rows.Scan(
-			&i.ID,
-			&i.Name,
-			&i.Description,
-			&i.ParentID,
-		); err != nil {
+		var i CatalogTag
+		if err := rows.Scan(&i.ID, &i.Tag, &i.Description); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -6947,101 +9105,56 @@ func (q *Queries) ListCatalogCategory(ctx context.Context, arg ListCatalogCatego
 	return items, nil
 }
 
-const listCatalogComment = `-- name: ListCatalogComment :many
-SELECT id, code, account_id, ref_type, ref_id, body, upvote, downvote, score, date_created, date_updated
-FROM "catalog"."comment"
+const listInventorySkuSerial = `-- name: ListInventorySkuSerial :many
+SELECT id, serial_number, sku_id, status, date_created
+FROM "inventory"."sku_serial"
 WHERE (
     ("id" = ANY($1) OR $1 IS NULL) AND
     ("id" >= $2 OR $2 IS NULL) AND
     ("id" <= $3 OR $3 IS NULL) AND
-    ("code" = ANY($4) OR $4 IS NULL) AND
-    ("account_id" = ANY($5) OR $5 IS NULL) AND
-    ("account_id" >= $6 OR $6 IS NULL) AND
-    ("account_id" <= $7 OR $7 IS NULL) AND
-    ("ref_type" = ANY($8) OR $8 IS NULL) AND
-    ("ref_id" = ANY($9) OR $9 IS NULL) AND
-    ("ref_id" >= $10 OR $10 IS NULL) AND
-    ("ref_id" <= $11 OR $11 IS NULL) AND
-    ("upvote" = ANY($12) OR $12 IS NULL) AND
-    ("upvote" >= $13 OR $13 IS NULL) AND
-    ("upvote" <= $14 OR $14 IS NULL) AND
-    ("downvote" = ANY($15) OR $15 IS NULL) AND
-    ("downvote" >= $16 OR $16 IS NULL) AND
-    ("downvote" <= $17 OR $17 IS NULL) AND
-    ("score" = ANY($18) OR $18 IS NULL) AND
-    ("score" >= $19 OR $19 IS NULL) AND
-    ("score" <= $20 OR $20 IS NULL) AND
-    ("date_created" = ANY($21) OR $21 IS NULL) AND
-    ("date_created" >= $22 OR $22 IS NULL) AND
-    ("date_created" <= $23 OR $23 IS NULL) AND
-    ("date_updated" = ANY($24) OR $24 IS NULL) AND
-    ("date_updated" >= $25 OR $25 IS NULL) AND
-    ("date_updated" <= $26 OR $26 IS NULL)
+    ("serial_number" = ANY($4) OR $4 IS NULL) AND
+    ("sku_id" = ANY($5) OR $5 IS NULL) AND
+    ("sku_id" >= $6 OR $6 IS NULL) AND
+    ("sku_id" <= $7 OR $7 IS NULL) AND
+    ("status" = ANY($8) OR $8 IS NULL) AND
+    ("date_created" = ANY($9) OR $9 IS NULL) AND
+    ("date_created" >= $10 OR $10 IS NULL) AND
+    ("date_created" <= $11 OR $11 IS NULL)
 )
 ORDER BY "id"
-LIMIT $28
-OFFSET $27
+LIMIT $13
+OFFSET $12
 `
 
-type ListCatalogCommentParams struct {
-	ID              []int64                 `json:"id"`
-	IDFrom          pgtype.Int8             `json:"id_from"`
-	IDTo            pgtype.Int8             `json:"id_to"`
-	Code            []string                `json:"code"`
-	AccountID       []int64                 `json:"account_id"`
-	AccountIDFrom   pgtype.Int8             `json:"account_id_from"`
-	AccountIDTo     pgtype.Int8             `json:"account_id_to"`
-	RefType         []CatalogCommentRefType `json:"ref_type"`
-	RefID           []int64                 `json:"ref_id"`
-	RefIDFrom       pgtype.Int8             `json:"ref_id_from"`
-	RefIDTo         pgtype.Int8             `json:"ref_id_to"`
-	Upvote          []int64                 `json:"upvote"`
-	UpvoteFrom      pgtype.Int8             `json:"upvote_from"`
-	UpvoteTo        pgtype.Int8             `json:"upvote_to"`
-	Downvote        []int64                 `json:"downvote"`
-	DownvoteFrom    pgtype.Int8             `json:"downvote_from"`
-	DownvoteTo      pgtype.Int8             `json:"downvote_to"`
-	Score           []int32                 `json:"score"`
-	ScoreFrom       pgtype.Int4             `json:"score_from"`
-	ScoreTo         pgtype.Int4             `json:"score_to"`
-	DateCreated     []pgtype.Timestamptz    `json:"date_created"`
-	DateCreatedFrom pgtype.Timestamptz      `json:"date_created_from"`
-	DateCreatedTo   pgtype.Timestamptz      `json:"date_created_to"`
-	DateUpdated     []pgtype.Timestamptz    `json:"date_updated"`
-	DateUpdatedFrom pgtype.Timestamptz      `json:"date_updated_from"`
-	DateUpdatedTo   pgtype.Timestamptz      `json:"date_updated_to"`
-	Offset          pgtype.Int4             `json:"offset"`
-	Limit           pgtype.Int4             `json:"limit"`
+type ListInventorySkuSerialParams struct {
+	ID              []int64                  `json:"id"`
+	IDFrom          pgtype.Int8              `json:"id_from"`
+	IDTo            pgtype.Int8              `json:"id_to"`
+	SerialNumber    []string                 `json:"serial_number"`
+	SkuID           []int64                  `json:"sku_id"`
+	SkuIDFrom       pgtype.Int8              `json:"sku_id_from"`
+	SkuIDTo         pgtype.Int8              `json:"sku_id_to"`
+	Status          []InventoryProductStatus `json:"status"`
+	DateCreated     []pgtype.Timestamptz     `json:"date_created"`
+	DateCreatedFrom pgtype.Timestamptz       `json:"date_created_from"`
+	DateCreatedTo   pgtype.Timestamptz       `json:"date_created_to"`
+	Offset          pgtype.Int4              `json:"offset"`
+	Limit           pgtype.Int4              `json:"limit"`
 }
 
-func (q *Queries) ListCatalogComment(ctx context.Context, arg ListCatalogCommentParams) ([]CatalogComment, error) {
-	rows, err := q.db.Query(ctx, listCatalogComment,
+func (q *Queries) ListInventorySkuSerial(ctx context.Context, arg ListInventorySkuSerialParams) ([]InventorySkuSerial, error) {
+	rows, err := q.db.Query(ctx, listInventorySkuSerial,
 		arg.ID,
 		arg.IDFrom,
 		arg.IDTo,
-		arg.Code,
-		arg.AccountID,
-		arg.AccountIDFrom,
-		arg.AccountIDTo,
-		arg.RefType,
-		arg.RefID,
-		arg.RefIDFrom,
-		arg.RefIDTo,
-		arg.Upvote,
-		arg.UpvoteFrom,
-		arg.UpvoteTo,
-		arg.Downvote,
-		arg.DownvoteFrom,
-		arg.DownvoteTo,
-		arg.Score,
-		arg.ScoreFrom,
-		arg.ScoreTo,
+		arg.SerialNumber,
+		arg.SkuID,
+		arg.SkuIDFrom,
+		arg.SkuIDTo,
+		arg.Status,
 		arg.DateCreated,
 		arg.DateCreatedFrom,
 		arg.DateCreatedTo,
-		arg.DateUpdated,
-		arg.DateUpdatedFrom,
-		arg.DateUpdatedTo,
 		arg.Offset,
 		arg.Limit,
 	)
@@ -7049,21 +9162,15 @@ func (q *Queries) ListCatalogComment(ctx context.Context, arg ListCatalogComment
 		return nil, err
 	}
 	defer rows.Close()
-	items := []CatalogComment{}
+	items := []InventorySkuSerial{}
 	for rows.Next() {
-		var i CatalogComment
+		var i InventorySkuSerial
 		if err := rows.Scan(
 			&i.ID,
-			&i.Code,
-			&i.AccountID,
-			&i.RefType,
-			&i.RefID,
-			&i.Body,
-			&i.Upvote,
-			&i.Downvote,
-			&i.Score,
+			&i.SerialNumber,
+			&i.SkuID,
+			&i.Status,
 			&i.DateCreated,
-			&i.DateUpdated,
 		); err != nil {
 			return nil, err
 		}
@@ -7075,74 +9182,92 @@ func (q *Queries) ListCatalogComment(ctx context.Context, arg ListCatalogComment
 	return items, nil
 }
 
-const listCatalogProductSku = `-- name: ListCatalogProductSku :many
-SELECT id, code, spu_id, price, can_combine, date_created, date_deleted
-FROM "catalog"."product_sku"
+const listInventoryStock = `-- name: ListInventoryStock :many
+SELECT id, ref_type, ref_id, current_stock, sold, low_stock_threshold, alert, date_alerted, date_created
+FROM "inventory"."stock"
 WHERE (
     ("id" = ANY($1) OR $1 IS NULL) AND
     ("id" >= $2 OR $2 IS NULL) AND
     ("id" <= $3 OR $3 IS NULL) AND
-    ("code" = ANY($4) OR $4 IS NULL) AND
-    ("spu_id" = ANY($5) OR $5 IS NULL) AND
-    ("spu_id" >= $6 OR $6 IS NULL) AND
-    ("spu_id" <= $7 OR $7 IS NULL) AND
-    ("price" = ANY($8) OR $8 IS NULL) AND
-    ("price" >= $9 OR $9 IS NULL) AND
-    ("price" <= $10 OR $10 IS NULL) AND
-    ("can_combine" = ANY($11) OR $11 IS NULL) AND
-    ("date_created" = ANY($12) OR $12 IS NULL) AND
-    ("date_created" >= $13 OR $13 IS NULL) AND
-    ("date_created" <= $14 OR $14 IS NULL) AND
-    ("date_deleted" = ANY($15) OR $15 IS NULL) AND
-    ("date_deleted" >= $16 OR $16 IS NULL) AND
-    ("date_deleted" <= $17 OR $17 IS NULL)
+    ("ref_type" = ANY($4) OR $4 IS NULL) AND
+    ("ref_id" = ANY($5) OR $5 IS NULL) AND
+    ("ref_id" >= $6 OR $6 IS NULL) AND
+    ("ref_id" <= $7 OR $7 IS NULL) AND
+    ("current_stock" = ANY($8) OR $8 IS NULL) AND
+    ("current_stock" >= $9 OR $9 IS NULL) AND
+    ("current_stock" <= $10 OR $10 IS NULL) AND
+    ("sold" = ANY($11) OR $11 IS NULL) AND
+    ("sold" >= $12 OR $12 IS NULL) AND
+    ("sold" <= $13 OR $13 IS NULL) AND
+    ("low_stock_threshold" = ANY($14) OR $14 IS NULL) AND
+    ("low_stock_threshold" >= $15 OR $15 IS NULL) AND
+    ("low_stock_threshold" <= $16 OR $16 IS NULL) AND
+    ("alert" = ANY($17) OR $17 IS NULL) AND
+    ("date_alerted" = ANY($18) OR $18 IS NULL) AND
+    ("date_alerted" >= $19 OR $19 IS NULL) AND
+    ("date_alerted" <= $20 OR $20 IS NULL) AND
+    ("date_created" = ANY($21) OR $21 IS NULL) AND
+    ("date_created" >= $22 OR $22 IS NULL) AND
+    ("date_created" <= $23 OR $23 IS NULL)
 )
 ORDER BY "id"
-LIMIT $19
-OFFSET $18
+LIMIT $25
+OFFSET $24
 `
 
-type ListCatalogProductSkuParams struct {
-	ID              []int64              `json:"id"`
-	IDFrom          pgtype.Int8          `json:"id_from"`
-	IDTo            pgtype.Int8          `json:"id_to"`
-	Code            []string             `json:"code"`
-	SpuID           []int64              `json:"spu_id"`
-	SpuIDFrom       pgtype.Int8          `json:"spu_id_from"`
-	SpuIDTo         pgtype.Int8          `json:"spu_id_to"`
-	Price           []int64              `json:"price"`
-	PriceFrom       pgtype.Int8          `json:"price_from"`
-	PriceTo         pgtype.Int8          `json:"price_to"`
-	CanCombine      []bool               `json:"can_combine"`
-	DateCreated     []pgtype.Timestamptz `json:"date_created"`
-	DateCreatedFrom pgtype.Timestamptz   `json:"date_created_from"`
-	DateCreatedTo   pgtype.Timestamptz   `json:"date_created_to"`
-	DateDeleted     []pgtype.Timestamptz `json:"date_deleted"`
-	DateDeletedFrom pgtype.Timestamptz   `json:"date_deleted_from"`
-	DateDeletedTo   pgtype.Timestamptz   `json:"date_deleted_to"`
-	Offset          pgtype.Int4          `json:"offset"`
-	Limit           pgtype.Int4          `json:"limit"`
+type ListInventoryStockParams struct {
+	ID                    []int64                   `json:"id"`
+	IDFrom                pgtype.Int8               `json:"id_from"`
+	IDTo                  pgtype.Int8               `json:"id_to"`
+	RefType               []InventoryStockType      `json:"ref_type"`
+	RefID                 []int64                   `json:"ref_id"`
+	RefIDFrom             pgtype.Int8               `json:"ref_id_from"`
+	RefIDTo               pgtype.Int8               `json:"ref_id_to"`
+	CurrentStock          []int64                   `json:"current_stock"`
+	CurrentStockFrom      pgtype.Int8               `json:"current_stock_from"`
+	CurrentStockTo        pgtype.Int8               `json:"current_stock_to"`
+	Sold                  []int64                   `json:"sold"`
+	SoldFrom              pgtype.Int8               `json:"sold_from"`
+	SoldTo                pgtype.Int8               `json:"sold_to"`
+	LowStockThreshold     []int64                   `json:"low_stock_threshold"`
+	LowStockThresholdFrom pgtype.Int8               `json:"low_stock_threshold_from"`
+	LowStockThresholdTo   pgtype.Int8               `json:"low_stock_threshold_to"`
+	Alert                 []NullInventoryStockAlert `json:"alert"`
+	DateAlerted           []pgtype.Timestamptz      `json:"date_alerted"`
+	DateAlertedFrom       pgtype.Timestamptz        `json:"date_alerted_from"`
+	DateAlertedTo         pgtype.Timestamptz        `json:"date_alerted_to"`
+	DateCreated           []pgtype.Timestamptz      `json:"date_created"`
+	DateCreatedFrom       pgtype.Timestamptz        `json:"date_created_from"`
+	DateCreatedTo         pgtype.Timestamptz        `json:"date_created_to"`
+	Offset                pgtype.Int4               `json:"offset"`
+	Limit                 pgtype.Int4               `json:"limit"`
 }
 
-func (q *Queries) ListCatalogProductSku(ctx context.Context, arg ListCatalogProductSkuParams) ([]CatalogProductSku, error) {
-	rows, err := q.db.Query(ctx, listCatalogProductSku,
+func (q *Queries) ListInventoryStock(ctx context.Context, arg ListInventoryStockParams) ([]InventoryStock, error) {
+	rows, err := q.db.Query(ctx, listInventoryStock,
 		arg.ID,
 		arg.IDFrom,
 		arg.IDTo,
-		arg.Code,
-		arg.SpuID,
-		arg.SpuIDFrom,
-		arg.SpuIDTo,
-		arg.Price,
-		arg.PriceFrom,
-		arg.PriceTo,
-		arg.CanCombine,
+		arg.RefType,
+		arg.RefID,
+		arg.RefIDFrom,
+		arg.RefIDTo,
+		arg.CurrentStock,
+		arg.CurrentStockFrom,
+		arg.CurrentStockTo,
+		arg.Sold,
+		arg.SoldFrom,
+		arg.SoldTo,
+		arg.LowStockThreshold,
+		arg.LowStockThresholdFrom,
+		arg.LowStockThresholdTo,
+		arg.Alert,
+		arg.DateAlerted,
+		arg.DateAlertedFrom,
+		arg.DateAlertedTo,
 		arg.DateCreated,
 		arg.DateCreatedFrom,
 		arg.DateCreatedTo,
-		arg.DateDeleted,
-		arg.DateDeletedFrom,
-		arg.DateDeletedTo,
 		arg.Offset,
 		arg.Limit,
 	)
@@ -7150,17 +9275,19 @@ func (q *Queries) ListCatalogProductSku(ctx context.Context, arg ListCatalogProd
 		return nil, err
 	}
 	defer rows.Close()
-	items := []CatalogProductSku{}
+	items := []InventoryStock{}
 	for rows.Next() {
-		var i CatalogProductSku
+		var i InventoryStock
 		if err := rows.Scan(
 			&i.ID,
-			&i.Code,
-			&i.SpuID,
-			&i.Price,
-			&i.CanCombine,
+			&i.RefType,
+			&i.RefID,
+			&i.CurrentStock,
+			&i.Sold,
+			&i.LowStockThreshold,
+			&i.Alert,
+			&i.DateAlerted,
 			&i.DateCreated,
-			&i.DateDeleted,
 		); err != nil {
 			return nil, err
 		}
@@ -7172,68 +9299,71 @@ func (q *Queries) ListCatalogProductSku(ctx context.Context, arg ListCatalogProd
 	return items, nil
 }
 
-const listCatalogProductSkuAttribute = `-- name: ListCatalogProductSkuAttribute :many
-SELECT id, code, sku_id, name, value, date_created, date_updated
-FROM "catalog"."product_sku_attribute"
+const listInventoryStockHistory = `-- name: ListInventoryStockHistory :many
+SELECT id, stock_id, change, account_id, reason, date_created
+FROM "inventory"."stock_history"
 WHERE (
     ("id" = ANY($1) OR $1 IS NULL) AND
     ("id" >= $2 OR $2 IS NULL) AND
     ("id" <= $3 OR $3 IS NULL) AND
-    ("code" = ANY($4) OR $4 IS NULL) AND
-    ("sku_id" = ANY($5) OR $5 IS NULL) AND
-    ("sku_id" >= $6 OR $6 IS NULL) AND
-    ("sku_id" <= $7 OR $7 IS NULL) AND
-    ("name" = ANY($8) OR $8 IS NULL) AND
-    ("value" = ANY($9) OR $9 IS NULL) AND
-    ("date_created" = ANY($10) OR $10 IS NULL) AND
-    ("date_created" >= $11 OR $11 IS NULL) AND
-    ("date_created" <= $12 OR $12 IS NULL) AND
-    ("date_updated" = ANY($13) OR $13 IS NULL) AND
-    ("date_updated" >= $14 OR $14 IS NULL) AND
-    ("date_updated" <= $15 OR $15 IS NULL)
+    ("stock_id" = ANY($4) OR $4 IS NULL) AND
+    ("stock_id" >= $5 OR $5 IS NULL) AND
+    ("stock_id" <= $6 OR $6 IS NULL) AND
+    ("change" = ANY($7) OR $7 IS NULL) AND
+    ("change" >= $8 OR $8 IS NULL) AND
+    ("change" <= $9 OR $9 IS NULL) AND
+    ("account_id" = ANY($10) OR $10 IS NULL) AND
+    ("account_id" >= $11 OR $11 IS NULL) AND
+    ("account_id" <= $12 OR $12 IS NULL) AND
+    ("reason" = ANY($13) OR $13 IS NULL) AND
+    ("date_created" = ANY($14) OR $14 IS NULL) AND
+    ("date_created" >= $15 OR $15 IS NULL) AND
+    ("date_created" <= $16 OR $16 IS NULL)
 )
 ORDER BY "id"
-LIMIT $17
-OFFSET $16
+LIMIT $18
+OFFSET $17
 `
 
-type ListCatalogProductSkuAttributeParams struct {
+type ListInventoryStockHistoryParams struct {
 	ID              []int64              `json:"id"`
 	IDFrom          pgtype.Int8          `json:"id_from"`
 	IDTo            pgtype.Int8          `json:"id_to"`
-	Code            []string             `json:"code"`
-	SkuID           []int64              `json:"sku_id"`
-	SkuIDFrom       pgtype.Int8          `json:"sku_id_from"`
-	SkuIDTo         pgtype.Int8          `json:"sku_id_to"`
-	Name            []string             `json:"name"`
-	Value           []string             `json:"value"`
+	StockID         []int64              `json:"stock_id"`
+	StockIDFrom     pgtype.Int8          `json:"stock_id_from"`
+	StockIDTo       pgtype.Int8          `json:"stock_id_to"`
+	Change          []int64              `json:"change"`
+	ChangeFrom      pgtype.Int8          `json:"change_from"`
+	ChangeTo        pgtype.Int8          `json:"change_to"`
+	AccountID       []pgtype.Int8        `json:"account_id"`
+	AccountIDFrom   pgtype.Int8          `json:"account_id_from"`
+	AccountIDTo     pgtype.Int8          `json:"account_id_to"`
+	Reason          []pgtype.Text        `json:"reason"`
 	DateCreated     []pgtype.Timestamptz `json:"date_created"`
 	DateCreatedFrom pgtype.Timestamptz   `json:"date_created_from"`
 	DateCreatedTo   pgtype.Timestamptz   `json:"date_created_to"`
-	DateUpdated     []pgtype.Timestamptz `json:"date_updated"`
-	DateUpdatedFrom pgtype.Timestamptz   `json:"date_updated_from"`
-	DateUpdatedTo   pgtype.Timestamptz   `json:"date_updated_to"`
 	Offset          pgtype.Int4          `json:"offset"`
 	Limit           pgtype.Int4          `json:"limit"`
 }
 
-func (q *Queries) ListCatalogProductSkuAttribute(ctx context.Context, arg ListCatalogProductSkuAttributeParams) ([]CatalogProductSkuAttribute, error) {
-	rows, err := q.db.Query(ctx, listCatalogProductSkuAttribute,
+func (q *Queries) ListInventoryStockHistory(ctx context.Context, arg ListInventoryStockHistoryParams) ([]InventoryStockHistory, error) {
+	rows, err := q.db.Query(ctx, listInventoryStockHistory,
 		arg.ID,
 		arg.IDFrom,
 		arg.IDTo,
-		arg.Code,
-		arg.SkuID,
-		arg.SkuIDFrom,
-		arg.SkuIDTo,
-		arg.Name,
-		arg.Value,
+		arg.StockID,
+		arg.StockIDFrom,
+		arg.StockIDTo,
+		arg.Change,
+		arg.ChangeFrom,
+		arg.ChangeTo,
+		arg.AccountID,
+		arg.AccountIDFrom,
+		arg.AccountIDTo,
+		arg.Reason,
 		arg.DateCreated,
 		arg.DateCreatedFrom,
 		arg.DateCreatedTo,
-		arg.DateUpdated,
-		arg.DateUpdatedFrom,
-		arg.DateUpdatedTo,
 		arg.Offset,
 		arg.Limit,
 	)
@@ -7241,123 +9371,113 @@ func (q *Queries) ListCatalogProductSkuAttribute(ctx context.Context, arg ListCa
 		return nil, err
 	}
 	defer rows.Close()
-	items := []CatalogProductSkuAttribute{}
+	items := []InventoryStockHistory{}
 	for rows.Next() {
-		var i CatalogProductSkuAttribute
+		var i InventoryStockHistory
 		if err := rows.Scan(
 			&i.ID,
-			&i.Code,
-			&i.SkuID,
-			&i.Name,
-			&i.Value,
+			&i.StockID,
+			&i.Change,
+			&i.AccountID,
+			&i.Reason,
 			&i.DateCreated,
-			&i.DateUpdated,
 		); err != nil {
 			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
-const listCatalogProductSpu = `-- name: ListCatalogProductSpu :many
-SELECT id, code, account_id, category_id, brand_id, name, description, is_active, date_manufactured, date_created, date_updated, date_deleted
-FROM "catalog"."product_spu"
-WHERE (
-    ("id" = ANY($1) OR $1 IS NULL) AND
-    ("id" >= $2 OR $2 IS NULL) AND
-    ("id" <= $3 OR $3 IS NULL) AND
-    ("code" = ANY($4) OR $4 IS NULL) AND
-    ("account_id" = ANY($5) OR $5 IS NULL) AND
-    ("account_id" >= $6 OR $6 IS NULL) AND
-    ("account_id" <= $7 OR $7 IS NULL) AND
-    ("category_id" = ANY($8) OR $8 IS NULL) AND
-    ("category_id" >= $9 OR $9 IS NULL) AND
-    ("category_id" <= $10 OR $10 IS NULL) AND
-    ("brand_id" = ANY($11) OR $11 IS NULL) AND
-    ("brand_id" >= $12 OR $12 IS NULL) AND
-    ("brand_id" <= $13 OR $13 IS NULL) AND
-    ("is_active" = ANY($14) OR $14 IS NULL) AND
-    ("date_manufactured" = ANY($15) OR $15 IS NULL) AND
-    ("date_manufactured" >= $16 OR $16 IS NULL) AND
-    ("date_manufactured" <= $17 OR $17 IS NULL) AND
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listInventoryStockReservation = `-- name: ListInventoryStockReservation :many
+SELECT id, stock_id, ref_type, ref_id, quantity, status, date_expired, date_created, date_updated
+FROM "inventory"."stock_reservation"
+WHERE (
+    ("id" = ANY($1) OR $1 IS NULL) AND
+    ("id" >= $2 OR $2 IS NULL) AND
+    ("id" <= $3 OR $3 IS NULL) AND
+    ("stock_id" = ANY($4) OR $4 IS NULL) AND
+    ("stock_id" >= $5 OR $5 IS NULL) AND
+    ("stock_id" <= $6 OR $6 IS NULL) AND
+    ("ref_type" = ANY($7) OR $7 IS NULL) AND
+    ("ref_id" = ANY($8) OR $8 IS NULL) AND
+    ("ref_id" >= $9 OR $9 IS NULL) AND
+    ("ref_id" <= $10 OR $10 IS NULL) AND
+    ("quantity" = ANY($11) OR $11 IS NULL) AND
+    ("quantity" >= $12 OR $12 IS NULL) AND
+    ("quantity" <= $13 OR $13 IS NULL) AND
+    ("status" = ANY($14) OR $14 IS NULL) AND
+    ("date_expired" = ANY($15) OR $15 IS NULL) AND
+    ("date_expired" >= $16 OR $16 IS NULL) AND
+    ("date_expired" <= $17 OR $17 IS NULL) AND
     ("date_created" = ANY($18) OR $18 IS NULL) AND
     ("date_created" >= $19 OR $19 IS NULL) AND
     ("date_created" <= $20 OR $20 IS NULL) AND
     ("date_updated" = ANY($21) OR $21 IS NULL) AND
     ("date_updated" >= $22 OR $22 IS NULL) AND
-    ("date_updated" <= $23 OR $23 IS NULL) AND
-    ("date_deleted" = ANY($24) OR $24 IS NULL) AND
-    ("date_deleted" >= $25 OR $25 IS NULL) AND
-    ("date_deleted" <= $26 OR $26 IS NULL)
+    ("date_updated" <= $23 OR $23 IS NULL)
 )
 ORDER BY "id"
-LIMIT $28
-OFFSET $27
-`
-
-type ListCatalogProductSpuParams struct {
-	ID                   []int64              `json:"id"`
-	IDFrom               pgtype.Int8          `json:"id_from"`
-	IDTo                 pgtype.Int8          `json:"id_to"`
-	Code                 []string             `json:"code"`
-	AccountID            []int64              `json:"account_id"`
-	AccountIDFrom        pgtype.Int8          `json:"account_id_from"`
-	AccountIDTo          pgtype.Int8          `json:"account_id_to"`
-	CategoryID           []int64              `json:"category_id"`
-	CategoryIDFrom       pgtype.Int8          `json:"category_id_from"`
-	CategoryIDTo         pgtype.Int8          `json:"category_id_to"`
-	BrandID              []int64              `json:"brand_id"`
-	BrandIDFrom          pgtype.Int8          `json:"brand_id_from"`
-	BrandIDTo            pgtype.Int8          `json:"brand_id_to"`
-	IsActive             []bool               `json:"is_active"`
-	DateManufactured     []pgtype.Timestamptz `json:"date_manufactured"`
-	DateManufacturedFrom pgtype.Timestamptz   `json:"date_manufactured_from"`
-	DateManufacturedTo   pgtype.Timestamptz   `json:"date_manufactured_to"`
-	DateCreated          []pgtype.Timestamptz `json:"date_created"`
-	DateCreatedFrom      pgtype.Timestamptz   `json:"date_created_from"`
-	DateCreatedTo        pgtype.Timestamptz   `json:"date_created_to"`
-	DateUpdated          []pgtype.Timestamptz `json:"date_updated"`
-	DateUpdatedFrom      pgtype.Timestamptz   `json:"date_updated_from"`
-	DateUpdatedTo        pgtype.Timestamptz   `json:"date_updated_to"`
-	DateDeleted          []pgtype.Timestamptz `json:"date_deleted"`
-	DateDeletedFrom      pgtype.Timestamptz   `json:"date_deleted_from"`
-	DateDeletedTo        pgtype.Timestamptz   `json:"date_deleted_to"`
-	Offset               pgtype.Int4          `json:"offset"`
-	Limit                pgtype.Int4          `json:"limit"`
-}
-
-func (q *Queries) ListCatalogProductSpu(ctx context.Context, arg ListCatalogProductSpuParams) ([]CatalogProductSpu, error) {
-	rows, err := q.db.Query(ctx, listCatalogProductSpu,
+LIMIT $25
+OFFSET $24
+`
+
+type ListInventoryStockReservationParams struct {
+	ID              []int64                    `json:"id"`
+	IDFrom          pgtype.Int8                `json:"id_from"`
+	IDTo            pgtype.Int8                `json:"id_to"`
+	StockID         []int64                    `json:"stock_id"`
+	StockIDFrom     pgtype.Int8                `json:"stock_id_from"`
+	StockIDTo       pgtype.Int8                `json:"stock_id_to"`
+	RefType         []InventoryReservationType `json:"ref_type"`
+	RefID           []int64                    `json:"ref_id"`
+	RefIDFrom       pgtype.Int8                `json:"ref_id_from"`
+	RefIDTo         pgtype.Int8                `json:"ref_id_to"`
+	Quantity        []int64                    `json:"quantity"`
+	QuantityFrom    pgtype.Int8                `json:"quantity_from"`
+	QuantityTo      pgtype.Int8                `json:"quantity_to"`
+	Status          []SharedStatus             `json:"status"`
+	DateExpired     []pgtype.Timestamptz       `json:"date_expired"`
+	DateExpiredFrom pgtype.Timestamptz         `json:"date_expired_from"`
+	DateExpiredTo   pgtype.Timestamptz         `json:"date_expired_to"`
+	DateCreated     []pgtype.Timestamptz       `json:"date_created"`
+	DateCreatedFrom pgtype.Timestamptz         `json:"date_created_from"`
+	DateCreatedTo   pgtype.Timestamptz         `json:"date_created_to"`
+	DateUpdated     []pgtype.Timestamptz       `json:"date_updated"`
+	DateUpdatedFrom pgtype.Timestamptz         `json:"date_updated_from"`
+	DateUpdatedTo   pgtype.Timestamptz         `json:"date_updated_to"`
+	Offset          pgtype.Int4                `json:"offset"`
+	Limit           pgtype.Int4                `json:"limit"`
+}
+
+func (q *Queries) ListInventoryStockReservation(ctx context.Context, arg ListInventoryStockReservationParams) ([]InventoryStockReservation, error) {
+	rows, err := q.db.Query(ctx, listInventoryStockReservation,
 		arg.ID,
 		arg.IDFrom,
 		arg.IDTo,
-		arg.Code,
-		arg.AccountID,
-		arg.AccountIDFrom,
-		arg.AccountIDTo,
-		arg.CategoryID,
-		arg.CategoryIDFrom,
-		arg.CategoryIDTo,
-		arg.BrandID,
-		arg.BrandIDFrom,
-		arg.BrandIDTo,
-		arg.IsActive,
-		arg.DateManufactured,
-		arg.DateManufacturedFrom,
-		arg.DateManufacturedTo,
+		arg.StockID,
+		arg.StockIDFrom,
+		arg.StockIDTo,
+		arg.RefType,
+		arg.RefID,
+		arg.RefIDFrom,
+		arg.RefIDTo,
+		arg.Quantity,
+		arg.QuantityFrom,
+		arg.QuantityTo,
+		arg.Status,
+		arg.DateExpired,
+		arg.DateExpiredFrom,
+		arg.DateExpiredTo,
 		arg.DateCreated,
 		arg.DateCreatedFrom,
 		arg.DateCreatedTo,
 		arg.DateUpdated,
 		arg.DateUpdatedFrom,
 		arg.DateUpdatedTo,
-		arg.DateDeleted,
-		arg.DateDeletedFrom,
-		arg.DateDeletedTo,
 		arg.Offset,
 		arg.Limit,
 	)
@@ -7365,22 +9485,19 @@ func (q *Queries) ListCatalogProductSpu(ctx context.Context, arg ListCatalogProd
 		return nil, err
 	}
 	defer rows.Close()
-	items := []CatalogProductSpu{}
+	items := []InventoryStockReservation{}
 	for rows.Next() {
-		var i CatalogProductSpu
+		var i InventoryStockReservation
 		if err := rows.Scan(
 			&i.ID,
-			&i.Code,
-			&i.AccountID,
-			&i.CategoryID,
-			&i.BrandID,
-			&i.Name,
-			&i.Description,
-			&i.IsActive,
-			&i.DateManufactured,
+			&i.StockID,
+			&i.RefType,
+			&i.RefID,
+			&i.Quantity,
+			&i.Status,
+			&i.DateExpired,
 			&i.DateCreated,
 			&i.DateUpdated,
-			&i.DateDeleted,
 		); err != nil {
 			return nil, err
 		}
@@ -7392,50 +9509,68 @@ func (q *Queries) ListCatalogProductSpu(ctx context.Context, arg ListCatalogProd
 	return items, nil
 }
 
-const listCatalogProductSpuTag = `-- name: ListCatalogProductSpuTag :many
-SELECT id, spu_id, tag_id
-FROM "catalog"."product_spu_tag"
+const listOrderBase = `-- name: ListOrderBase :many
+SELECT id, code, customer_id, payment_method, status, address, date_created, date_updated
+FROM "order"."base"
 WHERE (
     ("id" = ANY($1) OR $1 IS NULL) AND
     ("id" >= $2 OR $2 IS NULL) AND
     ("id" <= $3 OR $3 IS NULL) AND
-    ("spu_id" = ANY($4) OR $4 IS NULL) AND
-    ("spu_id" >= $5 OR $5 IS NULL) AND
-    ("spu_id" <= $6 OR $6 IS NULL) AND
-    ("tag_id" = ANY($7) OR $7 IS NULL) AND
-    ("tag_id" >= $8 OR $8 IS NULL) AND
-    ("tag_id" <= $9 OR $9 IS NULL)
+    ("code" = ANY($4) OR $4 IS NULL) AND
+    ("customer_id" = ANY($5) OR $5 IS NULL) AND
+    ("customer_id" >= $6 OR $6 IS NULL) AND
+    ("customer_id" <= $7 OR $7 IS NULL) AND
+    ("payment_method" = ANY($8) OR $8 IS NULL) AND
+    ("status" = ANY($9) OR $9 IS NULL) AND
+    ("date_created" = ANY($10) OR $10 IS NULL) AND
+    ("date_created" >= $11 OR $11 IS NULL) AND
+    ("date_created" <= $12 OR $12 IS NULL) AND
+    ("date_updated" = ANY($13) OR $13 IS NULL) AND
+    ("date_updated" >= $14 OR $14 IS NULL) AND
+    ("date_updated" <= $15 OR $15 IS NULL)
 )
 ORDER BY "id"
-LIMIT $11
-OFFSET $10
+LIMIT $17
+OFFSET $16
 `
 
-type ListCatalogProductSpuTagParams struct {
-	ID        []int64     `json:"id"`
-	IDFrom    pgtype.Int8 `json:"id_from"`
-	IDTo      pgtype.Int8 `json:"id_to"`
-	SpuID     []int64     `json:"spu_id"`
-	SpuIDFrom pgtype.Int8 `json:"spu_id_from"`
-	SpuIDTo   pgtype.Int8 `json:"spu_id_to"`
-	TagID     []int64     `json:"tag_id"`
-	TagIDFrom pgtype.Int8 `json:"tag_id_from"`
-	TagIDTo   pgtype.Int8 `json:"tag_id_to"`
-	Offset    pgtype.Int4 `json:"offset"`
-	Limit     pgtype.Int4 `json:"limit"`
+type ListOrderBaseParams struct {
+	ID              []int64              `json:"id"`
+	IDFrom          pgtype.Int8          `json:"id_from"`
+	IDTo            pgtype.Int8          `json:"id_to"`
+	Code            []string             `json:"code"`
+	CustomerID      []int64              `json:"customer_id"`
+	CustomerIDFrom  pgtype.Int8          `json:"customer_id_from"`
+	CustomerIDTo    pgtype.Int8          `json:"customer_id_to"`
+	PaymentMethod   []OrderPaymentMethod `json:"payment_method"`
+	Status          []SharedStatus       `json:"status"`
+	DateCreated     []pgtype.Timestamptz `json:"date_created"`
+	DateCreatedFrom pgtype.Timestamptz   `json:"date_created_from"`
+	DateCreatedTo   pgtype.Timestamptz   `json:"date_created_to"`
+	DateUpdated     []pgtype.Timestamptz `json:"date_updated"`
+	DateUpdatedFrom pgtype.Timestamptz   `json:"date_updated_from"`
+	DateUpdatedTo   pgtype.Timestamptz   `json:"date_updated_to"`
+	Offset          pgtype.Int4          `json:"offset"`
+	Limit           pgtype.Int4          `json:"limit"`
 }
 
-func (q *Queries) ListCatalogProductSpuTag(ctx context.Context, arg ListCatalogProductSpuTagParams) ([]CatalogProductSpuTag, error) {
-	rows, err := q.db.Query(ctx, listCatalogProductSpuTag,
+func (q *Queries) ListOrderBase(ctx context.Context, arg ListOrderBaseParams) ([]OrderBase, error) {
+	rows, err := q.db.Query(ctx, listOrderBase,
 		arg.ID,
 		arg.IDFrom,
 		arg.IDTo,
-		arg.SpuID,
-		arg.SpuIDFrom,
-		arg.SpuIDTo,
-		arg.TagID,
-		arg.TagIDFrom,
-		arg.TagIDTo,
+		arg.Code,
+		arg.CustomerID,
+		arg.CustomerIDFrom,
+		arg.CustomerIDTo,
+		arg.PaymentMethod,
+		arg.Status,
+		arg.DateCreated,
+		arg.DateCreatedFrom,
+		arg.DateCreatedTo,
+		arg.DateUpdated,
+		arg.DateUpdatedFrom,
+		arg.DateUpdatedTo,
 		arg.Offset,
 		arg.Limit,
 	)
@@ -7443,10 +9578,19 @@ func (q *Queries) ListCatalogProductSpuTag(ctx context.Context, arg ListCatalogP
 		return nil, err
 	}
 	defer rows.Close()
-	items := []CatalogProductSpuTag{}
+	items := []OrderBase{}
 	for rows.Next() {
-		var i CatalogProductSpuTag
-		if err := rows.Scan(&i.ID, &i.SpuID, &i.TagID); err != nil {
+		var i OrderBase
+		if err := rows.Scan(
+			&i.ID,
+			&i.Code,
+			&i.CustomerID,
+			&i.PaymentMethod,
+			&i.Status,
+			&i.Address,
+			&i.DateCreated,
+			&i.DateUpdated,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -7457,35 +9601,107 @@ func (q *Queries) ListCatalogProductSpuTag(ctx context.Context, arg ListCatalogP
 	return items, nil
 }
 
-const listCatalogTag = `-- name: ListCatalogTag :many
-SELECT id, tag, description
-FROM "catalog"."tag"
+const listOrderInvoice = `-- name: ListOrderInvoice :many
+SELECT id, code, type, ref_type, ref_id, seller_account_id, buyer_account_id, status, payment_method, address, phone, subtotal, total, file_rs_id, date_created, hash, prev_hash
+FROM "order"."invoice"
 WHERE (
     ("id" = ANY($1) OR $1 IS NULL) AND
     ("id" >= $2 OR $2 IS NULL) AND
     ("id" <= $3 OR $3 IS NULL) AND
-    ("tag" = ANY($4) OR $4 IS NULL)
+    ("code" = ANY($4) OR $4 IS NULL) AND
+    ("type" = ANY($5) OR $5 IS NULL) AND
+    ("ref_type" = ANY($6) OR $6 IS NULL) AND
+    ("ref_id" = ANY($7) OR $7 IS NULL) AND
+    ("ref_id" >= $8 OR $8 IS NULL) AND
+    ("ref_id" <= $9 OR $9 IS NULL) AND
+    ("seller_account_id" = ANY($10) OR $10 IS NULL) AND
+    ("seller_account_id" >= $11 OR $11 IS NULL) AND
+    ("seller_account_id" <= $12 OR $12 IS NULL) AND
+    ("buyer_account_id" = ANY($13) OR $13 IS NULL) AND
+    ("buyer_account_id" >= $14 OR $14 IS NULL) AND
+    ("buyer_account_id" <= $15 OR $15 IS NULL) AND
+    ("status" = ANY($16) OR $16 IS NULL) AND
+    ("payment_method" = ANY($17) OR $17 IS NULL) AND
+    ("subtotal" = ANY($18) OR $18 IS NULL) AND
+    ("subtotal" >= $19 OR $19 IS NULL) AND
+    ("subtotal" <= $20 OR $20 IS NULL) AND
+    ("total" = ANY($21) OR $21 IS NULL) AND
+    ("total" >= $22 OR $22 IS NULL) AND
+    ("total" <= $23 OR $23 IS NULL) AND
+    ("date_created" = ANY($24) OR $24 IS NULL) AND
+    ("date_created" >= $25 OR $25 IS NULL) AND
+    ("date_created" <= $26 OR $26 IS NULL) AND
+    ("hash" = ANY($27) OR $27 IS NULL) AND
+    ("prev_hash" = ANY($28) OR $28 IS NULL)
 )
 ORDER BY "id"
-LIMIT $6
-OFFSET $5
+LIMIT $30
+OFFSET $29
 `
 
-type ListCatalogTagParams struct {
-	ID     []int64     `json:"id"`
-	IDFrom pgtype.Int8 `json:"id_from"`
-	IDTo   pgtype.Int8 `json:"id_to"`
-	Tag    []string    `json:"tag"`
-	Offset pgtype.Int4 `json:"offset"`
-	Limit  pgtype.Int4 `json:"limit"`
+type ListOrderInvoiceParams struct {
+	ID                  []int64               `json:"id"`
+	IDFrom              pgtype.Int8           `json:"id_from"`
+	IDTo                pgtype.Int8           `json:"id_to"`
+	Code                []string              `json:"code"`
+	Type                []OrderInvoiceType    `json:"type"`
+	RefType             []OrderInvoiceRefType `json:"ref_type"`
+	RefID               []int64               `json:"ref_id"`
+	RefIDFrom           pgtype.Int8           `json:"ref_id_from"`
+	RefIDTo             pgtype.Int8           `json:"ref_id_to"`
+	SellerAccountID     []pgtype.Int8         `json:"seller_account_id"`
+	SellerAccountIDFrom pgtype.Int8           `json:"seller_account_id_from"`
+	SellerAccountIDTo   pgtype.Int8           `json:"seller_account_id_to"`
+	BuyerAccountID      []int64               `json:"buyer_account_id"`
+	BuyerAccountIDFrom  pgtype.Int8           `json:"buyer_account_id_from"`
+	BuyerAccountIDTo    pgtype.Int8           `json:"buyer_account_id_to"`
+	Status              []SharedStatus        `json:"status"`
+	PaymentMethod       []OrderPaymentMethod  `json:"payment_method"`
+	Subtotal            []int64               `json:"subtotal"`
+	SubtotalFrom        pgtype.Int8           `json:"subtotal_from"`
+	SubtotalTo          pgtype.Int8           `json:"subtotal_to"`
+	Total               []int64               `json:"total"`
+	TotalFrom           pgtype.Int8           `json:"total_from"`
+	TotalTo             pgtype.Int8           `json:"total_to"`
+	DateCreated         []pgtype.Timestamptz  `json:"date_created"`
+	DateCreatedFrom     pgtype.Timestamptz    `json:"date_created_from"`
+	DateCreatedTo       pgtype.Timestamptz    `json:"date_created_to"`
+	Hash                [][]byte              `json:"hash"`
+	PrevHash            [][]byte              `json:"prev_hash"`
+	Offset              pgtype.Int4           `json:"offset"`
+	Limit               pgtype.Int4           `json:"limit"`
 }
 
-func (q *Queries) ListCatalogTag(ctx context.Context, arg ListCatalogTagParams) ([]CatalogTag, error) {
-	rows, err := q.db.Query(ctx, listCatalogTag,
+func (q *Queries) ListOrderInvoice(ctx context.Context, arg ListOrderInvoiceParams) ([]OrderInvoice, error) {
+	rows, err := q.db.Query(ctx, listOrderInvoice,
 		arg.ID,
 		arg.IDFrom,
 		arg.IDTo,
-		arg.Tag,
+		arg.Code,
+		arg.Type,
+		arg.RefType,
+		arg.RefID,
+		arg.RefIDFrom,
+		arg.RefIDTo,
+		arg.SellerAccountID,
+		arg.SellerAccountIDFrom,
+		arg.SellerAccountIDTo,
+		arg.BuyerAccountID,
+		arg.BuyerAccountIDFrom,
+		arg.BuyerAccountIDTo,
+		arg.Status,
+		arg.PaymentMethod,
+		arg.Subtotal,
+		arg.SubtotalFrom,
+		arg.SubtotalTo,
+		arg.Total,
+		arg.TotalFrom,
+		arg.TotalTo,
+		arg.DateCreated,
+		arg.DateCreatedFrom,
+		arg.DateCreatedTo,
+		arg.Hash,
+		arg.PrevHash,
 		arg.Offset,
 		arg.Limit,
 	)
@@ -7493,10 +9709,28 @@ func (q *Queries) ListCatalogTag(ctx context.Context, arg ListCatalogTagParams)
 		return nil, err
 	}
 	defer rows.Close()
-	items := []CatalogTag{}
+	items := []OrderInvoice{}
 	for rows.Next() {
-		var i CatalogTag
-		if err := rows.Scan(&i.ID, &i.Tag, &i.Description); err != nil {
+		var i OrderInvoice
+		if err := rows.Scan(
+			&i.ID,
+			&i.Code,
+			&i.Type,
+			&i.RefType,
+			&i.RefID,
+			&i.SellerAccountID,
+			&i.BuyerAccountID,
+			&i.Status,
+			&i.PaymentMethod,
+			&i.Address,
+			&i.Phone,
+			&i.Subtotal,
+			&i.Total,
+			&i.FileRsID,
+			&i.DateCreated,
+			&i.Hash,
+			&i.PrevHash,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -7507,56 +9741,80 @@ func (q *Queries) ListCatalogTag(ctx context.Context, arg ListCatalogTagParams)
 	return items, nil
 }
 
-const listInventorySkuSerial = `-- name: ListInventorySkuSerial :many
-SELECT id, serial_number, sku_id, status, date_created
-FROM "inventory"."sku_serial"
+const listOrderInvoiceItem = `-- name: ListOrderInvoiceItem :many
+SELECT id, invoice_id, snapshot, quantity, unit_price, subtotal, total
+FROM "order"."invoice_item"
 WHERE (
     ("id" = ANY($1) OR $1 IS NULL) AND
     ("id" >= $2 OR $2 IS NULL) AND
     ("id" <= $3 OR $3 IS NULL) AND
-    ("serial_number" = ANY($4) OR $4 IS NULL) AND
-    ("sku_id" = ANY($5) OR $5 IS NULL) AND
-    ("sku_id" >= $6 OR $6 IS NULL) AND
-    ("sku_id" <= $7 OR $7 IS NULL) AND
-    ("status" = ANY($8) OR $8 IS NULL) AND
-    ("date_created" = ANY($9) OR $9 IS NULL) AND
-    ("date_created" >= $10 OR $10 IS NULL) AND
-    ("date_created" <= $11 OR $11 IS NULL)
+    ("invoice_id" = ANY($4) OR $4 IS NULL) AND
+    ("invoice_id" >= $5 OR $5 IS NULL) AND
+    ("invoice_id" <= $6 OR $6 IS NULL) AND
+    ("snapshot" = ANY($7) OR $7 IS NULL) AND
+    ("quantity" = ANY($8) OR $8 IS NULL) AND
+    ("quantity" >= $9 OR $9 IS NULL) AND
+    ("quantity" <= $10 OR $10 IS NULL) AND
+    ("unit_price" = ANY($11) OR $11 IS NULL) AND
+    ("unit_price" >= $12 OR $12 IS NULL) AND
+    ("unit_price" <= $13 OR $13 IS NULL) AND
+    ("subtotal" = ANY($14) OR $14 IS NULL) AND
+    ("subtotal" >= $15 OR $15 IS NULL) AND
+    ("subtotal" <= $16 OR $16 IS NULL) AND
+    ("total" = ANY($17) OR $17 IS NULL) AND
+    ("total" >= $18 OR $18 IS NULL) AND
+    ("total" <= $19 OR $19 IS NULL)
 )
 ORDER BY "id"
-LIMIT $13
-OFFSET $12
+LIMIT $21
+OFFSET $20
 `
 
-type ListInventorySkuSerialParams struct {
-	ID              []int64                  `json:"id"`
-	IDFrom          pgtype.Int8              `json:"id_from"`
-	IDTo            pgtype.Int8              `json:"id_to"`
-	SerialNumber    []string                 `json:"serial_number"`
-	SkuID           []int64                  `json:"sku_id"`
-	SkuIDFrom       pgtype.Int8              `json:"sku_id_from"`
-	SkuIDTo         pgtype.Int8              `json:"sku_id_to"`
-	Status          []InventoryProductStatus `json:"status"`
-	DateCreated     []pgtype.Timestamptz     `json:"date_created"`
-	DateCreatedFrom pgtype.Timestamptz       `json:"date_created_from"`
-	DateCreatedTo   pgtype.Timestamptz       `json:"date_created_to"`
-	Offset          pgtype.Int4              `json:"offset"`
-	Limit           pgtype.Int4              `json:"limit"`
+type ListOrderInvoiceItemParams struct {
+	ID            []int64     `json:"id"`
+	IDFrom        pgtype.Int8 `json:"id_from"`
+	IDTo          pgtype.Int8 `json:"id_to"`
+	InvoiceID     []int64     `json:"invoice_id"`
+	InvoiceIDFrom pgtype.Int8 `json:"invoice_id_from"`
+	InvoiceIDTo   pgtype.Int8 `json:"invoice_id_to"`
+	Snapshot      [][]byte    `json:"snapshot"`
+	Quantity      []int64     `json:"quantity"`
+	QuantityFrom  pgtype.Int8 `json:"quantity_from"`
+	QuantityTo    pgtype.Int8 `json:"quantity_to"`
+	UnitPrice     []int64     `json:"unit_price"`
+	UnitPriceFrom pgtype.Int8 `json:"unit_price_from"`
+	UnitPriceTo   pgtype.Int8 `json:"unit_price_to"`
+	Subtotal      []int64     `json:"subtotal"`
+	SubtotalFrom  pgtype.Int8 `json:"subtotal_from"`
+	SubtotalTo    pgtype.Int8 `json:"subtotal_to"`
+	Total         []int64     `json:"total"`
+	TotalFrom     pgtype.Int8 `json:"total_from"`
+	TotalTo       pgtype.Int8 `json:"total_to"`
+	Offset        pgtype.Int4 `json:"offset"`
+	Limit         pgtype.Int4 `json:"limit"`
 }
 
-func (q *Queries) ListInventorySkuSerial(ctx context.Context, arg ListInventorySkuSerialParams) ([]InventorySkuSerial, error) {
-	rows, err := q.db.Query(ctx, listInventorySkuSerial,
+func (q *Queries) ListOrderInvoiceItem(ctx context.Context, arg ListOrderInvoiceItemParams) ([]OrderInvoiceItem, error) {
+	rows, err := q.db.Query(ctx, listOrderInvoiceItem,
 		arg.ID,
 		arg.IDFrom,
 		arg.IDTo,
-		arg.SerialNumber,
-		arg.SkuID,
-		arg.SkuIDFrom,
-		arg.SkuIDTo,
-		arg.Status,
-		arg.DateCreated,
-		arg.DateCreatedFrom,
-		arg.DateCreatedTo,
+		arg.InvoiceID,
+		arg.InvoiceIDFrom,
+		arg.InvoiceIDTo,
+		arg.Snapshot,
+		arg.Quantity,
+		arg.QuantityFrom,
+		arg.QuantityTo,
+		arg.UnitPrice,
+		arg.UnitPriceFrom,
+		arg.UnitPriceTo,
+		arg.Subtotal,
+		arg.SubtotalFrom,
+		arg.SubtotalTo,
+		arg.Total,
+		arg.TotalFrom,
+		arg.TotalTo,
 		arg.Offset,
 		arg.Limit,
 	)
@@ -7564,15 +9822,17 @@ func (q *Queries) ListInventorySkuSerial(ctx context.Context, arg ListInventoryS
 		return nil, err
 	}
 	defer rows.Close()
-	items := []InventorySkuSerial{}
+	items := []OrderInvoiceItem{}
 	for rows.Next() {
-		var i InventorySkuSerial
+		var i OrderInvoiceItem
 		if err := rows.Scan(
 			&i.ID,
-			&i.SerialNumber,
-			&i.SkuID,
-			&i.Status,
-			&i.DateCreated,
+			&i.InvoiceID,
+			&i.Snapshot,
+			&i.Quantity,
+			&i.UnitPrice,
+			&i.Subtotal,
+			&i.Total,
 		); err != nil {
 			return nil, err
 		}
@@ -7584,71 +9844,89 @@ func (q *Queries) ListInventorySkuSerial(ctx context.Context, arg ListInventoryS
 	return items, nil
 }
 
-const listInventoryStock = `-- name: ListInventoryStock :many
-SELECT id, ref_type, ref_id, current_stock, sold, date_created
-FROM "inventory"."stock"
+const listOrderItem = `-- name: ListOrderItem :many
+SELECT id, code, order_id, sku_id, quantity, unit_price, subtotal, total
+FROM "order"."item"
 WHERE (
     ("id" = ANY($1) OR $1 IS NULL) AND
     ("id" >= $2 OR $2 IS NULL) AND
     ("id" <= $3 OR $3 IS NULL) AND
-    ("ref_type" = ANY($4) OR $4 IS NULL) AND
-    ("ref_id" = ANY($5) OR $5 IS NULL) AND
-    ("ref_id" >= $6 OR $6 IS NULL) AND
-    ("ref_id" <= $7 OR $7 IS NULL) AND
-    ("current_stock" = ANY($8) OR $8 IS NULL) AND
-    ("current_stock" >= $9 OR $9 IS NULL) AND
-    ("current_stock" <= $10 OR $10 IS NULL) AND
-    ("sold" = ANY($11) OR $11 IS NULL) AND
-    ("sold" >= $12 OR $12 IS NULL) AND
-    ("sold" <= $13 OR $13 IS NULL) AND
-    ("date_created" = ANY($14) OR $14 IS NULL) AND
-    ("date_created" >= $15 OR $15 IS NULL) AND
-    ("date_created" <= $16 OR $16 IS NULL)
+    ("code" = ANY($4) OR $4 IS NULL) AND
+    ("order_id" = ANY($5) OR $5 IS NULL) AND
+    ("order_id" >= $6 OR $6 IS NULL) AND
+    ("order_id" <= $7 OR $7 IS NULL) AND
+    ("sku_id" = ANY($8) OR $8 IS NULL) AND
+    ("sku_id" >= $9 OR $9 IS NULL) AND
+    ("sku_id" <= $10 OR $10 IS NULL) AND
+    ("quantity" = ANY($11) OR $11 IS NULL) AND
+    ("quantity" >= $12 OR $12 IS NULL) AND
+    ("quantity" <= $13 OR $13 IS NULL) AND
+    ("unit_price" = ANY($14) OR $14 IS NULL) AND
+    ("unit_price" >= $15 OR $15 IS NULL) AND
+    ("unit_price" <= $16 OR $16 IS NULL) AND
+    ("subtotal" = ANY($17) OR $17 IS NULL) AND
+    ("subtotal" >= $18 OR $18 IS NULL) AND
+    ("subtotal" <= $19 OR $19 IS NULL) AND
+    ("total" = ANY($20) OR $20 IS NULL) AND
+    ("total" >= $21 OR $21 IS NULL) AND
+    ("total" <= $22 OR $22 IS NULL)
 )
 ORDER BY "id"
-LIMIT $18
-OFFSET $17
+LIMIT $24
+OFFSET $23
 `
 
-type ListInventoryStockParams struct {
-	ID               []int64              `json:"id"`
-	IDFrom           pgtype.Int8          `json:"id_from"`
-	IDTo             pgtype.Int8          `json:"id_to"`
-	RefType          []InventoryStockType `json:"ref_type"`
-	RefID            []int64              `json:"ref_id"`
-	RefIDFrom        pgtype.Int8          `json:"ref_id_from"`
-	RefIDTo          pgtype.Int8          `json:"ref_id_to"`
-	CurrentStock     []int64              `json:"current_stock"`
-	CurrentStockFrom pgtype.Int8          `json:"current_stock_from"`
-	CurrentStockTo   pgtype.Int8          `json:"current_stock_to"`
-	Sold             []int64              `json:"sold"`
-	SoldFrom         pgtype.Int8          `json:"sold_from"`
-	SoldTo           pgtype.Int8          `json:"sold_to"`
-	DateCreated      []pgtype.Timestamptz `json:"date_created"`
-	DateCreatedFrom  pgtype.Timestamptz   `json:"date_created_from"`
-	DateCreatedTo    pgtype.Timestamptz   `json:"date_created_to"`
-	Offset           pgtype.Int4          `json:"offset"`
-	Limit            pgtype.Int4          `json:"limit"`
+type ListOrderItemParams struct {
+	ID            []int64     `json:"id"`
+	IDFrom        pgtype.Int8 `json:"id_from"`
+	IDTo          pgtype.Int8 `json:"id_to"`
+	Code          []string    `json:"code"`
+	OrderID       []int64     `json:"order_id"`
+	OrderIDFrom   pgtype.Int8 `json:"order_id_from"`
+	OrderIDTo     pgtype.Int8 `json:"order_id_to"`
+	SkuID         []int64     `json:"sku_id"`
+	SkuIDFrom     pgtype.Int8 `json:"sku_id_from"`
+	SkuIDTo       pgtype.Int8 `json:"sku_id_to"`
+	Quantity      []int64     `json:"quantity"`
+	QuantityFrom  pgtype.Int8 `json:"quantity_from"`
+	QuantityTo    pgtype.Int8 `json:"quantity_to"`
+	UnitPrice     []int64     `json:"unit_price"`
+	UnitPriceFrom pgtype.Int8 `json:"unit_price_from"`
+	UnitPriceTo   pgtype.Int8 `json:"unit_price_to"`
+	Subtotal      []int64     `json:"subtotal"`
+	SubtotalFrom  pgtype.Int8 `json:"subtotal_from"`
+	SubtotalTo    pgtype.Int8 `json:"subtotal_to"`
+	Total         []int64     `json:"total"`
+	TotalFrom     pgtype.Int8 `json:"total_from"`
+	TotalTo       pgtype.Int8 `json:"total_to"`
+	Offset        pgtype.Int4 `json:"offset"`
+	Limit         pgtype.Int4 `json:"limit"`
 }
 
-func (q *Queries) ListInventoryStock(ctx context.Context, arg ListInventoryStockParams) ([]InventoryStock, error) {
-	rows, err := q.db.Query(ctx, listInventoryStock,
+func (q *Queries) ListOrderItem(ctx context.Context, arg ListOrderItemParams) ([]OrderItem, error) {
+	rows, err := q.db.Query(ctx, listOrderItem,
 		arg.ID,
-		arg.IDFrom,
-		arg.IDTo,
-		arg.RefType,
-		arg.RefID,
-		arg.RefIDFrom,
-		arg.RefIDTo,
-		arg.CurrentStock,
-		arg.CurrentStockFrom,
-		arg.CurrentStockTo,
-		arg.Sold,
-		arg.SoldFrom,
-		arg.SoldTo,
-		arg.DateCreated,
-		arg.DateCreatedFrom,
-		arg.DateCreatedTo,
+		arg.IDFrom,
+		arg.IDTo,
+		arg.Code,
+		arg.OrderID,
+		arg.OrderIDFrom,
+		arg.OrderIDTo,
+		arg.SkuID,
+		arg.SkuIDFrom,
+		arg.SkuIDTo,
+		arg.Quantity,
+		arg.QuantityFrom,
+		arg.QuantityTo,
+		arg.UnitPrice,
+		arg.UnitPriceFrom,
+		arg.UnitPriceTo,
+		arg.Subtotal,
+		arg.SubtotalFrom,
+		arg.SubtotalTo,
+		arg.Total,
+		arg.TotalFrom,
+		arg.TotalTo,
 		arg.Offset,
 		arg.Limit,
 	)
@@ -7656,16 +9934,18 @@ func (q *Queries) ListInventoryStock(ctx context.Context, arg ListInventoryStock
 		return nil, err
 	}
 	defer rows.Close()
-	items := []InventoryStock{}
+	items := []OrderItem{}
 	for rows.Next() {
-		var i InventoryStock
+		var i OrderItem
 		if err := rows.Scan(
 			&i.ID,
-			&i.RefType,
-			&i.RefID,
-			&i.CurrentStock,
-			&i.Sold,
-			&i.DateCreated,
+			&i.Code,
+			&i.OrderID,
+			&i.SkuID,
+			&i.Quantity,
+			&i.UnitPrice,
+			&i.Subtotal,
+			&i.Total,
 		); err != nil {
 			return nil, err
 		}
@@ -7677,59 +9957,50 @@ func (q *Queries) ListInventoryStock(ctx context.Context, arg ListInventoryStock
 	return items, nil
 }
 
-const listInventoryStockHistory = `-- name: ListInventoryStockHistory :many
-SELECT id, stock_id, change, date_created
-FROM "inventory"."stock_history"
+const listOrderItemSerial = `-- name: ListOrderItemSerial :many
+SELECT id, order_item_id, product_serial_id
+FROM "order"."item_serial"
 WHERE (
     ("id" = ANY($1) OR $1 IS NULL) AND
     ("id" >= $2 OR $2 IS NULL) AND
     ("id" <= $3 OR $3 IS NULL) AND
-    ("stock_id" = ANY($4) OR $4 IS NULL) AND
-    ("stock_id" >= $5 OR $5 IS NULL) AND
-    ("stock_id" <= $6 OR $6 IS NULL) AND
-    ("change" = ANY($7) OR $7 IS NULL) AND
-    ("change" >= $8 OR $8 IS NULL) AND
-    ("change" <= $9 OR $9 IS NULL) AND
-    ("date_created" = ANY($10) OR $10 IS NULL) AND
-    ("date_created" >= $11 OR $11 IS NULL) AND
-    ("date_created" <= $12 OR $12 IS NULL)
+    ("order_item_id" = ANY($4) OR $4 IS NULL) AND
+    ("order_item_id" >= $5 OR $5 IS NULL) AND
+    ("order_item_id" <= $6 OR $6 IS NULL) AND
+    ("product_serial_id" = ANY($7) OR $7 IS NULL) AND
+    ("product_serial_id" >= $8 OR $8 IS NULL) AND
+    ("product_serial_id" <= $9 OR $9 IS NULL)
 )
 ORDER BY "id"
-LIMIT $14
-OFFSET $13
+LIMIT $11
+OFFSET $10
 `
 
-type ListInventoryStockHistoryParams struct {
-	ID              []int64              `json:"id"`
-	IDFrom          pgtype.Int8          `json:"id_from"`
-	IDTo            pgtype.Int8          `json:"id_to"`
-	StockID         []int64              `json:"stock_id"`
-	StockIDFrom     pgtype.Int8          `json:"stock_id_from"`
-	StockIDTo       pgtype.Int8          `json:"stock_id_to"`
-	Change          []int64              `json:"change"`
-	ChangeFrom      pgtype.Int8          `json:"change_from"`
-	ChangeTo        pgtype.Int8          `json:"change_to"`
-	DateCreated     []pgtype.Timestamptz `json:"date_created"`
-	DateCreatedFrom pgtype.Timestamptz   `json:"date_created_from"`
-	DateCreatedTo   pgtype.Timestamptz   `json:"date_created_to"`
-	Offset          pgtype.Int4          `json:"offset"`
-	Limit           pgtype.Int4          `json:"limit"`
+type ListOrderItemSerialParams struct {
+	ID                  []int64     `json:"id"`
+	IDFrom              pgtype.Int8 `json:"id_from"`
+	IDTo                pgtype.Int8 `json:"id_to"`
+	OrderItemID         []int64     `json:"order_item_id"`
+	OrderItemIDFrom     pgtype.Int8 `json:"order_item_id_from"`
+	OrderItemIDTo       pgtype.Int8 `json:"order_item_id_to"`
+	ProductSerialID     []int64     `json:"product_serial_id"`
+	ProductSerialIDFrom pgtype.Int8 `json:"product_serial_id_from"`
+	ProductSerialIDTo   pgtype.Int8 `json:"product_serial_id_to"`
+	Offset              pgtype.Int4 `json:"offset"`
+	Limit               pgtype.Int4 `json:"limit"`
 }
 
-func (q *Queries) ListInventoryStockHistory(ctx context.Context, arg ListInventoryStockHistoryParams) ([]InventoryStockHistory, error) {
-	rows, err := q.db.Query(ctx, listInventoryStockHistory,
+func (q *Queries) ListOrderItemSerial(ctx context.Context, arg ListOrderItemSerialParams) ([]OrderItemSerial, error) {
+	rows, err := q.db.Query(ctx, listOrderItemSerial,
 		arg.ID,
 		arg.IDFrom,
 		arg.IDTo,
-		arg.StockID,
-		arg.StockIDFrom,
-		arg.StockIDTo,
-		arg.Change,
-		arg.ChangeFrom,
-		arg.ChangeTo,
-		arg.DateCreated,
-		arg.DateCreatedFrom,
-		arg.DateCreatedTo,
+		arg.OrderItemID,
+		arg.OrderItemIDFrom,
+		arg.OrderItemIDTo,
+		arg.ProductSerialID,
+		arg.ProductSerialIDFrom,
+		arg.ProductSerialIDTo,
 		arg.Offset,
 		arg.Limit,
 	)
@@ -7737,15 +10008,10 @@ func (q *Queries) ListInventoryStockHistory(ctx context.Context, arg ListInvento
 		return nil, err
 	}
 	defer rows.Close()
-	items := []InventoryStockHistory{}
+	items := []OrderItemSerial{}
 	for rows.Next() {
-		var i InventoryStockHistory
-		if err := rows.Scan(
-			&i.ID,
-			&i.StockID,
-			&i.Change,
-			&i.DateCreated,
-		); err != nil {
+		var i OrderItemSerial
+		if err := rows.Scan(&i.ID, &i.OrderItemID, &i.ProductSerialID); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -7756,68 +10022,68 @@ func (q *Queries) ListInventoryStockHistory(ctx context.Context, arg ListInvento
 	return items, nil
 }
 
-const listOrderBase = `-- name: ListOrderBase :many
-SELECT id, code, customer_id, payment_method, status, address, date_created, date_updated
-FROM "order"."base"
+const listOrderRefund = `-- name: ListOrderRefund :many
+SELECT id, code, order_item_id, reviewed_by_id, method, status, reason, address, date_created
+FROM "order"."refund"
 WHERE (
     ("id" = ANY($1) OR $1 IS NULL) AND
     ("id" >= $2 OR $2 IS NULL) AND
     ("id" <= $3 OR $3 IS NULL) AND
     ("code" = ANY($4) OR $4 IS NULL) AND
-    ("customer_id" = ANY($5) OR $5 IS NULL) AND
-    ("customer_id" >= $6 OR $6 IS NULL) AND
-    ("customer_id" <= $7 OR $7 IS NULL) AND
-    ("payment_method" = ANY($8) OR $8 IS NULL) AND
-    ("status" = ANY($9) OR $9 IS NULL) AND
-    ("date_created" = ANY($10) OR $10 IS NULL) AND
-    ("date_created" >= $11 OR $11 IS NULL) AND
-    ("date_created" <= $12 OR $12 IS NULL) AND
-    ("date_updated" = ANY($13) OR $13 IS NULL) AND
-    ("date_updated" >= $14 OR $14 IS NULL) AND
-    ("date_updated" <= $15 OR $15 IS NULL)
+    ("order_item_id" = ANY($5) OR $5 IS NULL) AND
+    ("order_item_id" >= $6 OR $6 IS NULL) AND
+    ("order_item_id" <= $7 OR $7 IS NULL) AND
+    ("reviewed_by_id" = ANY($8) OR $8 IS NULL) AND
+    ("reviewed_by_id" >= $9 OR $9 IS NULL) AND
+    ("reviewed_by_id" <= $10 OR $10 IS NULL) AND
+    ("method" = ANY($11) OR $11 IS NULL) AND
+    ("status" = ANY($12) OR $12 IS NULL) AND
+    ("date_created" = ANY($13) OR $13 IS NULL) AND
+    ("date_created" >= $14 OR $14 IS NULL) AND
+    ("date_created" <= $15 OR $15 IS NULL)
 )
 ORDER BY "id"
 LIMIT $17
 OFFSET $16
 `
 
-type ListOrderBaseParams struct {
-	ID              []int64              `json:"id"`
-	IDFrom          pgtype.Int8          `json:"id_from"`
-	IDTo            pgtype.Int8          `json:"id_to"`
-	Code            []string             `json:"code"`
-	CustomerID      []int64              `json:"customer_id"`
-	CustomerIDFrom  pgtype.Int8          `json:"customer_id_from"`
-	CustomerIDTo    pgtype.Int8          `json:"customer_id_to"`
-	PaymentMethod   []OrderPaymentMethod `json:"payment_method"`
-	Status          []SharedStatus       `json:"status"`
-	DateCreated     []pgtype.Timestamptz `json:"date_created"`
-	DateCreatedFrom pgtype.Timestamptz   `json:"date_created_from"`
-	DateCreatedTo   pgtype.Timestamptz   `json:"date_created_to"`
-	DateUpdated     []pgtype.Timestamptz `json:"date_updated"`
-	DateUpdatedFrom pgtype.Timestamptz   `json:"date_updated_from"`
-	DateUpdatedTo   pgtype.Timestamptz   `json:"date_updated_to"`
-	Offset          pgtype.Int4          `json:"offset"`
-	Limit           pgtype.Int4          `json:"limit"`
+type ListOrderRefundParams struct {
+	ID               []int64              `json:"id"`
+	IDFrom           pgtype.Int8          `json:"id_from"`
+	IDTo             pgtype.Int8          `json:"id_to"`
+	Code             []string             `json:"code"`
+	OrderItemID      []int64              `json:"order_item_id"`
+	OrderItemIDFrom  pgtype.Int8          `json:"order_item_id_from"`
+	OrderItemIDTo    pgtype.Int8          `json:"order_item_id_to"`
+	ReviewedByID     []pgtype.Int8        `json:"reviewed_by_id"`
+	ReviewedByIDFrom pgtype.Int8          `json:"reviewed_by_id_from"`
+	ReviewedByIDTo   pgtype.Int8          `json:"reviewed_by_id_to"`
+	Method           []OrderRefundMethod  `json:"method"`
+	Status           []SharedStatus       `json:"status"`
+	DateCreated      []pgtype.Timestamptz `json:"date_created"`
+	DateCreatedFrom  pgtype.Timestamptz   `json:"date_created_from"`
+	DateCreatedTo    pgtype.Timestamptz   `json:"date_created_to"`
+	Offset           pgtype.Int4          `json:"offset"`
+	Limit            pgtype.Int4          `json:"limit"`
 }
 
-func (q *Queries) ListOrderBase(ctx context.Context, arg ListOrderBaseParams) ([]OrderBase, error) {
-	rows, err := q.db.Query(ctx, listOrderBase,
+func (q *Queries) ListOrderRefund(ctx context.Context, arg ListOrderRefundParams) ([]OrderRefund, error) {
+	rows, err := q.db.Query(ctx, listOrderRefund,
 		arg.ID,
 		arg.IDFrom,
 		arg.IDTo,
 		arg.Code,
-		arg.CustomerID,
-		arg.CustomerIDFrom,
-		arg.CustomerIDTo,
-		arg.PaymentMethod,
+		arg.OrderItemID,
+		arg.OrderItemIDFrom,
+		arg.OrderItemIDTo,
+		arg.ReviewedByID,
+		arg.ReviewedByIDFrom,
+		arg.ReviewedByIDTo,
+		arg.Method,
 		arg.Status,
 		arg.DateCreated,
 		arg.DateCreatedFrom,
 		arg.DateCreatedTo,
-		arg.DateUpdated,
-		arg.DateUpdatedFrom,
-		arg.DateUpdatedTo,
 		arg.Offset,
 		arg.Limit,
 	)
@@ -7825,18 +10091,19 @@ func (q *Queries) ListOrderBase(ctx context.Context, arg ListOrderBaseParams) ([
 		return nil, err
 	}
 	defer rows.Close()
-	items := []OrderBase{}
+	items := []OrderRefund{}
 	for rows.Next() {
-		var i OrderBase
+		var i OrderRefund
 		if err := rows.Scan(
 			&i.ID,
 			&i.Code,
-			&i.CustomerID,
-			&i.PaymentMethod,
+			&i.OrderItemID,
+			&i.ReviewedByID,
+			&i.Method,
 			&i.Status,
+			&i.Reason,
 			&i.Address,
 			&i.DateCreated,
-			&i.DateUpdated,
 		); err != nil {
 			return nil, err
 		}
@@ -7848,107 +10115,74 @@ func (q *Queries) ListOrderBase(ctx context.Context, arg ListOrderBaseParams) ([
 	return items, nil
 }
 
-const listOrderInvoice = `-- name: ListOrderInvoice :many
-SELECT id, code, type, ref_type, ref_id, seller_account_id, buyer_account_id, status, payment_method, address, phone, subtotal, total, file_rs_id, date_created, hash, prev_hash
-FROM "order"."invoice"
+const listOrderRefundDispute = `-- name: ListOrderRefundDispute :many
+SELECT id, code, refund_id, issued_by_id, reason, status, date_created, date_updated
+FROM "order"."refund_dispute"
 WHERE (
     ("id" = ANY($1) OR $1 IS NULL) AND
     ("id" >= $2 OR $2 IS NULL) AND
     ("id" <= $3 OR $3 IS NULL) AND
     ("code" = ANY($4) OR $4 IS NULL) AND
-    ("type" = ANY($5) OR $5 IS NULL) AND
-    ("ref_type" = ANY($6) OR $6 IS NULL) AND
-    ("ref_id" = ANY($7) OR $7 IS NULL) AND
-    ("ref_id" >= $8 OR $8 IS NULL) AND
-    ("ref_id" <= $9 OR $9 IS NULL) AND
-    ("seller_account_id" = ANY($10) OR $10 IS NULL) AND
-    ("seller_account_id" >= $11 OR $11 IS NULL) AND
-    ("seller_account_id" <= $12 OR $12 IS NULL) AND
-    ("buyer_account_id" = ANY($13) OR $13 IS NULL) AND
-    ("buyer_account_id" >= $14 OR $14 IS NULL) AND
-    ("buyer_account_id" <= $15 OR $15 IS NULL) AND
-    ("status" = ANY($16) OR $16 IS NULL) AND
-    ("payment_method" = ANY($17) OR $17 IS NULL) AND
-    ("subtotal" = ANY($18) OR $18 IS NULL) AND
-    ("subtotal" >= $19 OR $19 IS NULL) AND
-    ("subtotal" <= $20 OR $20 IS NULL) AND
-    ("total" = ANY($21) OR $21 IS NULL) AND
-    ("total" >= $22 OR $22 IS NULL) AND
-    ("total" <= $23 OR $23 IS NULL) AND
-    ("date_created" = ANY($24) OR $24 IS NULL) AND
-    ("date_created" >= $25 OR $25 IS NULL) AND
-    ("date_created" <= $26 OR $26 IS NULL) AND
-    ("hash" = ANY($27) OR $27 IS NULL) AND
-    ("prev_hash" = ANY($28) OR $28 IS NULL)
+    ("refund_id" = ANY($5) OR $5 IS NULL) AND
+    ("refund_id" >= $6 OR $6 IS NULL) AND
+    ("refund_id" <= $7 OR $7 IS NULL) AND
+    ("issued_by_id" = ANY($8) OR $8 IS NULL) AND
+    ("issued_by_id" >= $9 OR $9 IS NULL) AND
+    ("issued_by_id" <= $10 OR $10 IS NULL) AND
+    ("status" = ANY($11) OR $11 IS NULL) AND
+    ("date_created" = ANY($12) OR $12 IS NULL) AND
+    ("date_created" >= $13 OR $13 IS NULL) AND
+    ("date_created" <= $14 OR $14 IS NULL) AND
+    ("date_updated" = ANY($15) OR $15 IS NULL) AND
+    ("date_updated" >= $16 OR $16 IS NULL) AND
+    ("date_updated" <= $17 OR $17 IS NULL)
 )
 ORDER BY "id"
-LIMIT $30
-OFFSET $29
+LIMIT $19
+OFFSET $18
 `
 
-type ListOrderInvoiceParams struct {
-	ID                  []int64               `json:"id"`
-	IDFrom              pgtype.Int8           `json:"id_from"`
-	IDTo                pgtype.Int8           `json:"id_to"`
-	Code                []string              `json:"code"`
-	Type                []OrderInvoiceType    `json:"type"`
-	RefType             []OrderInvoiceRefType `json:"ref_type"`
-	RefID               []int64               `json:"ref_id"`
-	RefIDFrom           pgtype.Int8           `json:"ref_id_from"`
-	RefIDTo             pgtype.Int8           `json:"ref_id_to"`
-	SellerAccountID     []pgtype.Int8         `json:"seller_account_id"`
-	SellerAccountIDFrom pgtype.Int8           `json:"seller_account_id_from"`
-	SellerAccountIDTo   pgtype.Int8           `json:"seller_account_id_to"`
-	BuyerAccountID      []int64               `json:"buyer_account_id"`
-	BuyerAccountIDFrom  pgtype.Int8           `json:"buyer_account_id_from"`
-	BuyerAccountIDTo    pgtype.Int8           `json:"buyer_account_id_to"`
-	Status              []SharedStatus        `json:"status"`
-	PaymentMethod       []OrderPaymentMethod  `json:"payment_method"`
-	Subtotal            []int64               `json:"subtotal"`
-	SubtotalFrom        pgtype.Int8           `json:"subtotal_from"`
-	SubtotalTo          pgtype.Int8           `json:"subtotal_to"`
-	Total               []int64               `json:"total"`
-	TotalFrom           pgtype.Int8           `json:"total_from"`
-	TotalTo             pgtype.Int8           `json:"total_to"`
-	DateCreated         []pgtype.Timestamptz  `json:"date_created"`
-	DateCreatedFrom     pgtype.Timestamptz    `json:"date_created_from"`
-	DateCreatedTo       pgtype.Timestamptz    `json:"date_created_to"`
-	Hash                [][]byte              `json:"hash"`
-	PrevHash            [][]byte              `json:"prev_hash"`
-	Offset              pgtype.Int4           `json:"offset"`
-	Limit               pgtype.Int4           `json:"limit"`
+type ListOrderRefundDisputeParams struct {
+	ID              []int64              `json:"id"`
+	IDFrom          pgtype.Int8          `json:"id_from"`
+	IDTo            pgtype.Int8          `json:"id_to"`
+	Code            []string             `json:"code"`
+	RefundID        []int64              `json:"refund_id"`
+	RefundIDFrom    pgtype.Int8          `json:"refund_id_from"`
+	RefundIDTo      pgtype.Int8          `json:"refund_id_to"`
+	IssuedByID      []int64              `json:"issued_by_id"`
+	IssuedByIDFrom  pgtype.Int8          `json:"issued_by_id_from"`
+	IssuedByIDTo    pgtype.Int8          `json:"issued_by_id_to"`
+	Status          []SharedStatus       `json:"status"`
+	DateCreated     []pgtype.Timestamptz `json:"date_created"`
+	DateCreatedFrom pgtype.Timestamptz   `json:"date_created_from"`
+	DateCreatedTo   pgtype.Timestamptz   `json:"date_created_to"`
+	DateUpdated     []pgtype.Timestamptz `json:"date_updated"`
+	DateUpdatedFrom pgtype.Timestamptz   `json:"date_updated_from"`
+	DateUpdatedTo   pgtype.Timestamptz   `json:"date_updated_to"`
+	Offset          pgtype.Int4          `json:"offset"`
+	Limit           pgtype.Int4          `json:"limit"`
 }
 
-func (q *Queries) ListOrderInvoice(ctx context.Context, arg ListOrderInvoiceParams) ([]OrderInvoice, error) {
-	rows, err := q.db.Query(ctx, listOrderInvoice,
+func (q *Queries) ListOrderRefundDispute(ctx context.Context, arg ListOrderRefundDisputeParams) ([]OrderRefundDispute, error) {
+	rows, err := q.db.Query(ctx, listOrderRefundDispute,
 		arg.ID,
 		arg.IDFrom,
 		arg.IDTo,
 		arg.Code,
-		arg.Type,
-		arg.RefType,
-		arg.RefID,
-		arg.RefIDFrom,
-		arg.RefIDTo,
-		arg.SellerAccountID,
-		arg.SellerAccountIDFrom,
-		arg.SellerAccountIDTo,
-		arg.BuyerAccountID,
-		arg.BuyerAccountIDFrom,
-		arg.BuyerAccountIDTo,
+		arg.RefundID,
+		arg.RefundIDFrom,
+		arg.RefundIDTo,
+		arg.IssuedByID,
+		arg.IssuedByIDFrom,
+		arg.IssuedByIDTo,
 		arg.Status,
-		arg.PaymentMethod,
-		arg.Subtotal,
-		arg.SubtotalFrom,
-		arg.SubtotalTo,
-		arg.Total,
-		arg.TotalFrom,
-		arg.TotalTo,
 		arg.DateCreated,
 		arg.DateCreatedFrom,
 		arg.DateCreatedTo,
-		arg.Hash,
-		arg.PrevHash,
+		arg.DateUpdated,
+		arg.DateUpdatedFrom,
+		arg.DateUpdatedTo,
 		arg.Offset,
 		arg.Limit,
 	)
@@ -7956,27 +10190,18 @@ func (q *Queries) ListOrderInvoice(ctx context.Context, arg ListOrderInvoicePara
 		return nil, err
 	}
 	defer rows.Close()
-	items := []OrderInvoice{}
+	items := []OrderRefundDispute{}
 	for rows.Next() {
-		var i OrderInvoice
+		var i OrderRefundDispute
 		if err := rows.Scan(
 			&i.ID,
 			&i.Code,
-			&i.Type,
-			&i.RefType,
-			&i.RefID,
-			&i.SellerAccountID,
-			&i.BuyerAccountID,
+			&i.RefundID,
+			&i.IssuedByID,
+			&i.Reason,
 			&i.Status,
-			&i.PaymentMethod,
-			&i.Address,
-			&i.Phone,
-			&i.Subtotal,
-			&i.Total,
-			&i.FileRsID,
 			&i.DateCreated,
-			&i.Hash,
-			&i.PrevHash,
+			&i.DateUpdated,
 		); err != nil {
 			return nil, err
 		}
@@ -7988,80 +10213,32 @@ func (q *Queries) ListOrderInvoice(ctx context.Context, arg ListOrderInvoicePara
 	return items, nil
 }
 
-const listOrderInvoiceItem = `-- name: ListOrderInvoiceItem :many
-SELECT id, invoice_id, snapshot, quantity, unit_price, subtotal, total
-FROM "order"."invoice_item"
+const listOrderVnpay = `-- name: ListOrderVnpay :many
+SELECT id, "vnp_Amount", "vnp_BankCode", "vnp_BankTranNo", "vnp_CardType", "vnp_OrderInfo", "vnp_PayDate", "vnp_ResponseCode", "vnp_SecureHash", "vnp_TmnCode", "vnp_TransactionNo", "vnp_TransactionStatus", "vnp_TxnRef"
+FROM "order"."vnpay"
 WHERE (
     ("id" = ANY($1) OR $1 IS NULL) AND
     ("id" >= $2 OR $2 IS NULL) AND
-    ("id" <= $3 OR $3 IS NULL) AND
-    ("invoice_id" = ANY($4) OR $4 IS NULL) AND
-    ("invoice_id" >= $5 OR $5 IS NULL) AND
-    ("invoice_id" <= $6 OR $6 IS NULL) AND
-    ("snapshot" = ANY($7) OR $7 IS NULL) AND
-    ("quantity" = ANY($8) OR $8 IS NULL) AND
-    ("quantity" >= $9 OR $9 IS NULL) AND
-    ("quantity" <= $10 OR $10 IS NULL) AND
-    ("unit_price" = ANY($11) OR $11 IS NULL) AND
-    ("unit_price" >= $12 OR $12 IS NULL) AND
-    ("unit_price" <= $13 OR $13 IS NULL) AND
-    ("subtotal" = ANY($14) OR $14 IS NULL) AND
-    ("subtotal" >= $15 OR $15 IS NULL) AND
-    ("subtotal" <= $16 OR $16 IS NULL) AND
-    ("total" = ANY($17) OR $17 IS NULL) AND
-    ("total" >= $18 OR $18 IS NULL) AND
-    ("total" <= $19 OR $19 IS NULL)
+    ("id" <= $3 OR $3 IS NULL)
 )
 ORDER BY "id"
-LIMIT $21
-OFFSET $20
+LIMIT $5
+OFFSET $4
 `
 
-type ListOrderInvoiceItemParams struct {
-	ID            []int64     `json:"id"`
-	IDFrom        pgtype.Int8 `json:"id_from"`
-	IDTo          pgtype.Int8 `json:"id_to"`
-	InvoiceID     []int64     `json:"invoice_id"`
-	InvoiceIDFrom pgtype.Int8 `json:"invoice_id_from"`
-	InvoiceIDTo   pgtype.Int8 `json:"invoice_id_to"`
-	Snapshot      [][]byte    `json:"snapshot"`
-	Quantity      []int64     `json:"quantity"`
-	QuantityFrom  pgtype.Int8 `json:"quantity_from"`
-	QuantityTo    pgtype.Int8 `json:"quantity_to"`
-	UnitPrice     []int64     `json:"unit_price"`
-	UnitPriceFrom pgtype.Int8 `json:"unit_price_from"`
-	UnitPriceTo   pgtype.Int8 `json:"unit_price_to"`
-	Subtotal      []int64     `json:"subtotal"`
-	SubtotalFrom  pgtype.Int8 `json:"subtotal_from"`
-	SubtotalTo    pgtype.Int8 `json:"subtotal_to"`
-	Total         []int64     `json:"total"`
-	TotalFrom     pgtype.Int8 `json:"total_from"`
-	TotalTo       pgtype.Int8 `json:"total_to"`
-	Offset        pgtype.Int4 `json:"offset"`
-	Limit         pgtype.Int4 `json:"limit"`
+type ListOrderVnpayParams struct {
+	ID     []int64     `json:"id"`
+	IDFrom pgtype.Int8 `json:"id_from"`
+	IDTo   pgtype.Int8 `json:"id_to"`
+	Offset pgtype.Int4 `json:"offset"`
+	Limit  pgtype.Int4 `json:"limit"`
 }
 
-func (q *Queries) ListOrderInvoiceItem(ctx context.Context, arg ListOrderInvoiceItemParams) ([]OrderInvoiceItem, error) {
-	rows, err := q.db.Query(ctx, listOrderInvoiceItem,
+func (q *Queries) ListOrderVnpay(ctx context.Context, arg ListOrderVnpayParams) ([]OrderVnpay, error) {
+	rows, err := q.db.Query(ctx, listOrderVnpay,
 		arg.ID,
 		arg.IDFrom,
 		arg.IDTo,
-		arg.InvoiceID,
-		arg.InvoiceIDFrom,
-		arg.InvoiceIDTo,
-		arg.Snapshot,
-		arg.Quantity,
-		arg.QuantityFrom,
-		arg.QuantityTo,
-		arg.UnitPrice,
-		arg.UnitPriceFrom,
-		arg.UnitPriceTo,
-		arg.Subtotal,
-		arg.SubtotalFrom,
-		arg.SubtotalTo,
-		arg.Total,
-		arg.TotalFrom,
-		arg.TotalTo,
 		arg.Offset,
 		arg.Limit,
 	)
@@ -8069,17 +10246,23 @@ func (q *Queries) ListOrderInvoiceItem(ctx context.Context, arg ListOrderInvoice
 		return nil, err
 	}
 	defer rows.Close()
-	items := []OrderInvoiceItem{}
+	items := []OrderVnpay{}
 	for rows.Next() {
-		var i OrderInvoiceItem
+		var i OrderVnpay
 		if err := rows.Scan(
 			&i.ID,
-			&i.InvoiceID,
-			&i.Snapshot,
-			&i.Quantity,
-			&i.UnitPrice,
-			&i.Subtotal,
-			&i.Total,
+			&i.VnpAmount,
+			&i.VnpBankCode,
+			&i.VnpBankTranNo,
+			&i.VnpCardType,
+			&i.VnpOrderInfo,
+			&i.VnpPayDate,
+			&i.VnpResponseCode,
+			&i.VnpSecureHash,
+			&i.VnpTmnCode,
+			&i.VnpTransactionNo,
+			&i.VnpTransactionStatus,
+			&i.VnpTxnRef,
 		); err != nil {
 			return nil, err
 		}
@@ -8091,62 +10274,113 @@ func (q *Queries) ListOrderInvoiceItem(ctx context.Context, arg ListOrderInvoice
 	return items, nil
 }
 
-const listOrderItem = `-- name: ListOrderItem :many
-SELECT id, code, order_id, sku_id, quantity
-FROM "order"."item"
+const listPromotionBase = `-- name: ListPromotionBase :many
+SELECT id, code, owner_id, ref_type, ref_id, type, title, description, is_active, exclusive, date_started, date_ended, schedule_tz, schedule_start, schedule_duration, date_created, date_updated
+FROM "promotion"."base"
 WHERE (
     ("id" = ANY($1) OR $1 IS NULL) AND
     ("id" >= $2 OR $2 IS NULL) AND
     ("id" <= $3 OR $3 IS NULL) AND
     ("code" = ANY($4) OR $4 IS NULL) AND
-    ("order_id" = ANY($5) OR $5 IS NULL) AND
-    ("order_id" >= $6 OR $6 IS NULL) AND
-    ("order_id" <= $7 OR $7 IS NULL) AND
-    ("sku_id" = ANY($8) OR $8 IS NULL) AND
-    ("sku_id" >= $9 OR $9 IS NULL) AND
-    ("sku_id" <= $10 OR $10 IS NULL) AND
-    ("quantity" = ANY($11) OR $11 IS NULL) AND
-    ("quantity" >= $12 OR $12 IS NULL) AND
-    ("quantity" <= $13 OR $13 IS NULL)
+    ("owner_id" = ANY($5) OR $5 IS NULL) AND
+    ("owner_id" >= $6 OR $6 IS NULL) AND
+    ("owner_id" <= $7 OR $7 IS NULL) AND
+    ("ref_type" = ANY($8) OR $8 IS NULL) AND
+    ("ref_id" = ANY($9) OR $9 IS NULL) AND
+    ("ref_id" >= $10 OR $10 IS NULL) AND
+    ("ref_id" <= $11 OR $11 IS NULL) AND
+    ("type" = ANY($12) OR $12 IS NULL) AND
+    ("is_active" = ANY($13) OR $13 IS NULL) AND
+    ("exclusive" = ANY($14) OR $14 IS NULL) AND
+    ("date_started" = ANY($15) OR $15 IS NULL) AND
+    ("date_started" >= $16 OR $16 IS NULL) AND
+    ("date_started" <= $17 OR $17 IS NULL) AND
+    ("date_ended" = ANY($18) OR $18 IS NULL) AND
+    ("date_ended" >= $19 OR $19 IS NULL) AND
+    ("date_ended" <= $20 OR $20 IS NULL) AND
+    ("schedule_start" = ANY($21) OR $21 IS NULL) AND
+    ("schedule_duration" = ANY($22) OR $22 IS NULL) AND
+    ("schedule_duration" >= $23 OR $23 IS NULL) AND
+    ("schedule_duration" <= $24 OR $24 IS NULL) AND
+    ("date_created" = ANY($25) OR $25 IS NULL) AND
+    ("date_created" >= $26 OR $26 IS NULL) AND
+    ("date_created" <= $27 OR $27 IS NULL) AND
+    ("date_updated" = ANY($28) OR $28 IS NULL) AND
+    ("date_updated" >= $29 OR $29 IS NULL) AND
+    ("date_updated" <= $30 OR $30 IS NULL)
 )
 ORDER BY "id"
-LIMIT $15
-OFFSET $14
+LIMIT $32
+OFFSET $31
 `
 
-type ListOrderItemParams struct {
-	ID           []int64     `json:"id"`
-	IDFrom       pgtype.Int8 `json:"id_from"`
-	IDTo         pgtype.Int8 `json:"id_to"`
-	Code         []string    `json:"code"`
-	OrderID      []int64     `json:"order_id"`
-	OrderIDFrom  pgtype.Int8 `json:"order_id_from"`
-	OrderIDTo    pgtype.Int8 `json:"order_id_to"`
-	SkuID        []int64     `json:"sku_id"`
-	SkuIDFrom    pgtype.Int8 `json:"sku_id_from"`
-	SkuIDTo      pgtype.Int8 `json:"sku_id_to"`
-	Quantity     []int64     `json:"quantity"`
-	QuantityFrom pgtype.Int8 `json:"quantity_from"`
-	QuantityTo   pgtype.Int8 `json:"quantity_to"`
-	Offset       pgtype.Int4 `json:"offset"`
-	Limit        pgtype.Int4 `json:"limit"`
+type ListPromotionBaseParams struct {
+	ID                   []int64              `json:"id"`
+	IDFrom               pgtype.Int8          `json:"id_from"`
+	IDTo                 pgtype.Int8          `json:"id_to"`
+	Code                 []string             `json:"code"`
+	OwnerID              []pgtype.Int8        `json:"owner_id"`
+	OwnerIDFrom          pgtype.Int8          `json:"owner_id_from"`
+	OwnerIDTo            pgtype.Int8          `json:"owner_id_to"`
+	RefType              []PromotionRefType   `json:"ref_type"`
+	RefID                []pgtype.Int8        `json:"ref_id"`
+	RefIDFrom            pgtype.Int8          `json:"ref_id_from"`
+	RefIDTo              pgtype.Int8          `json:"ref_id_to"`
+	Type                 []PromotionType      `json:"type"`
+	IsActive             []bool               `json:"is_active"`
+	Exclusive            []bool               `json:"exclusive"`
+	DateStarted          []pgtype.Timestamptz `json:"date_started"`
+	DateStartedFrom      pgtype.Timestamptz   `json:"date_started_from"`
+	DateStartedTo        pgtype.Timestamptz   `json:"date_started_to"`
+	DateEnded            []pgtype.Timestamptz `json:"date_ended"`
+	DateEndedFrom        pgtype.Timestamptz   `json:"date_ended_from"`
+	DateEndedTo          pgtype.Timestamptz   `json:"date_ended_to"`
+	ScheduleStart        []pgtype.Text        `json:"schedule_start"`
+	ScheduleDuration     []pgtype.Int4        `json:"schedule_duration"`
+	ScheduleDurationFrom pgtype.Int4          `json:"schedule_duration_from"`
+	ScheduleDurationTo   pgtype.Int4          `json:"schedule_duration_to"`
+	DateCreated          []pgtype.Timestamptz `json:"date_created"`
+	DateCreatedFrom      pgtype.Timestamptz   `json:"date_created_from"`
+	DateCreatedTo        pgtype.Timestamptz   `json:"date_created_to"`
+	DateUpdated          []pgtype.Timestamptz `json:"date_updated"`
+	DateUpdatedFrom      pgtype.Timestamptz   `json:"date_updated_from"`
+	DateUpdatedTo        pgtype.Timestamptz   `json:"date_updated_to"`
+	Offset               pgtype.Int4          `json:"offset"`
+	Limit                pgtype.Int4          `json:"limit"`
 }
 
-func (q *Queries) ListOrderItem(ctx context.Context, arg ListOrderItemParams) ([]OrderItem, error) {
-	rows, err := q.db.Query(ctx, listOrderItem,
+func (q *Queries) ListPromotionBase(ctx context.Context, arg ListPromotionBaseParams) ([]PromotionBase, error) {
+	rows, err := q.db.Query(ctx, listPromotionBase,
 		arg.ID,
 		arg.IDFrom,
 		arg.IDTo,
 		arg.Code,
-		arg.OrderID,
-		arg.OrderIDFrom,
-		arg.OrderIDTo,
-		arg.SkuID,
-		arg.SkuIDFrom,
-		arg.SkuIDTo,
-		arg.Quantity,
-		arg.QuantityFrom,
-		arg.QuantityTo,
+		arg.OwnerID,
+		arg.OwnerIDFrom,
+		arg.OwnerIDTo,
+		arg.RefType,
+		arg.RefID,
+		arg.RefIDFrom,
+		arg.RefIDTo,
+		arg.Type,
+		arg.IsActive,
+		arg.Exclusive,
+		arg.DateStarted,
+		arg.DateStartedFrom,
+		arg.DateStartedTo,
+		arg.DateEnded,
+		arg.DateEndedFrom,
+		arg.DateEndedTo,
+		arg.ScheduleStart,
+		arg.ScheduleDuration,
+		arg.ScheduleDurationFrom,
+		arg.ScheduleDurationTo,
+		arg.DateCreated,
+		arg.DateCreatedFrom,
+		arg.DateCreatedTo,
+		arg.DateUpdated,
+		arg.DateUpdatedFrom,
+		arg.DateUpdatedTo,
 		arg.Offset,
 		arg.Limit,
 	)
@@ -8154,15 +10388,27 @@ func (q *Queries) ListOrderItem(ctx context.Context, arg ListOrderItemParams) ([
 		return nil, err
 	}
 	defer rows.Close()
-	items := []OrderItem{}
+	items := []PromotionBase{}
 	for rows.Next() {
-		var i OrderItem
+		var i PromotionBase
 		if err := rows.Scan(
 			&i.ID,
 			&i.Code,
-			&i.OrderID,
-			&i.SkuID,
-			&i.Quantity,
+			&i.OwnerID,
+			&i.RefType,
+			&i.RefID,
+			&i.Type,
+			&i.Title,
+			&i.Description,
+			&i.IsActive,
+			&i.Exclusive,
+			&i.DateStarted,
+			&i.DateEnded,
+			&i.ScheduleTz,
+			&i.ScheduleStart,
+			&i.ScheduleDuration,
+			&i.DateCreated,
+			&i.DateUpdated,
 		); err != nil {
 			return nil, err
 		}
@@ -8174,50 +10420,41 @@ func (q *Queries) ListOrderItem(ctx context.Context, arg ListOrderItemParams) ([
 	return items, nil
 }
 
-const listOrderItemSerial = `-- name: ListOrderItemSerial :many
-SELECT id, order_item_id, product_serial_id
-FROM "order"."item_serial"
+const listPromotionBundle = `-- name: ListPromotionBundle :many
+SELECT id, price
+FROM "promotion"."bundle"
 WHERE (
     ("id" = ANY($1) OR $1 IS NULL) AND
     ("id" >= $2 OR $2 IS NULL) AND
     ("id" <= $3 OR $3 IS NULL) AND
-    ("order_item_id" = ANY($4) OR $4 IS NULL) AND
-    ("order_item_id" >= $5 OR $5 IS NULL) AND
-    ("order_item_id" <= $6 OR $6 IS NULL) AND
-    ("product_serial_id" = ANY($7) OR $7 IS NULL) AND
-    ("product_serial_id" >= $8 OR $8 IS NULL) AND
-    ("product_serial_id" <= $9 OR $9 IS NULL)
+    ("price" = ANY($4) OR $4 IS NULL) AND
+    ("price" >= $5 OR $5 IS NULL) AND
+    ("price" <= $6 OR $6 IS NULL)
 )
 ORDER BY "id"
-LIMIT $11
-OFFSET $10
+LIMIT $8
+OFFSET $7
 `
 
-type ListOrderItemSerialParams struct {
-	ID                  []int64     `json:"id"`
-	IDFrom              pgtype.Int8 `json:"id_from"`
-	IDTo                pgtype.Int8 `json:"id_to"`
-	OrderItemID         []int64     `json:"order_item_id"`
-	OrderItemIDFrom     pgtype.Int8 `json:"order_item_id_from"`
-	OrderItemIDTo       pgtype.Int8 `json:"order_item_id_to"`
-	ProductSerialID     []int64     `json:"product_serial_id"`
-	ProductSerialIDFrom pgtype.Int8 `json:"product_serial_id_from"`
-	ProductSerialIDTo   pgtype.Int8 `json:"product_serial_id_to"`
-	Offset              pgtype.Int4 `json:"offset"`
-	Limit               pgtype.Int4 `json:"limit"`
+type ListPromotionBundleParams struct {
+	ID        []int64     `json:"id"`
+	IDFrom    pgtype.Int8 `json:"id_from"`
+	IDTo      pgtype.Int8 `json:"id_to"`
+	Price     []int64     `json:"price"`
+	PriceFrom pgtype.Int8 `json:"price_from"`
+	PriceTo   pgtype.Int8 `json:"price_to"`
+	Offset    pgtype.Int4 `json:"offset"`
+	Limit     pgtype.Int4 `json:"limit"`
 }
 
-func (q *Queries) ListOrderItemSerial(ctx context.Context, arg ListOrderItemSerialParams) ([]OrderItemSerial, error) {
-	rows, err := q.db.Query(ctx, listOrderItemSerial,
+func (q *Queries) ListPromotionBundle(ctx context.Context, arg ListPromotionBundleParams) ([]PromotionBundle, error) {
+	rows, err := q.db.Query(ctx, listPromotionBundle,
 		arg.ID,
 		arg.IDFrom,
 		arg.IDTo,
-		arg.OrderItemID,
-		arg.OrderItemIDFrom,
-		arg.OrderItemIDTo,
-		arg.ProductSerialID,
-		arg.ProductSerialIDFrom,
-		arg.ProductSerialIDTo,
+		arg.Price,
+		arg.PriceFrom,
+		arg.PriceTo,
 		arg.Offset,
 		arg.Limit,
 	)
@@ -8225,10 +10462,10 @@ func (q *Queries) ListOrderItemSerial(ctx context.Context, arg ListOrderItemSeri
 		return nil, err
 	}
 	defer rows.Close()
-	items := []OrderItemSerial{}
+	items := []PromotionBundle{}
 	for rows.Next() {
-		var i OrderItemSerial
-		if err := rows.Scan(&i.ID, &i.OrderItemID, &i.ProductSerialID); err != nil {
+		var i PromotionBundle
+		if err := rows.Scan(&i.ID, &i.Price); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -8239,68 +10476,59 @@ func (q *Queries) ListOrderItemSerial(ctx context.Context, arg ListOrderItemSeri
 	return items, nil
 }
 
-const listOrderRefund = `-- name: ListOrderRefund :many
-SELECT id, code, order_item_id, reviewed_by_id, method, status, reason, address, date_created
-FROM "order"."refund"
+const listPromotionBundleItem = `-- name: ListPromotionBundleItem :many
+SELECT id, bundle_id, sku_id, quantity
+FROM "promotion"."bundle_item"
 WHERE (
     ("id" = ANY($1) OR $1 IS NULL) AND
     ("id" >= $2 OR $2 IS NULL) AND
     ("id" <= $3 OR $3 IS NULL) AND
-    ("code" = ANY($4) OR $4 IS NULL) AND
-    ("order_item_id" = ANY($5) OR $5 IS NULL) AND
-    ("order_item_id" >= $6 OR $6 IS NULL) AND
-    ("order_item_id" <= $7 OR $7 IS NULL) AND
-    ("reviewed_by_id" = ANY($8) OR $8 IS NULL) AND
-    ("reviewed_by_id" >= $9 OR $9 IS NULL) AND
-    ("reviewed_by_id" <= $10 OR $10 IS NULL) AND
-    ("method" = ANY($11) OR $11 IS NULL) AND
-    ("status" = ANY($12) OR $12 IS NULL) AND
-    ("date_created" = ANY($13) OR $13 IS NULL) AND
-    ("date_created" >= $14 OR $14 IS NULL) AND
-    ("date_created" <= $15 OR $15 IS NULL)
+    ("bundle_id" = ANY($4) OR $4 IS NULL) AND
+    ("bundle_id" >= $5 OR $5 IS NULL) AND
+    ("bundle_id" <= $6 OR $6 IS NULL) AND
+    ("sku_id" = ANY($7) OR $7 IS NULL) AND
+    ("sku_id" >= $8 OR $8 IS NULL) AND
+    ("sku_id" <= $9 OR $9 IS NULL) AND
+    ("quantity" = ANY($10) OR $10 IS NULL) AND
+    ("quantity" >= $11 OR $11 IS NULL) AND
+    ("quantity" <= $12 OR $12 IS NULL)
 )
 ORDER BY "id"
-LIMIT $17
-OFFSET $16
+LIMIT $14
+OFFSET $13
 `
 
-type ListOrderRefundParams struct {
-	ID               []int64              `json:"id"`
-	IDFrom           pgtype.Int8          `json:"id_from"`
-	IDTo             pgtype.Int8          `json:"id_to"`
-	Code             []string             `json:"code"`
-	OrderItemID      []int64              `json:"order_item_id"`
-	OrderItemIDFrom  pgtype.Int8          `json:"order_item_id_from"`
-	OrderItemIDTo    pgtype.Int8          `json:"order_item_id_to"`
-	ReviewedByID     []pgtype.Int8        `json:"reviewed_by_id"`
-	ReviewedByIDFrom pgtype.Int8          `json:"reviewed_by_id_from"`
-	ReviewedByIDTo   pgtype.Int8          `json:"reviewed_by_id_to"`
-	Method           []OrderRefundMethod  `json:"method"`
-	Status           []SharedStatus       `json:"status"`
-	DateCreated      []pgtype.Timestamptz `json:"date_created"`
-	DateCreatedFrom  pgtype.Timestamptz   `json:"date_created_from"`
-	DateCreatedTo    pgtype.Timestamptz   `json:"date_created_to"`
-	Offset           pgtype.Int4          `json:"offset"`
-	Limit            pgtype.Int4          `json:"limit"`
+type ListPromotionBundleItemParams struct {
+	ID           []int64     `json:"id"`
+	IDFrom       pgtype.Int8 `json:"id_from"`
+	IDTo         pgtype.Int8 `json:"id_to"`
+	BundleID     []int64     `json:"bundle_id"`
+	BundleIDFrom pgtype.Int8 `json:"bundle_id_from"`
+	BundleIDTo   pgtype.Int8 `json:"bundle_id_to"`
+	SkuID        []int64     `json:"sku_id"`
+	SkuIDFrom    pgtype.Int8 `json:"sku_id_from"`
+	SkuIDTo      pgtype.Int8 `json:"sku_id_to"`
+	Quantity     []int64     `json:"quantity"`
+	QuantityFrom pgtype.Int8 `json:"quantity_from"`
+	QuantityTo   pgtype.Int8 `json:"quantity_to"`
+	Offset       pgtype.Int4 `json:"offset"`
+	Limit        pgtype.Int4 `json:"limit"`
 }
 
-func (q *Queries) ListOrderRefund(ctx context.Context, arg ListOrderRefundParams) ([]OrderRefund, error) {
-	rows, err := q.db.Query(ctx, listOrderRefund,
+func (q *Queries) ListPromotionBundleItem(ctx context.Context, arg ListPromotionBundleItemParams) ([]PromotionBundleItem, error) {
+	rows, err := q.db.Query(ctx, listPromotionBundleItem,
 		arg.ID,
 		arg.IDFrom,
 		arg.IDTo,
-		arg.Code,
-		arg.OrderItemID,
-		arg.OrderItemIDFrom,
-		arg.OrderItemIDTo,
-		arg.ReviewedByID,
-		arg.ReviewedByIDFrom,
-		arg.ReviewedByIDTo,
-		arg.Method,
-		arg.Status,
-		arg.DateCreated,
-		arg.DateCreatedFrom,
-		arg.DateCreatedTo,
+		arg.BundleID,
+		arg.BundleIDFrom,
+		arg.BundleIDTo,
+		arg.SkuID,
+		arg.SkuIDFrom,
+		arg.SkuIDTo,
+		arg.Quantity,
+		arg.QuantityFrom,
+		arg.QuantityTo,
 		arg.Offset,
 		arg.Limit,
 	)
@@ -8308,19 +10536,14 @@ func (q *Queries) ListOrderRefund(ctx context.Context, arg ListOrderRefundParams
 		return nil, err
 	}
 	defer rows.Close()
-	items := []OrderRefund{}
+	items := []PromotionBundleItem{}
 	for rows.Next() {
-		var i OrderRefund
+		var i PromotionBundleItem
 		if err := rows.Scan(
 			&i.ID,
-			&i.Code,
-			&i.OrderItemID,
-			&i.ReviewedByID,
-			&i.Method,
-			&i.Status,
-			&i.Reason,
-			&i.Address,
-			&i.DateCreated,
+			&i.BundleID,
+			&i.SkuID,
+			&i.Quantity,
 		); err != nil {
 			return nil, err
 		}
@@ -8332,74 +10555,50 @@ func (q *Queries) ListOrderRefund(ctx context.Context, arg ListOrderRefundParams
 	return items, nil
 }
 
-const listOrderRefundDispute = `-- name: ListOrderRefundDispute :many
-SELECT id, code, refund_id, issued_by_id, reason, status, date_created, date_updated
-FROM "order"."refund_dispute"
+const listPromotionBuyXGetY = `-- name: ListPromotionBuyXGetY :many
+SELECT id, buy_quantity, get_quantity
+FROM "promotion"."buy_x_get_y"
 WHERE (
     ("id" = ANY($1) OR $1 IS NULL) AND
     ("id" >= $2 OR $2 IS NULL) AND
     ("id" <= $3 OR $3 IS NULL) AND
-    ("code" = ANY($4) OR $4 IS NULL) AND
-    ("refund_id" = ANY($5) OR $5 IS NULL) AND
-    ("refund_id" >= $6 OR $6 IS NULL) AND
-    ("refund_id" <= $7 OR $7 IS NULL) AND
-    ("issued_by_id" = ANY($8) OR $8 IS NULL) AND
-    ("issued_by_id" >= $9 OR $9 IS NULL) AND
-    ("issued_by_id" <= $10 OR $10 IS NULL) AND
-    ("status" = ANY($11) OR $11 IS NULL) AND
-    ("date_created" = ANY($12) OR $12 IS NULL) AND
-    ("date_created" >= $13 OR $13 IS NULL) AND
-    ("date_created" <= $14 OR $14 IS NULL) AND
-    ("date_updated" = ANY($15) OR $15 IS NULL) AND
-    ("date_updated" >= $16 OR $16 IS NULL) AND
-    ("date_updated" <= $17 OR $17 IS NULL)
+    ("buy_quantity" = ANY($4) OR $4 IS NULL) AND
+    ("buy_quantity" >= $5 OR $5 IS NULL) AND
+    ("buy_quantity" <= $6 OR $6 IS NULL) AND
+    ("get_quantity" = ANY($7) OR $7 IS NULL) AND
+    ("get_quantity" >= $8 OR $8 IS NULL) AND
+    ("get_quantity" <= $9 OR $9 IS NULL)
 )
 ORDER BY "id"
-LIMIT $19
-OFFSET $18
+LIMIT $11
+OFFSET $10
 `
 
-type ListOrderRefundDisputeParams struct {
-	ID              []int64              `json:"id"`
-	IDFrom          pgtype.Int8          `json:"id_from"`
-	IDTo            pgtype.Int8          `json:"id_to"`
-	Code            []string             `json:"code"`
-	RefundID        []int64              `json:"refund_id"`
-	RefundIDFrom    pgtype.Int8          `json:"refund_id_from"`
-	RefundIDTo      pgtype.Int8          `json:"refund_id_to"`
-	IssuedByID      []int64              `json:"issued_by_id"`
-	IssuedByIDFrom  pgtype.Int8          `json:"issued_by_id_from"`
-	IssuedByIDTo    pgtype.Int8          `json:"issued_by_id_to"`
-	Status          []SharedStatus       `json:"status"`
-	DateCreated     []pgtype.Timestamptz `json:"date_created"`
-	DateCreatedFrom pgtype.Timestamptz   `json:"date_created_from"`
-	DateCreatedTo   pgtype.Timestamptz   `json:"date_created_to"`
-	DateUpdated     []pgtype.Timestamptz `json:"date_updated"`
-	DateUpdatedFrom pgtype.Timestamptz   `json:"date_updated_from"`
-	DateUpdatedTo   pgtype.Timestamptz   `json:"date_updated_to"`
-	Offset          pgtype.Int4          `json:"offset"`
-	Limit           pgtype.Int4          `json:"limit"`
+type ListPromotionBuyXGetYParams struct {
+	ID              []int64     `json:"id"`
+	IDFrom          pgtype.Int8 `json:"id_from"`
+	IDTo            pgtype.Int8 `json:"id_to"`
+	BuyQuantity     []int64     `json:"buy_quantity"`
+	BuyQuantityFrom pgtype.Int8 `json:"buy_quantity_from"`
+	BuyQuantityTo   pgtype.Int8 `json:"buy_quantity_to"`
+	GetQuantity     []int64     `json:"get_quantity"`
+	GetQuantityFrom pgtype.Int8 `json:"get_quantity_from"`
+	GetQuantityTo   pgtype.Int8 `json:"get_quantity_to"`
+	Offset          pgtype.Int4 `json:"offset"`
+	Limit           pgtype.Int4 `json:"limit"`
 }
 
-func (q *Queries) ListOrderRefundDispute(ctx context.Context, arg ListOrderRefundDisputeParams) ([]OrderRefundDispute, error) {
-	rows, err := q.db.Query(ctx, listOrderRefundDispute,
-		arg.ID,
-		arg.IDFrom,
-		arg.IDTo,
-		arg.Code,
-		arg.RefundID,
-		arg.RefundIDFrom,
-		arg.RefundIDTo,
-		arg.IssuedByID,
-		arg.IssuedByIDFrom,
-		arg.IssuedByIDTo,
-		arg.Status,
-		arg.DateCreated,
-		arg.DateCreatedFrom,
-		arg.DateCreatedTo,
-		arg.DateUpdated,
-		arg.DateUpdatedFrom,
-		arg.DateUpdatedTo,
+func (q *Queries) ListPromotionBuyXGetY(ctx context.Context, arg ListPromotionBuyXGetYParams) ([]PromotionBuyXGetY, error) {
+	rows, err := q.db.Query(ctx, listPromotionBuyXGetY,
+		arg.ID,
+		arg.IDFrom,
+		arg.IDTo,
+		arg.BuyQuantity,
+		arg.BuyQuantityFrom,
+		arg.BuyQuantityTo,
+		arg.GetQuantity,
+		arg.GetQuantityFrom,
+		arg.GetQuantityTo,
 		arg.Offset,
 		arg.Limit,
 	)
@@ -8407,19 +10606,10 @@ func (q *Queries) ListOrderRefundDispute(ctx context.Context, arg ListOrderRefun
 		return nil, err
 	}
 	defer rows.Close()
-	items := []OrderRefundDispute{}
+	items := []PromotionBuyXGetY{}
 	for rows.Next() {
-		var i OrderRefundDispute
-		if err := rows.Scan(
-			&i.ID,
-			&i.Code,
-			&i.RefundID,
-			&i.IssuedByID,
-			&i.Reason,
-			&i.Status,
-			&i.DateCreated,
-			&i.DateUpdated,
-		); err != nil {
+		var i PromotionBuyXGetY
+		if err := rows.Scan(&i.ID, &i.BuyQuantity, &i.GetQuantity); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -8430,32 +10620,68 @@ func (q *Queries) ListOrderRefundDispute(ctx context.Context, arg ListOrderRefun
 	return items, nil
 }
 
-const listOrderVnpay = `-- name: ListOrderVnpay :many
-SELECT id, "vnp_Amount", "vnp_BankCode", "vnp_CardType", "vnp_OrderInfo", "vnp_PayDate", "vnp_ResponseCode", "vnp_SecureHash", "vnp_TmnCode", "vnp_TransactionNo", "vnp_TransactionStatus", "vnp_TxnRef"
-FROM "order"."vnpay"
+const listPromotionCashback = `-- name: ListPromotionCashback :many
+SELECT id, min_spend, max_cashback, cashback_percent, cashback_price
+FROM "promotion"."cashback"
 WHERE (
     ("id" = ANY($1) OR $1 IS NULL) AND
     ("id" >= $2 OR $2 IS NULL) AND
-    ("id" <= $3 OR $3 IS NULL)
+    ("id" <= $3 OR $3 IS NULL) AND
+    ("min_spend" = ANY($4) OR $4 IS NULL) AND
+    ("min_spend" >= $5 OR $5 IS NULL) AND
+    ("min_spend" <= $6 OR $6 IS NULL) AND
+    ("max_cashback" = ANY($7) OR $7 IS NULL) AND
+    ("max_cashback" >= $8 OR $8 IS NULL) AND
+    ("max_cashback" <= $9 OR $9 IS NULL) AND
+    ("cashback_percent" = ANY($10) OR $10 IS NULL) AND
+    ("cashback_percent" >= $11 OR $11 IS NULL) AND
+    ("cashback_percent" <= $12 OR $12 IS NULL) AND
+    ("cashback_price" = ANY($13) OR $13 IS NULL) AND
+    ("cashback_price" >= $14 OR $14 IS NULL) AND
+    ("cashback_price" <= $15 OR $15 IS NULL)
 )
 ORDER BY "id"
-LIMIT $5
-OFFSET $4
+LIMIT $17
+OFFSET $16
 `
 
-type ListOrderVnpayParams struct {
-	ID     []int64     `json:"id"`
-	IDFrom pgtype.Int8 `json:"id_from"`
-	IDTo   pgtype.Int8 `json:"id_to"`
-	Offset pgtype.Int4 `json:"offset"`
-	Limit  pgtype.Int4 `json:"limit"`
+type ListPromotionCashbackParams struct {
+	ID                  []int64       `json:"id"`
+	IDFrom              pgtype.Int8   `json:"id_from"`
+	IDTo                pgtype.Int8   `json:"id_to"`
+	MinSpend            []int64       `json:"min_spend"`
+	MinSpendFrom        pgtype.Int8   `json:"min_spend_from"`
+	MinSpendTo          pgtype.Int8   `json:"min_spend_to"`
+	MaxCashback         []int64       `json:"max_cashback"`
+	MaxCashbackFrom     pgtype.Int8   `json:"max_cashback_from"`
+	MaxCashbackTo       pgtype.Int8   `json:"max_cashback_to"`
+	CashbackPercent     []pgtype.Int4 `json:"cashback_percent"`
+	CashbackPercentFrom pgtype.Int4   `json:"cashback_percent_from"`
+	CashbackPercentTo   pgtype.Int4   `json:"cashback_percent_to"`
+	CashbackPrice       []pgtype.Int8 `json:"cashback_price"`
+	CashbackPriceFrom   pgtype.Int8   `json:"cashback_price_from"`
+	CashbackPriceTo     pgtype.Int8   `json:"cashback_price_to"`
+	Offset              pgtype.Int4   `json:"offset"`
+	Limit               pgtype.Int4   `json:"limit"`
 }
 
-func (q *Queries) ListOrderVnpay(ctx context.Context, arg ListOrderVnpayParams) ([]OrderVnpay, error) {
-	rows, err := q.db.Query(ctx, listOrderVnpay,
+func (q *Queries) ListPromotionCashback(ctx context.Context, arg ListPromotionCashbackParams) ([]PromotionCashback, error) {
+	rows, err := q.db.Query(ctx, listPromotionCashback,
 		arg.ID,
 		arg.IDFrom,
 		arg.IDTo,
+		arg.MinSpend,
+		arg.MinSpendFrom,
+		arg.MinSpendTo,
+		arg.MaxCashback,
+		arg.MaxCashbackFrom,
+		arg.MaxCashbackTo,
+		arg.CashbackPercent,
+		arg.CashbackPercentFrom,
+		arg.CashbackPercentTo,
+		arg.CashbackPrice,
+		arg.CashbackPriceFrom,
+		arg.CashbackPriceTo,
 		arg.Offset,
 		arg.Limit,
 	)
@@ -8463,22 +10689,15 @@ func (q *Queries) ListOrderVnpay(ctx context.Context, arg ListOrderVnpayParams)
 		return nil, err
 	}
 	defer rows.Close()
-	items := []OrderVnpay{}
+	items := []PromotionCashback{}
 	for rows.Next() {
-		var i OrderVnpay
+		var i PromotionCashback
 		if err := rows.Scan(
 			&i.ID,
-			&i.VnpAmount,
-			&i.VnpBankCode,
-			&i.VnpCardType,
-			&i.VnpOrderInfo,
-			&i.VnpPayDate,
-			&i.VnpResponseCode,
-			&i.VnpSecureHash,
-			&i.VnpTmnCode,
-			&i.VnpTransactionNo,
-			&i.VnpTransactionStatus,
-			&i.VnpTxnRef,
+			&i.MinSpend,
+			&i.MaxCashback,
+			&i.CashbackPercent,
+			&i.CashbackPrice,
 		); err != nil {
 			return nil, err
 		}
@@ -8490,110 +10709,83 @@ func (q *Queries) ListOrderVnpay(ctx context.Context, arg ListOrderVnpayParams)
 	return items, nil
 }
 
-const listPromotionBase = `-- name: ListPromotionBase :many
-SELECT id, code, owner_id, ref_type, ref_id, type, title, description, is_active, date_started, date_ended, schedule_tz, schedule_start, schedule_duration, date_created, date_updated
-FROM "promotion"."base"
+const listPromotionCashbackCredit = `-- name: ListPromotionCashbackCredit :many
+SELECT id, account_id, order_id, promotion_id, amount, status, date_created, date_updated
+FROM "promotion"."cashback_credit"
 WHERE (
     ("id" = ANY($1) OR $1 IS NULL) AND
     ("id" >= $2 OR $2 IS NULL) AND
     ("id" <= $3 OR $3 IS NULL) AND
-    ("code" = ANY($4) OR $4 IS NULL) AND
-    ("owner_id" = ANY($5) OR $5 IS NULL) AND
-    ("owner_id" >= $6 OR $6 IS NULL) AND
-    ("owner_id" <= $7 OR $7 IS NULL) AND
-    ("ref_type" = ANY($8) OR $8 IS NULL) AND
-    ("ref_id" = ANY($9) OR $9 IS NULL) AND
-    ("ref_id" >= $10 OR $10 IS NULL) AND
-    ("ref_id" <= $11 OR $11 IS NULL) AND
-    ("type" = ANY($12) OR $12 IS NULL) AND
-    ("is_active" = ANY($13) OR $13 IS NULL) AND
-    ("date_started" = ANY($14) OR $14 IS NULL) AND
-    ("date_started" >= $15 OR $15 IS NULL) AND
-    ("date_started" <= $16 OR $16 IS NULL) AND
-    ("date_ended" = ANY($17) OR $17 IS NULL) AND
-    ("date_ended" >= $18 OR $18 IS NULL) AND
-    ("date_ended" <= $19 OR $19 IS NULL) AND
-    ("schedule_start" = ANY($20) OR $20 IS NULL) AND
-    ("schedule_start" >= $21 OR $21 IS NULL) AND
-    ("schedule_start" <= $22 OR $22 IS NULL) AND
-    ("schedule_duration" = ANY($23) OR $23 IS NULL) AND
-    ("schedule_duration" >= $24 OR $24 IS NULL) AND
-    ("schedule_duration" <= $25 OR $25 IS NULL) AND
-    ("date_created" = ANY($26) OR $26 IS NULL) AND
-    ("date_created" >= $27 OR $27 IS NULL) AND
-    ("date_created" <= $28 OR $28 IS NULL) AND
-    ("date_updated" = ANY($29) OR $29 IS NULL) AND
-    ("date_updated" >= $30 OR $30 IS NULL) AND
-    ("date_updated" <= $31 OR $31 IS NULL)
+    ("account_id" = ANY($4) OR $4 IS NULL) AND
+    ("account_id" >= $5 OR $5 IS NULL) AND
+    ("account_id" <= $6 OR $6 IS NULL) AND
+    ("order_id" = ANY($7) OR $7 IS NULL) AND
+    ("order_id" >= $8 OR $8 IS NULL) AND
+    ("order_id" <= $9 OR $9 IS NULL) AND
+    ("promotion_id" = ANY($10) OR $10 IS NULL) AND
+    ("promotion_id" >= $11 OR $11 IS NULL) AND
+    ("promotion_id" <= $12 OR $12 IS NULL) AND
+    ("amount" = ANY($13) OR $13 IS NULL) AND
+    ("amount" >= $14 OR $14 IS NULL) AND
+    ("amount" <= $15 OR $15 IS NULL) AND
+    ("status" = ANY($16) OR $16 IS NULL) AND
+    ("date_created" = ANY($17) OR $17 IS NULL) AND
+    ("date_created" >= $18 OR $18 IS NULL) AND
+    ("date_created" <= $19 OR $19 IS NULL) AND
+    ("date_updated" = ANY($20) OR $20 IS NULL) AND
+    ("date_updated" >= $21 OR $21 IS NULL) AND
+    ("date_updated" <= $22 OR $22 IS NULL)
 )
 ORDER BY "id"
-LIMIT $33
-OFFSET $32
+LIMIT $24
+OFFSET $23
 `
 
-type ListPromotionBaseParams struct {
-	ID                   []int64              `json:"id"`
-	IDFrom               pgtype.Int8          `json:"id_from"`
-	IDTo                 pgtype.Int8          `json:"id_to"`
-	Code                 []string             `json:"code"`
-	OwnerID              []pgtype.Int8        `json:"owner_id"`
-	OwnerIDFrom          pgtype.Int8          `json:"owner_id_from"`
-	OwnerIDTo            pgtype.Int8          `json:"owner_id_to"`
-	RefType              []PromotionRefType   `json:"ref_type"`
-	RefID                []pgtype.Int8        `json:"ref_id"`
-	RefIDFrom            pgtype.Int8          `json:"ref_id_from"`
-	RefIDTo              pgtype.Int8          `json:"ref_id_to"`
-	Type                 []PromotionType      `json:"type"`
-	IsActive             []bool               `json:"is_active"`
-	DateStarted          []pgtype.Timestamptz `json:"date_started"`
-	DateStartedFrom      pgtype.Timestamptz   `json:"date_started_from"`
-	DateStartedTo        pgtype.Timestamptz   `json:"date_started_to"`
-	DateEnded            []pgtype.Timestamptz `json:"date_ended"`
-	DateEndedFrom        pgtype.Timestamptz   `json:"date_ended_from"`
-	DateEndedTo          pgtype.Timestamptz   `json:"date_ended_to"`
-	ScheduleStart        []pgtype.Timestamptz `json:"schedule_start"`
-	ScheduleStartFrom    pgtype.Timestamptz   `json:"schedule_start_from"`
-	ScheduleStartTo      pgtype.Timestamptz   `json:"schedule_start_to"`
-	ScheduleDuration     []pgtype.Int4        `json:"schedule_duration"`
-	ScheduleDurationFrom pgtype.Int4          `json:"schedule_duration_from"`
-	ScheduleDurationTo   pgtype.Int4          `json:"schedule_duration_to"`
-	DateCreated          []pgtype.Timestamptz `json:"date_created"`
-	DateCreatedFrom      pgtype.Timestamptz   `json:"date_created_from"`
-	DateCreatedTo        pgtype.Timestamptz   `json:"date_created_to"`
-	DateUpdated          []pgtype.Timestamptz `json:"date_updated"`
-	DateUpdatedFrom      pgtype.Timestamptz   `json:"date_updated_from"`
-	DateUpdatedTo        pgtype.Timestamptz   `json:"date_updated_to"`
-	Offset               pgtype.Int4          `json:"offset"`
-	Limit                pgtype.Int4          `json:"limit"`
+type ListPromotionCashbackCreditParams struct {
+	ID              []int64              `json:"id"`
+	IDFrom          pgtype.Int8          `json:"id_from"`
+	IDTo            pgtype.Int8          `json:"id_to"`
+	AccountID       []int64              `json:"account_id"`
+	AccountIDFrom   pgtype.Int8          `json:"account_id_from"`
+	AccountIDTo     pgtype.Int8          `json:"account_id_to"`
+	OrderID         []int64              `json:"order_id"`
+	OrderIDFrom     pgtype.Int8          `json:"order_id_from"`
+	OrderIDTo       pgtype.Int8          `json:"order_id_to"`
+	PromotionID     []int64              `json:"promotion_id"`
+	PromotionIDFrom pgtype.Int8          `json:"promotion_id_from"`
+	PromotionIDTo   pgtype.Int8          `json:"promotion_id_to"`
+	Amount          []int64              `json:"amount"`
+	AmountFrom      pgtype.Int8          `json:"amount_from"`
+	AmountTo        pgtype.Int8          `json:"amount_to"`
+	Status          []SharedStatus       `json:"status"`
+	DateCreated     []pgtype.Timestamptz `json:"date_created"`
+	DateCreatedFrom pgtype.Timestamptz   `json:"date_created_from"`
+	DateCreatedTo   pgtype.Timestamptz   `json:"date_created_to"`
+	DateUpdated     []pgtype.Timestamptz `json:"date_updated"`
+	DateUpdatedFrom pgtype.Timestamptz   `json:"date_updated_from"`
+	DateUpdatedTo   pgtype.Timestamptz   `json:"date_updated_to"`
+	Offset          pgtype.Int4          `json:"offset"`
+	Limit           pgtype.Int4          `json:"limit"`
 }
 
-func (q *Queries) ListPromotionBase(ctx context.Context, arg ListPromotionBaseParams) ([]PromotionBase, error) {
-	rows, err := q.db.Query(ctx, listPromotionBase,
+func (q *Queries) ListPromotionCashbackCredit(ctx context.Context, arg ListPromotionCashbackCreditParams) ([]PromotionCashbackCredit, error) {
+	rows, err := q.db.Query(ctx, listPromotionCashbackCredit,
 		arg.ID,
 		arg.IDFrom,
 		arg.IDTo,
-		arg.Code,
-		arg.OwnerID,
-		arg.OwnerIDFrom,
-		arg.OwnerIDTo,
-		arg.RefType,
-		arg.RefID,
-		arg.RefIDFrom,
-		arg.RefIDTo,
-		arg.Type,
-		arg.IsActive,
-		arg.DateStarted,
-		arg.DateStartedFrom,
-		arg.DateStartedTo,
-		arg.DateEnded,
-		arg.DateEndedFrom,
-		arg.DateEndedTo,
-		arg.ScheduleStart,
-		arg.ScheduleStartFrom,
-		arg.ScheduleStartTo,
-		arg.ScheduleDuration,
-		arg.ScheduleDurationFrom,
-		arg.ScheduleDurationTo,
+		arg.AccountID,
+		arg.AccountIDFrom,
+		arg.AccountIDTo,
+		arg.OrderID,
+		arg.OrderIDFrom,
+		arg.OrderIDTo,
+		arg.PromotionID,
+		arg.PromotionIDFrom,
+		arg.PromotionIDTo,
+		arg.Amount,
+		arg.AmountFrom,
+		arg.AmountTo,
+		arg.Status,
 		arg.DateCreated,
 		arg.DateCreatedFrom,
 		arg.DateCreatedTo,
@@ -8607,24 +10799,16 @@ func (q *Queries) ListPromotionBase(ctx context.Context, arg ListPromotionBasePa
 		return nil, err
 	}
 	defer rows.Close()
-	items := []PromotionBase{}
+	items := []PromotionCashbackCredit{}
 	for rows.Next() {
-		var i PromotionBase
+		var i PromotionCashbackCredit
 		if err := rows.Scan(
 			&i.ID,
-			&i.Code,
-			&i.OwnerID,
-			&i.RefType,
-			&i.RefID,
-			&i.Type,
-			&i.Title,
-			&i.Description,
-			&i.IsActive,
-			&i.DateStarted,
-			&i.DateEnded,
-			&i.ScheduleTz,
-			&i.ScheduleStart,
-			&i.ScheduleDuration,
+			&i.AccountID,
+			&i.OrderID,
+			&i.PromotionID,
+			&i.Amount,
+			&i.Status,
 			&i.DateCreated,
 			&i.DateUpdated,
 		); err != nil {
@@ -8685,24 +10869,219 @@ type ListPromotionDiscountParams struct {
 	Limit               pgtype.Int4   `json:"limit"`
 }
 
-func (q *Queries) ListPromotionDiscount(ctx context.Context, arg ListPromotionDiscountParams) ([]PromotionDiscount, error) {
-	rows, err := q.db.Query(ctx, listPromotionDiscount,
+func (q *Queries) ListPromotionDiscount(ctx context.Context, arg ListPromotionDiscountParams) ([]PromotionDiscount, error) {
+	rows, err := q.db.Query(ctx, listPromotionDiscount,
+		arg.ID,
+		arg.IDFrom,
+		arg.IDTo,
+		arg.OrderWide,
+		arg.MinSpend,
+		arg.MinSpendFrom,
+		arg.MinSpendTo,
+		arg.MaxDiscount,
+		arg.MaxDiscountFrom,
+		arg.MaxDiscountTo,
+		arg.DiscountPercent,
+		arg.DiscountPercentFrom,
+		arg.DiscountPercentTo,
+		arg.DiscountPrice,
+		arg.DiscountPriceFrom,
+		arg.DiscountPriceTo,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PromotionDiscount{}
+	for rows.Next() {
+		var i PromotionDiscount
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrderWide,
+			&i.MinSpend,
+			&i.MaxDiscount,
+			&i.DiscountPercent,
+			&i.DiscountPrice,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPromotionVoucher = `-- name: ListPromotionVoucher :many
+SELECT id, usage_limit, per_customer_limit, first_order_only, min_spend, used
+FROM "promotion"."voucher"
+WHERE (
+    ("id" = ANY($1) OR $1 IS NULL) AND
+    ("id" >= $2 OR $2 IS NULL) AND
+    ("id" <= $3 OR $3 IS NULL) AND
+    ("usage_limit" = ANY($4) OR $4 IS NULL) AND
+    ("usage_limit" >= $5 OR $5 IS NULL) AND
+    ("usage_limit" <= $6 OR $6 IS NULL) AND
+    ("per_customer_limit" = ANY($7) OR $7 IS NULL) AND
+    ("per_customer_limit" >= $8 OR $8 IS NULL) AND
+    ("per_customer_limit" <= $9 OR $9 IS NULL) AND
+    ("first_order_only" = ANY($10) OR $10 IS NULL) AND
+    ("min_spend" = ANY($11) OR $11 IS NULL) AND
+    ("min_spend" >= $12 OR $12 IS NULL) AND
+    ("min_spend" <= $13 OR $13 IS NULL) AND
+    ("used" = ANY($14) OR $14 IS NULL) AND
+    ("used" >= $15 OR $15 IS NULL) AND
+    ("used" <= $16 OR $16 IS NULL)
+)
+ORDER BY "id"
+LIMIT $18
+OFFSET $17
+`
+
+type ListPromotionVoucherParams struct {
+	ID                   []int64     `json:"id"`
+	IDFrom               pgtype.Int8 `json:"id_from"`
+	IDTo                 pgtype.Int8 `json:"id_to"`
+	UsageLimit           []int64     `json:"usage_limit"`
+	UsageLimitFrom       pgtype.Int8 `json:"usage_limit_from"`
+	UsageLimitTo         pgtype.Int8 `json:"usage_limit_to"`
+	PerCustomerLimit     []int64     `json:"per_customer_limit"`
+	PerCustomerLimitFrom pgtype.Int8 `json:"per_customer_limit_from"`
+	PerCustomerLimitTo   pgtype.Int8 `json:"per_customer_limit_to"`
+	FirstOrderOnly       []bool      `json:"first_order_only"`
+	MinSpend             []int64     `json:"min_spend"`
+	MinSpendFrom         pgtype.Int8 `json:"min_spend_from"`
+	MinSpendTo           pgtype.Int8 `json:"min_spend_to"`
+	Used                 []int64     `json:"used"`
+	UsedFrom             pgtype.Int8 `json:"used_from"`
+	UsedTo               pgtype.Int8 `json:"used_to"`
+	Offset               pgtype.Int4 `json:"offset"`
+	Limit                pgtype.Int4 `json:"limit"`
+}
+
+func (q *Queries) ListPromotionVoucher(ctx context.Context, arg ListPromotionVoucherParams) ([]PromotionVoucher, error) {
+	rows, err := q.db.Query(ctx, listPromotionVoucher,
+		arg.ID,
+		arg.IDFrom,
+		arg.IDTo,
+		arg.UsageLimit,
+		arg.UsageLimitFrom,
+		arg.UsageLimitTo,
+		arg.PerCustomerLimit,
+		arg.PerCustomerLimitFrom,
+		arg.PerCustomerLimitTo,
+		arg.FirstOrderOnly,
+		arg.MinSpend,
+		arg.MinSpendFrom,
+		arg.MinSpendTo,
+		arg.Used,
+		arg.UsedFrom,
+		arg.UsedTo,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PromotionVoucher{}
+	for rows.Next() {
+		var i PromotionVoucher
+		if err := rows.Scan(
+			&i.ID,
+			&i.UsageLimit,
+			&i.PerCustomerLimit,
+			&i.FirstOrderOnly,
+			&i.MinSpend,
+			&i.Used,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPromotionVoucherRedemption = `-- name: ListPromotionVoucherRedemption :many
+SELECT id, voucher_id, account_id, order_id, status, date_created, date_updated
+FROM "promotion"."voucher_redemption"
+WHERE (
+    ("id" = ANY($1) OR $1 IS NULL) AND
+    ("id" >= $2 OR $2 IS NULL) AND
+    ("id" <= $3 OR $3 IS NULL) AND
+    ("voucher_id" = ANY($4) OR $4 IS NULL) AND
+    ("voucher_id" >= $5 OR $5 IS NULL) AND
+    ("voucher_id" <= $6 OR $6 IS NULL) AND
+    ("account_id" = ANY($7) OR $7 IS NULL) AND
+    ("account_id" >= $8 OR $8 IS NULL) AND
+    ("account_id" <= $9 OR $9 IS NULL) AND
+    ("order_id" = ANY($10) OR $10 IS NULL) AND
+    ("order_id" >= $11 OR $11 IS NULL) AND
+    ("order_id" <= $12 OR $12 IS NULL) AND
+    ("status" = ANY($13) OR $13 IS NULL) AND
+    ("date_created" = ANY($14) OR $14 IS NULL) AND
+    ("date_created" >= $15 OR $15 IS NULL) AND
+    ("date_created" <= $16 OR $16 IS NULL) AND
+    ("date_updated" = ANY($17) OR $17 IS NULL) AND
+    ("date_updated" >= $18 OR $18 IS NULL) AND
+    ("date_updated" <= $19 OR $19 IS NULL)
+)
+ORDER BY "id"
+LIMIT $21
+OFFSET $20
+`
+
+type ListPromotionVoucherRedemptionParams struct {
+	ID              []int64              `json:"id"`
+	IDFrom          pgtype.Int8          `json:"id_from"`
+	IDTo            pgtype.Int8          `json:"id_to"`
+	VoucherID       []int64              `json:"voucher_id"`
+	VoucherIDFrom   pgtype.Int8          `json:"voucher_id_from"`
+	VoucherIDTo     pgtype.Int8          `json:"voucher_id_to"`
+	AccountID       []int64              `json:"account_id"`
+	AccountIDFrom   pgtype.Int8          `json:"account_id_from"`
+	AccountIDTo     pgtype.Int8          `json:"account_id_to"`
+	OrderID         []int64              `json:"order_id"`
+	OrderIDFrom     pgtype.Int8          `json:"order_id_from"`
+	OrderIDTo       pgtype.Int8          `json:"order_id_to"`
+	Status          []SharedStatus       `json:"status"`
+	DateCreated     []pgtype.Timestamptz `json:"date_created"`
+	DateCreatedFrom pgtype.Timestamptz   `json:"date_created_from"`
+	DateCreatedTo   pgtype.Timestamptz   `json:"date_created_to"`
+	DateUpdated     []pgtype.Timestamptz `json:"date_updated"`
+	DateUpdatedFrom pgtype.Timestamptz   `json:"date_updated_from"`
+	DateUpdatedTo   pgtype.Timestamptz   `json:"date_updated_to"`
+	Offset          pgtype.Int4          `json:"offset"`
+	Limit           pgtype.Int4          `json:"limit"`
+}
+
+func (q *Queries) ListPromotionVoucherRedemption(ctx context.Context, arg ListPromotionVoucherRedemptionParams) ([]PromotionVoucherRedemption, error) {
+	rows, err := q.db.Query(ctx, listPromotionVoucherRedemption,
 		arg.ID,
 		arg.IDFrom,
 		arg.IDTo,
-		arg.OrderWide,
-		arg.MinSpend,
-		arg.MinSpendFrom,
-		arg.MinSpendTo,
-		arg.MaxDiscount,
-		arg.MaxDiscountFrom,
-		arg.MaxDiscountTo,
-		arg.DiscountPercent,
-		arg.DiscountPercentFrom,
-		arg.DiscountPercentTo,
-		arg.DiscountPrice,
-		arg.DiscountPriceFrom,
-		arg.DiscountPriceTo,
+		arg.VoucherID,
+		arg.VoucherIDFrom,
+		arg.VoucherIDTo,
+		arg.AccountID,
+		arg.AccountIDFrom,
+		arg.AccountIDTo,
+		arg.OrderID,
+		arg.OrderIDFrom,
+		arg.OrderIDTo,
+		arg.Status,
+		arg.DateCreated,
+		arg.DateCreatedFrom,
+		arg.DateCreatedTo,
+		arg.DateUpdated,
+		arg.DateUpdatedFrom,
+		arg.DateUpdatedTo,
 		arg.Offset,
 		arg.Limit,
 	)
@@ -8710,16 +11089,17 @@ func (q *Queries) ListPromotionDiscount(ctx context.Context, arg ListPromotionDi
 		return nil, err
 	}
 	defer rows.Close()
-	items := []PromotionDiscount{}
+	items := []PromotionVoucherRedemption{}
 	for rows.Next() {
-		var i PromotionDiscount
+		var i PromotionVoucherRedemption
 		if err := rows.Scan(
 			&i.ID,
-			&i.OrderWide,
-			&i.MinSpend,
-			&i.MaxDiscount,
-			&i.DiscountPercent,
-			&i.DiscountPrice,
+			&i.VoucherID,
+			&i.AccountID,
+			&i.OrderID,
+			&i.Status,
+			&i.DateCreated,
+			&i.DateUpdated,
 		); err != nil {
 			return nil, err
 		}
@@ -9745,18 +12125,26 @@ SET "ref_type" = COALESCE($1, "ref_type"),
     "ref_id" = COALESCE($2, "ref_id"),
     "current_stock" = COALESCE($3, "current_stock"),
     "sold" = COALESCE($4, "sold"),
-    "date_created" = COALESCE($5, "date_created")
-WHERE ("id" = $6) OR ("ref_id" = $2 AND "ref_type" = $1)
-RETURNING id, ref_type, ref_id, current_stock, sold, date_created
+    "low_stock_threshold" = COALESCE($5, "low_stock_threshold"),
+    "alert" = CASE WHEN $6::bool = TRUE THEN NULL ELSE COALESCE($7, "alert") END,
+    "date_alerted" = CASE WHEN $8::bool = TRUE THEN NULL ELSE COALESCE($9, "date_alerted") END,
+    "date_created" = COALESCE($10, "date_created")
+WHERE ("id" = $11) OR ("ref_id" = $2 AND "ref_type" = $1)
+RETURNING id, ref_type, ref_id, current_stock, sold, low_stock_threshold, alert, date_alerted, date_created
 `
 
 type UpdateInventoryStockParams struct {
-	RefType      NullInventoryStockType `json:"ref_type"`
-	RefID        pgtype.Int8            `json:"ref_id"`
-	CurrentStock pgtype.Int8            `json:"current_stock"`
-	Sold         pgtype.Int8            `json:"sold"`
-	DateCreated  pgtype.Timestamptz     `json:"date_created"`
-	ID           pgtype.Int8            `json:"id"`
+	RefType           NullInventoryStockType  `json:"ref_type"`
+	RefID             pgtype.Int8             `json:"ref_id"`
+	CurrentStock      pgtype.Int8             `json:"current_stock"`
+	Sold              pgtype.Int8             `json:"sold"`
+	LowStockThreshold pgtype.Int8             `json:"low_stock_threshold"`
+	NullAlert         bool                    `json:"null_alert"`
+	Alert             NullInventoryStockAlert `json:"alert"`
+	NullDateAlerted   bool                    `json:"null_date_alerted"`
+	DateAlerted       pgtype.Timestamptz      `json:"date_alerted"`
+	DateCreated       pgtype.Timestamptz      `json:"date_created"`
+	ID                pgtype.Int8             `json:"id"`
 }
 
 func (q *Queries) UpdateInventoryStock(ctx context.Context, arg UpdateInventoryStockParams) (InventoryStock, error) {
@@ -9765,6 +12153,11 @@ func (q *Queries) UpdateInventoryStock(ctx context.Context, arg UpdateInventoryS
 		arg.RefID,
 		arg.CurrentStock,
 		arg.Sold,
+		arg.LowStockThreshold,
+		arg.NullAlert,
+		arg.Alert,
+		arg.NullDateAlerted,
+		arg.DateAlerted,
 		arg.DateCreated,
 		arg.ID,
 	)
@@ -9775,6 +12168,9 @@ func (q *Queries) UpdateInventoryStock(ctx context.Context, arg UpdateInventoryS
 		&i.RefID,
 		&i.CurrentStock,
 		&i.Sold,
+		&i.LowStockThreshold,
+		&i.Alert,
+		&i.DateAlerted,
 		&i.DateCreated,
 	)
 	return i, err
@@ -9784,22 +12180,32 @@ const updateInventoryStockHistory = `-- name: UpdateInventoryStockHistory :one
 UPDATE "inventory"."stock_history"
 SET "stock_id" = COALESCE($1, "stock_id"),
     "change" = COALESCE($2, "change"),
-    "date_created" = COALESCE($3, "date_created")
-WHERE ("id" = $4)
-RETURNING id, stock_id, change, date_created
+    "account_id" = CASE WHEN $3::bool = TRUE THEN NULL ELSE COALESCE($4, "account_id") END,
+    "reason" = CASE WHEN $5::bool = TRUE THEN NULL ELSE COALESCE($6, "reason") END,
+    "date_created" = COALESCE($7, "date_created")
+WHERE ("id" = $8)
+RETURNING id, stock_id, change, account_id, reason, date_created
 `
 
 type UpdateInventoryStockHistoryParams struct {
-	StockID     pgtype.Int8        `json:"stock_id"`
-	Change      pgtype.Int8        `json:"change"`
-	DateCreated pgtype.Timestamptz `json:"date_created"`
-	ID          pgtype.Int8        `json:"id"`
+	StockID       pgtype.Int8        `json:"stock_id"`
+	Change        pgtype.Int8        `json:"change"`
+	NullAccountID bool               `json:"null_account_id"`
+	AccountID     pgtype.Int8        `json:"account_id"`
+	NullReason    bool               `json:"null_reason"`
+	Reason        pgtype.Text        `json:"reason"`
+	DateCreated   pgtype.Timestamptz `json:"date_created"`
+	ID            pgtype.Int8        `json:"id"`
 }
 
 func (q *Queries) UpdateInventoryStockHistory(ctx context.Context, arg UpdateInventoryStockHistoryParams) (InventoryStockHistory, error) {
 	row := q.db.QueryRow(ctx, updateInventoryStockHistory,
 		arg.StockID,
 		arg.Change,
+		arg.NullAccountID,
+		arg.AccountID,
+		arg.NullReason,
+		arg.Reason,
 		arg.DateCreated,
 		arg.ID,
 	)
@@ -9808,7 +12214,62 @@ func (q *Queries) UpdateInventoryStockHistory(ctx context.Context, arg UpdateInv
 		&i.ID,
 		&i.StockID,
 		&i.Change,
+		&i.AccountID,
+		&i.Reason,
+		&i.DateCreated,
+	)
+	return i, err
+}
+
+const updateInventoryStockReservation = `-- name: UpdateInventoryStockReservation :one
+UPDATE "inventory"."stock_reservation"
+SET "stock_id" = COALESCE($1, "stock_id"),
+    "ref_type" = COALESCE($2, "ref_type"),
+    "ref_id" = COALESCE($3, "ref_id"),
+    "quantity" = COALESCE($4, "quantity"),
+    "status" = COALESCE($5, "status"),
+    "date_expired" = COALESCE($6, "date_expired"),
+    "date_created" = COALESCE($7, "date_created"),
+    "date_updated" = COALESCE($8, "date_updated")
+WHERE ("id" = $9)
+RETURNING id, stock_id, ref_type, ref_id, quantity, status, date_expired, date_created, date_updated
+`
+
+type UpdateInventoryStockReservationParams struct {
+	StockID     pgtype.Int8                  `json:"stock_id"`
+	RefType     NullInventoryReservationType `json:"ref_type"`
+	RefID       pgtype.Int8                  `json:"ref_id"`
+	Quantity    pgtype.Int8                  `json:"quantity"`
+	Status      NullSharedStatus             `json:"status"`
+	DateExpired pgtype.Timestamptz           `json:"date_expired"`
+	DateCreated pgtype.Timestamptz           `json:"date_created"`
+	DateUpdated pgtype.Timestamptz           `json:"date_updated"`
+	ID          pgtype.Int8                  `json:"id"`
+}
+
+func (q *Queries) UpdateInventoryStockReservation(ctx context.Context, arg UpdateInventoryStockReservationParams) (InventoryStockReservation, error) {
+	row := q.db.QueryRow(ctx, updateInventoryStockReservation,
+		arg.StockID,
+		arg.RefType,
+		arg.RefID,
+		arg.Quantity,
+		arg.Status,
+		arg.DateExpired,
+		arg.DateCreated,
+		arg.DateUpdated,
+		arg.ID,
+	)
+	var i InventoryStockReservation
+	err := row.Scan(
+		&i.ID,
+		&i.StockID,
+		&i.RefType,
+		&i.RefID,
+		&i.Quantity,
+		&i.Status,
+		&i.DateExpired,
 		&i.DateCreated,
+		&i.DateUpdated,
 	)
 	return i, err
 }
@@ -9999,17 +12460,23 @@ UPDATE "order"."item"
 SET "code" = COALESCE($1, "code"),
     "order_id" = COALESCE($2, "order_id"),
     "sku_id" = COALESCE($3, "sku_id"),
-    "quantity" = COALESCE($4, "quantity")
-WHERE ("id" = $5) OR ("code" = $1)
-RETURNING id, code, order_id, sku_id, quantity
+    "quantity" = COALESCE($4, "quantity"),
+    "unit_price" = COALESCE($5, "unit_price"),
+    "subtotal" = COALESCE($6, "subtotal"),
+    "total" = COALESCE($7, "total")
+WHERE ("id" = $8) OR ("code" = $1)
+RETURNING id, code, order_id, sku_id, quantity, unit_price, subtotal, total
 `
 
 type UpdateOrderItemParams struct {
-	Code     pgtype.Text `json:"code"`
-	OrderID  pgtype.Int8 `json:"order_id"`
-	SkuID    pgtype.Int8 `json:"sku_id"`
-	Quantity pgtype.Int8 `json:"quantity"`
-	ID       pgtype.Int8 `json:"id"`
+	Code      pgtype.Text `json:"code"`
+	OrderID   pgtype.Int8 `json:"order_id"`
+	SkuID     pgtype.Int8 `json:"sku_id"`
+	Quantity  pgtype.Int8 `json:"quantity"`
+	UnitPrice pgtype.Int8 `json:"unit_price"`
+	Subtotal  pgtype.Int8 `json:"subtotal"`
+	Total     pgtype.Int8 `json:"total"`
+	ID        pgtype.Int8 `json:"id"`
 }
 
 func (q *Queries) UpdateOrderItem(ctx context.Context, arg UpdateOrderItemParams) (OrderItem, error) {
@@ -10018,6 +12485,9 @@ func (q *Queries) UpdateOrderItem(ctx context.Context, arg UpdateOrderItemParams
 		arg.OrderID,
 		arg.SkuID,
 		arg.Quantity,
+		arg.UnitPrice,
+		arg.Subtotal,
+		arg.Total,
 		arg.ID,
 	)
 	var i OrderItem
@@ -10027,6 +12497,9 @@ func (q *Queries) UpdateOrderItem(ctx context.Context, arg UpdateOrderItemParams
 		&i.OrderID,
 		&i.SkuID,
 		&i.Quantity,
+		&i.UnitPrice,
+		&i.Subtotal,
+		&i.Total,
 	)
 	return i, err
 }
@@ -10162,22 +12635,25 @@ const updateOrderVnpay = `-- name: UpdateOrderVnpay :one
 UPDATE "order"."vnpay"
 SET "vnp_Amount" = COALESCE($1, "vnp_Amount"),
     "vnp_BankCode" = COALESCE($2, "vnp_BankCode"),
-    "vnp_CardType" = COALESCE($3, "vnp_CardType"),
-    "vnp_OrderInfo" = COALESCE($4, "vnp_OrderInfo"),
-    "vnp_PayDate" = COALESCE($5, "vnp_PayDate"),
-    "vnp_ResponseCode" = COALESCE($6, "vnp_ResponseCode"),
-    "vnp_SecureHash" = COALESCE($7, "vnp_SecureHash"),
-    "vnp_TmnCode" = COALESCE($8, "vnp_TmnCode"),
-    "vnp_TransactionNo" = COALESCE($9, "vnp_TransactionNo"),
-    "vnp_TransactionStatus" = COALESCE($10, "vnp_TransactionStatus"),
-    "vnp_TxnRef" = COALESCE($11, "vnp_TxnRef")
-WHERE ("id" = $12)
-RETURNING id, "vnp_Amount", "vnp_BankCode", "vnp_CardType", "vnp_OrderInfo", "vnp_PayDate", "vnp_ResponseCode", "vnp_SecureHash", "vnp_TmnCode", "vnp_TransactionNo", "vnp_TransactionStatus", "vnp_TxnRef"
+    "vnp_BankTranNo" = CASE WHEN $3::bool = TRUE THEN NULL ELSE COALESCE($4, "vnp_BankTranNo") END,
+    "vnp_CardType" = COALESCE($5, "vnp_CardType"),
+    "vnp_OrderInfo" = COALESCE($6, "vnp_OrderInfo"),
+    "vnp_PayDate" = COALESCE($7, "vnp_PayDate"),
+    "vnp_ResponseCode" = COALESCE($8, "vnp_ResponseCode"),
+    "vnp_SecureHash" = COALESCE($9, "vnp_SecureHash"),
+    "vnp_TmnCode" = COALESCE($10, "vnp_TmnCode"),
+    "vnp_TransactionNo" = COALESCE($11, "vnp_TransactionNo"),
+    "vnp_TransactionStatus" = COALESCE($12, "vnp_TransactionStatus"),
+    "vnp_TxnRef" = COALESCE($13, "vnp_TxnRef")
+WHERE ("id" = $14)
+RETURNING id, "vnp_Amount", "vnp_BankCode", "vnp_BankTranNo", "vnp_CardType", "vnp_OrderInfo", "vnp_PayDate", "vnp_ResponseCode", "vnp_SecureHash", "vnp_TmnCode", "vnp_TransactionNo", "vnp_TransactionStatus", "vnp_TxnRef"
 `
 
 type UpdateOrderVnpayParams struct {
 	VnpAmount            pgtype.Text `json:"vnp_Amount"`
 	VnpBankCode          pgtype.Text `json:"vnp_BankCode"`
+	NullVnpBankTranNo    bool        `json:"null_vnp_BankTranNo"`
+	VnpBankTranNo        pgtype.Text `json:"vnp_BankTranNo"`
 	VnpCardType          pgtype.Text `json:"vnp_CardType"`
 	VnpOrderInfo         pgtype.Text `json:"vnp_OrderInfo"`
 	VnpPayDate           pgtype.Text `json:"vnp_PayDate"`
@@ -10194,6 +12670,8 @@ func (q *Queries) UpdateOrderVnpay(ctx context.Context, arg UpdateOrderVnpayPara
 	row := q.db.QueryRow(ctx, updateOrderVnpay,
 		arg.VnpAmount,
 		arg.VnpBankCode,
+		arg.NullVnpBankTranNo,
+		arg.VnpBankTranNo,
 		arg.VnpCardType,
 		arg.VnpOrderInfo,
 		arg.VnpPayDate,
@@ -10210,6 +12688,7 @@ func (q *Queries) UpdateOrderVnpay(ctx context.Context, arg UpdateOrderVnpayPara
 		&i.ID,
 		&i.VnpAmount,
 		&i.VnpBankCode,
+		&i.VnpBankTranNo,
 		&i.VnpCardType,
 		&i.VnpOrderInfo,
 		&i.VnpPayDate,
@@ -10233,15 +12712,16 @@ SET "code" = COALESCE($1, "code"),
     "title" = COALESCE($8, "title"),
     "description" = CASE WHEN $9::bool = TRUE THEN NULL ELSE COALESCE($10, "description") END,
     "is_active" = COALESCE($11, "is_active"),
-    "date_started" = COALESCE($12, "date_started"),
-    "date_ended" = CASE WHEN $13::bool = TRUE THEN NULL ELSE COALESCE($14, "date_ended") END,
-    "schedule_tz" = CASE WHEN $15::bool = TRUE THEN NULL ELSE COALESCE($16, "schedule_tz") END,
-    "schedule_start" = CASE WHEN $17::bool = TRUE THEN NULL ELSE COALESCE($18, "schedule_start") END,
-    "schedule_duration" = CASE WHEN $19::bool = TRUE THEN NULL ELSE COALESCE($20, "schedule_duration") END,
-    "date_created" = COALESCE($21, "date_created"),
-    "date_updated" = COALESCE($22, "date_updated")
-WHERE ("id" = $23) OR ("code" = $1)
-RETURNING id, code, owner_id, ref_type, ref_id, type, title, description, is_active, date_started, date_ended, schedule_tz, schedule_start, schedule_duration, date_created, date_updated
+    "exclusive" = COALESCE($12, "exclusive"),
+    "date_started" = COALESCE($13, "date_started"),
+    "date_ended" = CASE WHEN $14::bool = TRUE THEN NULL ELSE COALESCE($15, "date_ended") END,
+    "schedule_tz" = CASE WHEN $16::bool = TRUE THEN NULL ELSE COALESCE($17, "schedule_tz") END,
+    "schedule_start" = CASE WHEN $18::bool = TRUE THEN NULL ELSE COALESCE($19, "schedule_start") END,
+    "schedule_duration" = CASE WHEN $20::bool = TRUE THEN NULL ELSE COALESCE($21, "schedule_duration") END,
+    "date_created" = COALESCE($22, "date_created"),
+    "date_updated" = COALESCE($23, "date_updated")
+WHERE ("id" = $24) OR ("code" = $1)
+RETURNING id, code, owner_id, ref_type, ref_id, type, title, description, is_active, exclusive, date_started, date_ended, schedule_tz, schedule_start, schedule_duration, date_created, date_updated
 `
 
 type UpdatePromotionBaseParams struct {
@@ -10256,13 +12736,14 @@ type UpdatePromotionBaseParams struct {
 	NullDescription      bool                 `json:"null_description"`
 	Description          pgtype.Text          `json:"description"`
 	IsActive             pgtype.Bool          `json:"is_active"`
+	Exclusive            pgtype.Bool          `json:"exclusive"`
 	DateStarted          pgtype.Timestamptz   `json:"date_started"`
 	NullDateEnded        bool                 `json:"null_date_ended"`
 	DateEnded            pgtype.Timestamptz   `json:"date_ended"`
 	NullScheduleTz       bool                 `json:"null_schedule_tz"`
 	ScheduleTz           pgtype.Text          `json:"schedule_tz"`
 	NullScheduleStart    bool                 `json:"null_schedule_start"`
-	ScheduleStart        pgtype.Timestamptz   `json:"schedule_start"`
+	ScheduleStart        pgtype.Text          `json:"schedule_start"`
 	NullScheduleDuration bool                 `json:"null_schedule_duration"`
 	ScheduleDuration     pgtype.Int4          `json:"schedule_duration"`
 	DateCreated          pgtype.Timestamptz   `json:"date_created"`
@@ -10283,6 +12764,7 @@ func (q *Queries) UpdatePromotionBase(ctx context.Context, arg UpdatePromotionBa
 		arg.NullDescription,
 		arg.Description,
 		arg.IsActive,
+		arg.Exclusive,
 		arg.DateStarted,
 		arg.NullDateEnded,
 		arg.DateEnded,
@@ -10307,6 +12789,7 @@ func (q *Queries) UpdatePromotionBase(ctx context.Context, arg UpdatePromotionBa
 		&i.Title,
 		&i.Description,
 		&i.IsActive,
+		&i.Exclusive,
 		&i.DateStarted,
 		&i.DateEnded,
 		&i.ScheduleTz,
@@ -10318,6 +12801,169 @@ func (q *Queries) UpdatePromotionBase(ctx context.Context, arg UpdatePromotionBa
 	return i, err
 }
 
+const updatePromotionBundle = `-- name: UpdatePromotionBundle :one
+UPDATE "promotion"."bundle"
+SET "price" = COALESCE($1, "price")
+WHERE ("id" = $2)
+RETURNING id, price
+`
+
+type UpdatePromotionBundleParams struct {
+	Price pgtype.Int8 `json:"price"`
+	ID    pgtype.Int8 `json:"id"`
+}
+
+func (q *Queries) UpdatePromotionBundle(ctx context.Context, arg UpdatePromotionBundleParams) (PromotionBundle, error) {
+	row := q.db.QueryRow(ctx, updatePromotionBundle, arg.Price, arg.ID)
+	var i PromotionBundle
+	err := row.Scan(&i.ID, &i.Price)
+	return i, err
+}
+
+const updatePromotionBundleItem = `-- name: UpdatePromotionBundleItem :one
+UPDATE "promotion"."bundle_item"
+SET "bundle_id" = COALESCE($1, "bundle_id"),
+    "sku_id" = COALESCE($2, "sku_id"),
+    "quantity" = COALESCE($3, "quantity")
+WHERE ("id" = $4) OR ("bundle_id" = $1 AND "sku_id" = $2)
+RETURNING id, bundle_id, sku_id, quantity
+`
+
+type UpdatePromotionBundleItemParams struct {
+	BundleID pgtype.Int8 `json:"bundle_id"`
+	SkuID    pgtype.Int8 `json:"sku_id"`
+	Quantity pgtype.Int8 `json:"quantity"`
+	ID       pgtype.Int8 `json:"id"`
+}
+
+func (q *Queries) UpdatePromotionBundleItem(ctx context.Context, arg UpdatePromotionBundleItemParams) (PromotionBundleItem, error) {
+	row := q.db.QueryRow(ctx, updatePromotionBundleItem,
+		arg.BundleID,
+		arg.SkuID,
+		arg.Quantity,
+		arg.ID,
+	)
+	var i PromotionBundleItem
+	err := row.Scan(
+		&i.ID,
+		&i.BundleID,
+		&i.SkuID,
+		&i.Quantity,
+	)
+	return i, err
+}
+
+const updatePromotionBuyXGetY = `-- name: UpdatePromotionBuyXGetY :one
+UPDATE "promotion"."buy_x_get_y"
+SET "buy_quantity" = COALESCE($1, "buy_quantity"),
+    "get_quantity" = COALESCE($2, "get_quantity")
+WHERE ("id" = $3)
+RETURNING id, buy_quantity, get_quantity
+`
+
+type UpdatePromotionBuyXGetYParams struct {
+	BuyQuantity pgtype.Int8 `json:"buy_quantity"`
+	GetQuantity pgtype.Int8 `json:"get_quantity"`
+	ID          pgtype.Int8 `json:"id"`
+}
+
+func (q *Queries) UpdatePromotionBuyXGetY(ctx context.Context, arg UpdatePromotionBuyXGetYParams) (PromotionBuyXGetY, error) {
+	row := q.db.QueryRow(ctx, updatePromotionBuyXGetY, arg.BuyQuantity, arg.GetQuantity, arg.ID)
+	var i PromotionBuyXGetY
+	err := row.Scan(&i.ID, &i.BuyQuantity, &i.GetQuantity)
+	return i, err
+}
+
+const updatePromotionCashback = `-- name: UpdatePromotionCashback :one
+UPDATE "promotion"."cashback"
+SET "min_spend" = COALESCE($1, "min_spend"),
+    "max_cashback" = COALESCE($2, "max_cashback"),
+    "cashback_percent" = CASE WHEN $3::bool = TRUE THEN NULL ELSE COALESCE($4, "cashback_percent") END,
+    "cashback_price" = CASE WHEN $5::bool = TRUE THEN NULL ELSE COALESCE($6, "cashback_price") END
+WHERE ("id" = $7)
+RETURNING id, min_spend, max_cashback, cashback_percent, cashback_price
+`
+
+type UpdatePromotionCashbackParams struct {
+	MinSpend            pgtype.Int8 `json:"min_spend"`
+	MaxCashback         pgtype.Int8 `json:"max_cashback"`
+	NullCashbackPercent bool        `json:"null_cashback_percent"`
+	CashbackPercent     pgtype.Int4 `json:"cashback_percent"`
+	NullCashbackPrice   bool        `json:"null_cashback_price"`
+	CashbackPrice       pgtype.Int8 `json:"cashback_price"`
+	ID                  pgtype.Int8 `json:"id"`
+}
+
+func (q *Queries) UpdatePromotionCashback(ctx context.Context, arg UpdatePromotionCashbackParams) (PromotionCashback, error) {
+	row := q.db.QueryRow(ctx, updatePromotionCashback,
+		arg.MinSpend,
+		arg.MaxCashback,
+		arg.NullCashbackPercent,
+		arg.CashbackPercent,
+		arg.NullCashbackPrice,
+		arg.CashbackPrice,
+		arg.ID,
+	)
+	var i PromotionCashback
+	err := row.Scan(
+		&i.ID,
+		&i.MinSpend,
+		&i.MaxCashback,
+		&i.CashbackPercent,
+		&i.CashbackPrice,
+	)
+	return i, err
+}
+
+const updatePromotionCashbackCredit = `-- name: UpdatePromotionCashbackCredit :one
+UPDATE "promotion"."cashback_credit"
+SET "account_id" = COALESCE($1, "account_id"),
+    "order_id" = COALESCE($2, "order_id"),
+    "promotion_id" = COALESCE($3, "promotion_id"),
+    "amount" = COALESCE($4, "amount"),
+    "status" = COALESCE($5, "status"),
+    "date_created" = COALESCE($6, "date_created"),
+    "date_updated" = COALESCE($7, "date_updated")
+WHERE ("id" = $8) OR ("order_id" = $2 AND "promotion_id" = $3)
+RETURNING id, account_id, order_id, promotion_id, amount, status, date_created, date_updated
+`
+
+type UpdatePromotionCashbackCreditParams struct {
+	AccountID   pgtype.Int8        `json:"account_id"`
+	OrderID     pgtype.Int8        `json:"order_id"`
+	PromotionID pgtype.Int8        `json:"promotion_id"`
+	Amount      pgtype.Int8        `json:"amount"`
+	Status      NullSharedStatus   `json:"status"`
+	DateCreated pgtype.Timestamptz `json:"date_created"`
+	DateUpdated pgtype.Timestamptz `json:"date_updated"`
+	ID          pgtype.Int8        `json:"id"`
+}
+
+func (q *Queries) UpdatePromotionCashbackCredit(ctx context.Context, arg UpdatePromotionCashbackCreditParams) (PromotionCashbackCredit, error) {
+	row := q.db.QueryRow(ctx, updatePromotionCashbackCredit,
+		arg.AccountID,
+		arg.OrderID,
+		arg.PromotionID,
+		arg.Amount,
+		arg.Status,
+		arg.DateCreated,
+		arg.DateUpdated,
+		arg.ID,
+	)
+	var i PromotionCashbackCredit
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.OrderID,
+		&i.PromotionID,
+		&i.Amount,
+		&i.Status,
+		&i.DateCreated,
+		&i.DateUpdated,
+	)
+	return i, err
+}
+
 const updatePromotionDiscount = `-- name: UpdatePromotionDiscount :one
 UPDATE "promotion"."discount"
 SET "order_wide" = COALESCE($1, "order_wide"),
@@ -10363,6 +13009,92 @@ func (q *Queries) UpdatePromotionDiscount(ctx context.Context, arg UpdatePromoti
 	return i, err
 }
 
+const updatePromotionVoucher = `-- name: UpdatePromotionVoucher :one
+UPDATE "promotion"."voucher"
+SET "usage_limit" = COALESCE($1, "usage_limit"),
+    "per_customer_limit" = COALESCE($2, "per_customer_limit"),
+    "first_order_only" = COALESCE($3, "first_order_only"),
+    "min_spend" = COALESCE($4, "min_spend"),
+    "used" = COALESCE($5, "used")
+WHERE ("id" = $6)
+RETURNING id, usage_limit, per_customer_limit, first_order_only, min_spend, used
+`
+
+type UpdatePromotionVoucherParams struct {
+	UsageLimit       pgtype.Int8 `json:"usage_limit"`
+	PerCustomerLimit pgtype.Int8 `json:"per_customer_limit"`
+	FirstOrderOnly   pgtype.Bool `json:"first_order_only"`
+	MinSpend         pgtype.Int8 `json:"min_spend"`
+	Used             pgtype.Int8 `json:"used"`
+	ID               pgtype.Int8 `json:"id"`
+}
+
+func (q *Queries) UpdatePromotionVoucher(ctx context.Context, arg UpdatePromotionVoucherParams) (PromotionVoucher, error) {
+	row := q.db.QueryRow(ctx, updatePromotionVoucher,
+		arg.UsageLimit,
+		arg.PerCustomerLimit,
+		arg.FirstOrderOnly,
+		arg.MinSpend,
+		arg.Used,
+		arg.ID,
+	)
+	var i PromotionVoucher
+	err := row.Scan(
+		&i.ID,
+		&i.UsageLimit,
+		&i.PerCustomerLimit,
+		&i.FirstOrderOnly,
+		&i.MinSpend,
+		&i.Used,
+	)
+	return i, err
+}
+
+const updatePromotionVoucherRedemption = `-- name: UpdatePromotionVoucherRedemption :one
+UPDATE "promotion"."voucher_redemption"
+SET "voucher_id" = COALESCE($1, "voucher_id"),
+    "account_id" = COALESCE($2, "account_id"),
+    "order_id" = COALESCE($3, "order_id"),
+    "status" = COALESCE($4, "status"),
+    "date_created" = COALESCE($5, "date_created"),
+    "date_updated" = COALESCE($6, "date_updated")
+WHERE ("id" = $7) OR ("voucher_id" = $1 AND "order_id" = $3)
+RETURNING id, voucher_id, account_id, order_id, status, date_created, date_updated
+`
+
+type UpdatePromotionVoucherRedemptionParams struct {
+	VoucherID   pgtype.Int8        `json:"voucher_id"`
+	AccountID   pgtype.Int8        `json:"account_id"`
+	OrderID     pgtype.Int8        `json:"order_id"`
+	Status      NullSharedStatus   `json:"status"`
+	DateCreated pgtype.Timestamptz `json:"date_created"`
+	DateUpdated pgtype.Timestamptz `json:"date_updated"`
+	ID          pgtype.Int8        `json:"id"`
+}
+
+func (q *Queries) UpdatePromotionVoucherRedemption(ctx context.Context, arg UpdatePromotionVoucherRedemptionParams) (PromotionVoucherRedemption, error) {
+	row := q.db.QueryRow(ctx, updatePromotionVoucherRedemption,
+		arg.VoucherID,
+		arg.AccountID,
+		arg.OrderID,
+		arg.Status,
+		arg.DateCreated,
+		arg.DateUpdated,
+		arg.ID,
+	)
+	var i PromotionVoucherRedemption
+	err := row.Scan(
+		&i.ID,
+		&i.VoucherID,
+		&i.AccountID,
+		&i.OrderID,
+		&i.Status,
+		&i.DateCreated,
+		&i.DateUpdated,
+	)
+	return i, err
+}
+
 const updateSharedResource = `-- name: UpdateSharedResource :one
 UPDATE "shared"."resource"
 SET "mime_type" = COALESCE($1, "mime_type"),
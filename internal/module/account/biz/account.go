@@ -8,19 +8,25 @@ import (
 
 	"shopnexus-remastered/internal/db"
 	authmodel "shopnexus-remastered/internal/module/auth/model"
+	inventorybiz "shopnexus-remastered/internal/module/inventory/biz"
+	promotionbiz "shopnexus-remastered/internal/module/promotion/biz"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 )
 
 type AccountBiz struct {
-	storage *pgutil.Storage
+	storage      *pgutil.Storage
+	inventoryBiz *inventorybiz.InventoryBiz
+	promotionBiz *promotionbiz.PromotionBiz
 }
 
 // NewAccountBiz creates a new instance of AccountBiz.
-func NewAccountBiz(storage *pgutil.Storage) *AccountBiz {
+func NewAccountBiz(storage *pgutil.Storage, inventoryBiz *inventorybiz.InventoryBiz, promotionBiz *promotionbiz.PromotionBiz) *AccountBiz {
 	return &AccountBiz{
-		storage: storage,
+		storage:      storage,
+		inventoryBiz: inventoryBiz,
+		promotionBiz: promotionBiz,
 	}
 }
 
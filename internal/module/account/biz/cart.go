@@ -2,30 +2,46 @@ package accountbiz
 
 import (
 	"context"
+	"time"
+
 	"shopnexus-remastered/internal/db"
+	inventorybiz "shopnexus-remastered/internal/module/inventory/biz"
+	inventorymodel "shopnexus-remastered/internal/module/inventory/model"
+	promotionbiz "shopnexus-remastered/internal/module/promotion/biz"
 	promotionmodel "shopnexus-remastered/internal/module/promotion/model"
+	"shopnexus-remastered/internal/utils/pgutil"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type GetCartParams struct {
-	AccountID int64
+	AccountID   int64
+	SkuIDs      []int64 // Only return these SKUs, nil means the whole cart
+	VoucherCode *string // Voucher entered by the customer, priced along the promotions applying automatically
 }
 
 type CartItem struct {
-	Sku       db.CatalogProductSku
-	Spu       db.CatalogProductSpu
-	Promotion db.PromotionBase
+	Sku     db.CatalogProductSku
+	Spu     db.CatalogProductSpu
+	Product promotionmodel.ProductRef    // What promotions target on the SKU
+	Pricing promotionmodel.ResolvedPrice // Promotions applied to Price and why the others were not
 
-	Price    int64
+	Price    int64 // Price per unit after promotions, at checkout only while their quotas remain
 	Quantity int64
 }
 
 func (s *AccountBiz) GetCart(ctx context.Context, params GetCartParams) ([]CartItem, error) {
 	cartItems, err := s.storage.ListAccountCartItem(ctx, db.ListAccountCartItemParams{
 		CartID: []int64{params.AccountID},
+		SkuID:  params.SkuIDs,
 	})
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
+	if len(cartItems) == 0 {
+		return []CartItem{}, nil
+	}
+
 	skuIDs := make([]int64, 0, len(cartItems))
 	for _, item := range cartItems {
 		skuIDs = append(skuIDs, item.SkuID)
@@ -35,7 +51,7 @@ func (s *AccountBiz) GetCart(ctx context.Context, params GetCartParams) ([]CartI
 		ID: skuIDs,
 	})
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	skuMap := make(map[int64]db.CatalogProductSku)
 	spuIDs := make([]int64, 0, len(skus))
@@ -48,7 +64,7 @@ func (s *AccountBiz) GetCart(ctx context.Context, params GetCartParams) ([]CartI
 		ID: spuIDs,
 	})
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	spuMap := make(map[int64]db.CatalogProductSpu) // map[spuID]SPU
 	for _, spu := range spus {
@@ -56,60 +72,175 @@ func (s *AccountBiz) GetCart(ctx context.Context, params GetCartParams) ([]CartI
 	}
 
 	// -- Calculate sale price
+	var (
+		items   []db.AccountCartItem
+		targets []promotionbiz.ProductRefParams
+	)
+	for _, item := range cartItems {
+		sku, ok := skuMap[item.SkuID]
+		if !ok {
+			// SKU has been removed from the catalog
+			continue
+		}
+		items = append(items, item)
+		targets = append(targets, promotionbiz.ProductRefParams{
+			Spu:   spuMap[sku.SpuID],
+			SkuID: sku.ID,
+		})
+	}
 
-	// Get all active promotions
-	promotions, err := s.storage.ListActivePromotion(ctx, db.ListActivePromotionParams{})
+	refs, err := s.promotionBiz.ListProductRefs(ctx, s.storage, targets)
 	if err != nil {
 		return nil, err
 	}
-
-	// Get all applicable promotions for each product
-	applicablePromotions := make(map[int64][]db.PromotionBase) // map[skuID][]Promotion
-	for _, sku := range skus {
-		for _, promo := range promotions {
-			if promotionmodel.IsPromotionApplicable(promo, spuMap[sku.SpuID], sku.ID) {
-				applicablePromotions[sku.ID] = append(applicablePromotions[sku.ID], promo)
-			}
+	resolve := make([]promotionbiz.ResolveItem, len(items))
+	for i, item := range items {
+		resolve[i] = promotionbiz.ResolveItem{
+			Product: refs[i],
+			Price:   skuMap[item.SkuID].Price,
 		}
 	}
 
-	// Calculate the price after applying the best promotion of each type (Voucher, Flash Sale, ...)\
-	// First with voucher:
-	discountPromotions, err := s.storage.ListPromotionDiscount(ctx, db.ListPromotionDiscountParams{
-		ID: func() []int64 {
-			var ids []int64
-			for _, promo := range promotions {
-				if promo.Type == db.PromotionTypeDiscount {
-					ids = append(ids, promo.ID)
-				}
-			}
-			return ids
-		}(),
+	prices, err := s.promotionBiz.ResolveItemPrices(ctx, s.storage, promotionbiz.ResolveItemPricesParams{
+		Items:       resolve,
+		VoucherCode: params.VoucherCode,
 	})
-
-	promotionMap := make(map[int64]db.PromotionBase)
-	for _, promo := range promotions {
-		promotionMap[promo.ID] = promo
+	if err != nil {
+		return nil, err
 	}
 
-	result := make([]CartItem, 0, len(cartItems))
-	for _, item := range cartItems {
+	result := make([]CartItem, 0, len(items))
+	for i, item := range items {
 		sku := skuMap[item.SkuID]
-		spu := spuMap[sku.SpuID]
-		price := int64(0)
-
-		// Get best promotion
-		for _, promo := range discountPromotions {
-			price = min(price, promotionmodel.CalculateDiscountedItemPrice(sku.Price, promo))
-		}
-
 		result = append(result, CartItem{
 			Sku:      sku,
-			Spu:      spu,
-			Price:    price,
+			Spu:      spuMap[sku.SpuID],
+			Product:  refs[i],
+			Pricing:  prices[i],
+			Price:    prices[i].Final,
 			Quantity: item.Quantity,
 		})
 	}
 
 	return result, nil
 }
+
+type CartPrice struct {
+	Items []CartItem
+	Price promotionmodel.OrderPrice // Promotions priced on the whole cart (bundles, buy X get Y, ...), Lines follow Items
+}
+
+// PriceCart returns the cart with the promotions priced on the whole cart, the same way checkout prices the order
+func (s *AccountBiz) PriceCart(ctx context.Context, params GetCartParams) (CartPrice, error) {
+	var zero CartPrice
+
+	items, err := s.GetCart(ctx, params)
+	if err != nil {
+		return zero, err
+	}
+
+	promotions, err := s.promotionBiz.ListOrderPromotions(ctx, s.storage, promotionbiz.ListActivePromotionParams{
+		VoucherCode: params.VoucherCode,
+	})
+	if err != nil {
+		return zero, err
+	}
+
+	lines := make([]promotionmodel.OrderLine, len(items))
+	for i, item := range items {
+		lines[i] = promotionmodel.OrderLine{
+			Product:  item.Product,
+			Quantity: item.Quantity,
+			Total:    item.Price * item.Quantity,
+		}
+	}
+
+	return CartPrice{
+		Items: items,
+		Price: promotionmodel.CalculateDiscountedOrderPrice(lines, promotions),
+	}, nil
+}
+
+type UpdateCartItemParams struct {
+	AccountID int64
+	SkuID     int64
+	Quantity  int64 // New quantity of the SKU in the cart, 0 removes it
+}
+
+// UpdateCartItem sets the quantity of a SKU in the cart and holds that quantity in the stock.
+// Any cart activity keeps the whole cart reserved for another CartReservationTTL.
+func (s *AccountBiz) UpdateCartItem(ctx context.Context, params UpdateCartItemParams) error {
+	txStorage, err := s.storage.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer txStorage.Rollback(ctx)
+
+	// Give back what the SKU held, then hold the new quantity
+	if err = s.inventoryBiz.ReleaseStock(ctx, txStorage, inventorybiz.ReleaseStockParams{
+		RefType: db.InventoryReservationTypeCart,
+		RefID:   params.AccountID,
+		SkuIDs:  []int64{params.SkuID},
+	}); err != nil {
+		return err
+	}
+
+	items, err := txStorage.ListAccountCartItem(ctx, db.ListAccountCartItemParams{
+		CartID: []int64{params.AccountID},
+		SkuID:  []int64{params.SkuID},
+	})
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case params.Quantity <= 0:
+		if err = txStorage.DeleteAccountCartItem(ctx, db.DeleteAccountCartItemParams{
+			CartID: pgutil.Int64ToPgInt8(params.AccountID),
+			SkuID:  pgutil.Int64ToPgInt8(params.SkuID),
+		}); err != nil {
+			return err
+		}
+	case len(items) == 0:
+		if _, err = txStorage.CreateDefaultAccountCartItem(ctx, []db.CreateDefaultAccountCartItemParams{{
+			CartID:   params.AccountID,
+			SkuID:    params.SkuID,
+			Quantity: params.Quantity,
+		}}); err != nil {
+			return err
+		}
+	default:
+		if _, err = txStorage.UpdateAccountCartItem(ctx, db.UpdateAccountCartItemParams{
+			ID:          pgutil.Int64ToPgInt8(items[0].ID),
+			Quantity:    pgutil.Int64ToPgInt8(params.Quantity),
+			DateUpdated: pgtype.Timestamptz{Time: time.Now(), Valid: true},
+		}); err != nil {
+			return err
+		}
+	}
+
+	if params.Quantity > 0 {
+		if err = s.inventoryBiz.ReserveStock(ctx, txStorage, inventorybiz.ReserveStockParams{
+			RefType: db.InventoryReservationTypeCart,
+			RefID:   params.AccountID,
+			Items:   map[int64]int64{params.SkuID: params.Quantity},
+			TTL:     inventorymodel.CartReservationTTL,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if err = s.inventoryBiz.ExtendReservation(ctx, txStorage, inventorybiz.ExtendReservationParams{
+		RefType: db.InventoryReservationTypeCart,
+		RefID:   params.AccountID,
+		TTL:     inventorymodel.CartReservationTTL,
+	}); err != nil {
+		return err
+	}
+
+	if err = txStorage.Commit(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
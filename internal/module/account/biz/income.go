@@ -0,0 +1,66 @@
+package accountbiz
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+
+	"shopnexus-remastered/internal/db"
+	"shopnexus-remastered/internal/utils/pgutil"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Income history types
+const (
+	IncomeTypeSale   = "sale"
+	IncomeTypeRefund = "refund"
+)
+
+type RecordIncomeParams struct {
+	AccountID int64 // Vendor
+	Type      string
+	Income    int64 // Negative for money leaving the vendor
+	Note      string
+}
+
+// RecordIncome appends an entry to the income history chain of a vendor.
+// It runs on the given storage so the income is recorded in the same transaction as its cause.
+func (s *AccountBiz) RecordIncome(ctx context.Context, storage db.Querier, params RecordIncomeParams) error {
+	// Lock the latest entry so concurrent records are chained one after another
+	prevHash := []byte{} // Empty for the first entry of the chain
+	var balance int64
+	latest, err := storage.GetLatestAccountIncomeHistory(ctx, params.AccountID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return err
+	}
+	if err == nil {
+		prevHash = latest.Hash
+		balance = latest.CurrentBalance
+	}
+	balance += params.Income
+
+	_, err = storage.CreateDefaultAccountIncomeHistory(ctx, []db.CreateDefaultAccountIncomeHistoryParams{{
+		AccountID:      params.AccountID,
+		Type:           params.Type,
+		Income:         params.Income,
+		CurrentBalance: balance,
+		Note:           pgutil.StringToPgText(params.Note),
+		Hash:           incomeHash(prevHash, params, balance),
+		PrevHash:       prevHash,
+	}})
+	return err
+}
+
+// incomeHash hashes an income history entry together with the hash of the previous entry
+func incomeHash(prevHash []byte, params RecordIncomeParams, balance int64) []byte {
+	h := sha256.New()
+	h.Write(prevHash)
+	binary.Write(h, binary.BigEndian, params.AccountID)
+	h.Write([]byte(params.Type))
+	binary.Write(h, binary.BigEndian, params.Income)
+	binary.Write(h, binary.BigEndian, balance)
+	h.Write([]byte(params.Note))
+	return h.Sum(nil)
+}
@@ -21,6 +21,7 @@ func NewHandler(e *echo.Echo, biz *accountbiz.AccountBiz) *Handler {
 	api := e.Group("/api/v1/account")
 	api.GET("/", h.GetAccount)
 	api.GET("/me", h.GetMe)
+	api.PUT("/cart", h.UpdateCartItem)
 
 	return h
 }
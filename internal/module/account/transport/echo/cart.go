@@ -3,6 +3,7 @@ package accountecho
 import (
 	"net/http"
 	accountbiz "shopnexus-remastered/internal/module/account/biz"
+	authbiz "shopnexus-remastered/internal/module/auth/biz"
 	"shopnexus-remastered/internal/module/shared/transport/echo/response"
 
 	"github.com/labstack/echo/v4"
@@ -23,7 +24,7 @@ func (h *Handler) GetCart(c echo.Context) error {
 		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
 	}
 
-	result, err := h.biz.GetCart(c.Request().Context(), accountbiz.GetCartParams{
+	result, err := h.biz.PriceCart(c.Request().Context(), accountbiz.GetCartParams{
 		AccountID: 1,
 	})
 	if err != nil {
@@ -32,3 +33,34 @@ func (h *Handler) GetCart(c echo.Context) error {
 
 	return response.FromDTO(c.Response().Writer, http.StatusOK, result)
 }
+
+type UpdateCartItemRequest struct {
+	SkuID    int64 `json:"sku_id" validate:"required,gt=0"`
+	Quantity int64 `json:"quantity" validate:"gte=0"`
+}
+
+// UpdateCartItem sets the quantity of a SKU in the cart of the current customer, 0 removes it
+func (h *Handler) UpdateCartItem(c echo.Context) error {
+	var req UpdateCartItemRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := authbiz.GetClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	if err = h.biz.UpdateCartItem(c.Request().Context(), accountbiz.UpdateCartItemParams{
+		AccountID: claims.AccountID(),
+		SkuID:     req.SkuID,
+		Quantity:  req.Quantity,
+	}); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromMessage(c.Response().Writer, http.StatusOK, "Cart updated successfully")
+}
@@ -8,6 +8,7 @@ import (
 	"shopnexus-remastered/config"
 	"shopnexus-remastered/internal/client/cachestruct"
 	authmodel "shopnexus-remastered/internal/module/auth/model"
+	"strconv"
 	"strings"
 	"time"
 
@@ -64,5 +65,10 @@ func ValidateAccessToken(tokenStr string) (claims authmodel.Claims, err error) {
 		return claims, errors.New("invalid token or token expired")
 	}
 
+	// Handlers read the account id from the subject, a token without one is rejected here
+	if _, err = strconv.ParseInt(claims.Subject, 10, 64); err != nil {
+		return claims, errors.New("invalid token subject")
+	}
+
 	return claims, nil
 }
@@ -1,6 +1,8 @@
 package authmodel
 
 import (
+	"strconv"
+
 	"shopnexus-remastered/internal/db"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -11,3 +13,9 @@ type Claims struct {
 	Type db.AccountType
 	Code string
 }
+
+// AccountID returns the account id stored in the token subject, authbiz.GetClaims only returns claims with a valid one
+func (c Claims) AccountID() int64 {
+	id, _ := strconv.ParseInt(c.Subject, 10, 64)
+	return id
+}
@@ -3,7 +3,7 @@ package catalogbiz
 import (
 	"context"
 	catalogmodel "shopnexus-remastered/internal/module/catalog/model"
-	promotionmodel "shopnexus-remastered/internal/module/promotion/model"
+	promotionbiz "shopnexus-remastered/internal/module/promotion/biz"
 	"shopnexus-remastered/internal/utils/pgutil"
 
 	"shopnexus-remastered/internal/db"
@@ -11,12 +11,14 @@ import (
 )
 
 type CatalogBiz struct {
-	storage *pgutil.Storage
+	storage      *pgutil.Storage
+	promotionBiz *promotionbiz.PromotionBiz
 }
 
-func NewCatalogBiz(storage *pgutil.Storage) *CatalogBiz {
+func NewCatalogBiz(storage *pgutil.Storage, promotionBiz *promotionbiz.PromotionBiz) *CatalogBiz {
 	return &CatalogBiz{
-		storage: storage,
+		storage:      storage,
+		promotionBiz: promotionBiz,
 	}
 }
 
@@ -75,52 +77,46 @@ func (c *CatalogBiz) ListProductCard(ctx context.Context, params ListProductCard
 		}
 	}
 
-	// -- Calculate sale price
+	// -- Calculate sale price of the flagship SKU
+	var (
+		priced  []db.CatalogProductSpu
+		targets []promotionbiz.ProductRefParams
+	)
+	for _, spu := range spus {
+		fp, ok := flagshipPrice[spu.ID]
+		if !ok {
+			continue
+		}
+		priced = append(priced, spu)
+		targets = append(targets, promotionbiz.ProductRefParams{
+			Spu:   spu,
+			SkuID: fp.SkuID,
+		})
+	}
 
-	// Get all active promotions
-	promotions, err := c.storage.ListActivePromotion(ctx, db.ListActivePromotionParams{})
+	refs, err := c.promotionBiz.ListProductRefs(ctx, c.storage, targets)
 	if err != nil {
 		return zero, err
 	}
-	promotionsMap := make(map[int64]db.PromotionBase) // map[promoID]Promotion
-	for _, promo := range promotions {
-		promotionsMap[promo.ID] = promo
-	}
-
-	// Get all applicable promotions for each product
-	applicablePromotions := make(map[int64][]db.PromotionBase) // map[spuID][]Promotion
-	for _, spu := range spus {
-		fp := flagshipPrice[spu.ID]
-		for _, promo := range promotions {
-			if promotionmodel.IsPromotionApplicable(promo, spu, fp.SkuID) {
-				applicablePromotions[spu.ID] = append(applicablePromotions[spu.ID], promo)
-			}
+	resolve := make([]promotionbiz.ResolveItem, len(priced))
+	for i, spu := range priced {
+		resolve[i] = promotionbiz.ResolveItem{
+			Product: refs[i],
+			Price:   flagshipPrice[spu.ID].OriginalPrice,
 		}
 	}
 
-	// Calculate the price after applying the best promotion of each type (Voucher, Flash Sale, ...)\
-	// First with voucher:
-	discountPromotions, err := c.storage.ListPromotionDiscount(ctx, db.ListPromotionDiscountParams{
-		ID: func() []int64 {
-			var ids []int64
-			for _, promo := range promotions {
-				if promo.Type == db.PromotionTypeDiscount {
-					ids = append(ids, promo.ID)
-				}
-			}
-			return ids
-		}(),
+	prices, err := c.promotionBiz.ResolveItemPrices(ctx, c.storage, promotionbiz.ResolveItemPricesParams{
+		Items: resolve,
 	})
-
-	for _, spu := range spus {
+	if err != nil {
+		return zero, err
+	}
+	for i, spu := range priced {
 		fp := flagshipPrice[spu.ID]
-
-		for _, promo := range discountPromotions {
-			discounted := promotionmodel.CalculateDiscountedItemPrice(fp.OriginalPrice, promo)
-			if fp.Price > discounted {
-				fp.AppliedPromotionID = &promo.ID
-				fp.Price = discounted
-			}
+		fp.Price = prices[i].Final
+		for _, applied := range prices[i].Applied {
+			fp.AppliedPromotions = append(fp.AppliedPromotions, applied.Promotion)
 		}
 	}
 
@@ -147,17 +143,19 @@ func (c *CatalogBiz) ListProductCard(ctx context.Context, params ListProductCard
 		resourceMap[res.OwnerID] = res.Url
 	}
 
-	// Map promotion to ProductCardPromo
-	promoCardMap := make(map[int64]*catalogmodel.ProductCardPromo) // map[spuID]ProductCardPromo
+	// Map promotions to ProductCardPromo
+	promoCardMap := make(map[int64][]catalogmodel.ProductCardPromo) // map[spuID][]ProductCardPromo
 	for _, spu := range spus {
-		fp := flagshipPrice[spu.ID]
-		if fp.AppliedPromotionID != nil {
-			promo := promotionsMap[*fp.AppliedPromotionID]
-			promoCardMap[spu.ID] = &catalogmodel.ProductCardPromo{
+		fp, ok := flagshipPrice[spu.ID]
+		if !ok {
+			continue
+		}
+		for _, promo := range fp.AppliedPromotions {
+			promoCardMap[spu.ID] = append(promoCardMap[spu.ID], catalogmodel.ProductCardPromo{
 				ID:          promo.ID,
 				Title:       promo.Title,
 				Description: promo.Description.String,
-			}
+			})
 		}
 	}
 
@@ -176,7 +174,7 @@ func (c *CatalogBiz) ListProductCard(ctx context.Context, params ListProductCard
 			DateUpdated:      spu.DateUpdated,
 			DateDeleted:      spu.DateDeleted,
 
-			Promo:         promoCardMap[spu.ID],
+			Promos:        promoCardMap[spu.ID],
 			Price:         flagshipPrice[spu.ID].Price,
 			OriginalPrice: flagshipPrice[spu.ID].OriginalPrice,
 			Rating:        ratingMap[spu.ID],
@@ -0,0 +1,278 @@
+package catalogbiz
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"shopnexus-remastered/internal/db"
+	catalogmodel "shopnexus-remastered/internal/module/catalog/model"
+	sharedmodel "shopnexus-remastered/internal/module/shared/model"
+	"shopnexus-remastered/internal/utils/pgutil"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// GetCategoryTree returns the root categories with all the categories under them, for the storefront navigation.
+// Categories caught in a cycle by a bad parent_id are unreachable from a root and left out.
+func (c *CatalogBiz) GetCategoryTree(ctx context.Context) ([]catalogmodel.CategoryNode, error) {
+	categories, err := c.storage.ListCatalogCategory(ctx, db.ListCatalogCategoryParams{})
+	if err != nil {
+		return nil, err
+	}
+
+	exists := make(map[int64]bool, len(categories))
+	for _, category := range categories {
+		exists[category.ID] = true
+	}
+	children := make(map[int64][]db.CatalogCategory) // map[parentID][]Category
+	var roots []db.CatalogCategory
+	for _, category := range categories {
+		if !category.ParentID.Valid || !exists[category.ParentID.Int64] {
+			// A missing parent was deleted without its subcategories, they are shown as roots
+			roots = append(roots, category)
+			continue
+		}
+		children[category.ParentID.Int64] = append(children[category.ParentID.Int64], category)
+	}
+
+	var build func(categories []db.CatalogCategory) []catalogmodel.CategoryNode
+	build = func(categories []db.CatalogCategory) []catalogmodel.CategoryNode {
+		slices.SortFunc(categories, func(a, b db.CatalogCategory) int {
+			return strings.Compare(a.Name, b.Name)
+		})
+		nodes := make([]catalogmodel.CategoryNode, 0, len(categories))
+		for _, category := range categories {
+			nodes = append(nodes, catalogmodel.CategoryNode{
+				CatalogCategory: category,
+				Children:        build(children[category.ID]),
+			})
+		}
+		return nodes
+	}
+
+	return build(roots), nil
+}
+
+// GetCategoryBreadcrumb returns the path from the root down to the category, the category last
+func (c *CatalogBiz) GetCategoryBreadcrumb(ctx context.Context, id int64) ([]db.CatalogCategory, error) {
+	breadcrumb, err := c.storage.ListCatalogCategoryAncestor(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(breadcrumb) == 0 {
+		return nil, catalogmodel.ErrCategoryNotFound
+	}
+	return breadcrumb, nil
+}
+
+type ListCategoryProductSpuParams struct {
+	sharedmodel.PaginationParams
+	CategoryID int64
+	IsActive   []bool
+}
+
+// ListCategoryProductSpu lists the SPUs of the category and of all the categories under it
+func (c *CatalogBiz) ListCategoryProductSpu(ctx context.Context, params ListCategoryProductSpuParams) (sharedmodel.PaginateResult[db.CatalogProductSpu], error) {
+	var zero sharedmodel.PaginateResult[db.CatalogProductSpu]
+
+	subtree, err := c.storage.ListCatalogCategoryDescendant(ctx, params.CategoryID)
+	if err != nil {
+		return zero, err
+	}
+	if len(subtree) == 0 {
+		return zero, catalogmodel.ErrCategoryNotFound
+	}
+
+	categoryIDs := make([]int64, 0, len(subtree))
+	for _, category := range subtree {
+		categoryIDs = append(categoryIDs, category.ID)
+	}
+
+	return c.ListProductSpu(ctx, ListProductSpuParams{
+		PaginationParams: params.PaginationParams,
+		CategoryID:       categoryIDs,
+		IsActive:         params.IsActive,
+	})
+}
+
+type CreateCategoryParams struct {
+	Name        string
+	Description string
+	ParentID    *int64 // nil creates a root category
+}
+
+func (c *CatalogBiz) CreateCategory(ctx context.Context, params CreateCategoryParams) (db.CatalogCategory, error) {
+	var zero db.CatalogCategory
+
+	txStorage, err := c.storage.BeginTx(ctx)
+	if err != nil {
+		return zero, err
+	}
+	defer txStorage.Rollback(ctx)
+
+	if _, err = txStorage.LockCatalogCategory(ctx); err != nil {
+		return zero, err
+	}
+
+	if _, err = txStorage.GetCatalogCategory(ctx, db.GetCatalogCategoryParams{
+		Name: pgutil.StringToPgText(params.Name),
+	}); err == nil {
+		return zero, catalogmodel.ErrCategoryNameExists
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return zero, err
+	}
+
+	parentID := pgutil.PtrToPgtype(params.ParentID, pgutil.Int64ToPgInt8)
+	if err = checkCategoryParent(ctx, txStorage, parentID); err != nil {
+		return zero, err
+	}
+
+	if _, err = txStorage.CreateCatalogCategory(ctx, []db.CreateCatalogCategoryParams{{
+		Name:        params.Name,
+		Description: params.Description,
+		ParentID:    parentID,
+	}}); err != nil {
+		return zero, err
+	}
+
+	category, err := txStorage.GetCatalogCategory(ctx, db.GetCatalogCategoryParams{
+		Name: pgutil.StringToPgText(params.Name),
+	})
+	if err != nil {
+		return zero, err
+	}
+
+	if err = txStorage.Commit(ctx); err != nil {
+		return zero, err
+	}
+
+	c.promotionBiz.InvalidateCategoryTree()
+	return category, nil
+}
+
+type MoveCategoryParams struct {
+	ID       int64
+	ParentID *int64 // nil makes the category a root
+}
+
+// MoveCategory puts the category, with everything under it, under another parent
+func (c *CatalogBiz) MoveCategory(ctx context.Context, params MoveCategoryParams) (db.CatalogCategory, error) {
+	var zero db.CatalogCategory
+
+	txStorage, err := c.storage.BeginTx(ctx)
+	if err != nil {
+		return zero, err
+	}
+	defer txStorage.Rollback(ctx)
+
+	if _, err = txStorage.LockCatalogCategory(ctx); err != nil {
+		return zero, err
+	}
+
+	if _, err = txStorage.GetCatalogCategory(ctx, db.GetCatalogCategoryParams{
+		ID: pgutil.Int64ToPgInt8(params.ID),
+	}); err != nil {
+		return zero, categoryLookupError(err, catalogmodel.ErrCategoryNotFound)
+	}
+
+	parentID := pgutil.PtrToPgtype(params.ParentID, pgutil.Int64ToPgInt8)
+	if err = checkCategoryParent(ctx, txStorage, parentID); err != nil {
+		return zero, err
+	}
+	if parentID.Valid {
+		// The new parent must not be the category or under it
+		ancestors, err := txStorage.ListCatalogCategoryAncestor(ctx, parentID.Int64)
+		if err != nil {
+			return zero, err
+		}
+		for _, ancestor := range ancestors {
+			if ancestor.ID == params.ID {
+				return zero, catalogmodel.ErrCategoryCycle
+			}
+		}
+	}
+
+	category, err := txStorage.UpdateCatalogCategory(ctx, db.UpdateCatalogCategoryParams{
+		ID:           pgutil.Int64ToPgInt8(params.ID),
+		NullParentID: !parentID.Valid,
+		ParentID:     parentID,
+	})
+	if err != nil {
+		return zero, err
+	}
+
+	if err = txStorage.Commit(ctx); err != nil {
+		return zero, err
+	}
+
+	c.promotionBiz.InvalidateCategoryTree()
+	return category, nil
+}
+
+// DeleteCategory deletes an empty category. Categories with subcategories or products are kept, deleting the
+// category would delete its products with it.
+func (c *CatalogBiz) DeleteCategory(ctx context.Context, id int64) error {
+	txStorage, err := c.storage.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer txStorage.Rollback(ctx)
+
+	if _, err = txStorage.LockCatalogCategory(ctx); err != nil {
+		return err
+	}
+
+	if _, err = txStorage.GetCatalogCategory(ctx, db.GetCatalogCategoryParams{
+		ID: pgutil.Int64ToPgInt8(id),
+	}); err != nil {
+		return categoryLookupError(err, catalogmodel.ErrCategoryNotFound)
+	}
+
+	subcategories, err := txStorage.CountCatalogCategory(ctx, db.CountCatalogCategoryParams{
+		ParentID: []pgtype.Int8{pgutil.Int64ToPgInt8(id)},
+	})
+	if err != nil {
+		return err
+	}
+	spus, err := txStorage.CountCatalogProductSpu(ctx, db.CountCatalogProductSpuParams{
+		CategoryID: []int64{id},
+	})
+	if err != nil {
+		return err
+	}
+	if subcategories > 0 || spus > 0 {
+		return catalogmodel.ErrCategoryNotEmpty
+	}
+
+	if err = txStorage.DeleteCatalogCategory(ctx, db.DeleteCatalogCategoryParams{
+		ID: pgutil.Int64ToPgInt8(id),
+	}); err != nil {
+		return err
+	}
+
+	if err = txStorage.Commit(ctx); err != nil {
+		return err
+	}
+
+	c.promotionBiz.InvalidateCategoryTree()
+	return nil
+}
+
+// checkCategoryParent checks the parent category exists, an invalid parent is a root
+func checkCategoryParent(ctx context.Context, storage db.Querier, parentID pgtype.Int8) error {
+	if !parentID.Valid {
+		return nil
+	}
+	_, err := storage.GetCatalogCategory(ctx, db.GetCatalogCategoryParams{ID: parentID})
+	return categoryLookupError(err, catalogmodel.ErrCategoryParentNotFound)
+}
+
+func categoryLookupError(err error, notFound error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return notFound
+	}
+	return err
+}
@@ -1,6 +1,8 @@
 package catalogmodel
 
 import (
+	"shopnexus-remastered/internal/db"
+
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
@@ -18,11 +20,11 @@ type ProductCard struct {
 	DateUpdated      pgtype.Timestamptz `json:"date_updated"`
 	DateDeleted      pgtype.Timestamptz `json:"date_deleted"`
 
-	Price         int64             `json:"price"`
-	OriginalPrice int64             `json:"original_price"`
-	Rating        Rating            `json:"rating"`
-	Image         string            `json:"image,omitempty"`
-	Promo         *ProductCardPromo `json:"promo,omitempty"`
+	Price         int64              `json:"price"`
+	OriginalPrice int64              `json:"original_price"`
+	Rating        Rating             `json:"rating"`
+	Image         string             `json:"image,omitempty"`
+	Promos        []ProductCardPromo `json:"promos,omitempty"` // Promotions applied to Price, in the order they apply
 }
 
 type ProductCardPromo struct {
@@ -55,10 +57,10 @@ type ProductSkuAttribute struct {
 
 // FlagshipPrice is the best price for the product (currently is the lowest price of a product's SKU)
 type FlagshipPrice struct {
-	OriginalPrice      int64
-	Price              int64
-	SkuID              int64
-	AppliedPromotionID *int64
+	OriginalPrice     int64
+	Price             int64
+	SkuID             int64
+	AppliedPromotions []db.PromotionBase
 }
 
 type Rating struct {
@@ -0,0 +1,9 @@
+package catalogmodel
+
+import "shopnexus-remastered/internal/db"
+
+// CategoryNode is a category with the categories under it
+type CategoryNode struct {
+	db.CatalogCategory
+	Children []CategoryNode `json:"children"` // Sorted by name
+}
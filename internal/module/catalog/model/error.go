@@ -0,0 +1,11 @@
+package catalogmodel
+
+import sharedmodel "shopnexus-remastered/internal/module/shared/model"
+
+var (
+	ErrCategoryNotFound       = sharedmodel.NewError("catalog.category_not_found", "Category not found")
+	ErrCategoryParentNotFound = sharedmodel.NewError("catalog.category_parent_not_found", "Parent category not found")
+	ErrCategoryNameExists     = sharedmodel.NewError("catalog.category_name_exists", "Category name is already used")
+	ErrCategoryCycle          = sharedmodel.NewError("catalog.category_cycle", "Category cannot be moved under itself or one of its subcategories")
+	ErrCategoryNotEmpty       = sharedmodel.NewError("catalog.category_not_empty", "Category still has subcategories or products")
+)
@@ -2,8 +2,10 @@ package catalogecho
 
 import (
 	"net/http"
+	"shopnexus-remastered/config"
 	catalogbiz "shopnexus-remastered/internal/module/catalog/biz"
 	sharedmodel "shopnexus-remastered/internal/module/shared/model"
+	"shopnexus-remastered/internal/module/shared/transport/echo/middleware"
 	"shopnexus-remastered/internal/module/shared/transport/echo/response"
 
 	"github.com/labstack/echo/v4"
@@ -13,8 +15,10 @@ type Handler struct {
 	biz *catalogbiz.CatalogBiz
 }
 
-func NewHandler(e *echo.Echo, catalogbiz *catalogbiz.CatalogBiz) *Handler {
-	h := &Handler{biz: catalogbiz}
+func NewHandler(e *echo.Echo, catalogbiz *catalogbiz.CatalogBiz, cfg *config.Config) *Handler {
+	h := &Handler{
+		biz: catalogbiz,
+	}
 	api := e.Group("/api/v1/catalog")
 	api.GET("/product-card", h.ListProductCard)
 
@@ -22,6 +26,16 @@ func NewHandler(e *echo.Echo, catalogbiz *catalogbiz.CatalogBiz) *Handler {
 	api.GET("/product-sku", h.ListProductSku)
 	api.GET("/product-sku-attribute", h.ListProductSkuAttribute)
 
+	api.GET("/category", h.GetCategoryTree)
+	api.GET("/category/:id/breadcrumb", h.GetCategoryBreadcrumb)
+	api.GET("/category/:id/product-spu", h.ListCategoryProductSpu)
+
+	// Category management, by the back office
+	system := api.Group("/system/category", middleware.RequireAdminToken(cfg.App.AdminToken))
+	system.POST("", h.CreateCategory)
+	system.POST("/:id/move", h.MoveCategory)
+	system.DELETE("/:id", h.DeleteCategory)
+
 	return h
 }
 
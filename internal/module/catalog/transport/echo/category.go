@@ -0,0 +1,142 @@
+package catalogecho
+
+import (
+	"net/http"
+
+	catalogbiz "shopnexus-remastered/internal/module/catalog/biz"
+	sharedmodel "shopnexus-remastered/internal/module/shared/model"
+	"shopnexus-remastered/internal/module/shared/transport/echo/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+// GetCategoryTree returns the whole category tree, for the storefront navigation
+func (h *Handler) GetCategoryTree(c echo.Context) error {
+	result, err := h.biz.GetCategoryTree(c.Request().Context())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, result)
+}
+
+type GetCategoryBreadcrumbRequest struct {
+	ID int64 `param:"id" validate:"required,gt=0"`
+}
+
+func (h *Handler) GetCategoryBreadcrumb(c echo.Context) error {
+	var req GetCategoryBreadcrumbRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	result, err := h.biz.GetCategoryBreadcrumb(c.Request().Context(), req.ID)
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, result)
+}
+
+type ListCategoryProductSpuRequest struct {
+	sharedmodel.PaginationParams
+	ID       int64  `param:"id" validate:"required,gt=0"`
+	IsActive []bool `query:"is_active" comma_separated:"true" validate:"omitempty,dive"`
+}
+
+// ListCategoryProductSpu lists the SPUs of the category and of all its subcategories
+func (h *Handler) ListCategoryProductSpu(c echo.Context) error {
+	var req ListCategoryProductSpuRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	result, err := h.biz.ListCategoryProductSpu(c.Request().Context(), catalogbiz.ListCategoryProductSpuParams{
+		PaginationParams: req.PaginationParams,
+		CategoryID:       req.ID,
+		IsActive:         req.IsActive,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromPaginate(c.Response().Writer, result)
+}
+
+type CreateCategoryRequest struct {
+	Name        string `json:"name" validate:"required,max=100"`
+	Description string `json:"description" validate:"max=1000"`
+	ParentID    *int64 `json:"parent_id" validate:"omitempty,gt=0"` // Empty creates a root category
+}
+
+func (h *Handler) CreateCategory(c echo.Context) error {
+	var req CreateCategoryRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	result, err := h.biz.CreateCategory(c.Request().Context(), catalogbiz.CreateCategoryParams{
+		Name:        req.Name,
+		Description: req.Description,
+		ParentID:    req.ParentID,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusCreated, result)
+}
+
+type MoveCategoryRequest struct {
+	ID       int64  `param:"id" validate:"required,gt=0"`
+	ParentID *int64 `json:"parent_id" validate:"omitempty,gt=0"` // Empty makes the category a root
+}
+
+func (h *Handler) MoveCategory(c echo.Context) error {
+	var req MoveCategoryRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	result, err := h.biz.MoveCategory(c.Request().Context(), catalogbiz.MoveCategoryParams{
+		ID:       req.ID,
+		ParentID: req.ParentID,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, result)
+}
+
+type DeleteCategoryRequest struct {
+	ID int64 `param:"id" validate:"required,gt=0"`
+}
+
+func (h *Handler) DeleteCategory(c echo.Context) error {
+	var req DeleteCategoryRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	if err := h.biz.DeleteCategory(c.Request().Context(), req.ID); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromMessage(c.Response().Writer, http.StatusOK, "Category deleted successfully")
+}
@@ -0,0 +1,241 @@
+package inventorybiz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"shopnexus-remastered/internal/db"
+	"shopnexus-remastered/internal/logger"
+	inventorymodel "shopnexus-remastered/internal/module/inventory/model"
+	"shopnexus-remastered/internal/utils/pgutil"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/fx"
+)
+
+const (
+	// alertInterval is how often stocks are checked for alerts
+	alertInterval = time.Minute
+	// alertBatchSize is the max number of stocks alerted per check
+	alertBatchSize = 500
+)
+
+type SetLowStockThresholdParams struct {
+	VendorID  int64
+	SkuID     int64
+	Threshold int64 // 0 disables low stock alerts, out of stock alerts are always sent
+}
+
+// SetLowStockThreshold sets the stock level of a SKU of the vendor below which the vendor is alerted.
+// A SKU never stocked gets an empty stock to hold the threshold, it is not alerted until its stock first changes.
+func (b *InventoryBiz) SetLowStockThreshold(ctx context.Context, params SetLowStockThresholdParams) (db.InventoryStock, error) {
+	var zero db.InventoryStock
+
+	txStorage, err := b.storage.BeginTx(ctx)
+	if err != nil {
+		return zero, err
+	}
+	defer txStorage.Rollback(ctx)
+
+	if err = b.checkSkuVendor(ctx, txStorage, params.SkuID, params.VendorID); err != nil {
+		return zero, err
+	}
+
+	stock, err := b.getOrCreateSkuStock(ctx, txStorage, params.SkuID)
+	if err != nil {
+		return zero, err
+	}
+
+	stock, err = txStorage.UpdateInventoryStock(ctx, db.UpdateInventoryStockParams{
+		ID:                pgutil.Int64ToPgInt8(stock.ID),
+		LowStockThreshold: pgutil.Int64ToPgInt8(params.Threshold),
+	})
+	if err != nil {
+		return zero, err
+	}
+
+	if err = txStorage.Commit(ctx); err != nil {
+		return zero, err
+	}
+
+	return stock, nil
+}
+
+// CheckStockAlerts alerts the vendors of the SKUs whose stock dropped below their threshold or ran out,
+// each alert creates a notification for the vendor and a system event.
+// A SKU is alerted once per level until its stock is back above the threshold.
+func (b *InventoryBiz) CheckStockAlerts(ctx context.Context) error {
+	txStorage, err := b.storage.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer txStorage.Rollback(ctx)
+
+	// Restocked SKUs can be alerted again
+	if err = txStorage.ClearStockAlert(ctx); err != nil {
+		return err
+	}
+
+	stocks, err := txStorage.ListStockToAlert(ctx, alertBatchSize)
+	if err != nil {
+		return err
+	}
+	if len(stocks) == 0 {
+		return txStorage.Commit(ctx)
+	}
+
+	skuIDs := make([]int64, 0, len(stocks))
+	for _, stock := range stocks {
+		skuIDs = append(skuIDs, stock.RefID)
+	}
+	skus, vendors, err := b.skuVendors(ctx, txStorage, skuIDs)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, stock := range stocks {
+		alert := db.InventoryStockAlertLowStock
+		if stock.CurrentStock <= 0 {
+			alert = db.InventoryStockAlertOutOfStock
+		}
+
+		// A SKU that just recovered stays quiet for a while, an escalation to out of stock is always sent
+		notify := stock.Alert.Valid || !stock.DateAlerted.Valid || now.Sub(stock.DateAlerted.Time) >= inventorymodel.StockAlertDebounce
+		dateAlerted := stock.DateAlerted
+		if notify {
+			dateAlerted = pgtype.Timestamptz{Time: now, Valid: true}
+		}
+
+		if _, err = txStorage.UpdateInventoryStock(ctx, db.UpdateInventoryStockParams{
+			ID:          pgutil.Int64ToPgInt8(stock.ID),
+			Alert:       db.NullInventoryStockAlert{InventoryStockAlert: alert, Valid: true},
+			DateAlerted: dateAlerted,
+		}); err != nil {
+			return err
+		}
+
+		vendorID, ok := vendors[stock.RefID]
+		if !notify || !ok {
+			continue
+		}
+		if err = b.sendStockAlert(ctx, txStorage, vendorID, skus[stock.RefID], stock, alert); err != nil {
+			return err
+		}
+	}
+
+	return txStorage.Commit(ctx)
+}
+
+// sendStockAlert creates the notification of a stock alert for the vendor and the matching system event
+func (b *InventoryBiz) sendStockAlert(ctx context.Context, txStorage *pgutil.TxStorage, vendorID int64, sku db.CatalogProductSku, stock db.InventoryStock, alert db.InventoryStockAlert) error {
+	content := fmt.Sprintf("SKU %s is out of stock", sku.Code)
+	if alert == db.InventoryStockAlertLowStock {
+		content = fmt.Sprintf("SKU %s is low on stock: %d left, below the threshold of %d", sku.Code, stock.CurrentStock, stock.LowStockThreshold)
+	}
+
+	if _, err := txStorage.CreateDefaultAccountNotification(ctx, []db.CreateDefaultAccountNotificationParams{{
+		AccountID: vendorID,
+		Type:      inventorymodel.NotificationTypePush,
+		Channel:   inventorymodel.NotificationChannelStockAlert,
+		Content:   content,
+		DateSent:  pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	}}); err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(inventorymodel.StockAlertPayload{
+		SkuID:             sku.ID,
+		SkuCode:           sku.Code,
+		Alert:             alert,
+		CurrentStock:      stock.CurrentStock,
+		LowStockThreshold: stock.LowStockThreshold,
+	})
+	if err != nil {
+		return err
+	}
+
+	count, err := txStorage.CountSystemEvent(ctx, db.CountSystemEventParams{
+		AggregateID:   []int64{stock.ID},
+		AggregateType: []string{inventorymodel.StockAggregateType},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = txStorage.CreateDefaultSystemEvent(ctx, []db.CreateDefaultSystemEventParams{{
+		AggregateID:   stock.ID,
+		AggregateType: inventorymodel.StockAggregateType,
+		EventType:     db.SystemEventTypeUpdated,
+		Payload:       payload,
+		Version:       count + 1,
+	}})
+	return err
+}
+
+// skuVendors returns the SKUs, map[skuID]SKU, and the vendors owning them, map[skuID]vendorID
+func (b *InventoryBiz) skuVendors(ctx context.Context, storage db.Querier, skuIDs []int64) (map[int64]db.CatalogProductSku, map[int64]int64, error) {
+	skus, err := storage.ListCatalogProductSku(ctx, db.ListCatalogProductSkuParams{
+		ID: skuIDs,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	spuIDs := make([]int64, 0, len(skus))
+	for _, sku := range skus {
+		spuIDs = append(spuIDs, sku.SpuID)
+	}
+	spus, err := storage.ListCatalogProductSpu(ctx, db.ListCatalogProductSpuParams{
+		ID: spuIDs,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	spuVendors := make(map[int64]int64) // map[spuID]vendorID
+	for _, spu := range spus {
+		spuVendors[spu.ID] = spu.AccountID
+	}
+
+	skuMap := make(map[int64]db.CatalogProductSku)
+	vendors := make(map[int64]int64)
+	for _, sku := range skus {
+		skuMap[sku.ID] = sku
+		if vendorID, ok := spuVendors[sku.SpuID]; ok {
+			vendors[sku.ID] = vendorID
+		}
+	}
+
+	return skuMap, vendors, nil
+}
+
+// StartStockAlertWatcher runs CheckStockAlerts periodically while the app is running
+func StartStockAlertWatcher(lc fx.Lifecycle, biz *InventoryBiz) {
+	ticker := time.NewTicker(alertInterval)
+	stop := make(chan struct{})
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			go func() {
+				for {
+					select {
+					case <-ticker.C:
+						if err := biz.CheckStockAlerts(context.Background()); err != nil {
+							logger.Log.Sugar().Errorf("Failed to check stock alerts: %v", err)
+						}
+					case <-stop:
+						return
+					}
+				}
+			}()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			ticker.Stop()
+			close(stop)
+			return nil
+		},
+	})
+}
@@ -0,0 +1,354 @@
+package inventorybiz
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"shopnexus-remastered/internal/db"
+	inventorymodel "shopnexus-remastered/internal/module/inventory/model"
+	"shopnexus-remastered/internal/utils/pgutil"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type ImportInventoryParams struct {
+	VendorID int64
+	File     io.Reader // CSV with the columns of inventorymodel.ImportColumn*
+	DryRun   bool      // Only validate the rows
+}
+
+// importRow is a parsed data row of an inventory import
+type importRow struct {
+	line          int
+	skuCode       string
+	serialNumber  string
+	quantityDelta int64
+}
+
+// ImportInventory registers serials and adjusts stock of the vendor SKUs from a CSV file.
+// Every row is validated first, the file is imported as a whole only when no row has an error.
+func (b *InventoryBiz) ImportInventory(ctx context.Context, params ImportInventoryParams) (inventorymodel.ImportResult, error) {
+	result := inventorymodel.ImportResult{
+		DryRun: params.DryRun,
+		Errors: []inventorymodel.ImportRowError{},
+	}
+
+	rows, rowErrors, err := parseImportFile(params.File)
+	if err != nil {
+		return result, err
+	}
+	result.Rows = len(rows)
+	result.Errors = append(result.Errors, rowErrors...)
+
+	// -- Validate the rows against the database
+
+	skuCodes := make([]string, 0, len(rows))
+	serialNumbers := make([]string, 0, len(rows))
+	for _, row := range rows {
+		skuCodes = append(skuCodes, row.skuCode)
+		if row.serialNumber != "" {
+			serialNumbers = append(serialNumbers, row.serialNumber)
+		}
+	}
+
+	skus, err := b.vendorSkusByCode(ctx, params.VendorID, skuCodes)
+	if err != nil {
+		return result, err
+	}
+
+	existing, err := b.storage.ListInventorySkuSerial(ctx, db.ListInventorySkuSerialParams{
+		SerialNumber: serialNumbers,
+	})
+	if err != nil {
+		return result, err
+	}
+	usedSerials := make(map[string]int) // map[serialNumber]line, 0 if already registered
+	for _, serial := range existing {
+		usedSerials[serial.SerialNumber] = 0
+	}
+
+	var skuIDs []int64
+	deltas := make(map[int64]int64) // map[skuID]stock change
+	var serials []db.CreateDefaultInventorySkuSerialParams
+	for _, row := range rows {
+		sku, ok := skus[row.skuCode]
+		if !ok {
+			result.Errors = append(result.Errors, inventorymodel.ImportRowError{
+				Row:     row.line,
+				Column:  inventorymodel.ImportColumnSkuCode,
+				Message: fmt.Sprintf("Unknown SKU %q", row.skuCode),
+			})
+			continue
+		}
+
+		if row.serialNumber != "" {
+			if line, ok := usedSerials[row.serialNumber]; ok {
+				message := fmt.Sprintf("Serial %q is already registered", row.serialNumber)
+				if line > 0 {
+					message = fmt.Sprintf("Serial %q is duplicated on row %d", row.serialNumber, line)
+				}
+				result.Errors = append(result.Errors, inventorymodel.ImportRowError{
+					Row:     row.line,
+					Column:  inventorymodel.ImportColumnSerialNumber,
+					Message: message,
+				})
+				continue
+			}
+			usedSerials[row.serialNumber] = row.line
+
+			serials = append(serials, db.CreateDefaultInventorySkuSerialParams{
+				SerialNumber: row.serialNumber,
+				SkuID:        sku.ID,
+				Status:       db.InventoryProductStatusActive,
+			})
+		}
+
+		if _, ok := deltas[sku.ID]; !ok {
+			skuIDs = append(skuIDs, sku.ID)
+		}
+		deltas[sku.ID] += row.quantityDelta
+		if row.serialNumber != "" {
+			deltas[sku.ID]++ // Each serial is one unit in the stock
+		}
+	}
+
+	// Stock must not go negative once the whole file is applied
+	stocks, err := b.storage.ListInventoryStock(ctx, db.ListInventoryStockParams{
+		RefType: []db.InventoryStockType{db.InventoryStockTypeProductSKU},
+		RefID:   skuIDs,
+	})
+	if err != nil {
+		return result, err
+	}
+	currentStock := make(map[int64]int64) // map[skuID]current stock
+	for _, stock := range stocks {
+		currentStock[stock.RefID] = stock.CurrentStock
+	}
+	for _, row := range rows {
+		sku, ok := skus[row.skuCode]
+		if !ok || deltas[sku.ID] == 0 || currentStock[sku.ID]+deltas[sku.ID] >= 0 {
+			continue
+		}
+		result.Errors = append(result.Errors, inventorymodel.ImportRowError{
+			Row:     row.line,
+			Column:  inventorymodel.ImportColumnQuantityDelta,
+			Message: fmt.Sprintf("Stock of SKU %q would be %d", row.skuCode, currentStock[sku.ID]+deltas[sku.ID]),
+		})
+		delete(deltas, sku.ID) // Report once per SKU
+	}
+
+	slices.SortFunc(result.Errors, func(a, b inventorymodel.ImportRowError) int {
+		return a.Row - b.Row
+	})
+
+	result.Serials = len(serials)
+	for _, skuID := range skuIDs {
+		if deltas[skuID] != 0 {
+			result.Skus++
+		}
+	}
+	if params.DryRun || len(result.Errors) > 0 {
+		return result, nil
+	}
+
+	// -- Import
+
+	txStorage, err := b.storage.BeginTx(ctx)
+	if err != nil {
+		return result, err
+	}
+	defer txStorage.Rollback(ctx)
+
+	if len(serials) > 0 {
+		if _, err = txStorage.CreateDefaultInventorySkuSerial(ctx, serials); err != nil {
+			return result, err
+		}
+	}
+
+	histories := make([]db.CreateDefaultInventoryStockHistoryParams, 0, len(skuIDs))
+	for _, skuID := range skuIDs {
+		if deltas[skuID] == 0 {
+			continue
+		}
+
+		stock, err := b.getOrCreateSkuStock(ctx, txStorage, skuID)
+		if err != nil {
+			return result, err
+		}
+		stock, err = txStorage.AdjustStock(ctx, db.AdjustStockParams{
+			StockChange: deltas[skuID],
+			ID:          stock.ID,
+		})
+		if err != nil {
+			return result, err
+		}
+		if stock.CurrentStock < 0 {
+			// Stock was taken by checkouts since the validation
+			return result, inventorymodel.ErrOutOfStock
+		}
+
+		histories = append(histories, db.CreateDefaultInventoryStockHistoryParams{
+			StockID:   stock.ID,
+			Change:    deltas[skuID],
+			AccountID: pgutil.Int64ToPgInt8(params.VendorID),
+			Reason:    pgtype.Text{String: "CSV import", Valid: true},
+		})
+	}
+	if len(histories) > 0 {
+		if _, err = txStorage.CreateDefaultInventoryStockHistory(ctx, histories); err != nil {
+			return result, err
+		}
+	}
+
+	if err = txStorage.Commit(ctx); err != nil {
+		return result, err
+	}
+
+	result.Imported = true
+	return result, nil
+}
+
+// parseImportFile reads the data rows of an import CSV, rows with invalid values are reported as row errors
+func parseImportFile(file io.Reader) ([]importRow, []inventorymodel.ImportRowError, error) {
+	reader := csv.NewReader(file)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, inventorymodel.ErrInvalidImportFile
+	}
+	columns := make(map[string]int) // map[column]index
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if _, ok := columns[inventorymodel.ImportColumnSkuCode]; !ok {
+		return nil, nil, inventorymodel.ErrInvalidImportFile
+	}
+	_, hasSerial := columns[inventorymodel.ImportColumnSerialNumber]
+	_, hasDelta := columns[inventorymodel.ImportColumnQuantityDelta]
+	if !hasSerial && !hasDelta {
+		return nil, nil, inventorymodel.ErrInvalidImportFile
+	}
+
+	get := func(record []string, column string) string {
+		i, ok := columns[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var rows []importRow
+	var rowErrors []inventorymodel.ImportRowError
+	reader.FieldsPerRecord = -1 // Missing trailing columns are reported per row
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				rowErrors = append(rowErrors, inventorymodel.ImportRowError{
+					Row:     line,
+					Message: parseErr.Err.Error(),
+				})
+				continue
+			}
+			return nil, nil, err
+		}
+		if len(rows)+len(rowErrors) >= inventorymodel.ImportMaxRows {
+			return nil, nil, inventorymodel.ErrImportTooLarge
+		}
+
+		row := importRow{
+			line:         line,
+			skuCode:      get(record, inventorymodel.ImportColumnSkuCode),
+			serialNumber: get(record, inventorymodel.ImportColumnSerialNumber),
+		}
+
+		if row.skuCode == "" {
+			rowErrors = append(rowErrors, inventorymodel.ImportRowError{
+				Row:     line,
+				Column:  inventorymodel.ImportColumnSkuCode,
+				Message: "SKU code is required",
+			})
+			continue
+		}
+		if len(row.serialNumber) > 50 {
+			rowErrors = append(rowErrors, inventorymodel.ImportRowError{
+				Row:     line,
+				Column:  inventorymodel.ImportColumnSerialNumber,
+				Message: "Serial number must be at most 50 characters",
+			})
+			continue
+		}
+		if delta := get(record, inventorymodel.ImportColumnQuantityDelta); delta != "" {
+			row.quantityDelta, err = strconv.ParseInt(delta, 10, 64)
+			if err != nil {
+				rowErrors = append(rowErrors, inventorymodel.ImportRowError{
+					Row:     line,
+					Column:  inventorymodel.ImportColumnQuantityDelta,
+					Message: fmt.Sprintf("Invalid quantity delta %q", delta),
+				})
+				continue
+			}
+		}
+		if row.serialNumber == "" && row.quantityDelta == 0 {
+			rowErrors = append(rowErrors, inventorymodel.ImportRowError{
+				Row:     line,
+				Message: "Row must have a serial number or a quantity delta",
+			})
+			continue
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, rowErrors, nil
+}
+
+// vendorSkusByCode returns the SKUs of the vendor with the given codes, map[code]SKU
+func (b *InventoryBiz) vendorSkusByCode(ctx context.Context, vendorID int64, codes []string) (map[string]db.CatalogProductSku, error) {
+	result := make(map[string]db.CatalogProductSku)
+	if len(codes) == 0 {
+		return result, nil
+	}
+
+	skus, err := b.storage.ListCatalogProductSku(ctx, db.ListCatalogProductSkuParams{
+		Code: codes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	spuIDs := make([]int64, 0, len(skus))
+	for _, sku := range skus {
+		spuIDs = append(spuIDs, sku.SpuID)
+	}
+	spus, err := b.storage.ListCatalogProductSpu(ctx, db.ListCatalogProductSpuParams{
+		ID:        spuIDs,
+		AccountID: []int64{vendorID},
+	})
+	if err != nil {
+		return nil, err
+	}
+	owned := make(map[int64]bool) // map[spuID]owned by the vendor
+	for _, spu := range spus {
+		owned[spu.ID] = true
+	}
+
+	for _, sku := range skus {
+		if owned[sku.SpuID] {
+			result[sku.Code] = sku
+		}
+	}
+
+	return result, nil
+}
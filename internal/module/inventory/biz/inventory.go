@@ -1,8 +1,14 @@
 package inventorybiz
 
+import "shopnexus-remastered/internal/utils/pgutil"
+
 type InventoryBiz struct {
+	storage *pgutil.Storage
 }
 
-func NewInventoryBiz() *InventoryBiz {
-	return &InventoryBiz{}
+// NewInventoryBiz creates a new instance of InventoryBiz.
+func NewInventoryBiz(storage *pgutil.Storage) *InventoryBiz {
+	return &InventoryBiz{
+		storage: storage,
+	}
 }
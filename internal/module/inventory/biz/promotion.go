@@ -0,0 +1,188 @@
+package inventorybiz
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"shopnexus-remastered/internal/db"
+	inventorymodel "shopnexus-remastered/internal/module/inventory/model"
+	"shopnexus-remastered/internal/utils/pgutil"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// A promotion with a stock (ref_type Promotion) only discounts as many units as its stock, e.g. a flash sale.
+// Promotions without a stock are unlimited.
+
+type SetPromotionQuotaParams struct {
+	VendorID    int64
+	PromotionID int64
+	Quota       int64 // Units left at the promotion price
+}
+
+// SetPromotionQuota sets how many more units the promotion of the vendor discounts
+func (b *InventoryBiz) SetPromotionQuota(ctx context.Context, params SetPromotionQuotaParams) (db.InventoryStock, error) {
+	var zero db.InventoryStock
+
+	txStorage, err := b.storage.BeginTx(ctx)
+	if err != nil {
+		return zero, err
+	}
+	defer txStorage.Rollback(ctx)
+
+	promo, err := txStorage.GetPromotionBase(ctx, db.GetPromotionBaseParams{
+		ID: pgutil.Int64ToPgInt8(params.PromotionID),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return zero, inventorymodel.ErrPromotionNotFound
+		}
+		return zero, err
+	}
+	if !promo.OwnerID.Valid || promo.OwnerID.Int64 != params.VendorID {
+		return zero, inventorymodel.ErrPromotionNotFound
+	}
+
+	if _, err = b.getOrCreateStock(ctx, txStorage, db.InventoryStockTypePromotion, promo.ID); err != nil {
+		return zero, err
+	}
+	stock, err := txStorage.GetStockForUpdate(ctx, db.GetStockForUpdateParams{
+		RefType: db.InventoryStockTypePromotion,
+		RefID:   promo.ID,
+	})
+	if err != nil {
+		return zero, err
+	}
+
+	if change := params.Quota - stock.CurrentStock; change != 0 {
+		if stock, err = txStorage.AdjustStock(ctx, db.AdjustStockParams{
+			StockChange: change,
+			ID:          stock.ID,
+		}); err != nil {
+			return zero, err
+		}
+		if _, err = txStorage.CreateDefaultInventoryStockHistory(ctx, []db.CreateDefaultInventoryStockHistoryParams{{
+			StockID:   stock.ID,
+			Change:    change,
+			AccountID: pgutil.Int64ToPgInt8(params.VendorID),
+			Reason:    pgtype.Text{String: "Promotion quota", Valid: true},
+		}}); err != nil {
+			return zero, err
+		}
+	}
+
+	if err = txStorage.Commit(ctx); err != nil {
+		return zero, err
+	}
+
+	return stock, nil
+}
+
+// GetPromotionQuotas returns the units left of the promotions having a quota, map[promotionID]units.
+// Promotions missing from the map are unlimited.
+func (b *InventoryBiz) GetPromotionQuotas(ctx context.Context, storage db.Querier, promotionIDs []int64) (map[int64]int64, error) {
+	result := make(map[int64]int64)
+	if len(promotionIDs) == 0 {
+		return result, nil
+	}
+
+	stocks, err := storage.ListInventoryStock(ctx, db.ListInventoryStockParams{
+		RefType: []db.InventoryStockType{db.InventoryStockTypePromotion},
+		RefID:   promotionIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, stock := range stocks {
+		result[stock.RefID] = stock.CurrentStock
+	}
+
+	return result, nil
+}
+
+// LockPromotionQuotas locks the quotas of the promotions until the end of the transaction and returns their units left,
+// map[promotionID]units. Promotions missing from the map are unlimited.
+func (b *InventoryBiz) LockPromotionQuotas(ctx context.Context, storage db.Querier, promotionIDs []int64) (map[int64]int64, error) {
+	// Lock in the same order in every checkout, so two checkouts locking the same quotas cannot deadlock
+	ids := slices.Clone(promotionIDs)
+	slices.Sort(ids)
+	ids = slices.Compact(ids)
+
+	result := make(map[int64]int64, len(ids))
+	for _, id := range ids {
+		stock, err := storage.GetStockForUpdate(ctx, db.GetStockForUpdateParams{
+			RefType: db.InventoryStockTypePromotion,
+			RefID:   id,
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				continue
+			}
+			return nil, err
+		}
+		result[id] = max(stock.CurrentStock, 0)
+	}
+
+	return result, nil
+}
+
+type ReservePromotionQuotaParams struct {
+	RefType     db.InventoryReservationType
+	RefID       int64
+	PromotionID int64
+	Quantity    int64
+	TTL         time.Duration
+}
+
+// ReservePromotionQuota takes up to Quantity units from the quota of the promotion and holds them for the order,
+// it returns how many units get the promotion price, all of them if the promotion has no quota.
+// The held units follow the order like its stock reservation: committed when paid, given back when canceled.
+func (b *InventoryBiz) ReservePromotionQuota(ctx context.Context, storage db.Querier, params ReservePromotionQuotaParams) (int64, error) {
+	if params.Quantity <= 0 {
+		return 0, nil
+	}
+
+	// Lock the quota so concurrent checkouts cannot take the same units
+	stock, err := storage.GetStockForUpdate(ctx, db.GetStockForUpdateParams{
+		RefType: db.InventoryStockTypePromotion,
+		RefID:   params.PromotionID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return params.Quantity, nil
+		}
+		return 0, err
+	}
+
+	taken := min(max(stock.CurrentStock, 0), params.Quantity)
+	if taken == 0 {
+		return 0, nil
+	}
+
+	if _, err = storage.AdjustStock(ctx, db.AdjustStockParams{
+		StockChange: -taken,
+		ID:          stock.ID,
+	}); err != nil {
+		return 0, err
+	}
+	if _, err = storage.CreateDefaultInventoryStockReservation(ctx, []db.CreateDefaultInventoryStockReservationParams{{
+		StockID:     stock.ID,
+		RefType:     params.RefType,
+		RefID:       params.RefID,
+		Quantity:    taken,
+		DateExpired: pgtype.Timestamptz{Time: time.Now().Add(params.TTL), Valid: true},
+	}}); err != nil {
+		return 0, err
+	}
+	if _, err = storage.CreateDefaultInventoryStockHistory(ctx, []db.CreateDefaultInventoryStockHistoryParams{{
+		StockID: stock.ID,
+		Change:  -taken,
+	}}); err != nil {
+		return 0, err
+	}
+
+	return taken, nil
+}
@@ -0,0 +1,102 @@
+package inventorybiz
+
+import (
+	"context"
+
+	"shopnexus-remastered/internal/db"
+	inventorymodel "shopnexus-remastered/internal/module/inventory/model"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// reconcileBatchSize is the max number of stocks checked per transaction
+const reconcileBatchSize = 500
+
+// reconcileReason is the stock history reason of corrective entries
+const reconcileReason = "Reconciliation"
+
+type ReconcileStockParams struct {
+	Fix bool // Write a corrective history entry for each stock drift
+}
+
+// ReconcileStock checks every SKU stock against its stock history, the sold order items and the Sold serials,
+// and returns the stocks that do not match.
+// With Fix, the history of drifted stocks is corrected to the current stock, sold and serial drifts are only reported.
+func (b *InventoryBiz) ReconcileStock(ctx context.Context, params ReconcileStockParams) ([]inventorymodel.StockReconciliation, error) {
+	var result []inventorymodel.StockReconciliation
+
+	var cursor int64
+	for {
+		mismatches, last, err := b.reconcileStockBatch(ctx, cursor, params.Fix)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, mismatches...)
+		if last == 0 {
+			return result, nil
+		}
+		cursor = last
+	}
+}
+
+// reconcileStockBatch reconciles the stocks after the cursor, returning the last stock id or 0 when there is none left
+func (b *InventoryBiz) reconcileStockBatch(ctx context.Context, cursor int64, fix bool) ([]inventorymodel.StockReconciliation, int64, error) {
+	txStorage, err := b.storage.BeginTx(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer txStorage.Rollback(ctx)
+
+	// Stock rows are locked so the corrections match the stock they were computed from
+	rows, err := txStorage.ListStockReconciliation(ctx, db.ListStockReconciliationParams{
+		Cursor: cursor,
+		Limit:  reconcileBatchSize,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(rows) == 0 {
+		return nil, 0, nil
+	}
+
+	var mismatches []inventorymodel.StockReconciliation
+	var histories []db.CreateDefaultInventoryStockHistoryParams
+	for _, row := range rows {
+		mismatch := inventorymodel.StockReconciliation{
+			StockID:      row.ID,
+			SkuID:        row.RefID,
+			CurrentStock: row.CurrentStock,
+			HistoryStock: row.HistoryStock,
+			Sold:         row.Sold,
+			SoldItems:    row.SoldItems,
+			PendingItems: row.PendingItems,
+			Serials:      row.Serials,
+			SoldSerials:  row.SoldSerials,
+		}
+		if mismatch.StockDrift() == 0 && mismatch.SoldDrift() == 0 && mismatch.SerialDrift() == 0 {
+			continue
+		}
+
+		if fix && mismatch.StockDrift() != 0 {
+			histories = append(histories, db.CreateDefaultInventoryStockHistoryParams{
+				StockID: row.ID,
+				Change:  mismatch.StockDrift(),
+				Reason:  pgtype.Text{String: reconcileReason, Valid: true},
+			})
+			mismatch.Fixed = true
+		}
+		mismatches = append(mismatches, mismatch)
+	}
+
+	if len(histories) > 0 {
+		if _, err = txStorage.CreateDefaultInventoryStockHistory(ctx, histories); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	if err = txStorage.Commit(ctx); err != nil {
+		return nil, 0, err
+	}
+
+	return mismatches, rows[len(rows)-1].ID, nil
+}
@@ -0,0 +1,277 @@
+package inventorybiz
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"shopnexus-remastered/internal/db"
+	"shopnexus-remastered/internal/logger"
+	inventorymodel "shopnexus-remastered/internal/module/inventory/model"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/fx"
+)
+
+const (
+	// sweepInterval is how often expired reservations are released
+	sweepInterval = time.Minute
+	// sweepBatchSize is the max number of reservations released per sweep
+	sweepBatchSize = 500
+)
+
+// Reservation operations take the storage to run on, so they join the transaction of the cart or order change.
+
+type ReserveStockParams struct {
+	RefType db.InventoryReservationType
+	RefID   int64
+	Items   map[int64]int64 // map[skuID]quantity
+	TTL     time.Duration
+}
+
+// ReserveStock takes the quantity of each SKU out of the stock and holds it for the cart or order until TTL
+func (b *InventoryBiz) ReserveStock(ctx context.Context, storage db.Querier, params ReserveStockParams) error {
+	// Reserve in the same SKU order everywhere so concurrent reservations do not deadlock
+	skuIDs := make([]int64, 0, len(params.Items))
+	for skuID, quantity := range params.Items {
+		if quantity > 0 {
+			skuIDs = append(skuIDs, skuID)
+		}
+	}
+	slices.Sort(skuIDs)
+
+	expired := pgtype.Timestamptz{Time: time.Now().Add(params.TTL), Valid: true}
+	reservations := make([]db.CreateDefaultInventoryStockReservationParams, 0, len(skuIDs))
+	histories := make([]db.CreateDefaultInventoryStockHistoryParams, 0, len(skuIDs))
+	for _, skuID := range skuIDs {
+		quantity := params.Items[skuID]
+		stock, err := storage.ReserveStock(ctx, db.ReserveStockParams{
+			Quantity: quantity,
+			RefType:  db.InventoryStockTypeProductSKU,
+			RefID:    skuID,
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return inventorymodel.ErrOutOfStock
+			}
+			return err
+		}
+
+		reservations = append(reservations, db.CreateDefaultInventoryStockReservationParams{
+			StockID:     stock.ID,
+			RefType:     params.RefType,
+			RefID:       params.RefID,
+			Quantity:    quantity,
+			DateExpired: expired,
+		})
+		histories = append(histories, db.CreateDefaultInventoryStockHistoryParams{
+			StockID: stock.ID,
+			Change:  -quantity,
+		})
+	}
+	if len(reservations) == 0 {
+		return nil
+	}
+
+	if _, err := storage.CreateDefaultInventoryStockReservation(ctx, reservations); err != nil {
+		return err
+	}
+	_, err := storage.CreateDefaultInventoryStockHistory(ctx, histories)
+	return err
+}
+
+type CommitStockParams struct {
+	RefType db.InventoryReservationType
+	RefID   int64
+}
+
+// CommitStock turns the held stock of an order into sold stock, it is not released on expiry anymore
+func (b *InventoryBiz) CommitStock(ctx context.Context, storage db.Querier, params CommitStockParams) error {
+	reservations, err := storage.ListInventoryStockReservation(ctx, db.ListInventoryStockReservationParams{
+		RefType: []db.InventoryReservationType{params.RefType},
+		RefID:   []int64{params.RefID},
+		Status:  []db.SharedStatus{db.SharedStatusPending},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, reservation := range reservations {
+		// Only commit if the sweeper did not release it since we read it
+		if _, err = storage.UpdateStockReservationStatus(ctx, db.UpdateStockReservationStatusParams{
+			NewStatus: db.SharedStatusSuccess,
+			ID:        reservation.ID,
+			OldStatus: reservation.Status,
+		}); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				continue
+			}
+			return err
+		}
+
+		if _, err = storage.AdjustStock(ctx, db.AdjustStockParams{
+			SoldChange: reservation.Quantity,
+			ID:         reservation.StockID,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type ReleaseStockParams struct {
+	RefType db.InventoryReservationType
+	RefID   int64
+	SkuIDs  []int64 // Only release these SKUs, nil means all
+}
+
+// ReleaseStock gives the stock held or sold for a cart or order back to the stock
+func (b *InventoryBiz) ReleaseStock(ctx context.Context, storage db.Querier, params ReleaseStockParams) error {
+	var stockIDs []int64
+	if params.SkuIDs != nil {
+		stocks, err := storage.ListInventoryStock(ctx, db.ListInventoryStockParams{
+			RefType: []db.InventoryStockType{db.InventoryStockTypeProductSKU},
+			RefID:   params.SkuIDs,
+		})
+		if err != nil {
+			return err
+		}
+		stockIDs = make([]int64, 0, len(stocks))
+		for _, stock := range stocks {
+			stockIDs = append(stockIDs, stock.ID)
+		}
+	}
+
+	reservations, err := storage.ListInventoryStockReservation(ctx, db.ListInventoryStockReservationParams{
+		StockID: stockIDs,
+		RefType: []db.InventoryReservationType{params.RefType},
+		RefID:   []int64{params.RefID},
+		Status:  []db.SharedStatus{db.SharedStatusPending, db.SharedStatusSuccess},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, reservation := range reservations {
+		if err = b.releaseReservation(ctx, storage, reservation); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// releaseReservation cancels a reservation and puts its quantity back to the stock.
+// It does nothing if the reservation has been changed since it was read.
+func (b *InventoryBiz) releaseReservation(ctx context.Context, storage db.Querier, reservation db.InventoryStockReservation) error {
+	if _, err := storage.UpdateStockReservationStatus(ctx, db.UpdateStockReservationStatusParams{
+		NewStatus: db.SharedStatusCanceled,
+		ID:        reservation.ID,
+		OldStatus: reservation.Status,
+	}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+
+	// Committed reservations were counted as sold
+	var soldChange int64
+	if reservation.Status == db.SharedStatusSuccess {
+		soldChange = -reservation.Quantity
+	}
+	if _, err := storage.AdjustStock(ctx, db.AdjustStockParams{
+		StockChange: reservation.Quantity,
+		SoldChange:  soldChange,
+		ID:          reservation.StockID,
+	}); err != nil {
+		return err
+	}
+
+	_, err := storage.CreateDefaultInventoryStockHistory(ctx, []db.CreateDefaultInventoryStockHistoryParams{{
+		StockID: reservation.StockID,
+		Change:  reservation.Quantity,
+	}})
+	return err
+}
+
+type ExtendReservationParams struct {
+	RefType db.InventoryReservationType
+	RefID   int64
+	TTL     time.Duration
+}
+
+// ExtendReservation keeps the held stock of a cart or order for TTL from now, e.g. on cart activity
+func (b *InventoryBiz) ExtendReservation(ctx context.Context, storage db.Querier, params ExtendReservationParams) error {
+	return storage.ExtendStockReservation(ctx, db.ExtendStockReservationParams{
+		DateExpired: pgtype.Timestamptz{Time: time.Now().Add(params.TTL), Valid: true},
+		RefType:     params.RefType,
+		RefID:       params.RefID,
+	})
+}
+
+// ReleaseExpiredReservations gives back the stock of reservations held past their expiry,
+// from inactive carts and unpaid orders.
+func (b *InventoryBiz) ReleaseExpiredReservations(ctx context.Context) error {
+	reservations, err := b.storage.ListExpiredStockReservation(ctx, db.ListExpiredStockReservationParams{
+		DateExpired: pgtype.Timestamptz{Time: time.Now(), Valid: true},
+		Limit:       sweepBatchSize,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, reservation := range reservations {
+		if err := b.releaseExpiredReservation(ctx, reservation); err != nil {
+			logger.Log.Sugar().Errorf("Failed to release stock reservation %d: %v", reservation.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func (b *InventoryBiz) releaseExpiredReservation(ctx context.Context, reservation db.InventoryStockReservation) error {
+	txStorage, err := b.storage.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer txStorage.Rollback(ctx)
+
+	if err = b.releaseReservation(ctx, txStorage, reservation); err != nil {
+		return err
+	}
+
+	return txStorage.Commit(ctx)
+}
+
+// StartReservationSweeper runs ReleaseExpiredReservations periodically while the app is running
+func StartReservationSweeper(lc fx.Lifecycle, biz *InventoryBiz) {
+	ticker := time.NewTicker(sweepInterval)
+	stop := make(chan struct{})
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			go func() {
+				for {
+					select {
+					case <-ticker.C:
+						if err := biz.ReleaseExpiredReservations(context.Background()); err != nil {
+							logger.Log.Sugar().Errorf("Failed to release expired stock reservations: %v", err)
+						}
+					case <-stop:
+						return
+					}
+				}
+			}()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			ticker.Stop()
+			close(stop)
+			return nil
+		},
+	})
+}
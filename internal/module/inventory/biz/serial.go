@@ -0,0 +1,212 @@
+package inventorybiz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"shopnexus-remastered/internal/db"
+	inventorymodel "shopnexus-remastered/internal/module/inventory/model"
+	sharedmodel "shopnexus-remastered/internal/module/shared/model"
+	"shopnexus-remastered/internal/utils/pgutil"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type ListSerialsParams struct {
+	sharedmodel.PaginationParams
+	VendorID int64
+	SkuID    int64
+	Status   []db.InventoryProductStatus
+}
+
+// ListSerials lists the serials of a SKU of the vendor
+func (b *InventoryBiz) ListSerials(ctx context.Context, params ListSerialsParams) (sharedmodel.PaginateResult[db.InventorySkuSerial], error) {
+	var zero sharedmodel.PaginateResult[db.InventorySkuSerial]
+
+	if err := b.checkSkuVendor(ctx, b.storage, params.SkuID, params.VendorID); err != nil {
+		return zero, err
+	}
+
+	total, err := b.storage.CountInventorySkuSerial(ctx, db.CountInventorySkuSerialParams{
+		SkuID:  []int64{params.SkuID},
+		Status: params.Status,
+	})
+	if err != nil {
+		return zero, err
+	}
+
+	serials, err := b.storage.ListInventorySkuSerial(ctx, db.ListInventorySkuSerialParams{
+		Limit:  pgutil.Int32ToPgInt4(params.GetLimit()),
+		Offset: pgutil.Int32ToPgInt4(params.GetOffset()),
+		SkuID:  []int64{params.SkuID},
+		Status: params.Status,
+	})
+	if err != nil {
+		return zero, err
+	}
+
+	return sharedmodel.PaginateResult[db.InventorySkuSerial]{
+		Data:       serials,
+		Limit:      params.GetLimit(),
+		Page:       params.GetPage(),
+		Total:      total,
+		NextPage:   params.NextPage(total),
+		NextCursor: params.NextCursor(total),
+	}, nil
+}
+
+type RegisterSerialsParams struct {
+	VendorID      int64
+	SkuID         int64
+	SerialNumbers []string
+}
+
+// RegisterSerials adds Active serials to a SKU of the vendor, each serial adds one unit to the stock
+func (b *InventoryBiz) RegisterSerials(ctx context.Context, params RegisterSerialsParams) ([]db.InventorySkuSerial, error) {
+	// A serial repeated in the input would be registered, and counted in the stock, twice
+	seen := make(map[string]bool, len(params.SerialNumbers))
+	for _, serialNumber := range params.SerialNumbers {
+		if seen[serialNumber] {
+			return nil, inventorymodel.ErrSerialExists
+		}
+		seen[serialNumber] = true
+	}
+
+	txStorage, err := b.storage.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer txStorage.Rollback(ctx)
+
+	if err = b.checkSkuVendor(ctx, txStorage, params.SkuID, params.VendorID); err != nil {
+		return nil, err
+	}
+
+	exists, err := txStorage.CountInventorySkuSerial(ctx, db.CountInventorySkuSerialParams{
+		SerialNumber: params.SerialNumbers,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if exists > 0 {
+		return nil, inventorymodel.ErrSerialExists
+	}
+
+	serials := make([]db.CreateDefaultInventorySkuSerialParams, 0, len(params.SerialNumbers))
+	for _, serialNumber := range params.SerialNumbers {
+		serials = append(serials, db.CreateDefaultInventorySkuSerialParams{
+			SerialNumber: serialNumber,
+			SkuID:        params.SkuID,
+			Status:       db.InventoryProductStatusActive,
+		})
+	}
+	if _, err = txStorage.CreateDefaultInventorySkuSerial(ctx, serials); err != nil {
+		return nil, err
+	}
+
+	if _, err = b.adjustSkuStock(ctx, txStorage, AdjustStockParams{
+		VendorID: params.VendorID,
+		SkuID:    params.SkuID,
+		Change:   int64(len(serials)),
+		Reason:   fmt.Sprintf("Registered %d serials", len(serials)),
+	}); err != nil {
+		return nil, err
+	}
+
+	result, err := txStorage.ListInventorySkuSerial(ctx, db.ListInventorySkuSerialParams{
+		SerialNumber: params.SerialNumbers,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = txStorage.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+type UpdateSerialStatusParams struct {
+	VendorID int64
+	SerialID int64
+	Status   db.InventoryProductStatus // Active, Inactive (retired) or Damaged
+}
+
+// UpdateSerialStatus retires, damages or reactivates a serial of the vendor.
+// Only Active serials count in the stock, so the stock follows the change.
+func (b *InventoryBiz) UpdateSerialStatus(ctx context.Context, params UpdateSerialStatusParams) (db.InventorySkuSerial, error) {
+	var zero db.InventorySkuSerial
+
+	switch params.Status {
+	case db.InventoryProductStatusActive, db.InventoryProductStatusInactive, db.InventoryProductStatusDamaged:
+	default:
+		return zero, inventorymodel.ErrInvalidSerialStatus
+	}
+
+	txStorage, err := b.storage.BeginTx(ctx)
+	if err != nil {
+		return zero, err
+	}
+	defer txStorage.Rollback(ctx)
+
+	serial, err := txStorage.GetInventorySkuSerial(ctx, db.GetInventorySkuSerialParams{
+		ID: pgutil.Int64ToPgInt8(params.SerialID),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return zero, inventorymodel.ErrSerialNotFound
+		}
+		return zero, err
+	}
+	if err = b.checkSkuVendor(ctx, txStorage, serial.SkuID, params.VendorID); err != nil {
+		if errors.Is(err, inventorymodel.ErrSkuNotFound) {
+			return zero, inventorymodel.ErrSerialNotFound
+		}
+		return zero, err
+	}
+	if serial.Status == db.InventoryProductStatusSold {
+		return zero, inventorymodel.ErrSerialSold
+	}
+	if serial.Status == params.Status {
+		return serial, nil
+	}
+
+	// Only update if nobody changed the status since we read it (e.g. sold at checkout)
+	updated, err := txStorage.UpdateInventorySkuSerialStatus(ctx, db.UpdateInventorySkuSerialStatusParams{
+		NewStatus: params.Status,
+		ID:        serial.ID,
+		OldStatus: serial.Status,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return zero, inventorymodel.ErrSerialStatusConflict
+		}
+		return zero, err
+	}
+
+	var change int64
+	switch {
+	case serial.Status == db.InventoryProductStatusActive:
+		change = -1
+	case params.Status == db.InventoryProductStatusActive:
+		change = 1
+	}
+	if change != 0 {
+		if _, err = b.adjustSkuStock(ctx, txStorage, AdjustStockParams{
+			VendorID: params.VendorID,
+			SkuID:    serial.SkuID,
+			Change:   change,
+			Reason:   fmt.Sprintf("Serial %s is %s", serial.SerialNumber, params.Status),
+		}); err != nil {
+			return zero, err
+		}
+	}
+
+	if err = txStorage.Commit(ctx); err != nil {
+		return zero, err
+	}
+
+	return updated, nil
+}
@@ -0,0 +1,212 @@
+package inventorybiz
+
+import (
+	"context"
+	"errors"
+
+	"shopnexus-remastered/internal/db"
+	inventorymodel "shopnexus-remastered/internal/module/inventory/model"
+	sharedmodel "shopnexus-remastered/internal/module/shared/model"
+	"shopnexus-remastered/internal/utils/pgutil"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type GetStockParams struct {
+	VendorID int64
+	SkuID    int64
+}
+
+// GetStock returns the stock of a SKU of the vendor
+func (b *InventoryBiz) GetStock(ctx context.Context, params GetStockParams) (db.InventoryStock, error) {
+	var zero db.InventoryStock
+
+	if err := b.checkSkuVendor(ctx, b.storage, params.SkuID, params.VendorID); err != nil {
+		return zero, err
+	}
+
+	stock, err := b.storage.GetInventoryStock(ctx, db.GetInventoryStockParams{
+		RefID:   pgutil.Int64ToPgInt8(params.SkuID),
+		RefType: db.NullInventoryStockType{InventoryStockType: db.InventoryStockTypeProductSKU, Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return zero, inventorymodel.ErrStockNotFound
+		}
+		return zero, err
+	}
+
+	return stock, nil
+}
+
+type AdjustStockParams struct {
+	VendorID int64
+	SkuID    int64
+	Change   int64 // Positive adds stock, negative removes stock
+	Reason   string
+}
+
+// AdjustStock adds or removes stock of a SKU of the vendor, the stock is created on the first adjustment
+func (b *InventoryBiz) AdjustStock(ctx context.Context, params AdjustStockParams) (db.InventoryStock, error) {
+	var zero db.InventoryStock
+
+	if params.Change == 0 {
+		return zero, inventorymodel.ErrInvalidStockChange
+	}
+
+	txStorage, err := b.storage.BeginTx(ctx)
+	if err != nil {
+		return zero, err
+	}
+	defer txStorage.Rollback(ctx)
+
+	if err = b.checkSkuVendor(ctx, txStorage, params.SkuID, params.VendorID); err != nil {
+		return zero, err
+	}
+
+	stock, err := b.adjustSkuStock(ctx, txStorage, params)
+	if err != nil {
+		return zero, err
+	}
+
+	if err = txStorage.Commit(ctx); err != nil {
+		return zero, err
+	}
+
+	return stock, nil
+}
+
+// adjustSkuStock changes the stock of a SKU and writes the change to the stock history
+func (b *InventoryBiz) adjustSkuStock(ctx context.Context, storage db.Querier, params AdjustStockParams) (db.InventoryStock, error) {
+	var zero db.InventoryStock
+
+	stock, err := b.getOrCreateSkuStock(ctx, storage, params.SkuID)
+	if err != nil {
+		return zero, err
+	}
+
+	stock, err = storage.AdjustStock(ctx, db.AdjustStockParams{
+		StockChange: params.Change,
+		ID:          stock.ID,
+	})
+	if err != nil {
+		return zero, err
+	}
+	if stock.CurrentStock < 0 {
+		return zero, inventorymodel.ErrOutOfStock
+	}
+
+	if _, err = storage.CreateDefaultInventoryStockHistory(ctx, []db.CreateDefaultInventoryStockHistoryParams{{
+		StockID:   stock.ID,
+		Change:    params.Change,
+		AccountID: pgutil.Int64ToPgInt8(params.VendorID),
+		Reason:    pgtype.Text{String: params.Reason, Valid: params.Reason != ""},
+	}}); err != nil {
+		return zero, err
+	}
+
+	return stock, nil
+}
+
+// getOrCreateSkuStock returns the stock of a SKU, creating an empty one if the SKU has none yet
+func (b *InventoryBiz) getOrCreateSkuStock(ctx context.Context, storage db.Querier, skuID int64) (db.InventoryStock, error) {
+	return b.getOrCreateStock(ctx, storage, db.InventoryStockTypeProductSKU, skuID)
+}
+
+// getOrCreateStock returns the stock of a SKU or promotion, creating an empty one if it has none yet
+func (b *InventoryBiz) getOrCreateStock(ctx context.Context, storage db.Querier, refType db.InventoryStockType, refID int64) (db.InventoryStock, error) {
+	getParams := db.GetInventoryStockParams{
+		RefID:   pgutil.Int64ToPgInt8(refID),
+		RefType: db.NullInventoryStockType{InventoryStockType: refType, Valid: true},
+	}
+
+	stock, err := storage.GetInventoryStock(ctx, getParams)
+	if err == nil || !errors.Is(err, pgx.ErrNoRows) {
+		return stock, err
+	}
+
+	if _, err = storage.CreateDefaultInventoryStock(ctx, []db.CreateDefaultInventoryStockParams{{
+		RefType: refType,
+		RefID:   refID,
+	}}); err != nil {
+		return stock, err
+	}
+
+	return storage.GetInventoryStock(ctx, getParams)
+}
+
+type ListStockHistoryParams struct {
+	sharedmodel.CursorParams
+	VendorID int64
+	SkuID    int64
+}
+
+// ListStockHistory lists the stock changes of a SKU of the vendor, oldest first
+func (b *InventoryBiz) ListStockHistory(ctx context.Context, params ListStockHistoryParams) (sharedmodel.PaginateResult[db.InventoryStockHistory], error) {
+	var zero sharedmodel.PaginateResult[db.InventoryStockHistory]
+
+	stock, err := b.GetStock(ctx, GetStockParams{
+		VendorID: params.VendorID,
+		SkuID:    params.SkuID,
+	})
+	if err != nil {
+		return zero, err
+	}
+
+	total, err := b.storage.CountInventoryStockHistory(ctx, db.CountInventoryStockHistoryParams{
+		StockID: []int64{stock.ID},
+	})
+	if err != nil {
+		return zero, err
+	}
+
+	histories, err := b.storage.ListInventoryStockHistory(ctx, db.ListInventoryStockHistoryParams{
+		Limit:   pgutil.Int32ToPgInt4(params.GetLimit()),
+		StockID: []int64{stock.ID},
+		IDFrom:  pgutil.Int64ToPgInt8(params.Cursor + 1),
+	})
+	if err != nil {
+		return zero, err
+	}
+
+	var nextCursor *string
+	if len(histories) > 0 {
+		nextCursor = params.NextCursor(len(histories), histories[len(histories)-1].ID)
+	}
+
+	return sharedmodel.PaginateResult[db.InventoryStockHistory]{
+		Data:       histories,
+		Limit:      params.GetLimit(),
+		Total:      total,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// checkSkuVendor returns ErrSkuNotFound unless the SPU of the SKU belongs to the vendor
+func (b *InventoryBiz) checkSkuVendor(ctx context.Context, storage db.Querier, skuID int64, vendorID int64) error {
+	sku, err := storage.GetCatalogProductSku(ctx, db.GetCatalogProductSkuParams{
+		ID: pgutil.Int64ToPgInt8(skuID),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return inventorymodel.ErrSkuNotFound
+		}
+		return err
+	}
+
+	spu, err := storage.GetCatalogProductSpu(ctx, db.GetCatalogProductSpuParams{
+		ID: pgutil.Int64ToPgInt8(sku.SpuID),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return inventorymodel.ErrSkuNotFound
+		}
+		return err
+	}
+	if spu.AccountID != vendorID {
+		return inventorymodel.ErrSkuNotFound
+	}
+
+	return nil
+}
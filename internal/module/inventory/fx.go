@@ -13,4 +13,10 @@ var Module = fx.Module("inventory",
 		inventorybiz.NewInventoryBiz,
 		inventoryecho.NewHandler,
 	),
+
+	// Background jobs
+	fx.Invoke(
+		inventorybiz.StartReservationSweeper,
+		inventorybiz.StartStockAlertWatcher,
+	),
 )
@@ -0,0 +1,29 @@
+package inventorymodel
+
+import (
+	"time"
+
+	"shopnexus-remastered/internal/db"
+)
+
+const (
+	// StockAggregateType is the system.event aggregate type of stock events
+	StockAggregateType = "Stock"
+
+	// StockAlertDebounce is how long a stock stays quiet after an alert, when it goes back and forth around its threshold
+	StockAlertDebounce = 6 * time.Hour
+
+	// NotificationTypePush is the account.notification type of in-app notifications
+	NotificationTypePush = "push"
+	// NotificationChannelStockAlert is the account.notification channel of low and out of stock alerts
+	NotificationChannelStockAlert = "stock_alert"
+)
+
+// StockAlertPayload is the system.event payload written when a stock alert is sent
+type StockAlertPayload struct {
+	SkuID             int64                  `json:"sku_id"`
+	SkuCode           string                 `json:"sku_code"`
+	Alert             db.InventoryStockAlert `json:"alert"`
+	CurrentStock      int64                  `json:"current_stock"`
+	LowStockThreshold int64                  `json:"low_stock_threshold"`
+}
@@ -0,0 +1,18 @@
+package inventorymodel
+
+import sharedmodel "shopnexus-remastered/internal/module/shared/model"
+
+var (
+	ErrOutOfStock           = sharedmodel.NewError("inventory.out_of_stock", "Not enough stock for some items")
+	ErrSkuNotFound          = sharedmodel.NewError("inventory.sku_not_found", "Product SKU not found")
+	ErrStockNotFound        = sharedmodel.NewError("inventory.stock_not_found", "Stock of the product SKU not found")
+	ErrInvalidStockChange   = sharedmodel.NewError("inventory.invalid_stock_change", "Stock change must not be zero")
+	ErrSerialNotFound       = sharedmodel.NewError("inventory.serial_not_found", "Serial not found")
+	ErrSerialExists         = sharedmodel.NewError("inventory.serial_exists", "Some serial numbers are already registered")
+	ErrSerialSold           = sharedmodel.NewError("inventory.serial_sold", "Sold serials cannot be changed")
+	ErrInvalidSerialStatus  = sharedmodel.NewError("inventory.invalid_serial_status", "Serials can only be set to Active, Inactive or Damaged")
+	ErrInvalidImportFile    = sharedmodel.NewError("inventory.invalid_import_file", "Import file must be a CSV with a sku_code column and a serial_number or quantity_delta column")
+	ErrImportTooLarge       = sharedmodel.NewError("inventory.import_too_large", "Import file has too many rows")
+	ErrSerialStatusConflict = sharedmodel.NewError("inventory.serial_status_conflict", "Serial status has been changed by another request, please retry")
+	ErrPromotionNotFound    = sharedmodel.NewError("inventory.promotion_not_found", "Promotion not found")
+)
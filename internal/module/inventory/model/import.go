@@ -0,0 +1,28 @@
+package inventorymodel
+
+// Columns of an inventory import CSV, the header row is required and columns can be in any order
+const (
+	ImportColumnSkuCode       = "sku_code"
+	ImportColumnSerialNumber  = "serial_number"
+	ImportColumnQuantityDelta = "quantity_delta"
+)
+
+// ImportMaxRows is the max number of data rows of an inventory import
+const ImportMaxRows = 10000
+
+// ImportRowError is a problem found on a row of an inventory import
+type ImportRowError struct {
+	Row     int    `json:"row"` // Line number in the file, the header is row 1
+	Column  string `json:"column,omitempty"`
+	Message string `json:"message"`
+}
+
+// ImportResult summarizes an inventory import. Nothing is written when there is any error.
+type ImportResult struct {
+	DryRun   bool             `json:"dry_run"`
+	Rows     int              `json:"rows"`
+	Serials  int              `json:"serials"`  // Serials registered
+	Skus     int              `json:"skus"`     // SKUs whose stock changed
+	Imported bool             `json:"imported"` // Whether the changes were written
+	Errors   []ImportRowError `json:"errors"`
+}
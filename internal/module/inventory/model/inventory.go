@@ -1 +1,10 @@
 package inventorymodel
+
+import "time"
+
+const (
+	// CartReservationTTL is how long cart items hold their stock after the last cart activity
+	CartReservationTTL = time.Hour
+	// OrderReservationTTL is how long an unpaid order holds its stock, longer than the order payment expiry
+	OrderReservationTTL = 2 * time.Hour
+)
@@ -0,0 +1,33 @@
+package inventorymodel
+
+// StockReconciliation is a SKU stock whose numbers do not match its history, orders or serials
+type StockReconciliation struct {
+	StockID      int64 `json:"stock_id"`
+	SkuID        int64 `json:"sku_id"`
+	CurrentStock int64 `json:"current_stock"`
+	HistoryStock int64 `json:"history_stock"` // Sum of the stock history changes
+	Sold         int64 `json:"sold"`
+	SoldItems    int64 `json:"sold_items"`    // Quantity in Processing and Success orders
+	PendingItems int64 `json:"pending_items"` // Quantity in Pending orders, their serials are already Sold
+	Serials      int64 `json:"serials"`
+	SoldSerials  int64 `json:"sold_serials"`
+	Fixed        bool  `json:"fixed"` // A corrective history entry was written
+}
+
+// StockDrift is how much the current stock is above the stock history
+func (r StockReconciliation) StockDrift() int64 {
+	return r.CurrentStock - r.HistoryStock
+}
+
+// SoldDrift is how much the sold count is above the sold order items
+func (r StockReconciliation) SoldDrift() int64 {
+	return r.Sold - r.SoldItems
+}
+
+// SerialDrift is how much the Sold serials are above the order items holding them, 0 for SKUs without serials
+func (r StockReconciliation) SerialDrift() int64 {
+	if r.Serials == 0 {
+		return 0
+	}
+	return r.SoldSerials - r.SoldItems - r.PendingItems
+}
@@ -0,0 +1,56 @@
+package inventoryecho
+
+import (
+	"net/http"
+
+	authbiz "shopnexus-remastered/internal/module/auth/biz"
+	inventorybiz "shopnexus-remastered/internal/module/inventory/biz"
+	"shopnexus-remastered/internal/module/shared/transport/echo/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ImportInventoryRequest struct {
+	DryRun bool `query:"dry_run"`
+}
+
+// ImportInventory imports serials and stock changes from the CSV in the "file" form field.
+// Responds 422 with the row errors when any row is invalid, nothing is imported in that case.
+func (h *Handler) ImportInventory(c echo.Context) error {
+	var req ImportInventoryRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+	defer file.Close()
+
+	claims, err := authbiz.GetClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	result, err := h.biz.ImportInventory(c.Request().Context(), inventorybiz.ImportInventoryParams{
+		VendorID: claims.AccountID(),
+		File:     file,
+		DryRun:   req.DryRun,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	if len(result.Errors) > 0 {
+		return response.FromDTO(c.Response().Writer, http.StatusUnprocessableEntity, result)
+	}
+	return response.FromDTO(c.Response().Writer, http.StatusOK, result)
+}
@@ -1,7 +1,13 @@
 package inventoryecho
 
 import (
+	"net/http"
+
+	"shopnexus-remastered/internal/db"
+	authbiz "shopnexus-remastered/internal/module/auth/biz"
 	inventorybiz "shopnexus-remastered/internal/module/inventory/biz"
+	sharedmodel "shopnexus-remastered/internal/module/shared/model"
+	"shopnexus-remastered/internal/module/shared/transport/echo/response"
 
 	"github.com/labstack/echo/v4"
 )
@@ -13,7 +19,271 @@ type Handler struct {
 func NewHandler(e *echo.Echo, biz *inventorybiz.InventoryBiz) *Handler {
 	h := &Handler{biz: biz}
 	api := e.Group("/api/v1/inventory")
-	_ = api
+	api.GET("/stock/:sku_id", h.GetStock)
+	api.POST("/stock/:sku_id/adjust", h.AdjustStock)
+	api.GET("/stock/:sku_id/history", h.ListStockHistory)
+	api.PUT("/stock/:sku_id/threshold", h.SetLowStockThreshold)
+
+	api.GET("/serial", h.ListSerials)
+	api.POST("/serial", h.RegisterSerials)
+	api.PATCH("/serial/:id/status", h.UpdateSerialStatus)
+
+	api.POST("/import", h.ImportInventory)
+
+	api.PUT("/promotion/:promotion_id/quota", h.SetPromotionQuota)
 
 	return h
 }
+
+type GetStockRequest struct {
+	SkuID int64 `param:"sku_id" validate:"required,gt=0"`
+}
+
+func (h *Handler) GetStock(c echo.Context) error {
+	var req GetStockRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := authbiz.GetClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	result, err := h.biz.GetStock(c.Request().Context(), inventorybiz.GetStockParams{
+		VendorID: claims.AccountID(),
+		SkuID:    req.SkuID,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, result)
+}
+
+type AdjustStockRequest struct {
+	SkuID  int64  `param:"sku_id" validate:"required,gt=0"`
+	Change int64  `json:"change" validate:"required,ne=0"`
+	Reason string `json:"reason" validate:"required,max=255"`
+}
+
+func (h *Handler) AdjustStock(c echo.Context) error {
+	var req AdjustStockRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := authbiz.GetClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	result, err := h.biz.AdjustStock(c.Request().Context(), inventorybiz.AdjustStockParams{
+		VendorID: claims.AccountID(),
+		SkuID:    req.SkuID,
+		Change:   req.Change,
+		Reason:   req.Reason,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, result)
+}
+
+type ListStockHistoryRequest struct {
+	sharedmodel.CursorParams
+	SkuID int64 `param:"sku_id" validate:"required,gt=0"`
+}
+
+func (h *Handler) ListStockHistory(c echo.Context) error {
+	var req ListStockHistoryRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := authbiz.GetClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	result, err := h.biz.ListStockHistory(c.Request().Context(), inventorybiz.ListStockHistoryParams{
+		CursorParams: req.CursorParams,
+		VendorID:     claims.AccountID(),
+		SkuID:        req.SkuID,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromPaginate(c.Response().Writer, result)
+}
+
+type SetLowStockThresholdRequest struct {
+	SkuID     int64 `param:"sku_id" validate:"required,gt=0"`
+	Threshold int64 `json:"threshold" validate:"gte=0"`
+}
+
+// SetLowStockThreshold sets the stock level below which the vendor is alerted, 0 disables low stock alerts
+func (h *Handler) SetLowStockThreshold(c echo.Context) error {
+	var req SetLowStockThresholdRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := authbiz.GetClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	result, err := h.biz.SetLowStockThreshold(c.Request().Context(), inventorybiz.SetLowStockThresholdParams{
+		VendorID:  claims.AccountID(),
+		SkuID:     req.SkuID,
+		Threshold: req.Threshold,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, result)
+}
+
+type SetPromotionQuotaRequest struct {
+	PromotionID int64 `param:"promotion_id" validate:"required,gt=0"`
+	Quota       int64 `json:"quota" validate:"gte=0"`
+}
+
+// SetPromotionQuota sets how many more units a promotion of the vendor discounts, e.g. for a flash sale
+func (h *Handler) SetPromotionQuota(c echo.Context) error {
+	var req SetPromotionQuotaRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := authbiz.GetClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	result, err := h.biz.SetPromotionQuota(c.Request().Context(), inventorybiz.SetPromotionQuotaParams{
+		VendorID:    claims.AccountID(),
+		PromotionID: req.PromotionID,
+		Quota:       req.Quota,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, result)
+}
+
+type ListSerialsRequest struct {
+	sharedmodel.PaginationParams
+	SkuID  int64                       `query:"sku_id" validate:"required,gt=0"`
+	Status []db.InventoryProductStatus `query:"status" comma_separated:"true" validate:"omitempty,dive,oneof=Active Inactive Sold Damaged"`
+}
+
+func (h *Handler) ListSerials(c echo.Context) error {
+	var req ListSerialsRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := authbiz.GetClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	result, err := h.biz.ListSerials(c.Request().Context(), inventorybiz.ListSerialsParams{
+		PaginationParams: req.PaginationParams,
+		VendorID:         claims.AccountID(),
+		SkuID:            req.SkuID,
+		Status:           req.Status,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromPaginate(c.Response().Writer, result)
+}
+
+type RegisterSerialsRequest struct {
+	SkuID         int64    `json:"sku_id" validate:"required,gt=0"`
+	SerialNumbers []string `json:"serial_numbers" validate:"required,min=1,max=1000,unique,dive,required,max=50"`
+}
+
+func (h *Handler) RegisterSerials(c echo.Context) error {
+	var req RegisterSerialsRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := authbiz.GetClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	result, err := h.biz.RegisterSerials(c.Request().Context(), inventorybiz.RegisterSerialsParams{
+		VendorID:      claims.AccountID(),
+		SkuID:         req.SkuID,
+		SerialNumbers: req.SerialNumbers,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusCreated, result)
+}
+
+type UpdateSerialStatusRequest struct {
+	ID     int64                     `param:"id" validate:"required,gt=0"`
+	Status db.InventoryProductStatus `json:"status" validate:"required,oneof=Active Inactive Damaged"`
+}
+
+// UpdateSerialStatus retires (Inactive), damages or reactivates a serial
+func (h *Handler) UpdateSerialStatus(c echo.Context) error {
+	var req UpdateSerialStatusRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := authbiz.GetClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	result, err := h.biz.UpdateSerialStatus(c.Request().Context(), inventorybiz.UpdateSerialStatusParams{
+		VendorID: claims.AccountID(),
+		SerialID: req.ID,
+		Status:   req.Status,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, result)
+}
@@ -1,408 +1,521 @@
-package paymentbiz
+package orderbiz
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
+
 	"shopnexus-remastered/internal/db"
+	"shopnexus-remastered/internal/logger"
+	accountbiz "shopnexus-remastered/internal/module/account/biz"
+	inventorybiz "shopnexus-remastered/internal/module/inventory/biz"
+	inventorymodel "shopnexus-remastered/internal/module/inventory/model"
+	ordermodel "shopnexus-remastered/internal/module/order/model"
+	promotionbiz "shopnexus-remastered/internal/module/promotion/biz"
+	promotionmodel "shopnexus-remastered/internal/module/promotion/model"
 	sharedmodel "shopnexus-remastered/internal/module/shared/model"
-	pgxsqlc "shopnexus-remastered/internal/utils/pgx/sqlc"
-	"shopnexus-remastered/internal/utils/ptr"
+	"shopnexus-remastered/internal/utils/pgutil"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type OrderBiz struct {
-	storage *pgxsqlc.Storage
+	storage      *pgutil.Storage
+	accountBiz   *accountbiz.AccountBiz
+	inventoryBiz *inventorybiz.InventoryBiz
+	promotionBiz *promotionbiz.PromotionBiz
+	payments     *PaymentRegistry
 }
 
-func NewOrderBiz(storage *pgxsqlc.Storage) *OrderBiz {
+// NewOrderBiz creates a new instance of OrderBiz.
+func NewOrderBiz(storage *pgutil.Storage, accountBiz *accountbiz.AccountBiz, inventoryBiz *inventorybiz.InventoryBiz, promotionBiz *promotionbiz.PromotionBiz, payments *PaymentRegistry) *OrderBiz {
 	return &OrderBiz{
-		storage: storage,
+		storage:      storage,
+		accountBiz:   accountBiz,
+		inventoryBiz: inventoryBiz,
+		promotionBiz: promotionBiz,
+		payments:     payments,
 	}
 }
 
-type GetOrderParams = struct {
+type GetOrderParams struct {
 	AccountID   int64
 	AccountType db.AccountType
 	OrderID     int64
 }
 
-func (s *OrderBiz) GetOrder(ctx context.Context, params GetOrderParams) (db.OrderBase, error) {
-	storageParams := db.GetOrderBaseParams{
-		ID: params.OrderID,
+func (s *OrderBiz) GetOrder(ctx context.Context, params GetOrderParams) (ordermodel.Order, error) {
+	var zero ordermodel.Order
+
+	order, err := s.storage.GetOrderBase(ctx, db.GetOrderBaseParams{
+		ID: pgutil.Int64ToPgInt8(params.OrderID),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return zero, ordermodel.ErrOrderNotFound
+		}
+		return zero, err
+	}
+
+	// Customer only see their own orders, vendor only see their items of the orders of their products
+	switch params.AccountType {
+	case db.AccountTypeCustomer:
+		if order.CustomerID != params.AccountID {
+			return zero, ordermodel.ErrOrderNotFound
+		}
+	case db.AccountTypeVendor:
+		items, err := s.listVendorOrderItems(ctx, s.storage, order.ID, params.AccountID)
+		if err != nil {
+			return zero, err
+		}
+		if len(items) == 0 {
+			return zero, ordermodel.ErrOrderNotFound
+		}
+		return ordermodel.NewOrder(order, items), nil
 	}
 
-	//if params.Role == db.RoleUser {
-	//	storageParams.UserID = &params.AccountID
-	//}
+	items, err := s.storage.ListOrderItem(ctx, db.ListOrderItemParams{
+		OrderID: []int64{order.ID},
+	})
+	if err != nil {
+		return zero, err
+	}
 
-	return s.storage.GetOrderBase(ctx, storageParams)
+	return ordermodel.NewOrder(order, items), nil
 }
 
 type ListOrdersParams struct {
 	sharedmodel.PaginationParams
+	CustomerID []int64
+	Status     []db.SharedStatus
 }
 
-func (s *OrderBiz) ListOrders(ctx context.Context, params ListOrdersParams) (result sharedmodel.PaginateResult[db.OrderOrder], err error) {
-	storageParams := db.ListOrderParams{
-		Limit:  params.GetLimit(),
-		Offset: params.GetOffset(),
-	}
+func (s *OrderBiz) ListOrders(ctx context.Context, params ListOrdersParams) (sharedmodel.PaginateResult[ordermodel.Order], error) {
+	var zero sharedmodel.PaginateResult[ordermodel.Order]
 
-	// User only see their own payments
-	//if params.Role == db.RoleUser {
-	//	storageParams.UserID = &params.AccountID
-	//}
+	total, err := s.storage.CountOrderBase(ctx, db.CountOrderBaseParams{
+		CustomerID: params.CustomerID,
+		Status:     params.Status,
+	})
+	if err != nil {
+		return zero, err
+	}
 
-	total, err := s.storage.CountOrder(ctx, db.CountOrderParams{})
+	orders, err := s.storage.ListOrderBase(ctx, db.ListOrderBaseParams{
+		Limit:      pgutil.Int32ToPgInt4(params.GetLimit()),
+		Offset:     pgutil.Int32ToPgInt4(params.GetOffset()),
+		CustomerID: params.CustomerID,
+		Status:     params.Status,
+	})
 	if err != nil {
-		return result, err
+		return zero, err
+	}
+
+	orderIDs := make([]int64, len(orders))
+	for i, order := range orders {
+		orderIDs[i] = order.ID
 	}
 
-	payments, err := s.storage.ListOrder(ctx, storageParams)
+	items, err := s.storage.ListOrderItem(ctx, db.ListOrderItemParams{
+		OrderID: orderIDs,
+	})
 	if err != nil {
-		return result, err
+		return zero, err
+	}
+	itemMap := make(map[int64][]db.OrderItem) // map[orderID][]OrderItem
+	for _, item := range items {
+		itemMap[item.OrderID] = append(itemMap[item.OrderID], item)
+	}
+
+	data := make([]ordermodel.Order, 0, len(orders))
+	for _, order := range orders {
+		data = append(data, ordermodel.NewOrder(order, itemMap[order.ID]))
 	}
 
-	return sharedmodel.PaginateResult[db.OrderOrder]{
-		Data:       payments,
-		Limit:      params.Limit,
-		Page:       params.Page,
+	return sharedmodel.PaginateResult[ordermodel.Order]{
+		Data:       data,
+		Limit:      params.GetLimit(),
+		Page:       params.GetPage(),
 		Total:      total,
 		NextPage:   params.NextPage(total),
-		NextCursor: params.NextCursor(payments[len(payments)-1].ID),
+		NextCursor: params.NextCursor(total),
 	}, nil
 }
 
 type CreateOrderParams struct {
-	UserID        int64
+	AccountID     int64
 	Address       string
-	OrderMethod   db.OrderPaymentMethod
-	ProductSkuIDs []int64
+	PaymentMethod db.OrderPaymentMethod
+	SkuIDs        []int64 // SKUs in the cart to checkout
+	ClientIP      string
+	Locale        string  // Language of the payment page
+	BankCode      string  // Bank preselected on the payment page
+	VoucherCode   *string // Voucher entered by the customer, nil for none
 }
 
 type CreateOrderResult struct {
-	Order db.OrderOrder
-	Url   string
+	Order      ordermodel.Order `json:"order"`
+	PaymentUrl *string          `json:"payment_url,omitempty"` // Where the customer pays, nil if the payment method has no payment page
 }
 
 func (s *OrderBiz) CreateOrder(ctx context.Context, params CreateOrderParams) (CreateOrderResult, error) {
 	var zero CreateOrderResult
 
-	// Start transaction
-	txStorage, err := s.storage.BeginTx(ctx)
-	if err != nil {
-		return zero, err
+	if len(params.SkuIDs) == 0 {
+		return zero, ordermodel.ErrEmptyOrder
 	}
-	defer txStorage.Rollback(ctx)
-
-	// Remove products from cartItems
-	if err = txStorage.RemoveCartItem(ctx, cartItems.ID, params.ProductSkuIDs); err != nil {
+	if !params.PaymentMethod.Valid() {
+		return zero, ordermodel.ErrInvalidPaymentMethod
+	}
+	if params.PaymentMethod == db.OrderPaymentMethodCOD && strings.TrimSpace(params.Address) == "" {
+		return zero, ordermodel.ErrAddressRequired
+	}
+	provider, err := s.payments.Get(params.PaymentMethod)
+	if err != nil {
 		return zero, err
 	}
 
-	var (
-		productOnOrders []db.ProductOnOrder
-		totalOrder      int64
-	)
-
-	// Calculate total payment
-	// Iterate through each product model in the cartItems
-	for _, cartProduct := range cartItems.Products {
-		// Get product details
-		product, err := txStorage.GetProduct(ctx, cartProduct.GetID())
+	var voucher *db.PromotionBase
+	if params.VoucherCode != nil {
+		promo, err := s.promotionBiz.GetActiveVoucher(ctx, s.storage, *params.VoucherCode)
 		if err != nil {
-			return CreateOrderResult{}, err
+			return zero, err
 		}
+		voucher = &promo
+	}
 
-		// Get product model details
-		productModel, err := txStorage.GetProductModel(ctx, product.ProductModelID)
-		if err != nil {
-			return CreateOrderResult{}, err
-		}
+	// Price the selected cart items the same way the cart does
+	cartItems, err := s.accountBiz.GetCart(ctx, accountbiz.GetCartParams{
+		AccountID:   params.AccountID,
+		SkuIDs:      params.SkuIDs,
+		VoucherCode: params.VoucherCode,
+	})
+	if err != nil {
+		return zero, err
+	}
+	skuIDs := make(map[int64]struct{}, len(params.SkuIDs))
+	for _, skuID := range params.SkuIDs {
+		skuIDs[skuID] = struct{}{}
+	}
+	if len(cartItems) != len(skuIDs) {
+		return zero, ordermodel.ErrCartItemNotFound
+	}
 
-		// Get any available product serial_ids from that product
-		var serialIDs []string
-		productSerials, err := txStorage.GetAvailableProducts(
-			ctx,
-			cartProduct.GetID(),
-			cartProduct.GetQuantity(),
-		)
-		if err != nil {
-			return CreateOrderResult{}, err
-		}
-		for _, productSerial := range productSerials {
-			serialIDs = append(serialIDs, productSerial.SerialID)
-		}
+	txStorage, err := s.storage.BeginTx(ctx)
+	if err != nil {
+		return zero, err
+	}
+	defer txStorage.Rollback(ctx)
 
-		// Get available sales for the product model
-		sales, err := txStorage.GetAvailableSales(ctx, db.GetLatestSaleParams{
-			ProductModelID: productModel.ID,
-			BrandID:        productModel.BrandID,
-			Tags:           productModel.Tags,
-		})
-		if err != nil {
-			return CreateOrderResult{}, err
-		}
+	code := uuid.New().String()
+	if _, err = txStorage.CreateDefaultOrderBase(ctx, []db.CreateDefaultOrderBaseParams{{
+		Code:          code,
+		CustomerID:    params.AccountID,
+		PaymentMethod: params.PaymentMethod,
+		Status:        db.SharedStatusPending,
+		Address:       params.Address,
+		DateUpdated:   pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	}}); err != nil {
+		return zero, err
+	}
 
-		combinePrice := (productModel.ListPrice + product.AdditionalPrice) * cartProduct.GetQuantity()
-		var combineDiscount int64
+	order, err := txStorage.GetOrderBase(ctx, db.GetOrderBaseParams{
+		Code: pgutil.StringToPgText(code),
+	})
+	if err != nil {
+		return zero, err
+	}
 
-		// Apply sales
-		for _, sale := range sales {
-			combineDiscount += sale.Apply(productModel.ListPrice+product.AdditionalPrice) * cartProduct.GetQuantity()
+	// Lock the quotas of the promotions of all the items at once, always in the same order, so two checkouts sharing
+	// promotions wait on each other instead of deadlocking
+	var promotionIDs []int64
+	for _, item := range cartItems {
+		for _, applied := range item.Pricing.Applied {
+			promotionIDs = append(promotionIDs, applied.Promotion.ID)
 		}
+	}
+	quotas, err := s.inventoryBiz.LockPromotionQuotas(ctx, txStorage, promotionIDs)
+	if err != nil {
+		return zero, err
+	}
 
-		// Ensure combineDiscount is not greater than combinePrice
-		if combineDiscount > combinePrice {
-			combineDiscount = combinePrice
+	orderItems := make([]db.CreateDefaultOrderItemParams, 0, len(cartItems))
+	for _, item := range cartItems {
+		// Only the units within the quotas of all the applied promotions get the promotion price, the rest are at the normal price.
+		// The quotas stay locked, so each applied promotion holds exactly the discounted units.
+		discounted := item.Quantity
+		for _, applied := range item.Pricing.Applied {
+			if left, limited := quotas[applied.Promotion.ID]; limited {
+				discounted = min(discounted, left)
+			}
+		}
+		for _, applied := range item.Pricing.Applied {
+			promotionID := applied.Promotion.ID
+			if _, limited := quotas[promotionID]; limited {
+				// The next items applying the promotion get what this one leaves
+				quotas[promotionID] -= discounted
+			}
+			if _, err = s.inventoryBiz.ReservePromotionQuota(ctx, txStorage, inventorybiz.ReservePromotionQuotaParams{
+				RefType:     db.InventoryReservationTypeOrder,
+				RefID:       order.ID,
+				PromotionID: promotionID,
+				Quantity:    discounted,
+				TTL:         inventorymodel.OrderReservationTTL,
+			}); err != nil {
+				return zero, err
+			}
 		}
 
-		totalOrder += combinePrice - combineDiscount
-
-		// If product can combine, add all quantity at once
-		if product.CanCombine {
-			productOnOrders = append(productOnOrders, db.ProductOnOrder{
-				ItemQuantityBase: db.ItemQuantityBase[int64]{
-					ItemID:   cartProduct.GetID(),
-					Quantity: cartProduct.GetQuantity(),
-				},
-				SerialIDs:  serialIDs,
-				Price:      combinePrice,
-				TotalPrice: combinePrice - combineDiscount,
+		// If the SKU can combine, add all quantity at once, otherwise each unit is a single item (for refunding stuff)
+		if item.Sku.CanCombine {
+			orderItems = append(orderItems, db.CreateDefaultOrderItemParams{
+				Code:      uuid.New().String(),
+				OrderID:   order.ID,
+				SkuID:     item.Sku.ID,
+				Quantity:  item.Quantity,
+				UnitPrice: item.Sku.Price,
+				Subtotal:  item.Sku.Price * item.Quantity,
+				Total:     item.Price*discounted + item.Sku.Price*(item.Quantity-discounted),
 			})
-		} else {
-			for i := int64(0); i < cartProduct.GetQuantity(); i++ {
-				productOnOrders = append(productOnOrders, db.ProductOnOrder{
-					ItemQuantityBase: db.ItemQuantityBase[int64]{
-						ItemID:   cartProduct.GetID(),
-						Quantity: 1,
-					},
-					SerialIDs:  []string{serialIDs[i]},
-					Price:      combinePrice / cartProduct.GetQuantity(),
-					TotalPrice: (combinePrice - combineDiscount) / cartProduct.GetQuantity(),
-				})
+			continue
+		}
+		for i := range item.Quantity {
+			total := item.Sku.Price
+			if i < discounted {
+				total = item.Price
 			}
+			orderItems = append(orderItems, db.CreateDefaultOrderItemParams{
+				Code:      uuid.New().String(),
+				OrderID:   order.ID,
+				SkuID:     item.Sku.ID,
+				Quantity:  1,
+				UnitPrice: item.Sku.Price,
+				Subtotal:  item.Sku.Price,
+				Total:     total,
+			})
 		}
 	}
 
-	// Create payment
-	newOrder, err := txStorage.CreateDefaultOrder(ctx, db.CreateDefaultOrderParams{
-		//UserID:   params.UserID,
-		//Address:  params.Address,
-		//Method:   params.OrderMethod,
-		//Total:    totalOrder,
-		//Status:   db.StatusPending,
-		//Products: productOnOrders,
+	// Take the order promotions (bundles, buy X get Y, order-wide discount) from the items they come from,
+	// so a refunded item gives back its share
+	orderPromotions, err := s.promotionBiz.ListOrderPromotions(ctx, txStorage, promotionbiz.ListActivePromotionParams{
+		VoucherCode: params.VoucherCode,
 	})
 	if err != nil {
-		return CreateOrderResult{}, err
+		return zero, err
 	}
-
-	// Create payment url
-	var pp OrderPlatform
-
-	switch params.OrderMethod {
-	case db.OrderOrderMethodVnpay:
-		pp, err = s.getPlatform(PlatformVNPAY)
-		if err != nil {
-			return CreateOrderResult{}, err
+	products := make(map[int64]promotionmodel.ProductRef, len(cartItems)) // map[skuID]ProductRef
+	for _, item := range cartItems {
+		products[item.Sku.ID] = item.Product
+	}
+	lines := make([]promotionmodel.OrderLine, len(orderItems))
+	for i, item := range orderItems {
+		lines[i] = promotionmodel.OrderLine{
+			Product:  products[item.SkuID],
+			Quantity: item.Quantity,
+			Total:    item.Total,
 		}
-	case db.OrderOrderMethodMomo:
-		// TODO: support momo payment
-		return CreateOrderResult{}, fmt.Errorf("payment method momo not yet supported")
-		// pp, err = s.GetPlatform(PlatformMOMO)
-		// if err != nil {
-		// 	return CreateOrderResult{}, err
-		// }
-	case db.OrderOrderMethodCash:
-		// Do nothing
-		// TODO: add logic for cash payment
-		return CreateOrderResult{}, fmt.Errorf("payment method cash not yet supported")
-	default:
-		return CreateOrderResult{}, fmt.Errorf("payment method %s not supported", params.OrderMethod)
-	}
-
-	url, err := pp.CreateOrder(ctx, CreateOrderParams{
-		OrderID: newOrder.ID,
-		Info:    fmt.Sprintf("Order for order %d", newOrder.ID),
-		Amount:  newOrder.Total,
-	})
-	if err != nil {
-		return CreateOrderResult{}, err
 	}
-
-	// TODO: move this update product sold to cron job check success payment (because currently we don't know if payment is success or not)
-	txProductSvc, err := s.productSvc.WithTx(txStorage)
-	if err != nil {
-		return CreateOrderResult{}, err
+	orderPrice := promotionmodel.CalculateDiscountedOrderPrice(lines, orderPromotions)
+	for i := range orderItems {
+		orderItems[i].Total -= orderPrice.Lines[i]
 	}
-	if err = txProductSvc.UpdateProductSold(ctx, product.UpdateProductSoldParams{
-		IDs: func() []int64 {
-			ids := make([]int64, 0, len(productOnOrders))
-			for _, pop := range productOnOrders {
-				ids = append(ids, pop.ItemID)
-			}
-			return ids
-		}(),
-		Amount: 1,
-	}); err != nil {
-		return CreateOrderResult{}, err
+	if voucher != nil && !isVoucherApplied(voucher.ID, cartItems, orderPrice) {
+		return zero, promotionmodel.ErrVoucherNotApplicable
 	}
 
-	// Rollback if purchase failed
-	if err = txStorage.Commit(ctx); err != nil {
-		return CreateOrderResult{}, err
+	if _, err = txStorage.CreateDefaultOrderItem(ctx, orderItems); err != nil {
+		return zero, err
 	}
 
-	return CreateOrderResult{Order: newOrder, Url: url}, nil
-}
-
-func (s *OrderBiz) getPlatform(platform Platform) (OrderPlatform, error) {
-	pp, ok := s.platforms[platform]
-	if !ok {
-		return nil, fmt.Errorf("platform %s not found", platform)
+	items, err := txStorage.ListOrderItem(ctx, db.ListOrderItemParams{
+		OrderID: []int64{order.ID},
+	})
+	if err != nil {
+		return zero, err
 	}
-	return pp, nil
-}
-
-type UpdateOrderParams struct {
-	ID        int64
-	AccountID int64
-	Role      db.AccountType
-	Method    *db.OrderOrderMethod
-	Address   *string
-	Status    *db.Status
-}
 
-func (s *OrderBiz) UpdateOrder(ctx context.Context, params UpdateOrderParams) error {
-	txStorage, err := s.storage.Begin(ctx)
-	if err != nil {
-		return err
+	if err = s.allocateSerials(ctx, txStorage, items); err != nil {
+		return zero, err
 	}
-	defer txStorage.Rollback(ctx)
 
-	getOrderParams := db.GetOrderParams{
-		ID:     params.ID,
-		Status: ptr.ToPtr(db.StatusPending),
+	if err = s.promotionBiz.CreateCashbackCredits(ctx, txStorage, promotionbiz.CreateCashbackCreditsParams{
+		AccountID: params.AccountID,
+		OrderID:   order.ID,
+		Applied:   orderPrice.Applied,
+	}); err != nil {
+		return zero, err
 	}
 
-	// User only see their own payments
-	if params.Role == db.RoleUser {
-		getOrderParams.UserID = &params.AccountID
+	if voucher != nil {
+		var spend int64
+		for _, item := range orderItems {
+			spend += item.Subtotal
+		}
+		if err = s.promotionBiz.RedeemVoucher(ctx, txStorage, promotionbiz.RedeemVoucherParams{
+			PromotionID: voucher.ID,
+			AccountID:   params.AccountID,
+			OrderID:     order.ID,
+			Spend:       spend,
+		}); err != nil {
+			return zero, err
+		}
 	}
 
-	// Order must be pending
-	payment, err := txStorage.GetOrder(ctx, getOrderParams)
-	if err != nil {
-		return err
+	if err = s.createOrderEvent(ctx, txStorage, ordermodel.NewActor(params.AccountID, db.AccountTypeCustomer), order.ID, db.SystemEventTypeCreated, ordermodel.StatusChangedPayload{
+		NewStatus: order.Status,
+		ActorRole: ordermodel.ActorRoleCustomer,
+	}); err != nil {
+		return zero, err
 	}
 
-	// If payment method is cash, address is required
-	if (params.Method == nil && payment.Method == db.OrderOrderMethodCash || params.Method != nil && *params.Method == db.OrderOrderMethodCash) &&
-		(params.Address == nil && payment.Address == "" || params.Address != nil && *params.Address == "") {
-		return fmt.Errorf("address is required for payment method %s", *params.Method)
+	// Move the held stock from the cart to the order
+	if err = s.inventoryBiz.ReleaseStock(ctx, txStorage, inventorybiz.ReleaseStockParams{
+		RefType: db.InventoryReservationTypeCart,
+		RefID:   params.AccountID,
+		SkuIDs:  params.SkuIDs,
+	}); err != nil {
+		return zero, err
+	}
+	quantities := make(map[int64]int64, len(cartItems)) // map[skuID]quantity
+	for _, item := range cartItems {
+		quantities[item.Sku.ID] += item.Quantity
+	}
+	if err = s.inventoryBiz.ReserveStock(ctx, txStorage, inventorybiz.ReserveStockParams{
+		RefType: db.InventoryReservationTypeOrder,
+		RefID:   order.ID,
+		Items:   quantities,
+		TTL:     inventorymodel.OrderReservationTTL,
+	}); err != nil {
+		return zero, err
 	}
 
-	// If params.Status is not nil and not admin, check if account (staff, ...) has permission to update status
-	if params.Status != nil && params.Role != db.RoleAdmin {
-		if ok, err := s.accountSvc.HasPermission(ctx, account.HasPermissionParams{
-			AccountID: params.AccountID,
-			Permissions: []db.Permission{
-				db.PermissionUpdateOrder,
-			},
+	// Remove purchased items from the cart
+	for _, item := range cartItems {
+		if err = txStorage.DeleteAccountCartItem(ctx, db.DeleteAccountCartItemParams{
+			CartID: pgutil.Int64ToPgInt8(params.AccountID),
+			SkuID:  pgutil.Int64ToPgInt8(item.Sku.ID),
 		}); err != nil {
-			return err
-		} else if !ok {
-			return fmt.Errorf("account %d does not have permission to update payment status", params.AccountID)
+			return zero, err
 		}
 	}
 
-	if err = txStorage.UpdateOrder(ctx, db.UpdateOrderParams{
-		ID:      params.ID,
-		Method:  params.Method,
-		Address: params.Address,
-		Status:  params.Status,
-	}); err != nil {
-		return err
-	}
-
 	if err = txStorage.Commit(ctx); err != nil {
-		return err
+		return zero, err
 	}
 
-	return nil
-}
+	result := ordermodel.NewOrder(order, items)
 
-type CancelOrderParams = struct {
-	UserID  int64
-	OrderID int64
-}
-
-func (s *OrderBiz) CancelOrder(ctx context.Context, params CancelOrderParams) error {
-	txStorage, err := s.storage.Begin(ctx)
-	if err != nil {
-		return err
-	}
-	defer txStorage.Rollback(ctx)
-
-	payment, err := txStorage.GetOrder(ctx, db.GetOrderParams{
-		ID:     params.OrderID,
-		UserID: &params.UserID,
+	// Create payment url, once the order is saved so a slow payment platform does not hold the checkout locks
+	payment, err := provider.CreatePayment(ctx, ordermodel.CreatePaymentParams{
+		OrderID:     result.ID,
+		Amount:      result.Total,
+		Info:        fmt.Sprintf("Payment for order %d", result.ID),
+		DateCreated: result.DateCreated.Time,
+		ClientIP:    params.ClientIP,
+		Locale:      params.Locale,
+		BankCode:    params.BankCode,
 	})
 	if err != nil {
-		return err
+		// The order can never be paid, give its stock and vouchers back. If this fails too, the payment reconciler
+		// cancels the order once its payment expires.
+		if _, failErr := s.TransitionOrder(ctx, TransitionOrderParams{
+			Actor:   ordermodel.SystemActor,
+			OrderID: order.ID,
+			Status:  db.SharedStatusFailed,
+			Reason:  fmt.Sprintf("Payment could not be created with %s", provider.Name()),
+		}); failErr != nil {
+			logger.Log.Sugar().Errorf("Failed to mark order %d failed after its payment could not be created: %v", order.ID, failErr)
+		}
+		return zero, err
 	}
 
-	// No need to check ownership as we already check it in GetOrder
-	// if payment.UserID != *params.UserID {
-	// 	return fmt.Errorf("payment %d not belong to user %d", params.OrderID, params.UserID)
-	// }
-
-	if payment.Status != db.StatusPending {
-		return fmt.Errorf("payment %d cannot be canceled", params.OrderID)
+	// Some payment methods (COD) process the order without waiting for a payment
+	if payment.OrderStatus != "" && payment.OrderStatus != order.Status {
+		updated, err := s.TransitionOrder(ctx, TransitionOrderParams{
+			Actor:   ordermodel.SystemActor,
+			OrderID: order.ID,
+			Status:  payment.OrderStatus,
+			Reason:  fmt.Sprintf("Checkout with %s", provider.Name()),
+		})
+		if err != nil {
+			return zero, err
+		}
+		result = ordermodel.NewOrder(updated, items)
 	}
 
-	if err = txStorage.UpdateOrder(ctx, db.UpdateOrderParams{
-		ID:     params.OrderID,
-		Status: ptr.ToPtr(db.StatusCanceled),
-	}); err != nil {
-		return err
+	var paymentUrl *string
+	if payment.Url != "" {
+		paymentUrl = &payment.Url
 	}
 
-	if err = txStorage.Commit(ctx); err != nil {
-		return err
-	}
+	return CreateOrderResult{
+		Order:      result,
+		PaymentUrl: paymentUrl,
+	}, nil
+}
 
-	return nil
+// isVoucherApplied reports whether the voucher promotion priced an item or the whole order
+func isVoucherApplied(promoID int64, cartItems []accountbiz.CartItem, orderPrice promotionmodel.OrderPrice) bool {
+	for _, item := range cartItems {
+		for _, applied := range item.Pricing.Applied {
+			if applied.Promotion.ID == promoID {
+				return true
+			}
+		}
+	}
+	for _, applied := range orderPrice.Applied {
+		if applied.PromotionID == promoID {
+			return true
+		}
+	}
+	return false
 }
 
-type CancelRefundParams = struct {
-	UserID   int64
-	RefundID int64
+type UpdateOrderParams struct {
+	AccountID int64
+	OrderID   int64
+	Address   string
 }
 
-func (s *OrderBiz) CancelRefund(ctx context.Context, params CancelRefundParams) error {
+// UpdateOrder changes the delivery address of a pending order of the customer. The payment method is kept, the payment
+// may already be started with its provider.
+func (s *OrderBiz) UpdateOrder(ctx context.Context, params UpdateOrderParams) error {
 	txStorage, err := s.storage.BeginTx(ctx)
 	if err != nil {
 		return err
 	}
 	defer txStorage.Rollback(ctx)
 
-	refund, err := txStorage.GetRefund(ctx, db.GetRefundParams{
-		ID:     params.RefundID,
-		UserID: &params.UserID,
+	order, err := txStorage.GetOrderBase(ctx, db.GetOrderBaseParams{
+		ID: pgutil.Int64ToPgInt8(params.OrderID),
 	})
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ordermodel.ErrOrderNotFound
+		}
 		return err
 	}
+	if order.CustomerID != params.AccountID {
+		return ordermodel.ErrOrderNotFound
+	}
 
-	if refund.Status != db.StatusPending {
-		return fmt.Errorf("refund %d cannot be canceled", params.RefundID)
+	// Order must be pending
+	if order.Status != db.SharedStatusPending {
+		return ordermodel.ErrOrderNotPending
+	}
+	if order.PaymentMethod == db.OrderPaymentMethodCOD && strings.TrimSpace(params.Address) == "" {
+		return ordermodel.ErrAddressRequired
 	}
 
-	if err = txStorage.UpdateRefund(ctx, db.UpdateRefundParams{
-		ID:     params.RefundID,
-		UserID: &params.UserID,
-		Status: ptr.ToPtr(db.StatusCanceled),
+	if _, err = txStorage.UpdateOrderBase(ctx, db.UpdateOrderBaseParams{
+		ID:          pgutil.Int64ToPgInt8(order.ID),
+		Address:     pgutil.StringToPgText(params.Address),
+		DateUpdated: pgtype.Timestamptz{Time: time.Now(), Valid: true},
 	}); err != nil {
 		return err
 	}
@@ -413,3 +526,19 @@ func (s *OrderBiz) CancelRefund(ctx context.Context, params CancelRefundParams)
 
 	return nil
 }
+
+type CancelOrderParams struct {
+	Actor   ordermodel.Actor
+	OrderID int64
+	Reason  string
+}
+
+func (s *OrderBiz) CancelOrder(ctx context.Context, params CancelOrderParams) error {
+	_, err := s.TransitionOrder(ctx, TransitionOrderParams{
+		Actor:   params.Actor,
+		OrderID: params.OrderID,
+		Status:  db.SharedStatusCanceled,
+		Reason:  params.Reason,
+	})
+	return err
+}
@@ -0,0 +1,193 @@
+package orderbiz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"shopnexus-remastered/internal/db"
+	ordermodel "shopnexus-remastered/internal/module/order/model"
+	"shopnexus-remastered/internal/utils/pgutil"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/fx"
+)
+
+// PaymentRegistry maps each payment method to the provider that handles it
+type PaymentRegistry struct {
+	providers map[db.OrderPaymentMethod]ordermodel.PaymentProvider
+	names     map[string]ordermodel.PaymentProvider
+}
+
+type PaymentRegistryParams struct {
+	fx.In
+	Providers []ordermodel.PaymentProvider `group:"payment_providers"`
+}
+
+// NewPaymentRegistry creates a new PaymentRegistry from the providers registered to fx.
+func NewPaymentRegistry(params PaymentRegistryParams) (*PaymentRegistry, error) {
+	providers := make(map[db.OrderPaymentMethod]ordermodel.PaymentProvider)
+	names := make(map[string]ordermodel.PaymentProvider)
+	for _, provider := range params.Providers {
+		if _, ok := names[provider.Name()]; ok {
+			return nil, fmt.Errorf("payment provider %s is registered more than once", provider.Name())
+		}
+		names[provider.Name()] = provider
+
+		for _, method := range provider.Methods() {
+			if _, ok := providers[method]; ok {
+				return nil, fmt.Errorf("payment method %s is handled by more than one provider", method)
+			}
+			providers[method] = provider
+		}
+	}
+
+	return &PaymentRegistry{
+		providers: providers,
+		names:     names,
+	}, nil
+}
+
+// Get returns the provider of the payment method
+func (r *PaymentRegistry) Get(method db.OrderPaymentMethod) (ordermodel.PaymentProvider, error) {
+	provider, ok := r.providers[method]
+	if !ok {
+		return nil, ordermodel.ErrPaymentMethodNotSupported
+	}
+	return provider, nil
+}
+
+// Methods returns the payment methods that have a provider
+func (r *PaymentRegistry) Methods() []db.OrderPaymentMethod {
+	methods := make([]db.OrderPaymentMethod, 0, len(r.providers))
+	for method := range r.providers {
+		methods = append(methods, method)
+	}
+	return methods
+}
+
+// QueryMethods returns the payment methods whose provider can query the status of a payment
+func (r *PaymentRegistry) QueryMethods() []db.OrderPaymentMethod {
+	var methods []db.OrderPaymentMethod
+	for method, provider := range r.providers {
+		if _, ok := provider.(ordermodel.PaymentQuerier); ok {
+			methods = append(methods, method)
+		}
+	}
+	return methods
+}
+
+// GetByName returns the provider with the given name
+func (r *PaymentRegistry) GetByName(name string) (ordermodel.PaymentProvider, error) {
+	provider, ok := r.names[name]
+	if !ok {
+		return nil, ordermodel.ErrPaymentMethodNotSupported
+	}
+	return provider, nil
+}
+
+type ConfirmPaymentParams struct {
+	Provider string         // Name of the provider that sent the callback
+	Data     map[string]any // Raw callback fields
+}
+
+// ConfirmPayment settles an order from a payment platform callback.
+// Platforms retry callbacks, so a payment that is already settled returns ErrPaymentAlreadyConfirmed.
+func (s *OrderBiz) ConfirmPayment(ctx context.Context, params ConfirmPaymentParams) (ordermodel.PaymentResult, error) {
+	var zero ordermodel.PaymentResult
+
+	provider, err := s.payments.GetByName(params.Provider)
+	if err != nil {
+		return zero, err
+	}
+
+	result, err := provider.VerifyCallback(ctx, params.Data)
+	if err != nil {
+		return zero, err
+	}
+
+	if err = s.settlePayment(ctx, provider, result); err != nil {
+		return zero, err
+	}
+
+	return result, nil
+}
+
+// settlePayment moves a pending order to the payment status reported by the provider
+func (s *OrderBiz) settlePayment(ctx context.Context, provider ordermodel.PaymentProvider, result ordermodel.PaymentResult) error {
+	txStorage, err := s.storage.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer txStorage.Rollback(ctx)
+
+	order, err := txStorage.GetOrderBase(ctx, db.GetOrderBaseParams{
+		ID: pgutil.Int64ToPgInt8(result.OrderID),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ordermodel.ErrOrderNotFound
+		}
+		return err
+	}
+
+	// The order must be paid through the provider that sent the result
+	if orderProvider, err := s.payments.Get(order.PaymentMethod); err != nil || orderProvider != provider {
+		return ordermodel.ErrInvalidPaymentCallback
+	}
+
+	if order.Status != db.SharedStatusPending {
+		return ordermodel.ErrPaymentAlreadyConfirmed
+	}
+
+	items, err := txStorage.ListOrderItem(ctx, db.ListOrderItemParams{
+		OrderID: []int64{order.ID},
+	})
+	if err != nil {
+		return err
+	}
+	if ordermodel.NewOrder(order, items).Total != result.Amount {
+		return ordermodel.ErrPaymentAmountMismatch
+	}
+
+	// Transition first, it locks the order row so concurrent retries of the same callback wait here
+	if _, err = s.transitionOrder(ctx, txStorage, TransitionOrderParams{
+		Actor:   ordermodel.SystemActor,
+		OrderID: order.ID,
+		Status:  result.Status,
+		Reason:  fmt.Sprintf("Payment %s from %s", result.TransactionNo, provider.Name()),
+	}); err != nil {
+		if errors.Is(err, ordermodel.ErrStatusConflict) {
+			return ordermodel.ErrPaymentAlreadyConfirmed
+		}
+		return err
+	}
+
+	if recorder, ok := provider.(ordermodel.PaymentRecorder); ok {
+		if err = recorder.RecordPayment(ctx, txStorage, result); err != nil {
+			return err
+		}
+	}
+
+	if err = txStorage.Commit(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+type VerifyPaymentReturnParams struct {
+	Provider string
+	Data     map[string]any
+}
+
+// VerifyPaymentReturn checks the result a platform sends along when redirecting the customer back.
+// It does not settle the order, the platform callback does.
+func (s *OrderBiz) VerifyPaymentReturn(ctx context.Context, params VerifyPaymentReturnParams) (ordermodel.PaymentResult, error) {
+	provider, err := s.payments.GetByName(params.Provider)
+	if err != nil {
+		return ordermodel.PaymentResult{}, err
+	}
+
+	return provider.VerifyCallback(ctx, params.Data)
+}
@@ -0,0 +1,157 @@
+package orderbiz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"shopnexus-remastered/internal/db"
+	accountbiz "shopnexus-remastered/internal/module/account/biz"
+	ordermodel "shopnexus-remastered/internal/module/order/model"
+	"shopnexus-remastered/internal/utils/pgutil"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// CodProvider handles cash on delivery, the cash is collected by the courier and confirmed by the vendor or the courier
+type CodProvider struct{}
+
+// NewCodProvider creates a new instance of CodProvider.
+func NewCodProvider() *CodProvider {
+	return &CodProvider{}
+}
+
+func (p *CodProvider) Name() string {
+	return "cod"
+}
+
+func (p *CodProvider) Methods() []db.OrderPaymentMethod {
+	return []db.OrderPaymentMethod{db.OrderPaymentMethodCOD}
+}
+
+// CreatePayment has nothing to pay online, the order is processed right away and paid on delivery
+func (p *CodProvider) CreatePayment(ctx context.Context, params ordermodel.CreatePaymentParams) (ordermodel.CreatePaymentResult, error) {
+	return ordermodel.CreatePaymentResult{
+		OrderStatus: db.SharedStatusProcessing,
+	}, nil
+}
+
+func (p *CodProvider) VerifyCallback(ctx context.Context, data map[string]any) (ordermodel.PaymentResult, error) {
+	return ordermodel.PaymentResult{}, ordermodel.ErrInvalidPaymentCallback
+}
+
+// RefundPayment is not supported, cash is given back by the vendor
+func (p *CodProvider) RefundPayment(ctx context.Context, params ordermodel.RefundPaymentParams) error {
+	return ordermodel.ErrPaymentRefundNotSupported
+}
+
+type ConfirmCodPaymentParams struct {
+	Actor   ordermodel.Actor // The vendor of the order, or System for the courier
+	OrderID int64
+}
+
+// ConfirmCodPayment marks a cash on delivery order as paid once the cash is collected,
+// and records the income of the vendors of the order.
+func (s *OrderBiz) ConfirmCodPayment(ctx context.Context, params ConfirmCodPaymentParams) (db.OrderBase, error) {
+	var zero db.OrderBase
+
+	txStorage, err := s.storage.BeginTx(ctx)
+	if err != nil {
+		return zero, err
+	}
+	defer txStorage.Rollback(ctx)
+
+	order, err := txStorage.GetOrderBase(ctx, db.GetOrderBaseParams{
+		ID: pgutil.Int64ToPgInt8(params.OrderID),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return zero, ordermodel.ErrOrderNotFound
+		}
+		return zero, err
+	}
+	if order.PaymentMethod != db.OrderPaymentMethodCOD {
+		return zero, ordermodel.ErrNotCodOrder
+	}
+
+	updated, err := s.transitionOrder(ctx, txStorage, TransitionOrderParams{
+		Actor:   params.Actor,
+		OrderID: order.ID,
+		Status:  db.SharedStatusSuccess,
+		Reason:  "Cash collected on delivery",
+	})
+	if err != nil {
+		return zero, err
+	}
+
+	if err = s.recordVendorIncome(ctx, txStorage, order.ID); err != nil {
+		return zero, err
+	}
+
+	if err = txStorage.Commit(ctx); err != nil {
+		return zero, err
+	}
+
+	return updated, nil
+}
+
+// recordVendorIncome records the sale of the order items to the income history of their vendors
+func (s *OrderBiz) recordVendorIncome(ctx context.Context, txStorage *pgutil.TxStorage, orderID int64) error {
+	items, err := txStorage.ListOrderItem(ctx, db.ListOrderItemParams{
+		OrderID: []int64{orderID},
+	})
+	if err != nil {
+		return err
+	}
+
+	skuIDs := make([]int64, 0, len(items))
+	for _, item := range items {
+		skuIDs = append(skuIDs, item.SkuID)
+	}
+	skus, err := txStorage.ListCatalogProductSku(ctx, db.ListCatalogProductSkuParams{
+		ID: skuIDs,
+	})
+	if err != nil {
+		return err
+	}
+	spuIDs := make([]int64, 0, len(skus))
+	skuMap := make(map[int64]db.CatalogProductSku) // map[skuID]SKU
+	for _, sku := range skus {
+		skuMap[sku.ID] = sku
+		spuIDs = append(spuIDs, sku.SpuID)
+	}
+	spus, err := txStorage.ListCatalogProductSpu(ctx, db.ListCatalogProductSpuParams{
+		ID: spuIDs,
+	})
+	if err != nil {
+		return err
+	}
+	vendorBySpu := make(map[int64]int64) // map[spuID]vendorID
+	for _, spu := range spus {
+		vendorBySpu[spu.ID] = spu.AccountID
+	}
+
+	// Sum the items per vendor, keeping the vendors in order of appearance
+	var vendorIDs []int64
+	incomes := make(map[int64]int64) // map[vendorID]income
+	for _, item := range items {
+		vendorID := vendorBySpu[skuMap[item.SkuID].SpuID]
+		if _, ok := incomes[vendorID]; !ok {
+			vendorIDs = append(vendorIDs, vendorID)
+		}
+		incomes[vendorID] += item.Total
+	}
+
+	for _, vendorID := range vendorIDs {
+		if err = s.accountBiz.RecordIncome(ctx, txStorage, accountbiz.RecordIncomeParams{
+			AccountID: vendorID,
+			Type:      accountbiz.IncomeTypeSale,
+			Income:    incomes[vendorID],
+			Note:      fmt.Sprintf("Order %d", orderID),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
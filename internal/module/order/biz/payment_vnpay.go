@@ -0,0 +1,223 @@
+package orderbiz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"shopnexus-remastered/config"
+	"shopnexus-remastered/internal/client/vnpay"
+	"shopnexus-remastered/internal/db"
+	"shopnexus-remastered/internal/logger"
+	ordermodel "shopnexus-remastered/internal/module/order/model"
+	"shopnexus-remastered/internal/utils/pgutil"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// vnpaySuccessCode is the vnp_ResponseCode and vnp_TransactionStatus of a successful payment
+const vnpaySuccessCode = "00"
+
+// VnpayProvider collects EWallet and Card payments through VNPay
+type VnpayProvider struct {
+	storage   *pgutil.Storage
+	client    vnpay.Client
+	returnUrl string
+}
+
+// NewVnpayProvider creates a new instance of VnpayProvider.
+func NewVnpayProvider(storage *pgutil.Storage, client vnpay.Client, cfg *config.Config) *VnpayProvider {
+	return &VnpayProvider{
+		storage:   storage,
+		client:    client,
+		returnUrl: cfg.Vnpay.ReturnUrl,
+	}
+}
+
+func (p *VnpayProvider) Name() string {
+	return "vnpay"
+}
+
+func (p *VnpayProvider) Methods() []db.OrderPaymentMethod {
+	return []db.OrderPaymentMethod{db.OrderPaymentMethodEWallet, db.OrderPaymentMethodCard}
+}
+
+func (p *VnpayProvider) CreatePayment(ctx context.Context, params ordermodel.CreatePaymentParams) (ordermodel.CreatePaymentResult, error) {
+	url, err := p.client.CreateOrder(ctx, vnpay.CreateOrderParams{
+		PaymentID:  params.OrderID,
+		Amount:     params.Amount,
+		Info:       params.Info,
+		ReturnUrl:  p.returnUrl,
+		CreateDate: params.DateCreated,
+		IpAddr:     params.ClientIP,
+		Locale:     params.Locale,
+		BankCode:   params.BankCode,
+	})
+	if err != nil {
+		return ordermodel.CreatePaymentResult{}, err
+	}
+
+	return ordermodel.CreatePaymentResult{Url: url}, nil
+}
+
+func (p *VnpayProvider) VerifyCallback(ctx context.Context, data map[string]any) (ordermodel.PaymentResult, error) {
+	var zero ordermodel.PaymentResult
+
+	// VerifyPayment removes vnp_SecureHash from the map, keep the caller's copy intact
+	fields := make(map[string]any, len(data))
+	for k, v := range data {
+		fields[k] = v
+	}
+	if err := p.client.VerifyPayment(ctx, fields); err != nil {
+		return zero, ordermodel.ErrInvalidPaymentCallback
+	}
+
+	return parseVnpayResult(data)
+}
+
+func (p *VnpayProvider) QueryStatus(ctx context.Context, params ordermodel.QueryPaymentParams) (ordermodel.PaymentResult, error) {
+	var zero ordermodel.PaymentResult
+
+	rsp, err := p.client.QueryDR(ctx, vnpay.QueryDRParams{
+		PaymentID:       params.OrderID,
+		Info:            fmt.Sprintf("Query payment of order %d", params.OrderID),
+		TransactionDate: params.DateCreated,
+	})
+	if err != nil {
+		return zero, err
+	}
+
+	switch rsp.ResponseCode {
+	case vnpay.ResponseCodeSuccess:
+	case vnpay.ResponseCodeNotFound:
+		// The customer never submitted the payment page
+		return ordermodel.PaymentResult{
+			OrderID: params.OrderID,
+			Status:  db.SharedStatusPending,
+		}, nil
+	default:
+		logger.Log.Sugar().Warnf("VNPay querydr of order %d failed: %s %s", params.OrderID, rsp.ResponseCode, rsp.Message)
+		return zero, ordermodel.ErrPaymentRequestFailed
+	}
+
+	amount, err := strconv.ParseInt(rsp.Amount, 10, 64)
+	if err != nil {
+		return zero, fmt.Errorf("invalid vnp_Amount: %w", err)
+	}
+
+	status := db.SharedStatusFailed
+	switch rsp.TransactionStatus {
+	case vnpay.TransactionStatusSuccess:
+		status = db.SharedStatusSuccess
+	case vnpay.TransactionStatusPending:
+		status = db.SharedStatusPending
+	}
+
+	return ordermodel.PaymentResult{
+		OrderID:       params.OrderID,
+		Amount:        amount / 100, // VNPay amount is multiplied by 100
+		Status:        status,
+		TransactionNo: rsp.TransactionNo,
+		Data: map[string]any{
+			"vnp_Amount":            rsp.Amount,
+			"vnp_BankCode":          rsp.BankCode,
+			"vnp_OrderInfo":         rsp.OrderInfo,
+			"vnp_PayDate":           rsp.PayDate,
+			"vnp_ResponseCode":      rsp.ResponseCode,
+			"vnp_SecureHash":        rsp.SecureHash,
+			"vnp_TmnCode":           rsp.TmnCode,
+			"vnp_TransactionNo":     rsp.TransactionNo,
+			"vnp_TransactionStatus": rsp.TransactionStatus,
+			"vnp_TxnRef":            rsp.TxnRef,
+		},
+	}, nil
+}
+
+func (p *VnpayProvider) RefundPayment(ctx context.Context, params ordermodel.RefundPaymentParams) error {
+	// VNPay finds the transaction to refund by its transaction number
+	payment, err := p.storage.GetOrderVnpay(ctx, pgutil.Int64ToPgInt8(params.OrderID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ordermodel.ErrPaymentNotFound
+		}
+		return err
+	}
+
+	rsp, err := p.client.Refund(ctx, vnpay.RefundParams{
+		PaymentID:       params.OrderID,
+		Amount:          params.Amount,
+		Full:            params.Full,
+		TransactionNo:   payment.VnpTransactionNo,
+		TransactionDate: params.DateCreated,
+		CreateBy:        params.RequestedBy,
+		Info:            params.Reason,
+	})
+	if err != nil {
+		return err
+	}
+	if rsp.ResponseCode != vnpay.ResponseCodeSuccess {
+		logger.Log.Sugar().Warnf("VNPay refund of order %d failed: %s %s", params.OrderID, rsp.ResponseCode, rsp.Message)
+		return ordermodel.ErrPaymentRequestFailed
+	}
+
+	return nil
+}
+
+// RecordPayment stores the vnp_* fields of a confirmed payment to order.vnpay
+func (p *VnpayProvider) RecordPayment(ctx context.Context, storage db.Querier, result ordermodel.PaymentResult) error {
+	get := func(key string) string {
+		v, _ := result.Data[key].(string)
+		return v
+	}
+
+	_, err := storage.CreateOrderVnpay(ctx, []db.CreateOrderVnpayParams{{
+		ID:                   result.OrderID,
+		VnpAmount:            get("vnp_Amount"),
+		VnpBankCode:          get("vnp_BankCode"),
+		VnpBankTranNo:        pgtype.Text{String: get("vnp_BankTranNo"), Valid: get("vnp_BankTranNo") != ""},
+		VnpCardType:          get("vnp_CardType"),
+		VnpOrderInfo:         get("vnp_OrderInfo"),
+		VnpPayDate:           get("vnp_PayDate"),
+		VnpResponseCode:      get("vnp_ResponseCode"),
+		VnpSecureHash:        get("vnp_SecureHash"),
+		VnpTmnCode:           get("vnp_TmnCode"),
+		VnpTransactionNo:     get("vnp_TransactionNo"),
+		VnpTransactionStatus: get("vnp_TransactionStatus"),
+		VnpTxnRef:            get("vnp_TxnRef"),
+	}})
+	return err
+}
+
+// parseVnpayResult reads the payment result from the vnp_* fields
+func parseVnpayResult(data map[string]any) (ordermodel.PaymentResult, error) {
+	var zero ordermodel.PaymentResult
+
+	get := func(key string) string {
+		v, _ := data[key].(string)
+		return v
+	}
+
+	orderID, err := strconv.ParseInt(get("vnp_TxnRef"), 10, 64)
+	if err != nil {
+		return zero, fmt.Errorf("invalid vnp_TxnRef: %w", err)
+	}
+	amount, err := strconv.ParseInt(get("vnp_Amount"), 10, 64)
+	if err != nil {
+		return zero, fmt.Errorf("invalid vnp_Amount: %w", err)
+	}
+
+	status := db.SharedStatusFailed
+	if get("vnp_ResponseCode") == vnpaySuccessCode && get("vnp_TransactionStatus") == vnpaySuccessCode {
+		status = db.SharedStatusSuccess
+	}
+
+	return ordermodel.PaymentResult{
+		OrderID:       orderID,
+		Amount:        amount / 100, // VNPay amount is multiplied by 100
+		Status:        status,
+		TransactionNo: get("vnp_TransactionNo"),
+		Data:          data,
+	}, nil
+}
@@ -0,0 +1,137 @@
+package orderbiz
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"shopnexus-remastered/internal/db"
+	"shopnexus-remastered/internal/logger"
+	ordermodel "shopnexus-remastered/internal/module/order/model"
+	"shopnexus-remastered/internal/utils/pgutil"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/fx"
+)
+
+const (
+	// reconcileInterval is how often pending payments are reconciled
+	reconcileInterval = 5 * time.Minute
+	// reconcileMinAge gives the payment platform time to send its callback before we ask for the status
+	reconcileMinAge = 15 * time.Minute
+	// reconcileBatchSize is the number of pending orders listed at a time
+	reconcileBatchSize = 100
+	// paymentExpireAfter is when an order still unpaid gets canceled, well after the payment page expires
+	paymentExpireAfter = time.Hour
+)
+
+// ReconcilePendingPayments asks the payment platforms for the status of orders stuck in Pending,
+// for example because the callback never arrived. Unpaid orders are canceled once expired.
+// Only the orders of platforms that can be queried are reconciled, all of them, a batch at a time.
+func (s *OrderBiz) ReconcilePendingPayments(ctx context.Context) error {
+	now := time.Now()
+
+	methods := s.payments.QueryMethods()
+	if len(methods) == 0 {
+		return nil
+	}
+
+	// Page by id, so the orders failing every run do not keep the next ones from being reconciled
+	var cursor int64
+	for {
+		orders, err := s.storage.ListOrderBase(ctx, db.ListOrderBaseParams{
+			Limit:         pgutil.Int32ToPgInt4(reconcileBatchSize),
+			IDFrom:        pgutil.Int64ToPgInt8(cursor + 1),
+			PaymentMethod: methods,
+			Status:        []db.SharedStatus{db.SharedStatusPending},
+			DateCreatedTo: pgtype.Timestamptz{Time: now.Add(-reconcileMinAge), Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, order := range orders {
+			if err := s.reconcilePayment(ctx, order, now); err != nil {
+				logger.Log.Sugar().Errorf("Failed to reconcile payment of order %d: %v", order.ID, err)
+			}
+			cursor = order.ID
+		}
+
+		if len(orders) < reconcileBatchSize {
+			return nil
+		}
+	}
+}
+
+// reconcilePayment settles a pending order from the status the payment platform reports
+func (s *OrderBiz) reconcilePayment(ctx context.Context, order db.OrderBase, now time.Time) error {
+	provider, err := s.payments.Get(order.PaymentMethod)
+	if err != nil {
+		return err
+	}
+	querier, ok := provider.(ordermodel.PaymentQuerier)
+	if !ok {
+		return nil
+	}
+
+	result, err := querier.QueryStatus(ctx, ordermodel.QueryPaymentParams{
+		OrderID:     order.ID,
+		DateCreated: order.DateCreated.Time,
+	})
+	if err != nil {
+		return err
+	}
+
+	if result.Status != db.SharedStatusPending {
+		err = s.settlePayment(ctx, provider, result)
+		if errors.Is(err, ordermodel.ErrPaymentAlreadyConfirmed) {
+			// The callback arrived in the meantime
+			return nil
+		}
+		return err
+	}
+
+	if now.Sub(order.DateCreated.Time) < paymentExpireAfter {
+		return nil
+	}
+
+	_, err = s.TransitionOrder(ctx, TransitionOrderParams{
+		Actor:   ordermodel.SystemActor,
+		OrderID: order.ID,
+		Status:  db.SharedStatusCanceled,
+		Reason:  "Payment expired",
+	})
+	if errors.Is(err, ordermodel.ErrStatusConflict) {
+		return nil
+	}
+	return err
+}
+
+// StartPaymentReconciler runs ReconcilePendingPayments periodically while the app is running
+func StartPaymentReconciler(lc fx.Lifecycle, biz *OrderBiz) {
+	ticker := time.NewTicker(reconcileInterval)
+	stop := make(chan struct{})
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			go func() {
+				for {
+					select {
+					case <-ticker.C:
+						if err := biz.ReconcilePendingPayments(context.Background()); err != nil {
+							logger.Log.Sugar().Errorf("Failed to reconcile pending payments: %v", err)
+						}
+					case <-stop:
+						return
+					}
+				}
+			}()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			ticker.Stop()
+			close(stop)
+			return nil
+		},
+	})
+}
@@ -0,0 +1,218 @@
+package orderbiz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"shopnexus-remastered/internal/db"
+	"shopnexus-remastered/internal/logger"
+	accountbiz "shopnexus-remastered/internal/module/account/biz"
+	ordermodel "shopnexus-remastered/internal/module/order/model"
+	"shopnexus-remastered/internal/utils/pgutil"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type ApproveRefundParams struct {
+	VendorID int64
+	RefundID int64
+}
+
+// ApproveRefund approves a pending refund of an order item and gives the money back through the payment platform.
+// The refund is Processing while the platform is called, so it is never approved twice and, if the approval cannot be
+// saved afterwards, the refund left in Processing records that the money may have been given back.
+func (s *OrderBiz) ApproveRefund(ctx context.Context, params ApproveRefundParams) (db.OrderRefund, error) {
+	var zero db.OrderRefund
+
+	approval, err := s.startRefund(ctx, params)
+	if err != nil {
+		return zero, err
+	}
+
+	// Without platform support (COD) the vendor gives the money back themselves
+	if err = approval.provider.RefundPayment(ctx, ordermodel.RefundPaymentParams{
+		OrderID:     approval.order.ID,
+		Amount:      approval.item.Total,
+		Full:        approval.full,
+		DateCreated: approval.order.DateCreated.Time,
+		RequestedBy: fmt.Sprintf("%d", params.VendorID),
+		Reason:      fmt.Sprintf("Refund %s of order %d", approval.refund.Code, approval.order.ID),
+	}); err != nil && !errors.Is(err, ordermodel.ErrPaymentRefundNotSupported) {
+		// The platform did not give the money back, the refund can be approved again
+		if _, resetErr := s.storage.UpdateOrderRefundStatus(ctx, db.UpdateOrderRefundStatusParams{
+			NewStatus:    db.SharedStatusPending,
+			ReviewedByID: approval.reviewedByID,
+			ID:           approval.refund.ID,
+			OldStatus:    db.SharedStatusProcessing,
+		}); resetErr != nil {
+			logger.Log.Sugar().Errorf("Failed to put refund %d back to Pending after the platform rejected it: %v", approval.refund.ID, resetErr)
+		}
+		return zero, err
+	}
+
+	updated, err := s.finishRefund(ctx, approval, params.VendorID)
+	if err != nil {
+		logger.Log.Sugar().Errorf("Refund %d was given back by %s but stays Processing: %v", approval.refund.ID, approval.provider.Name(), err)
+		return zero, err
+	}
+	return updated, nil
+}
+
+// refundApproval is a refund moved to Processing, waiting for the payment platform
+type refundApproval struct {
+	refund       db.OrderRefund
+	reviewedByID pgtype.Int8 // Reviewer before the approval started
+	item         db.OrderItem
+	order        db.OrderBase
+	provider     ordermodel.PaymentProvider
+	full         bool // The item is the whole payment
+}
+
+// startRefund checks the vendor can approve the refund and moves it to Processing
+func (s *OrderBiz) startRefund(ctx context.Context, params ApproveRefundParams) (refundApproval, error) {
+	var zero refundApproval
+
+	txStorage, err := s.storage.BeginTx(ctx)
+	if err != nil {
+		return zero, err
+	}
+	defer txStorage.Rollback(ctx)
+
+	refund, err := txStorage.GetOrderRefund(ctx, db.GetOrderRefundParams{
+		ID: pgutil.Int64ToPgInt8(params.RefundID),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return zero, ordermodel.ErrRefundNotFound
+		}
+		return zero, err
+	}
+	if refund.Status != db.SharedStatusPending {
+		return zero, ordermodel.ErrRefundNotPending
+	}
+
+	// Lock the item so the other refunds of the item wait for this approval
+	item, err := txStorage.GetOrderItemForUpdate(ctx, refund.OrderItemID)
+	if err != nil {
+		return zero, err
+	}
+
+	// Vendor can only approve refunds of their own products
+	sku, err := txStorage.GetCatalogProductSku(ctx, db.GetCatalogProductSkuParams{
+		ID: pgutil.Int64ToPgInt8(item.SkuID),
+	})
+	if err != nil {
+		return zero, err
+	}
+	spu, err := txStorage.GetCatalogProductSpu(ctx, db.GetCatalogProductSpuParams{
+		ID: pgutil.Int64ToPgInt8(sku.SpuID),
+	})
+	if err != nil {
+		return zero, err
+	}
+	if spu.AccountID != params.VendorID {
+		return zero, ordermodel.ErrRefundNotFound
+	}
+
+	// An item is refunded once
+	approved, err := txStorage.CountOrderRefund(ctx, db.CountOrderRefundParams{
+		OrderItemID: []int64{item.ID},
+		Status:      []db.SharedStatus{db.SharedStatusProcessing, db.SharedStatusSuccess},
+	})
+	if err != nil {
+		return zero, err
+	}
+	if approved > 0 {
+		return zero, ordermodel.ErrItemRefunded
+	}
+
+	order, err := txStorage.GetOrderBase(ctx, db.GetOrderBaseParams{
+		ID: pgutil.Int64ToPgInt8(item.OrderID),
+	})
+	if err != nil {
+		return zero, err
+	}
+	if order.Status != db.SharedStatusSuccess {
+		return zero, ordermodel.ErrOrderNotPaid
+	}
+
+	provider, err := s.payments.Get(order.PaymentMethod)
+	if err != nil {
+		return zero, err
+	}
+
+	items, err := txStorage.ListOrderItem(ctx, db.ListOrderItemParams{
+		OrderID: []int64{order.ID},
+	})
+	if err != nil {
+		return zero, err
+	}
+
+	// Only approve if nobody reviewed the refund since we read it
+	updated, err := txStorage.UpdateOrderRefundStatus(ctx, db.UpdateOrderRefundStatusParams{
+		NewStatus:    db.SharedStatusProcessing,
+		ReviewedByID: pgutil.Int64ToPgInt8(params.VendorID),
+		ID:           refund.ID,
+		OldStatus:    refund.Status,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return zero, ordermodel.ErrRefundNotPending
+		}
+		return zero, err
+	}
+
+	if err = txStorage.Commit(ctx); err != nil {
+		return zero, err
+	}
+
+	return refundApproval{
+		refund:       updated,
+		reviewedByID: refund.ReviewedByID,
+		item:         item,
+		order:        order,
+		provider:     provider,
+		full:         item.Total == ordermodel.NewOrder(order, items).Total,
+	}, nil
+}
+
+// finishRefund approves a refund the payment platform gave back
+func (s *OrderBiz) finishRefund(ctx context.Context, approval refundApproval, vendorID int64) (db.OrderRefund, error) {
+	var zero db.OrderRefund
+
+	txStorage, err := s.storage.BeginTx(ctx)
+	if err != nil {
+		return zero, err
+	}
+	defer txStorage.Rollback(ctx)
+
+	updated, err := txStorage.UpdateOrderRefundStatus(ctx, db.UpdateOrderRefundStatusParams{
+		NewStatus:    db.SharedStatusSuccess,
+		ReviewedByID: pgutil.Int64ToPgInt8(vendorID),
+		ID:           approval.refund.ID,
+		OldStatus:    db.SharedStatusProcessing,
+	})
+	if err != nil {
+		return zero, err
+	}
+
+	// Cash on delivery income is recorded when the cash is collected, take the refund back from it
+	if approval.order.PaymentMethod == db.OrderPaymentMethodCOD {
+		if err = s.accountBiz.RecordIncome(ctx, txStorage, accountbiz.RecordIncomeParams{
+			AccountID: vendorID,
+			Type:      accountbiz.IncomeTypeRefund,
+			Income:    -approval.item.Total,
+			Note:      fmt.Sprintf("Refund %d of order %d", approval.refund.ID, approval.order.ID),
+		}); err != nil {
+			return zero, err
+		}
+	}
+
+	if err = txStorage.Commit(ctx); err != nil {
+		return zero, err
+	}
+
+	return updated, nil
+}
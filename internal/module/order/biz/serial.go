@@ -0,0 +1,125 @@
+package orderbiz
+
+import (
+	"context"
+
+	"shopnexus-remastered/internal/db"
+	ordermodel "shopnexus-remastered/internal/module/order/model"
+	"shopnexus-remastered/internal/utils/pgutil"
+)
+
+// allocateSerials takes an Active serial for each unit of the order items of serial-tracked SKUs, marks them Sold and
+// links them to the items. A SKU is serial-tracked once it has any serial, the others are sold from their stock only.
+// Serials locked by another checkout are skipped instead of waited for.
+func (s *OrderBiz) allocateSerials(ctx context.Context, txStorage *pgutil.TxStorage, items []db.OrderItem) error {
+	quantities := make(map[int64]int64) // map[skuID]quantity
+	for _, item := range items {
+		quantities[item.SkuID] += item.Quantity
+	}
+
+	available := make(map[int64][]db.InventorySkuSerial) // map[skuID][]Serial, serial-tracked SKUs only
+	for skuID, quantity := range quantities {
+		tracked, err := txStorage.CountInventorySkuSerial(ctx, db.CountInventorySkuSerialParams{
+			SkuID: []int64{skuID},
+		})
+		if err != nil {
+			return err
+		}
+		if tracked == 0 {
+			continue
+		}
+
+		serials, err := txStorage.ListAvailableSkuSerial(ctx, db.ListAvailableSkuSerialParams{
+			SkuID: skuID,
+			Limit: int32(quantity),
+		})
+		if err != nil {
+			return err
+		}
+		available[skuID] = serials
+	}
+
+	links, err := planSerialAllocation(items, available)
+	if err != nil {
+		return err
+	}
+	if len(links) == 0 {
+		return nil
+	}
+
+	soldIDs := make([]int64, 0, len(links))
+	for _, link := range links {
+		soldIDs = append(soldIDs, link.ProductSerialID)
+	}
+	if err = txStorage.UpdateSkuSerialStatus(ctx, db.UpdateSkuSerialStatusParams{
+		Status: db.InventoryProductStatusSold,
+		ID:     soldIDs,
+	}); err != nil {
+		return err
+	}
+
+	_, err = txStorage.CreateDefaultOrderItemSerial(ctx, links)
+	return err
+}
+
+// planSerialAllocation hands out the available serials to the order items in order, one per unit.
+// Items of SKUs missing from available are not serial-tracked and get none.
+func planSerialAllocation(items []db.OrderItem, available map[int64][]db.InventorySkuSerial) ([]db.CreateDefaultOrderItemSerialParams, error) {
+	var links []db.CreateDefaultOrderItemSerialParams
+	next := make(map[int64]int) // map[skuID]index of the next serial to hand out
+	for _, item := range items {
+		serials, tracked := available[item.SkuID]
+		if !tracked {
+			continue
+		}
+		for range item.Quantity {
+			if next[item.SkuID] >= len(serials) {
+				return nil, ordermodel.ErrOutOfStock
+			}
+			links = append(links, db.CreateDefaultOrderItemSerialParams{
+				OrderItemID:     item.ID,
+				ProductSerialID: serials[next[item.SkuID]].ID,
+			})
+			next[item.SkuID]++
+		}
+	}
+	return links, nil
+}
+
+// releaseSerials puts the serials sold with an order back to Active.
+// The item serial links are kept as a record of what was allocated.
+func (s *OrderBiz) releaseSerials(ctx context.Context, txStorage *pgutil.TxStorage, orderID int64) error {
+	items, err := txStorage.ListOrderItem(ctx, db.ListOrderItemParams{
+		OrderID: []int64{orderID},
+	})
+	if err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		return nil
+	}
+
+	itemIDs := make([]int64, 0, len(items))
+	for _, item := range items {
+		itemIDs = append(itemIDs, item.ID)
+	}
+	links, err := txStorage.ListOrderItemSerial(ctx, db.ListOrderItemSerialParams{
+		OrderItemID: itemIDs,
+	})
+	if err != nil {
+		return err
+	}
+	if len(links) == 0 {
+		return nil
+	}
+
+	serialIDs := make([]int64, 0, len(links))
+	for _, link := range links {
+		serialIDs = append(serialIDs, link.ProductSerialID)
+	}
+
+	return txStorage.UpdateSkuSerialStatus(ctx, db.UpdateSkuSerialStatusParams{
+		Status: db.InventoryProductStatusActive,
+		ID:     serialIDs,
+	})
+}
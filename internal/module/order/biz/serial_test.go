@@ -0,0 +1,90 @@
+package orderbiz
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"shopnexus-remastered/internal/db"
+	ordermodel "shopnexus-remastered/internal/module/order/model"
+)
+
+func serials(ids ...int64) []db.InventorySkuSerial {
+	result := make([]db.InventorySkuSerial, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, db.InventorySkuSerial{ID: id})
+	}
+	return result
+}
+
+func TestPlanSerialAllocation(t *testing.T) {
+	tests := []struct {
+		name      string
+		items     []db.OrderItem
+		available map[int64][]db.InventorySkuSerial
+		want      []db.CreateDefaultOrderItemSerialParams
+		wantErr   error
+	}{
+		{
+			name:      "sku without serials",
+			items:     []db.OrderItem{{ID: 1, SkuID: 10, Quantity: 3}},
+			available: map[int64][]db.InventorySkuSerial{},
+		},
+		{
+			name:      "serial-tracked sku",
+			items:     []db.OrderItem{{ID: 1, SkuID: 10, Quantity: 2}},
+			available: map[int64][]db.InventorySkuSerial{10: serials(100, 101)},
+			want: []db.CreateDefaultOrderItemSerialParams{
+				{OrderItemID: 1, ProductSerialID: 100},
+				{OrderItemID: 1, ProductSerialID: 101},
+			},
+		},
+		{
+			name: "serials shared by the items of a sku",
+			items: []db.OrderItem{
+				{ID: 1, SkuID: 10, Quantity: 1},
+				{ID: 2, SkuID: 10, Quantity: 1},
+			},
+			available: map[int64][]db.InventorySkuSerial{10: serials(100, 101)},
+			want: []db.CreateDefaultOrderItemSerialParams{
+				{OrderItemID: 1, ProductSerialID: 100},
+				{OrderItemID: 2, ProductSerialID: 101},
+			},
+		},
+		{
+			name: "serial-tracked sku with a sku without serials",
+			items: []db.OrderItem{
+				{ID: 1, SkuID: 10, Quantity: 1},
+				{ID: 2, SkuID: 20, Quantity: 5},
+			},
+			available: map[int64][]db.InventorySkuSerial{10: serials(100)},
+			want: []db.CreateDefaultOrderItemSerialParams{
+				{OrderItemID: 1, ProductSerialID: 100},
+			},
+		},
+		{
+			name:      "not enough serials",
+			items:     []db.OrderItem{{ID: 1, SkuID: 10, Quantity: 2}},
+			available: map[int64][]db.InventorySkuSerial{10: serials(100)},
+			wantErr:   ordermodel.ErrOutOfStock,
+		},
+		{
+			name:      "serial-tracked sku with no serial left",
+			items:     []db.OrderItem{{ID: 1, SkuID: 10, Quantity: 1}},
+			available: map[int64][]db.InventorySkuSerial{10: {}},
+			wantErr:   ordermodel.ErrOutOfStock,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := planSerialAllocation(tt.items, tt.available)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("planSerialAllocation() error = %v, want %v", err, tt.wantErr)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("planSerialAllocation() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
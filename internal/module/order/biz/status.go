@@ -0,0 +1,232 @@
+package orderbiz
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"shopnexus-remastered/internal/db"
+	inventorybiz "shopnexus-remastered/internal/module/inventory/biz"
+	ordermodel "shopnexus-remastered/internal/module/order/model"
+	promotionbiz "shopnexus-remastered/internal/module/promotion/biz"
+	"shopnexus-remastered/internal/utils/pgutil"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// orderAggregateType is the system.event aggregate_type of order events
+const orderAggregateType = "Order"
+
+type TransitionOrderParams struct {
+	Actor   ordermodel.Actor
+	OrderID int64
+	Status  db.SharedStatus
+	Reason  string
+}
+
+// TransitionOrder moves an order to a new status, following the order state machine
+func (s *OrderBiz) TransitionOrder(ctx context.Context, params TransitionOrderParams) (db.OrderBase, error) {
+	var zero db.OrderBase
+
+	txStorage, err := s.storage.BeginTx(ctx)
+	if err != nil {
+		return zero, err
+	}
+	defer txStorage.Rollback(ctx)
+
+	order, err := s.transitionOrder(ctx, txStorage, params)
+	if err != nil {
+		return zero, err
+	}
+
+	if err = txStorage.Commit(ctx); err != nil {
+		return zero, err
+	}
+
+	return order, nil
+}
+
+// transitionOrder is TransitionOrder inside an existing transaction
+func (s *OrderBiz) transitionOrder(ctx context.Context, txStorage *pgutil.TxStorage, params TransitionOrderParams) (db.OrderBase, error) {
+	var zero db.OrderBase
+
+	order, err := txStorage.GetOrderBase(ctx, db.GetOrderBaseParams{
+		ID: pgutil.Int64ToPgInt8(params.OrderID),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return zero, ordermodel.ErrOrderNotFound
+		}
+		return zero, err
+	}
+
+	// Customers and vendors can only touch their own orders
+	switch params.Actor.Role {
+	case ordermodel.ActorRoleCustomer:
+		if order.CustomerID != params.Actor.AccountID {
+			return zero, ordermodel.ErrOrderNotFound
+		}
+	case ordermodel.ActorRoleVendor:
+		// Any vendor of the order moves it, the status is shared by the items of all its vendors
+		owned, err := s.listVendorOrderItems(ctx, txStorage, order.ID, params.Actor.AccountID)
+		if err != nil {
+			return zero, err
+		}
+		if len(owned) == 0 {
+			return zero, ordermodel.ErrOrderNotFound
+		}
+	}
+
+	if err = ordermodel.CheckStatusTransition(order.Status, params.Status, params.Actor.Role); err != nil {
+		return zero, err
+	}
+
+	// Only update if nobody changed the status since we read it
+	updated, err := txStorage.UpdateOrderBaseStatus(ctx, db.UpdateOrderBaseStatusParams{
+		NewStatus: params.Status,
+		ID:        order.ID,
+		OldStatus: order.Status,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return zero, ordermodel.ErrStatusConflict
+		}
+		return zero, err
+	}
+
+	switch updated.Status {
+	case db.SharedStatusProcessing, db.SharedStatusSuccess:
+		// Paid or accepted orders keep their stock for good
+		if err = s.inventoryBiz.CommitStock(ctx, txStorage, inventorybiz.CommitStockParams{
+			RefType: db.InventoryReservationTypeOrder,
+			RefID:   order.ID,
+		}); err != nil {
+			return zero, err
+		}
+	case db.SharedStatusCanceled, db.SharedStatusFailed:
+		// Orders that will never be delivered give their stock and serials back
+		if err = s.inventoryBiz.ReleaseStock(ctx, txStorage, inventorybiz.ReleaseStockParams{
+			RefType: db.InventoryReservationTypeOrder,
+			RefID:   order.ID,
+		}); err != nil {
+			return zero, err
+		}
+		if err = s.releaseSerials(ctx, txStorage, order.ID); err != nil {
+			return zero, err
+		}
+		if err = s.promotionBiz.CancelVoucherRedemptions(ctx, txStorage, order.ID); err != nil {
+			return zero, err
+		}
+	}
+
+	// The customer gets the cashback of the order once it succeeds, and never if it does not
+	switch updated.Status {
+	case db.SharedStatusSuccess, db.SharedStatusCanceled, db.SharedStatusFailed:
+		cashbackStatus := db.SharedStatusSuccess
+		if updated.Status != db.SharedStatusSuccess {
+			cashbackStatus = db.SharedStatusCanceled
+		}
+		if err = s.promotionBiz.SettleCashbackCredits(ctx, txStorage, promotionbiz.SettleCashbackCreditsParams{
+			OrderID: order.ID,
+			Status:  cashbackStatus,
+		}); err != nil {
+			return zero, err
+		}
+	}
+
+	if err = s.createOrderEvent(ctx, txStorage, params.Actor, order.ID, db.SystemEventTypeUpdated, ordermodel.StatusChangedPayload{
+		OldStatus: order.Status,
+		NewStatus: updated.Status,
+		ActorRole: params.Actor.Role,
+		Reason:    params.Reason,
+	}); err != nil {
+		return zero, err
+	}
+
+	return updated, nil
+}
+
+// listVendorOrderItems returns the items of the order that belong to the vendor. Checkout does not split carts by
+// vendor, so the other items of the order may belong to other vendors.
+func (s *OrderBiz) listVendorOrderItems(ctx context.Context, storage db.Querier, orderID int64, vendorID int64) ([]db.OrderItem, error) {
+	items, err := storage.ListOrderItem(ctx, db.ListOrderItemParams{
+		OrderID: []int64{orderID},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, nil
+	}
+
+	skuIDs := make([]int64, 0, len(items))
+	for _, item := range items {
+		skuIDs = append(skuIDs, item.SkuID)
+	}
+	skus, err := storage.ListCatalogProductSku(ctx, db.ListCatalogProductSkuParams{
+		ID: skuIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	spuIDs := make([]int64, 0, len(skus))
+	for _, sku := range skus {
+		spuIDs = append(spuIDs, sku.SpuID)
+	}
+	spus, err := storage.ListCatalogProductSpu(ctx, db.ListCatalogProductSpuParams{
+		ID: spuIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return filterVendorItems(items, skus, spus, vendorID), nil
+}
+
+// filterVendorItems keeps the order items whose SPU belongs to the vendor
+func filterVendorItems(items []db.OrderItem, skus []db.CatalogProductSku, spus []db.CatalogProductSpu, vendorID int64) []db.OrderItem {
+	spuVendor := make(map[int64]int64, len(spus)) // map[spuID]vendorID
+	for _, spu := range spus {
+		spuVendor[spu.ID] = spu.AccountID
+	}
+	skuVendor := make(map[int64]int64, len(skus)) // map[skuID]vendorID
+	for _, sku := range skus {
+		skuVendor[sku.ID] = spuVendor[sku.SpuID]
+	}
+
+	var result []db.OrderItem
+	for _, item := range items {
+		if vendor, ok := skuVendor[item.SkuID]; ok && vendor == vendorID {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// createOrderEvent writes an order event to system.event, versioned per order
+func (s *OrderBiz) createOrderEvent(ctx context.Context, txStorage *pgutil.TxStorage, actor ordermodel.Actor, orderID int64, eventType db.SystemEventType, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	count, err := txStorage.CountSystemEvent(ctx, db.CountSystemEventParams{
+		AggregateID:   []int64{orderID},
+		AggregateType: []string{orderAggregateType},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = txStorage.CreateDefaultSystemEvent(ctx, []db.CreateDefaultSystemEventParams{{
+		AccountID:     pgtype.Int8{Int64: actor.AccountID, Valid: actor.Role != ordermodel.ActorRoleSystem},
+		AggregateID:   orderID,
+		AggregateType: orderAggregateType,
+		EventType:     eventType,
+		Payload:       data,
+		Version:       count + 1,
+	}})
+	return err
+}
@@ -0,0 +1,48 @@
+package orderbiz
+
+import (
+	"slices"
+	"testing"
+
+	"shopnexus-remastered/internal/db"
+)
+
+func TestFilterVendorItems(t *testing.T) {
+	// A cart of two vendors checked out as one order
+	items := []db.OrderItem{
+		{ID: 1, SkuID: 10, Quantity: 1},
+		{ID: 2, SkuID: 20, Quantity: 2},
+		{ID: 3, SkuID: 11, Quantity: 1},
+	}
+	skus := []db.CatalogProductSku{
+		{ID: 10, SpuID: 100},
+		{ID: 11, SpuID: 100},
+		{ID: 20, SpuID: 200},
+	}
+	spus := []db.CatalogProductSpu{
+		{ID: 100, AccountID: 1},
+		{ID: 200, AccountID: 2},
+	}
+
+	tests := []struct {
+		name     string
+		vendorID int64
+		want     []int64 // IDs of the items
+	}{
+		{name: "first vendor", vendorID: 1, want: []int64{1, 3}},
+		{name: "second vendor", vendorID: 2, want: []int64{2}},
+		{name: "vendor not in the order", vendorID: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []int64
+			for _, item := range filterVendorItems(items, skus, spus, tt.vendorID) {
+				got = append(got, item.ID)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("filterVendorItems(vendor %d) = %v, want %v", tt.vendorID, got, tt.want)
+			}
+		})
+	}
+}
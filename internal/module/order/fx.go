@@ -1,8 +1,12 @@
 package order
 
 import (
+	"shopnexus-remastered/config"
+	"shopnexus-remastered/internal/client/vnpay"
 	orderbiz "shopnexus-remastered/internal/module/order/biz"
+	ordermodel "shopnexus-remastered/internal/module/order/model"
 	orderecho "shopnexus-remastered/internal/module/order/transport/echo"
+	"shopnexus-remastered/internal/utils/pgutil"
 
 	"go.uber.org/fx"
 )
@@ -10,7 +14,39 @@ import (
 // Module provides the order module dependencies
 var Module = fx.Module("order",
 	fx.Provide(
+		orderbiz.NewPaymentRegistry,
 		orderbiz.NewOrderBiz,
 		orderecho.NewHandler,
 	),
+
+	// Payment providers
+	fx.Provide(
+		fx.Annotate(
+			newVnpayProviders,
+			fx.ResultTags(`group:"payment_providers,flatten"`),
+		),
+		asPaymentProvider(orderbiz.NewCodProvider),
+	),
+
+	// Background jobs
+	fx.Invoke(
+		orderbiz.StartPaymentReconciler,
+	),
 )
+
+// asPaymentProvider registers a payment provider constructor to the payment registry
+func asPaymentProvider(constructor any) any {
+	return fx.Annotate(
+		constructor,
+		fx.As(new(ordermodel.PaymentProvider)),
+		fx.ResultTags(`group:"payment_providers"`),
+	)
+}
+
+// newVnpayProviders registers the VNPay provider only when VNPay is configured
+func newVnpayProviders(storage *pgutil.Storage, client vnpay.Client, cfg *config.Config) []ordermodel.PaymentProvider {
+	if cfg.Vnpay == nil {
+		return nil
+	}
+	return []ordermodel.PaymentProvider{orderbiz.NewVnpayProvider(storage, client, cfg)}
+}
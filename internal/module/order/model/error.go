@@ -0,0 +1,31 @@
+package ordermodel
+
+import sharedmodel "shopnexus-remastered/internal/module/shared/model"
+
+var (
+	ErrOrderNotFound        = sharedmodel.NewError("order.not_found", "Order not found")
+	ErrEmptyOrder           = sharedmodel.NewError("order.empty", "At least one item must be selected")
+	ErrCartItemNotFound     = sharedmodel.NewError("order.cart_item_not_found", "Some selected items are not in the cart")
+	ErrOrderNotPending      = sharedmodel.NewError("order.not_pending", "Only pending orders can be changed")
+	ErrInvalidPaymentMethod = sharedmodel.NewError("order.invalid_payment_method", "Payment method is not supported")
+	ErrOutOfStock           = sharedmodel.NewError("order.out_of_stock", "Some items are out of stock")
+	ErrAddressRequired      = sharedmodel.NewError("order.address_required", "Delivery address is required for cash on delivery")
+
+	ErrPaymentMethodNotSupported = sharedmodel.NewError("order.payment_method_not_supported", "Payment method is not supported yet")
+	ErrInvalidPaymentCallback    = sharedmodel.NewError("order.invalid_payment_callback", "Payment callback is invalid")
+	ErrPaymentAlreadyConfirmed   = sharedmodel.NewError("order.payment_already_confirmed", "Payment of the order has already been confirmed")
+	ErrPaymentAmountMismatch     = sharedmodel.NewError("order.payment_amount_mismatch", "Paid amount does not match the order total")
+	ErrPaymentNotFound           = sharedmodel.NewError("order.payment_not_found", "Payment of the order not found")
+	ErrPaymentRequestFailed      = sharedmodel.NewError("order.payment_request_failed", "Payment platform rejected the request")
+	ErrPaymentRefundNotSupported = sharedmodel.NewError("order.payment_refund_not_supported", "Payment method does not support refunds through the platform")
+	ErrNotCodOrder               = sharedmodel.NewError("order.not_cod", "Order is not paid by cash on delivery")
+
+	ErrRefundNotFound   = sharedmodel.NewError("order.refund_not_found", "Refund not found")
+	ErrRefundNotPending = sharedmodel.NewError("order.refund_not_pending", "Only pending refunds can be approved")
+	ErrItemRefunded     = sharedmodel.NewError("order.item_refunded", "The order item is already refunded or being refunded")
+	ErrOrderNotPaid     = sharedmodel.NewError("order.not_paid", "Only paid orders can be refunded")
+
+	ErrInvalidStatusTransition   = sharedmodel.NewError("order.invalid_status_transition", "Order cannot move to the requested status")
+	ErrStatusTransitionForbidden = sharedmodel.NewError("order.status_transition_forbidden", "You are not allowed to move the order to the requested status")
+	ErrStatusConflict            = sharedmodel.NewError("order.status_conflict", "Order status has been changed by another request, please retry")
+)
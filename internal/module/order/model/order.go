@@ -1 +1,42 @@
 package ordermodel
+
+import (
+	"shopnexus-remastered/internal/db"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type Order struct {
+	ID            int64                 `json:"id"`
+	Code          string                `json:"code"`
+	CustomerID    int64                 `json:"customer_id"`
+	PaymentMethod db.OrderPaymentMethod `json:"payment_method"`
+	Status        db.SharedStatus       `json:"status"`
+	Address       string                `json:"address"`
+	DateCreated   pgtype.Timestamptz    `json:"date_created"`
+	DateUpdated   pgtype.Timestamptz    `json:"date_updated"`
+
+	Subtotal int64          `json:"subtotal"` // Total amount before discount
+	Total    int64          `json:"total"`    // Total amount after discount
+	Items    []db.OrderItem `json:"items"`
+}
+
+// NewOrder builds an Order from its base row and items, the amounts are summed from the items
+func NewOrder(base db.OrderBase, items []db.OrderItem) Order {
+	order := Order{
+		ID:            base.ID,
+		Code:          base.Code,
+		CustomerID:    base.CustomerID,
+		PaymentMethod: base.PaymentMethod,
+		Status:        base.Status,
+		Address:       base.Address,
+		DateCreated:   base.DateCreated,
+		DateUpdated:   base.DateUpdated,
+		Items:         items,
+	}
+	for _, item := range items {
+		order.Subtotal += item.Subtotal
+		order.Total += item.Total
+	}
+	return order
+}
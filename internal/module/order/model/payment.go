@@ -0,0 +1,72 @@
+package ordermodel
+
+import (
+	"context"
+	"time"
+
+	"shopnexus-remastered/internal/db"
+)
+
+// PaymentProvider is a payment platform (VNPay, MoMo, Stripe, ...) that collects money for orders.
+// Providers are registered to the "payment_providers" fx group.
+type PaymentProvider interface {
+	// Name returns the unique name of the provider, e.g. "vnpay"
+	Name() string
+	// Methods returns the payment methods handled by this provider
+	Methods() []db.OrderPaymentMethod
+	// CreatePayment starts a payment for an order and returns where the customer should pay
+	CreatePayment(ctx context.Context, params CreatePaymentParams) (CreatePaymentResult, error)
+	// VerifyCallback checks the signature of a callback sent by the platform and parses its result
+	VerifyCallback(ctx context.Context, data map[string]any) (PaymentResult, error)
+	// RefundPayment gives back part or all of a confirmed payment
+	RefundPayment(ctx context.Context, params RefundPaymentParams) error
+}
+
+// PaymentQuerier is implemented by providers whose platform can be asked for the status of a payment (e.g. VNPay querydr)
+type PaymentQuerier interface {
+	// QueryStatus asks the platform for the current status of an order payment
+	QueryStatus(ctx context.Context, params QueryPaymentParams) (PaymentResult, error)
+}
+
+// PaymentRecorder is implemented by providers that keep their own record of confirmed payments (e.g. order.vnpay)
+type PaymentRecorder interface {
+	RecordPayment(ctx context.Context, storage db.Querier, result PaymentResult) error
+}
+
+type CreatePaymentParams struct {
+	OrderID     int64
+	Amount      int64
+	Info        string
+	DateCreated time.Time // Order creation time
+	ClientIP    string    // IP address of the customer
+	Locale      string    // Language of the payment page, empty for the platform default
+	BankCode    string    // Bank or payment option preselected on the payment page, empty to let the customer choose
+}
+
+type CreatePaymentResult struct {
+	Url         string          // Payment page of the platform, empty if the customer does not pay online
+	OrderStatus db.SharedStatus // Status the order moves to right after checkout, empty to wait for the payment in Pending
+}
+
+type QueryPaymentParams struct {
+	OrderID     int64
+	DateCreated time.Time // Order creation time, some platforms need it to find the transaction
+}
+
+type RefundPaymentParams struct {
+	OrderID     int64
+	Amount      int64
+	Full        bool      // Amount is the whole payment
+	DateCreated time.Time // Order creation time
+	RequestedBy string    // Who approved the refund
+	Reason      string
+}
+
+// PaymentResult is the outcome of a payment reported by the platform
+type PaymentResult struct {
+	OrderID       int64
+	Amount        int64
+	Status        db.SharedStatus // Pending, Success or Failed
+	TransactionNo string          // Transaction id on the platform
+	Data          map[string]any  // Raw fields returned by the platform
+}
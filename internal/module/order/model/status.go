@@ -0,0 +1,68 @@
+package ordermodel
+
+import "shopnexus-remastered/internal/db"
+
+// ActorRole is the kind of party that requests an order status transition
+type ActorRole string
+
+const (
+	ActorRoleCustomer ActorRole = "Customer"
+	ActorRoleVendor   ActorRole = "Vendor"
+	ActorRoleSystem   ActorRole = "System" // Payment callbacks, background jobs, ...
+)
+
+// Actor is who requests an order status transition
+type Actor struct {
+	AccountID int64 // Zero for system actions
+	Role      ActorRole
+}
+
+// SystemActor is used by payment callbacks and background jobs
+var SystemActor = Actor{Role: ActorRoleSystem}
+
+// NewActor creates an Actor from the account that performs the action
+func NewActor(accountID int64, accountType db.AccountType) Actor {
+	role := ActorRoleCustomer
+	if accountType == db.AccountTypeVendor {
+		role = ActorRoleVendor
+	}
+	return Actor{AccountID: accountID, Role: role}
+}
+
+// statusTransitions lists the allowed transitions and who may perform them, map[from]map[to][]ActorRole.
+// Success, Failed and Canceled are final.
+var statusTransitions = map[db.SharedStatus]map[db.SharedStatus][]ActorRole{
+	db.SharedStatusPending: {
+		db.SharedStatusProcessing: {ActorRoleVendor, ActorRoleSystem},
+		db.SharedStatusSuccess:    {ActorRoleSystem}, // Paid online
+		db.SharedStatusFailed:     {ActorRoleSystem}, // Payment failed
+		db.SharedStatusCanceled:   {ActorRoleCustomer, ActorRoleVendor, ActorRoleSystem},
+	},
+	db.SharedStatusProcessing: {
+		db.SharedStatusSuccess:  {ActorRoleVendor, ActorRoleSystem},
+		db.SharedStatusFailed:   {ActorRoleVendor, ActorRoleSystem},
+		db.SharedStatusCanceled: {ActorRoleVendor, ActorRoleSystem},
+	},
+}
+
+// CheckStatusTransition returns an error if the actor role cannot move an order from one status to another
+func CheckStatusTransition(from, to db.SharedStatus, role ActorRole) error {
+	roles, ok := statusTransitions[from][to]
+	if !ok {
+		return ErrInvalidStatusTransition
+	}
+	for _, r := range roles {
+		if r == role {
+			return nil
+		}
+	}
+	return ErrStatusTransitionForbidden
+}
+
+// StatusChangedPayload is the system.event payload written on every order status transition
+type StatusChangedPayload struct {
+	OldStatus db.SharedStatus `json:"old_status,omitempty"` // Empty when the order is created
+	NewStatus db.SharedStatus `json:"new_status"`
+	ActorRole ActorRole       `json:"actor_role"`
+	Reason    string          `json:"reason,omitempty"`
+}
@@ -1,19 +1,233 @@
 package orderecho
 
 import (
+	"net/http"
+
+	"shopnexus-remastered/config"
+	"shopnexus-remastered/internal/db"
+	authbiz "shopnexus-remastered/internal/module/auth/biz"
 	orderbiz "shopnexus-remastered/internal/module/order/biz"
+	ordermodel "shopnexus-remastered/internal/module/order/model"
+	sharedmodel "shopnexus-remastered/internal/module/shared/model"
+	"shopnexus-remastered/internal/module/shared/transport/echo/response"
 
 	"github.com/labstack/echo/v4"
 )
 
 type Handler struct {
-	biz *orderbiz.OrderBiz
+	biz          *orderbiz.OrderBiz
+	courierToken string
 }
 
-func NewHandler(e *echo.Echo, biz *orderbiz.OrderBiz) *Handler {
-	h := &Handler{biz: biz}
+func NewHandler(e *echo.Echo, biz *orderbiz.OrderBiz, cfg *config.Config) *Handler {
+	h := &Handler{
+		biz:          biz,
+		courierToken: cfg.Cod.CourierToken,
+	}
 	api := e.Group("/api/v1/order")
-	_ = api
+	api.POST("", h.CreateOrder)
+	api.GET("", h.ListOrders)
+	api.GET("/:id", h.GetOrder)
+	api.POST("/:id/cancel", h.CancelOrder)
+	api.PATCH("/:id/address", h.UpdateOrderAddress)
+	api.POST("/refund/:id/approve", h.ApproveRefund)
+
+	api.GET("/payment/vnpay/ipn", h.VnpayIPN)
+	api.GET("/payment/vnpay/return", h.VnpayReturn)
+	api.POST("/:id/cod/confirm", h.ConfirmCodPayment)
+	api.POST("/:id/cod/courier-confirm", h.CourierConfirmCodPayment)
 
 	return h
 }
+
+type CreateOrderRequest struct {
+	SkuIDs        []int64               `json:"sku_ids" validate:"required,min=1,dive,gt=0"`
+	Address       string                `json:"address" validate:"required,min=1,max=500"`
+	PaymentMethod db.OrderPaymentMethod `json:"payment_method" validate:"required,oneof=COD Card EWallet Crypto"`
+	Locale        string                `json:"locale" validate:"omitempty,oneof=vn en"`
+	BankCode      string                `json:"bank_code" validate:"omitempty,alphanum,max=20"`
+	VoucherCode   *string               `json:"voucher_code" validate:"omitempty,min=1,max=100"`
+}
+
+func (h *Handler) CreateOrder(c echo.Context) error {
+	var req CreateOrderRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := authbiz.GetClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	result, err := h.biz.CreateOrder(c.Request().Context(), orderbiz.CreateOrderParams{
+		AccountID:     claims.AccountID(),
+		Address:       req.Address,
+		PaymentMethod: req.PaymentMethod,
+		SkuIDs:        req.SkuIDs,
+		ClientIP:      c.RealIP(),
+		Locale:        req.Locale,
+		BankCode:      req.BankCode,
+		VoucherCode:   req.VoucherCode,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusCreated, result)
+}
+
+type GetOrderRequest struct {
+	ID int64 `param:"id" validate:"required,gt=0"`
+}
+
+func (h *Handler) GetOrder(c echo.Context) error {
+	var req GetOrderRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := authbiz.GetClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	result, err := h.biz.GetOrder(c.Request().Context(), orderbiz.GetOrderParams{
+		AccountID:   claims.AccountID(),
+		AccountType: claims.Type,
+		OrderID:     req.ID,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, result)
+}
+
+type ListOrdersRequest struct {
+	sharedmodel.PaginationParams
+	Status []db.SharedStatus `query:"status" comma_separated:"true" validate:"omitempty,dive,oneof=Pending Processing Success Canceled Failed"`
+}
+
+// ListOrders lists the orders of the current customer
+func (h *Handler) ListOrders(c echo.Context) error {
+	var req ListOrdersRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := authbiz.GetClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	result, err := h.biz.ListOrders(c.Request().Context(), orderbiz.ListOrdersParams{
+		PaginationParams: req.PaginationParams,
+		CustomerID:       []int64{claims.AccountID()},
+		Status:           req.Status,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromPaginate(c.Response().Writer, result)
+}
+
+type CancelOrderRequest struct {
+	ID     int64  `param:"id" validate:"required,gt=0"`
+	Reason string `json:"reason" validate:"omitempty,max=500"`
+}
+
+func (h *Handler) CancelOrder(c echo.Context) error {
+	var req CancelOrderRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := authbiz.GetClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	if err = h.biz.CancelOrder(c.Request().Context(), orderbiz.CancelOrderParams{
+		Actor:   ordermodel.NewActor(claims.AccountID(), claims.Type),
+		OrderID: req.ID,
+		Reason:  req.Reason,
+	}); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromMessage(c.Response().Writer, http.StatusOK, "Order canceled successfully")
+}
+
+type UpdateOrderAddressRequest struct {
+	ID      int64  `param:"id" validate:"required,gt=0"`
+	Address string `json:"address" validate:"required,min=1,max=500"`
+}
+
+func (h *Handler) UpdateOrderAddress(c echo.Context) error {
+	var req UpdateOrderAddressRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := authbiz.GetClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	if err = h.biz.UpdateOrder(c.Request().Context(), orderbiz.UpdateOrderParams{
+		AccountID: claims.AccountID(),
+		OrderID:   req.ID,
+		Address:   req.Address,
+	}); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromMessage(c.Response().Writer, http.StatusOK, "Order address updated successfully")
+}
+
+type ApproveRefundRequest struct {
+	ID int64 `param:"id" validate:"required,gt=0"`
+}
+
+// ApproveRefund approves a refund of the current vendor's product
+func (h *Handler) ApproveRefund(c echo.Context) error {
+	var req ApproveRefundRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := authbiz.GetClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	result, err := h.biz.ApproveRefund(c.Request().Context(), orderbiz.ApproveRefundParams{
+		VendorID: claims.AccountID(),
+		RefundID: req.ID,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, result)
+}
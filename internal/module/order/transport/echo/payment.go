@@ -0,0 +1,141 @@
+package orderecho
+
+import (
+	"crypto/subtle"
+	"errors"
+	"net/http"
+
+	authbiz "shopnexus-remastered/internal/module/auth/biz"
+	orderbiz "shopnexus-remastered/internal/module/order/biz"
+	ordermodel "shopnexus-remastered/internal/module/order/model"
+	"shopnexus-remastered/internal/module/shared/transport/echo/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+// VnpayIPNResponse is the body VNPay expects as the answer of an IPN call
+type VnpayIPNResponse struct {
+	RspCode string `json:"RspCode"`
+	Message string `json:"Message"`
+}
+
+// VnpayIPN receives the payment result VNPay sends server to server and settles the order
+func (h *Handler) VnpayIPN(c echo.Context) error {
+	result := VnpayIPNResponse{RspCode: "00", Message: "Confirm Success"}
+
+	_, err := h.biz.ConfirmPayment(c.Request().Context(), orderbiz.ConfirmPaymentParams{
+		Provider: "vnpay",
+		Data:     queryToMap(c),
+	})
+	switch {
+	case err == nil:
+	case errors.Is(err, ordermodel.ErrOrderNotFound):
+		result = VnpayIPNResponse{RspCode: "01", Message: "Order not found"}
+	case errors.Is(err, ordermodel.ErrPaymentAlreadyConfirmed):
+		result = VnpayIPNResponse{RspCode: "02", Message: "Order already confirmed"}
+	case errors.Is(err, ordermodel.ErrPaymentAmountMismatch):
+		result = VnpayIPNResponse{RspCode: "04", Message: "Invalid amount"}
+	case errors.Is(err, ordermodel.ErrInvalidPaymentCallback):
+		result = VnpayIPNResponse{RspCode: "97", Message: "Invalid signature"}
+	default:
+		result = VnpayIPNResponse{RspCode: "99", Message: "Unknown error"}
+	}
+
+	// VNPay reads the RspCode from the body, not the HTTP status
+	return c.JSON(http.StatusOK, result)
+}
+
+type VnpayReturnResponse struct {
+	OrderID       int64  `json:"order_id"`
+	Status        string `json:"status"`
+	Amount        int64  `json:"amount"`
+	TransactionNo string `json:"transaction_no"`
+}
+
+// VnpayReturn verifies the result VNPay sends along when redirecting the customer back to the shop
+func (h *Handler) VnpayReturn(c echo.Context) error {
+	result, err := h.biz.VerifyPaymentReturn(c.Request().Context(), orderbiz.VerifyPaymentReturnParams{
+		Provider: "vnpay",
+		Data:     queryToMap(c),
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, VnpayReturnResponse{
+		OrderID:       result.OrderID,
+		Status:        string(result.Status),
+		Amount:        result.Amount,
+		TransactionNo: result.TransactionNo,
+	})
+}
+
+// queryToMap returns the query parameters of the request as a map
+func queryToMap(c echo.Context) map[string]any {
+	data := make(map[string]any)
+	for key, values := range c.QueryParams() {
+		if len(values) > 0 {
+			data[key] = values[0]
+		}
+	}
+	return data
+}
+
+type ConfirmCodPaymentRequest struct {
+	ID int64 `param:"id" validate:"required,gt=0"`
+}
+
+// ConfirmCodPayment lets the vendor confirm the cash of an order has been collected
+func (h *Handler) ConfirmCodPayment(c echo.Context) error {
+	var req ConfirmCodPaymentRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	claims, err := authbiz.GetClaims(c.Request())
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, err)
+	}
+
+	result, err := h.biz.ConfirmCodPayment(c.Request().Context(), orderbiz.ConfirmCodPaymentParams{
+		Actor:   ordermodel.NewActor(claims.AccountID(), claims.Type),
+		OrderID: req.ID,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, result)
+}
+
+// courierTokenHeader carries the token of the courier service
+const courierTokenHeader = "X-Courier-Token"
+
+// CourierConfirmCodPayment lets the courier service confirm the cash of an order has been collected
+func (h *Handler) CourierConfirmCodPayment(c echo.Context) error {
+	var req ConfirmCodPaymentRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	token := c.Request().Header.Get(courierTokenHeader)
+	if h.courierToken == "" || subtle.ConstantTimeCompare([]byte(token), []byte(h.courierToken)) != 1 {
+		return response.FromError(c.Response().Writer, http.StatusUnauthorized, errors.New("invalid courier token"))
+	}
+
+	result, err := h.biz.ConfirmCodPayment(c.Request().Context(), orderbiz.ConfirmCodPaymentParams{
+		Actor:   ordermodel.SystemActor,
+		OrderID: req.ID,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, result)
+}
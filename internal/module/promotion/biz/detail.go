@@ -0,0 +1,531 @@
+package promotionbiz
+
+import (
+	"context"
+
+	"shopnexus-remastered/internal/db"
+	promotionmodel "shopnexus-remastered/internal/module/promotion/model"
+	"shopnexus-remastered/internal/utils/pgutil"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type DiscountParams struct {
+	OrderWide       bool
+	MinSpend        int64  // 0 means applied immediately
+	MaxDiscount     int64  // 0 means no limit
+	DiscountPercent *int32 // Either DiscountPercent or DiscountPrice
+	DiscountPrice   *int64
+}
+
+type BundleItemParams struct {
+	SkuID    int64
+	Quantity int64 // Units of the SKU in one set
+}
+
+type BundleParams struct {
+	Price int64 // Price of one set
+	Items []BundleItemParams
+}
+
+type BuyXGetYParams struct {
+	BuyQuantity int64
+	GetQuantity int64
+}
+
+type CashbackParams struct {
+	MinSpend        int64  // 0 means applied immediately
+	MaxCashback     int64  // 0 means no limit
+	CashbackPercent *int32 // Either CashbackPercent or CashbackPrice
+	CashbackPrice   *int64
+}
+
+type UpdateDiscountParams struct {
+	OrderWide       *bool
+	MinSpend        *int64
+	MaxDiscount     *int64
+	DiscountPercent *int32 // Setting one of DiscountPercent or DiscountPrice clears the other
+	DiscountPrice   *int64
+}
+
+type UpdateBundleParams struct {
+	Price *int64
+	Items []BundleItemParams // Replaces the items of the bundle, nil leaves them unchanged
+}
+
+type UpdateBuyXGetYParams struct {
+	BuyQuantity *int64
+	GetQuantity *int64
+}
+
+type UpdateCashbackParams struct {
+	MinSpend        *int64
+	MaxCashback     *int64
+	CashbackPercent *int32 // Setting one of CashbackPercent or CashbackPrice clears the other
+	CashbackPrice   *int64
+}
+
+// validateDetails checks the details of the promotion type are set and valid
+func validateDetails(params CreatePromotionParams) error {
+	switch params.Type {
+	case db.PromotionTypeDiscount:
+		if params.Discount == nil {
+			return promotionmodel.ErrPromotionDetailsRequired
+		}
+		return validateDiscountValue(
+			pgutil.PtrToPgtype(params.Discount.DiscountPercent, pgutil.Int32ToPgInt4),
+			pgutil.PtrToPgtype(params.Discount.DiscountPrice, pgutil.Int64ToPgInt8),
+		)
+	case db.PromotionTypeBundle:
+		if params.Bundle == nil {
+			return promotionmodel.ErrPromotionDetailsRequired
+		}
+		return validateBundle(params.Bundle.Price, params.Bundle.Items)
+	case db.PromotionTypeBuyXGetY:
+		if params.BuyXGetY == nil {
+			return promotionmodel.ErrPromotionDetailsRequired
+		}
+		return validateBuyXGetY(params.BuyXGetY.BuyQuantity, params.BuyXGetY.GetQuantity)
+	case db.PromotionTypeCashback:
+		if params.Cashback == nil {
+			return promotionmodel.ErrPromotionDetailsRequired
+		}
+		return validateCashbackValue(
+			pgutil.PtrToPgtype(params.Cashback.CashbackPercent, pgutil.Int32ToPgInt4),
+			pgutil.PtrToPgtype(params.Cashback.CashbackPrice, pgutil.Int64ToPgInt8),
+		)
+	default:
+		return promotionmodel.ErrPromotionTypeMismatch
+	}
+}
+
+// createDetails creates the details of a new promotion, the params must have passed validateDetails
+func (b *PromotionBiz) createDetails(ctx context.Context, storage db.Querier, promo db.PromotionBase, params CreatePromotionParams) error {
+	switch promo.Type {
+	case db.PromotionTypeDiscount:
+		_, err := storage.CreatePromotionDiscount(ctx, []db.CreatePromotionDiscountParams{{
+			ID:              promo.ID,
+			OrderWide:       params.Discount.OrderWide,
+			MinSpend:        params.Discount.MinSpend,
+			MaxDiscount:     params.Discount.MaxDiscount,
+			DiscountPercent: pgutil.PtrToPgtype(params.Discount.DiscountPercent, pgutil.Int32ToPgInt4),
+			DiscountPrice:   pgutil.PtrToPgtype(params.Discount.DiscountPrice, pgutil.Int64ToPgInt8),
+		}})
+		return err
+	case db.PromotionTypeBundle:
+		if _, err := storage.CreatePromotionBundle(ctx, []db.CreatePromotionBundleParams{{
+			ID:    promo.ID,
+			Price: params.Bundle.Price,
+		}}); err != nil {
+			return err
+		}
+		return b.createBundleItems(ctx, storage, promo, params.Bundle.Items)
+	case db.PromotionTypeBuyXGetY:
+		_, err := storage.CreatePromotionBuyXGetY(ctx, []db.CreatePromotionBuyXGetYParams{{
+			ID:          promo.ID,
+			BuyQuantity: params.BuyXGetY.BuyQuantity,
+			GetQuantity: params.BuyXGetY.GetQuantity,
+		}})
+		return err
+	case db.PromotionTypeCashback:
+		_, err := storage.CreatePromotionCashback(ctx, []db.CreatePromotionCashbackParams{{
+			ID:              promo.ID,
+			MinSpend:        params.Cashback.MinSpend,
+			MaxCashback:     params.Cashback.MaxCashback,
+			CashbackPercent: pgutil.PtrToPgtype(params.Cashback.CashbackPercent, pgutil.Int32ToPgInt4),
+			CashbackPrice:   pgutil.PtrToPgtype(params.Cashback.CashbackPrice, pgutil.Int64ToPgInt8),
+		}})
+		return err
+	default:
+		return promotionmodel.ErrPromotionTypeMismatch
+	}
+}
+
+// updateDetails changes the details of a promotion, details of another type than the promotion are rejected
+func (b *PromotionBiz) updateDetails(ctx context.Context, storage db.Querier, promo db.PromotionBase, params UpdatePromotionParams) error {
+	switch {
+	case params.Discount != nil:
+		if promo.Type != db.PromotionTypeDiscount {
+			return promotionmodel.ErrPromotionTypeMismatch
+		}
+		return b.updateDiscount(ctx, storage, promo, *params.Discount)
+	case params.Bundle != nil:
+		if promo.Type != db.PromotionTypeBundle {
+			return promotionmodel.ErrPromotionTypeMismatch
+		}
+		return b.updateBundle(ctx, storage, promo, *params.Bundle)
+	case params.BuyXGetY != nil:
+		if promo.Type != db.PromotionTypeBuyXGetY {
+			return promotionmodel.ErrPromotionTypeMismatch
+		}
+		return b.updateBuyXGetY(ctx, storage, promo, *params.BuyXGetY)
+	case params.Cashback != nil:
+		if promo.Type != db.PromotionTypeCashback {
+			return promotionmodel.ErrPromotionTypeMismatch
+		}
+		return b.updateCashback(ctx, storage, promo, *params.Cashback)
+	}
+	return nil
+}
+
+// updateDiscount changes the discount details of a promotion, keeping exactly one of the percent or the price
+func (b *PromotionBiz) updateDiscount(ctx context.Context, storage db.Querier, promo db.PromotionBase, params UpdateDiscountParams) error {
+	discount, err := storage.GetPromotionDiscount(ctx, pgutil.Int64ToPgInt8(promo.ID))
+	if err != nil {
+		return err
+	}
+
+	discountPercent, discountPrice := discount.DiscountPercent, discount.DiscountPrice
+	if params.DiscountPercent != nil || params.DiscountPrice != nil {
+		discountPercent = pgutil.PtrToPgtype(params.DiscountPercent, pgutil.Int32ToPgInt4)
+		discountPrice = pgutil.PtrToPgtype(params.DiscountPrice, pgutil.Int64ToPgInt8)
+	}
+	if err = validateDiscountValue(discountPercent, discountPrice); err != nil {
+		return err
+	}
+
+	_, err = storage.UpdatePromotionDiscount(ctx, db.UpdatePromotionDiscountParams{
+		ID:                  pgutil.Int64ToPgInt8(promo.ID),
+		OrderWide:           pgutil.PtrToPgtype(params.OrderWide, pgutil.BoolToPgBool),
+		MinSpend:            pgutil.PtrToPgtype(params.MinSpend, pgutil.Int64ToPgInt8),
+		MaxDiscount:         pgutil.PtrToPgtype(params.MaxDiscount, pgutil.Int64ToPgInt8),
+		NullDiscountPercent: !discountPercent.Valid,
+		DiscountPercent:     discountPercent,
+		NullDiscountPrice:   !discountPrice.Valid,
+		DiscountPrice:       discountPrice,
+	})
+	return err
+}
+
+// updateBundle changes the price of a bundle and replaces its items if given
+func (b *PromotionBiz) updateBundle(ctx context.Context, storage db.Querier, promo db.PromotionBase, params UpdateBundleParams) error {
+	bundle, err := storage.GetPromotionBundle(ctx, pgutil.Int64ToPgInt8(promo.ID))
+	if err != nil {
+		return err
+	}
+
+	price := bundle.Price
+	if params.Price != nil {
+		price = *params.Price
+	}
+	if price <= 0 {
+		return promotionmodel.ErrInvalidBundle
+	}
+	if params.Items != nil {
+		if err = validateBundle(price, params.Items); err != nil {
+			return err
+		}
+	}
+
+	if _, err = storage.UpdatePromotionBundle(ctx, db.UpdatePromotionBundleParams{
+		ID:    pgutil.Int64ToPgInt8(promo.ID),
+		Price: pgutil.PtrToPgtype(params.Price, pgutil.Int64ToPgInt8),
+	}); err != nil {
+		return err
+	}
+
+	if params.Items == nil {
+		return nil
+	}
+	items, err := storage.ListPromotionBundleItem(ctx, db.ListPromotionBundleItemParams{
+		BundleID: []int64{promo.ID},
+	})
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		if err = storage.DeletePromotionBundleItem(ctx, db.DeletePromotionBundleItemParams{
+			ID: pgutil.Int64ToPgInt8(item.ID),
+		}); err != nil {
+			return err
+		}
+	}
+	return b.createBundleItems(ctx, storage, promo, params.Items)
+}
+
+// createBundleItems adds the SKUs to a bundle, vendor bundles can only hold SKUs of the vendor
+func (b *PromotionBiz) createBundleItems(ctx context.Context, storage db.Querier, promo db.PromotionBase, items []BundleItemParams) error {
+	skuIDs := make([]int64, 0, len(items))
+	for _, item := range items {
+		skuIDs = append(skuIDs, item.SkuID)
+	}
+
+	skus, err := storage.ListCatalogProductSku(ctx, db.ListCatalogProductSkuParams{
+		ID: skuIDs,
+	})
+	if err != nil {
+		return err
+	}
+	if len(skus) != len(skuIDs) {
+		return promotionmodel.ErrPromotionRefNotFound
+	}
+
+	if promo.OwnerID.Valid {
+		spuIDs := make([]int64, 0, len(skus))
+		for _, sku := range skus {
+			spuIDs = append(spuIDs, sku.SpuID)
+		}
+		spus, err := storage.ListCatalogProductSpu(ctx, db.ListCatalogProductSpuParams{
+			ID: spuIDs,
+		})
+		if err != nil {
+			return err
+		}
+		for _, spu := range spus {
+			if spu.AccountID != promo.OwnerID.Int64 {
+				return promotionmodel.ErrPromotionRefNotOwned
+			}
+		}
+	}
+
+	args := make([]db.CreatePromotionBundleItemParams, 0, len(items))
+	for _, item := range items {
+		args = append(args, db.CreatePromotionBundleItemParams{
+			BundleID: promo.ID,
+			SkuID:    item.SkuID,
+			Quantity: item.Quantity,
+		})
+	}
+	_, err = storage.CreatePromotionBundleItem(ctx, args)
+	return err
+}
+
+// updateBuyXGetY changes the quantities of a buy X get Y promotion
+func (b *PromotionBiz) updateBuyXGetY(ctx context.Context, storage db.Querier, promo db.PromotionBase, params UpdateBuyXGetYParams) error {
+	buyXGetY, err := storage.GetPromotionBuyXGetY(ctx, pgutil.Int64ToPgInt8(promo.ID))
+	if err != nil {
+		return err
+	}
+
+	buyQuantity, getQuantity := buyXGetY.BuyQuantity, buyXGetY.GetQuantity
+	if params.BuyQuantity != nil {
+		buyQuantity = *params.BuyQuantity
+	}
+	if params.GetQuantity != nil {
+		getQuantity = *params.GetQuantity
+	}
+	if err = validateBuyXGetY(buyQuantity, getQuantity); err != nil {
+		return err
+	}
+
+	_, err = storage.UpdatePromotionBuyXGetY(ctx, db.UpdatePromotionBuyXGetYParams{
+		ID:          pgutil.Int64ToPgInt8(promo.ID),
+		BuyQuantity: pgutil.PtrToPgtype(params.BuyQuantity, pgutil.Int64ToPgInt8),
+		GetQuantity: pgutil.PtrToPgtype(params.GetQuantity, pgutil.Int64ToPgInt8),
+	})
+	return err
+}
+
+// updateCashback changes the cashback details of a promotion, keeping exactly one of the percent or the price
+func (b *PromotionBiz) updateCashback(ctx context.Context, storage db.Querier, promo db.PromotionBase, params UpdateCashbackParams) error {
+	cashback, err := storage.GetPromotionCashback(ctx, pgutil.Int64ToPgInt8(promo.ID))
+	if err != nil {
+		return err
+	}
+
+	cashbackPercent, cashbackPrice := cashback.CashbackPercent, cashback.CashbackPrice
+	if params.CashbackPercent != nil || params.CashbackPrice != nil {
+		cashbackPercent = pgutil.PtrToPgtype(params.CashbackPercent, pgutil.Int32ToPgInt4)
+		cashbackPrice = pgutil.PtrToPgtype(params.CashbackPrice, pgutil.Int64ToPgInt8)
+	}
+	if err = validateCashbackValue(cashbackPercent, cashbackPrice); err != nil {
+		return err
+	}
+
+	_, err = storage.UpdatePromotionCashback(ctx, db.UpdatePromotionCashbackParams{
+		ID:                  pgutil.Int64ToPgInt8(promo.ID),
+		MinSpend:            pgutil.PtrToPgtype(params.MinSpend, pgutil.Int64ToPgInt8),
+		MaxCashback:         pgutil.PtrToPgtype(params.MaxCashback, pgutil.Int64ToPgInt8),
+		NullCashbackPercent: !cashbackPercent.Valid,
+		CashbackPercent:     cashbackPercent,
+		NullCashbackPrice:   !cashbackPrice.Valid,
+		CashbackPrice:       cashbackPrice,
+	})
+	return err
+}
+
+// withDetails adds the details of their type to the promotions
+func (b *PromotionBiz) withDetails(ctx context.Context, storage db.Querier, promos []db.PromotionBase) ([]promotionmodel.Promotion, error) {
+	details, err := b.listDetails(ctx, storage, promos)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]promotionmodel.Promotion, 0, len(promos))
+	for _, promo := range promos {
+		promotion := promotionmodel.NewPromotion(promo)
+		if discount, ok := details.discounts[promo.ID]; ok {
+			promotion.Discount = &discount
+		}
+		if bundle, ok := details.bundles[promo.ID]; ok {
+			promotion.Bundle = &bundle
+			promotion.BundleItems = details.bundleItems[promo.ID]
+		}
+		if buyXGetY, ok := details.buyXGetYs[promo.ID]; ok {
+			promotion.BuyXGetY = &buyXGetY
+		}
+		if cashback, ok := details.cashbacks[promo.ID]; ok {
+			promotion.Cashback = &cashback
+		}
+		if voucher, ok := details.vouchers[promo.ID]; ok {
+			promotion.Voucher = &voucher
+		}
+		result = append(result, promotion)
+	}
+
+	return result, nil
+}
+
+// promotionDetails are the details of promotions by promotion id
+type promotionDetails struct {
+	discounts   map[int64]db.PromotionDiscount
+	bundles     map[int64]db.PromotionBundle
+	bundleItems map[int64][]db.PromotionBundleItem
+	buyXGetYs   map[int64]db.PromotionBuyXGetY
+	cashbacks   map[int64]db.PromotionCashback
+	vouchers    map[int64]db.PromotionVoucher
+}
+
+// listDetails loads the details of the promotions, one query per promotion type present
+func (b *PromotionBiz) listDetails(ctx context.Context, storage db.Querier, promos []db.PromotionBase) (promotionDetails, error) {
+	details := promotionDetails{
+		discounts:   make(map[int64]db.PromotionDiscount),
+		bundles:     make(map[int64]db.PromotionBundle),
+		bundleItems: make(map[int64][]db.PromotionBundleItem),
+		buyXGetYs:   make(map[int64]db.PromotionBuyXGetY),
+		cashbacks:   make(map[int64]db.PromotionCashback),
+		vouchers:    make(map[int64]db.PromotionVoucher),
+	}
+
+	ids := make(map[db.PromotionType][]int64) // map[type][]promoID
+	allIDs := make([]int64, 0, len(promos))
+	for _, promo := range promos {
+		ids[promo.Type] = append(ids[promo.Type], promo.ID)
+		allIDs = append(allIDs, promo.ID)
+	}
+
+	if len(ids[db.PromotionTypeDiscount]) > 0 {
+		discounts, err := storage.ListPromotionDiscount(ctx, db.ListPromotionDiscountParams{
+			ID: ids[db.PromotionTypeDiscount],
+		})
+		if err != nil {
+			return details, err
+		}
+		for _, discount := range discounts {
+			details.discounts[discount.ID] = discount
+		}
+	}
+
+	if len(ids[db.PromotionTypeBundle]) > 0 {
+		bundles, err := storage.ListPromotionBundle(ctx, db.ListPromotionBundleParams{
+			ID: ids[db.PromotionTypeBundle],
+		})
+		if err != nil {
+			return details, err
+		}
+		for _, bundle := range bundles {
+			details.bundles[bundle.ID] = bundle
+		}
+
+		items, err := storage.ListPromotionBundleItem(ctx, db.ListPromotionBundleItemParams{
+			BundleID: ids[db.PromotionTypeBundle],
+		})
+		if err != nil {
+			return details, err
+		}
+		for _, item := range items {
+			details.bundleItems[item.BundleID] = append(details.bundleItems[item.BundleID], item)
+		}
+	}
+
+	if len(ids[db.PromotionTypeBuyXGetY]) > 0 {
+		buyXGetYs, err := storage.ListPromotionBuyXGetY(ctx, db.ListPromotionBuyXGetYParams{
+			ID: ids[db.PromotionTypeBuyXGetY],
+		})
+		if err != nil {
+			return details, err
+		}
+		for _, buyXGetY := range buyXGetYs {
+			details.buyXGetYs[buyXGetY.ID] = buyXGetY
+		}
+	}
+
+	if len(ids[db.PromotionTypeCashback]) > 0 {
+		cashbacks, err := storage.ListPromotionCashback(ctx, db.ListPromotionCashbackParams{
+			ID: ids[db.PromotionTypeCashback],
+		})
+		if err != nil {
+			return details, err
+		}
+		for _, cashback := range cashbacks {
+			details.cashbacks[cashback.ID] = cashback
+		}
+	}
+
+	// Any promotion type can be a voucher
+	if len(allIDs) > 0 {
+		vouchers, err := storage.ListPromotionVoucher(ctx, db.ListPromotionVoucherParams{
+			ID: allIDs,
+		})
+		if err != nil {
+			return details, err
+		}
+		for _, voucher := range vouchers {
+			details.vouchers[voucher.ID] = voucher
+		}
+	}
+
+	return details, nil
+}
+
+// validateDiscountValue checks exactly one of the discount percent or price is set, and that it is in range
+func validateDiscountValue(discountPercent pgtype.Int4, discountPrice pgtype.Int8) error {
+	if !validRate(discountPercent, discountPrice) {
+		return promotionmodel.ErrInvalidDiscountValue
+	}
+	return nil
+}
+
+// validateCashbackValue checks exactly one of the cashback percent or price is set, and that it is in range
+func validateCashbackValue(cashbackPercent pgtype.Int4, cashbackPrice pgtype.Int8) error {
+	if !validRate(cashbackPercent, cashbackPrice) {
+		return promotionmodel.ErrInvalidCashbackValue
+	}
+	return nil
+}
+
+// validRate reports whether exactly one of a percent (1-100) or a positive price is set
+func validRate(percent pgtype.Int4, price pgtype.Int8) bool {
+	switch {
+	case percent.Valid == price.Valid:
+		return false
+	case percent.Valid:
+		return percent.Int32 > 0 && percent.Int32 <= 100
+	default:
+		return price.Int64 > 0
+	}
+}
+
+func validateBundle(price int64, items []BundleItemParams) error {
+	if price <= 0 || len(items) == 0 {
+		return promotionmodel.ErrInvalidBundle
+	}
+	skuIDs := make(map[int64]struct{}, len(items))
+	for _, item := range items {
+		if item.Quantity <= 0 {
+			return promotionmodel.ErrInvalidBundle
+		}
+		if _, ok := skuIDs[item.SkuID]; ok {
+			return promotionmodel.ErrInvalidBundle
+		}
+		skuIDs[item.SkuID] = struct{}{}
+	}
+	return nil
+}
+
+func validateBuyXGetY(buyQuantity, getQuantity int64) error {
+	if buyQuantity <= 0 || getQuantity <= 0 {
+		return promotionmodel.ErrInvalidBuyXGetY
+	}
+	return nil
+}
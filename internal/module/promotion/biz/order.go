@@ -0,0 +1,101 @@
+package promotionbiz
+
+import (
+	"context"
+
+	"shopnexus-remastered/internal/db"
+	promotionmodel "shopnexus-remastered/internal/module/promotion/model"
+)
+
+// ListOrderPromotions returns the active promotions priced on the whole order: order-wide discounts, bundles, buy X get Y and cashbacks
+func (b *PromotionBiz) ListOrderPromotions(ctx context.Context, storage db.Querier, params ListActivePromotionParams) (promotionmodel.OrderPromotions, error) {
+	var result promotionmodel.OrderPromotions
+
+	promos, err := b.ListActivePromotion(ctx, storage, params)
+	if err != nil {
+		return result, err
+	}
+
+	details, err := b.listDetails(ctx, storage, promos)
+	if err != nil {
+		return result, err
+	}
+
+	for _, promo := range promos {
+		switch promo.Type {
+		case db.PromotionTypeDiscount:
+			if discount, ok := details.discounts[promo.ID]; ok && discount.OrderWide {
+				result.Discounts = append(result.Discounts, promotionmodel.OrderDiscount{
+					Promotion: promo,
+					Discount:  discount,
+				})
+			}
+		case db.PromotionTypeBundle:
+			if bundle, ok := details.bundles[promo.ID]; ok {
+				result.Bundles = append(result.Bundles, promotionmodel.OrderBundle{
+					Promotion: promo,
+					Bundle:    bundle,
+					Items:     details.bundleItems[promo.ID],
+				})
+			}
+		case db.PromotionTypeBuyXGetY:
+			if buyXGetY, ok := details.buyXGetYs[promo.ID]; ok {
+				result.BuyXGetYs = append(result.BuyXGetYs, promotionmodel.OrderBuyXGetY{
+					Promotion: promo,
+					BuyXGetY:  buyXGetY,
+				})
+			}
+		case db.PromotionTypeCashback:
+			if cashback, ok := details.cashbacks[promo.ID]; ok {
+				result.Cashbacks = append(result.Cashbacks, promotionmodel.OrderCashback{
+					Promotion: promo,
+					Cashback:  cashback,
+				})
+			}
+		}
+	}
+
+	return result, nil
+}
+
+type CreateCashbackCreditsParams struct {
+	AccountID int64 // Customer who placed the order
+	OrderID   int64
+	Applied   []promotionmodel.AppliedPromotion // Promotions applied to the order, only cashbacks are recorded
+}
+
+// CreateCashbackCredits records the cashback earned on a new order, pending until the order succeeds
+func (b *PromotionBiz) CreateCashbackCredits(ctx context.Context, storage db.Querier, params CreateCashbackCreditsParams) error {
+	var args []db.CreateDefaultPromotionCashbackCreditParams
+	for _, applied := range params.Applied {
+		if applied.Type != db.PromotionTypeCashback || applied.Amount <= 0 {
+			continue
+		}
+		args = append(args, db.CreateDefaultPromotionCashbackCreditParams{
+			AccountID:   params.AccountID,
+			OrderID:     params.OrderID,
+			PromotionID: applied.PromotionID,
+			Amount:      applied.Amount,
+		})
+	}
+	if len(args) == 0 {
+		return nil
+	}
+
+	_, err := storage.CreateDefaultPromotionCashbackCredit(ctx, args)
+	return err
+}
+
+type SettleCashbackCreditsParams struct {
+	OrderID int64
+	Status  db.SharedStatus // Success credits the customer, Canceled drops the cashback
+}
+
+// SettleCashbackCredits credits or drops the pending cashback of an order
+func (b *PromotionBiz) SettleCashbackCredits(ctx context.Context, storage db.Querier, params SettleCashbackCreditsParams) error {
+	_, err := storage.SettleCashbackCredit(ctx, db.SettleCashbackCreditParams{
+		Status:  params.Status,
+		OrderID: params.OrderID,
+	})
+	return err
+}
@@ -0,0 +1,193 @@
+package promotionbiz
+
+import (
+	"context"
+	"slices"
+
+	"shopnexus-remastered/internal/db"
+	promotionmodel "shopnexus-remastered/internal/module/promotion/model"
+	sharedmodel "shopnexus-remastered/internal/module/shared/model"
+	"shopnexus-remastered/internal/utils/pgutil"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type PreviewPromotionParams struct {
+	sharedmodel.PaginationParams
+	OwnerID   *int64
+	RefType   db.PromotionRefType
+	RefID     *int64 // nil when RefType is All
+	Exclusive bool
+	Discount  DiscountParams
+}
+
+// PreviewPromotion returns the SKUs a draft discount promotion would target, with their prices before and after
+// publishing it and the active promotions it conflicts with. Nothing is saved. Vendor drafts only reach the SKUs of the
+// vendor, like the published promotion would.
+func (b *PromotionBiz) PreviewPromotion(ctx context.Context, params PreviewPromotionParams) (sharedmodel.PaginateResult[promotionmodel.PromotionPreviewItem], error) {
+	var zero sharedmodel.PaginateResult[promotionmodel.PromotionPreviewItem]
+
+	if params.Discount.OrderWide {
+		return zero, promotionmodel.ErrPreviewOrderWide
+	}
+	discount := db.PromotionDiscount{
+		MinSpend:        params.Discount.MinSpend,
+		MaxDiscount:     params.Discount.MaxDiscount,
+		DiscountPercent: pgutil.PtrToPgtype(params.Discount.DiscountPercent, pgutil.Int32ToPgInt4),
+		DiscountPrice:   pgutil.PtrToPgtype(params.Discount.DiscountPrice, pgutil.Int64ToPgInt8),
+	}
+	if err := validateDiscountValue(discount.DiscountPercent, discount.DiscountPrice); err != nil {
+		return zero, err
+	}
+
+	refID := pgutil.PtrToPgtype(params.RefID, pgutil.Int64ToPgInt8)
+	if err := b.checkPromotionRef(ctx, b.storage, params.OwnerID, params.RefType, refID); err != nil {
+		return zero, err
+	}
+
+	spus, err := b.listTargetedSpus(ctx, b.storage, params.OwnerID, params.RefType, refID)
+	if err != nil {
+		return zero, err
+	}
+	if len(spus) == 0 {
+		return sharedmodel.PaginateResult[promotionmodel.PromotionPreviewItem]{
+			Data:  []promotionmodel.PromotionPreviewItem{},
+			Limit: params.GetLimit(),
+			Page:  params.GetPage(),
+		}, nil
+	}
+
+	spuMap := make(map[int64]db.CatalogProductSpu, len(spus)) // map[spuID]SPU
+	spuIDs := make([]int64, 0, len(spus))
+	for _, spu := range spus {
+		spuMap[spu.ID] = spu
+		spuIDs = append(spuIDs, spu.ID)
+	}
+	var skuIDs []int64
+	if params.RefType == db.PromotionRefTypeProductSku {
+		skuIDs = []int64{refID.Int64}
+	}
+
+	total, err := b.storage.CountCatalogProductSku(ctx, db.CountCatalogProductSkuParams{
+		ID:    skuIDs,
+		SpuID: spuIDs,
+	})
+	if err != nil {
+		return zero, err
+	}
+	skus, err := b.storage.ListCatalogProductSku(ctx, db.ListCatalogProductSkuParams{
+		Limit:  pgutil.Int32ToPgInt4(params.GetLimit()),
+		Offset: pgutil.Int32ToPgInt4(params.GetOffset()),
+		ID:     skuIDs,
+		SpuID:  spuIDs,
+	})
+	if err != nil {
+		return zero, err
+	}
+
+	targets := make([]ProductRefParams, 0, len(skus))
+	for _, sku := range skus {
+		targets = append(targets, ProductRefParams{
+			Spu:   spuMap[sku.SpuID],
+			SkuID: sku.ID,
+		})
+	}
+	refs, err := b.ListProductRefs(ctx, b.storage, targets)
+	if err != nil {
+		return zero, err
+	}
+
+	// Vouchers only apply to orders entering their code, they are left out like on the catalog
+	active, err := b.listItemDiscounts(ctx, b.storage, nil)
+	if err != nil {
+		return zero, err
+	}
+	draft := promotionmodel.ItemDiscount{
+		Promotion: db.PromotionBase{
+			OwnerID:   pgutil.PtrToPgtype(params.OwnerID, pgutil.Int64ToPgInt8),
+			RefType:   params.RefType,
+			RefID:     refID,
+			Type:      db.PromotionTypeDiscount,
+			IsActive:  true,
+			Exclusive: params.Exclusive,
+		},
+		Discount: discount,
+	}
+
+	items := make([]promotionmodel.PromotionPreviewItem, 0, len(skus))
+	for i, sku := range skus {
+		items = append(items, promotionmodel.PromotionPreviewItem{
+			Sku:          sku,
+			SpuName:      spuMap[sku.SpuID].Name,
+			PreviewPrice: promotionmodel.PreviewItemPrice(sku.Price, active.forItem(refs[i]), draft, b.policy),
+		})
+	}
+
+	return sharedmodel.PaginateResult[promotionmodel.PromotionPreviewItem]{
+		Data:       items,
+		Limit:      params.GetLimit(),
+		Page:       params.GetPage(),
+		Total:      total,
+		NextPage:   params.NextPage(total),
+		NextCursor: params.NextCursor(total),
+	}, nil
+}
+
+// listTargetedSpus returns the SPUs a promotion target reaches, only the owner's for vendor promotions
+func (b *PromotionBiz) listTargetedSpus(ctx context.Context, storage db.Querier, ownerID *int64, refType db.PromotionRefType, refID pgtype.Int8) ([]db.CatalogProductSpu, error) {
+	var params db.ListCatalogProductSpuParams
+	if ownerID != nil {
+		params.AccountID = []int64{*ownerID}
+	}
+
+	switch refType {
+	case db.PromotionRefTypeProductSpu:
+		params.ID = []int64{refID.Int64}
+	case db.PromotionRefTypeProductSku:
+		sku, err := storage.GetCatalogProductSku(ctx, db.GetCatalogProductSkuParams{ID: refID})
+		if err != nil {
+			return nil, refLookupError(err)
+		}
+		params.ID = []int64{sku.SpuID}
+	case db.PromotionRefTypeBrand:
+		params.BrandID = []int64{refID.Int64}
+	case db.PromotionRefTypeCategory:
+		// The category and all the categories under it
+		ancestors, err := b.categories.get(ctx, storage)
+		if err != nil {
+			return nil, err
+		}
+		params.CategoryID = []int64{refID.Int64}
+		for categoryID, chain := range ancestors {
+			if categoryID != refID.Int64 && slices.Contains(chain, refID.Int64) {
+				params.CategoryID = append(params.CategoryID, categoryID)
+			}
+		}
+	case db.PromotionRefTypeTag:
+		spuTags, err := storage.ListCatalogProductSpuTag(ctx, db.ListCatalogProductSpuTagParams{
+			TagID: []int64{refID.Int64},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(spuTags) == 0 {
+			return []db.CatalogProductSpu{}, nil
+		}
+		for _, spuTag := range spuTags {
+			params.ID = append(params.ID, spuTag.SpuID)
+		}
+	}
+
+	spus, err := storage.ListCatalogProductSpu(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]db.CatalogProductSpu, 0, len(spus))
+	for _, spu := range spus {
+		if !spu.DateDeleted.Valid {
+			result = append(result, spu)
+		}
+	}
+	return result, nil
+}
@@ -1,8 +1,447 @@
 package promotionbiz
 
+import (
+	"context"
+	"errors"
+	"time"
+
+	"shopnexus-remastered/config"
+	"shopnexus-remastered/internal/db"
+	inventorybiz "shopnexus-remastered/internal/module/inventory/biz"
+	promotionmodel "shopnexus-remastered/internal/module/promotion/model"
+	sharedmodel "shopnexus-remastered/internal/module/shared/model"
+	"shopnexus-remastered/internal/utils/pgutil"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Every operation takes the owner of the promotions: the vendor account id, or nil for system promotions.
+
 type PromotionBiz struct {
+	storage      *pgutil.Storage
+	inventoryBiz *inventorybiz.InventoryBiz
+	policy       promotionmodel.StackingPolicy
+	categories   *categoryTree
+}
+
+// NewPromotionBiz creates a new instance of PromotionBiz.
+func NewPromotionBiz(storage *pgutil.Storage, inventoryBiz *inventorybiz.InventoryBiz, cfg *config.Config) *PromotionBiz {
+	return &PromotionBiz{
+		storage:      storage,
+		inventoryBiz: inventoryBiz,
+		policy:       newStackingPolicy(cfg.Promotion),
+		categories:   &categoryTree{},
+	}
+}
+
+type CreatePromotionParams struct {
+	OwnerID     *int64
+	Code        string
+	RefType     db.PromotionRefType
+	RefID       *int64 // nil when RefType is All
+	Type        db.PromotionType
+	Title       string
+	Description *string
+	Exclusive   bool            // Never combined with another promotion on the same price
+	DateStarted *time.Time      // nil starts now
+	DateEnded   *time.Time      // nil never ends
+	Schedule    *ScheduleParams // nil applies during the whole period, otherwise only inside the windows of the schedule
+	Voucher     *VoucherParams  // nil applies the promotion automatically, otherwise only to orders entering its code
+
+	// Details of the promotion type, only the one matching Type is used
+	Discount *DiscountParams
+	Bundle   *BundleParams
+	BuyXGetY *BuyXGetYParams
+	Cashback *CashbackParams
+}
+
+// CreatePromotion creates an active promotion with the details of its type
+func (b *PromotionBiz) CreatePromotion(ctx context.Context, params CreatePromotionParams) (promotionmodel.Promotion, error) {
+	var zero promotionmodel.Promotion
+
+	if err := validateDetails(params); err != nil {
+		return zero, err
+	}
+
+	now := time.Now()
+	dateStarted := pgtype.Timestamptz{Time: now, Valid: true}
+	if params.DateStarted != nil {
+		dateStarted.Time = *params.DateStarted
+	}
+	dateEnded := pgutil.PtrToPgtype(params.DateEnded, timeToPgTimestamptz)
+	if err := validatePeriod(dateStarted, dateEnded); err != nil {
+		return zero, err
+	}
+	scheduleStart, scheduleTz, scheduleDuration, err := scheduleToPgtype(params.Schedule)
+	if err != nil {
+		return zero, err
+	}
+
+	txStorage, err := b.storage.BeginTx(ctx)
+	if err != nil {
+		return zero, err
+	}
+	defer txStorage.Rollback(ctx)
+
+	refID := pgutil.PtrToPgtype(params.RefID, pgutil.Int64ToPgInt8)
+	if err = b.checkPromotionRef(ctx, txStorage, params.OwnerID, params.RefType, refID); err != nil {
+		return zero, err
+	}
+
+	if _, err = txStorage.GetPromotionBase(ctx, db.GetPromotionBaseParams{
+		Code: pgutil.StringToPgText(params.Code),
+	}); err == nil {
+		return zero, promotionmodel.ErrPromotionCodeExists
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return zero, err
+	}
+
+	if _, err = txStorage.CreatePromotionBase(ctx, []db.CreatePromotionBaseParams{{
+		Code:             params.Code,
+		OwnerID:          pgutil.PtrToPgtype(params.OwnerID, pgutil.Int64ToPgInt8),
+		RefType:          params.RefType,
+		RefID:            refID,
+		Type:             params.Type,
+		Title:            params.Title,
+		Description:      pgutil.PtrToPgtype(params.Description, pgutil.StringToPgText),
+		IsActive:         true,
+		Exclusive:        params.Exclusive,
+		DateStarted:      dateStarted,
+		DateEnded:        dateEnded,
+		ScheduleTz:       scheduleTz,
+		ScheduleStart:    scheduleStart,
+		ScheduleDuration: scheduleDuration,
+		DateCreated:      pgtype.Timestamptz{Time: now, Valid: true},
+		DateUpdated:      pgtype.Timestamptz{Time: now, Valid: true},
+	}}); err != nil {
+		return zero, err
+	}
+
+	promo, err := txStorage.GetPromotionBase(ctx, db.GetPromotionBaseParams{
+		Code: pgutil.StringToPgText(params.Code),
+	})
+	if err != nil {
+		return zero, err
+	}
+
+	if err = b.createDetails(ctx, txStorage, promo, params); err != nil {
+		return zero, err
+	}
+
+	if params.Voucher != nil {
+		if err = b.createVoucher(ctx, txStorage, promo, *params.Voucher); err != nil {
+			return zero, err
+		}
+	}
+
+	promotions, err := b.withDetails(ctx, txStorage, []db.PromotionBase{promo})
+	if err != nil {
+		return zero, err
+	}
+
+	if err = txStorage.Commit(ctx); err != nil {
+		return zero, err
+	}
+
+	return promotions[0], nil
+}
+
+type GetPromotionParams struct {
+	OwnerID *int64
+	ID      int64
+}
+
+// GetPromotion returns a promotion of the owner
+func (b *PromotionBiz) GetPromotion(ctx context.Context, params GetPromotionParams) (promotionmodel.Promotion, error) {
+	var zero promotionmodel.Promotion
+
+	promo, err := b.getOwnedPromotion(ctx, b.storage, params.OwnerID, params.ID)
+	if err != nil {
+		return zero, err
+	}
+
+	promotions, err := b.withDetails(ctx, b.storage, []db.PromotionBase{promo})
+	if err != nil {
+		return zero, err
+	}
+
+	return promotions[0], nil
+}
+
+type ListPromotionParams struct {
+	sharedmodel.PaginationParams
+	OwnerID  *int64
+	IsActive []bool
+}
+
+// ListPromotion lists the promotions of the owner with their details
+func (b *PromotionBiz) ListPromotion(ctx context.Context, params ListPromotionParams) (sharedmodel.PaginateResult[promotionmodel.Promotion], error) {
+	var zero sharedmodel.PaginateResult[promotionmodel.Promotion]
+
+	ownerID := pgutil.PtrToPgtype(params.OwnerID, pgutil.Int64ToPgInt8)
+	total, err := b.storage.CountOwnerPromotion(ctx, db.CountOwnerPromotionParams{
+		OwnerID:  ownerID,
+		IsActive: params.IsActive,
+	})
+	if err != nil {
+		return zero, err
+	}
+
+	promos, err := b.storage.ListOwnerPromotion(ctx, db.ListOwnerPromotionParams{
+		OwnerID:  ownerID,
+		IsActive: params.IsActive,
+		Limit:    pgutil.Int32ToPgInt4(params.GetLimit()),
+		Offset:   pgutil.Int32ToPgInt4(params.GetOffset()),
+	})
+	if err != nil {
+		return zero, err
+	}
+
+	promotions, err := b.withDetails(ctx, b.storage, promos)
+	if err != nil {
+		return zero, err
+	}
+
+	return sharedmodel.PaginateResult[promotionmodel.Promotion]{
+		Data:       promotions,
+		Limit:      params.GetLimit(),
+		Page:       params.GetPage(),
+		Total:      total,
+		NextPage:   params.NextPage(total),
+		NextCursor: params.NextCursor(total),
+	}, nil
+}
+
+type UpdatePromotionParams struct {
+	OwnerID       *int64
+	ID            int64
+	RefType       *db.PromotionRefType
+	RefID         *int64 // Ignored when RefType is All
+	Title         *string
+	Description   *string
+	IsActive      *bool
+	Exclusive     *bool
+	DateStarted   *time.Time
+	DateEnded     *time.Time
+	Schedule      *ScheduleParams      // Replaces the schedule
+	ClearSchedule bool                 // Removes the schedule, the promotion then applies during its whole period
+	Voucher       *UpdateVoucherParams // Changes the voucher rules, making the promotion a voucher if it is not one
+	ClearVoucher  bool                 // Removes the voucher, the promotion then applies automatically
+
+	// Details of the promotion type, nil leaves them unchanged
+	Discount *UpdateDiscountParams
+	Bundle   *UpdateBundleParams
+	BuyXGetY *UpdateBuyXGetYParams
+	Cashback *UpdateCashbackParams
+}
+
+// UpdatePromotion changes the fields of a promotion of the owner, nil fields are left unchanged
+func (b *PromotionBiz) UpdatePromotion(ctx context.Context, params UpdatePromotionParams) (promotionmodel.Promotion, error) {
+	var zero promotionmodel.Promotion
+
+	txStorage, err := b.storage.BeginTx(ctx)
+	if err != nil {
+		return zero, err
+	}
+	defer txStorage.Rollback(ctx)
+
+	promo, err := b.getOwnedPromotion(ctx, txStorage, params.OwnerID, params.ID)
+	if err != nil {
+		return zero, err
+	}
+
+	// Validate the promotion as it will be after the update
+	refType, refID := promo.RefType, promo.RefID
+	if params.RefType != nil {
+		refType = *params.RefType
+	}
+	if params.RefID != nil {
+		refID = pgutil.Int64ToPgInt8(*params.RefID)
+	}
+	if refType == db.PromotionRefTypeAll {
+		refID = pgtype.Int8{}
+	}
+	if refType != promo.RefType || refID != promo.RefID {
+		if err = b.checkPromotionRef(ctx, txStorage, params.OwnerID, refType, refID); err != nil {
+			return zero, err
+		}
+	}
+
+	dateStarted, dateEnded := promo.DateStarted, promo.DateEnded
+	if params.DateStarted != nil {
+		dateStarted = timeToPgTimestamptz(*params.DateStarted)
+	}
+	if params.DateEnded != nil {
+		dateEnded = timeToPgTimestamptz(*params.DateEnded)
+	}
+	if err = validatePeriod(dateStarted, dateEnded); err != nil {
+		return zero, err
+	}
+
+	scheduleStart, scheduleTz, scheduleDuration, err := scheduleToPgtype(params.Schedule)
+	if err != nil {
+		return zero, err
+	}
+	clearSchedule := params.ClearSchedule && params.Schedule == nil
+
+	if promo, err = txStorage.UpdatePromotionBase(ctx, db.UpdatePromotionBaseParams{
+		ID:                   pgutil.Int64ToPgInt8(promo.ID),
+		RefType:              db.NullPromotionRefType{PromotionRefType: refType, Valid: true},
+		NullRefID:            !refID.Valid,
+		RefID:                refID,
+		Title:                pgutil.PtrToPgtype(params.Title, pgutil.StringToPgText),
+		Description:          pgutil.PtrToPgtype(params.Description, pgutil.StringToPgText),
+		IsActive:             pgutil.PtrToPgtype(params.IsActive, pgutil.BoolToPgBool),
+		Exclusive:            pgutil.PtrToPgtype(params.Exclusive, pgutil.BoolToPgBool),
+		DateStarted:          dateStarted,
+		DateEnded:            dateEnded,
+		NullScheduleTz:       clearSchedule,
+		ScheduleTz:           scheduleTz,
+		NullScheduleStart:    clearSchedule,
+		ScheduleStart:        scheduleStart,
+		NullScheduleDuration: clearSchedule,
+		ScheduleDuration:     scheduleDuration,
+		DateUpdated:          pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	}); err != nil {
+		return zero, err
+	}
+
+	if err = b.updateDetails(ctx, txStorage, promo, params); err != nil {
+		return zero, err
+	}
+
+	switch {
+	case params.Voucher != nil:
+		err = b.updateVoucher(ctx, txStorage, promo, *params.Voucher)
+	case params.ClearVoucher:
+		err = b.deleteVoucher(ctx, txStorage, promo)
+	}
+	if err != nil {
+		return zero, err
+	}
+
+	promotions, err := b.withDetails(ctx, txStorage, []db.PromotionBase{promo})
+	if err != nil {
+		return zero, err
+	}
+
+	if err = txStorage.Commit(ctx); err != nil {
+		return zero, err
+	}
+
+	return promotions[0], nil
+}
+
+type DeactivatePromotionParams struct {
+	OwnerID *int64
+	ID      int64
+}
+
+// DeactivatePromotion stops a promotion of the owner from applying, it can be activated again with UpdatePromotion
+func (b *PromotionBiz) DeactivatePromotion(ctx context.Context, params DeactivatePromotionParams) error {
+	txStorage, err := b.storage.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer txStorage.Rollback(ctx)
+
+	promo, err := b.getOwnedPromotion(ctx, txStorage, params.OwnerID, params.ID)
+	if err != nil {
+		return err
+	}
+
+	if _, err = txStorage.UpdatePromotionBase(ctx, db.UpdatePromotionBaseParams{
+		ID:          pgutil.Int64ToPgInt8(promo.ID),
+		IsActive:    pgutil.BoolToPgBool(false),
+		DateUpdated: pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	}); err != nil {
+		return err
+	}
+
+	return txStorage.Commit(ctx)
+}
+
+// getOwnedPromotion returns ErrPromotionNotFound unless the promotion belongs to the owner
+func (b *PromotionBiz) getOwnedPromotion(ctx context.Context, storage db.Querier, ownerID *int64, id int64) (db.PromotionBase, error) {
+	promo, err := storage.GetPromotionBase(ctx, db.GetPromotionBaseParams{
+		ID: pgutil.Int64ToPgInt8(id),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return promo, promotionmodel.ErrPromotionNotFound
+		}
+		return promo, err
+	}
+	if promo.OwnerID != pgutil.PtrToPgtype(ownerID, pgutil.Int64ToPgInt8) {
+		return promo, promotionmodel.ErrPromotionNotFound
+	}
+
+	return promo, nil
+}
+
+// checkPromotionRef checks the promotion target exists, and for vendor promotions that the targeted product is the vendor's
+func (b *PromotionBiz) checkPromotionRef(ctx context.Context, storage db.Querier, ownerID *int64, refType db.PromotionRefType, refID pgtype.Int8) error {
+	if refType == db.PromotionRefTypeAll {
+		if refID.Valid {
+			return promotionmodel.ErrInvalidPromotionRef
+		}
+		return nil
+	}
+	if !refID.Valid {
+		return promotionmodel.ErrInvalidPromotionRef
+	}
+
+	var spuID int64
+	switch refType {
+	case db.PromotionRefTypeCategory:
+		_, err := storage.GetCatalogCategory(ctx, db.GetCatalogCategoryParams{ID: refID})
+		return refLookupError(err)
+	case db.PromotionRefTypeBrand:
+		_, err := storage.GetCatalogBrand(ctx, db.GetCatalogBrandParams{ID: refID})
+		return refLookupError(err)
+	case db.PromotionRefTypeTag:
+		_, err := storage.GetCatalogTag(ctx, db.GetCatalogTagParams{ID: refID})
+		return refLookupError(err)
+	case db.PromotionRefTypeProductSku:
+		sku, err := storage.GetCatalogProductSku(ctx, db.GetCatalogProductSkuParams{ID: refID})
+		if err != nil {
+			return refLookupError(err)
+		}
+		spuID = sku.SpuID
+	case db.PromotionRefTypeProductSpu:
+		spuID = refID.Int64
+	default:
+		return promotionmodel.ErrInvalidPromotionRef
+	}
+
+	spu, err := storage.GetCatalogProductSpu(ctx, db.GetCatalogProductSpuParams{
+		ID: pgutil.Int64ToPgInt8(spuID),
+	})
+	if err != nil {
+		return refLookupError(err)
+	}
+	if ownerID != nil && spu.AccountID != *ownerID {
+		return promotionmodel.ErrPromotionRefNotOwned
+	}
+
+	return nil
+}
+
+func validatePeriod(dateStarted, dateEnded pgtype.Timestamptz) error {
+	if dateEnded.Valid && !dateEnded.Time.After(dateStarted.Time) {
+		return promotionmodel.ErrInvalidPromotionPeriod
+	}
+	return nil
+}
+
+func refLookupError(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return promotionmodel.ErrPromotionRefNotFound
+	}
+	return err
 }
 
-func NewPromotionBiz() *PromotionBiz {
-	return &PromotionBiz{}
+func timeToPgTimestamptz(t time.Time) pgtype.Timestamptz {
+	return pgtype.Timestamptz{Time: t, Valid: true}
 }
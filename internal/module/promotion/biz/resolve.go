@@ -0,0 +1,119 @@
+package promotionbiz
+
+import (
+	"context"
+
+	"shopnexus-remastered/config"
+	"shopnexus-remastered/internal/db"
+	promotionmodel "shopnexus-remastered/internal/module/promotion/model"
+)
+
+// ResolveItem is an item priced by ResolveItemPrices
+type ResolveItem struct {
+	Product promotionmodel.ProductRef // See ListProductRefs
+	Price   int64                     // Unit price before promotions
+}
+
+type ResolveItemPricesParams struct {
+	Items       []ResolveItem
+	VoucherCode *string // Voucher entered by the customer, other vouchers are left out
+}
+
+// ResolveItemPrices prices each item with the discounts targeting it, combined following the stacking policy.
+// The result follows the order of the items. Order-wide discounts and the other promotion types are priced on the
+// whole order, see ListOrderPromotions.
+func (b *PromotionBiz) ResolveItemPrices(ctx context.Context, storage db.Querier, params ResolveItemPricesParams) ([]promotionmodel.ResolvedPrice, error) {
+	active, err := b.listItemDiscounts(ctx, storage, params.VoucherCode)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]promotionmodel.ResolvedPrice, len(params.Items))
+	for i, item := range params.Items {
+		result[i] = promotionmodel.ResolveItemPrice(item.Price, active.forItem(item.Product), b.policy)
+	}
+
+	return result, nil
+}
+
+// itemDiscounts are the active discount promotions that can lower item prices, with their details and quotas
+type itemDiscounts struct {
+	promos    []db.PromotionBase
+	discounts map[int64]db.PromotionDiscount // map[promotionID]PromotionDiscount
+	quotas    map[int64]int64                // map[promotionID]unitsLeft, missing when the promotion has no quota
+}
+
+// listItemDiscounts loads the active discount promotions, vouchers are left out unless their code is given
+func (b *PromotionBiz) listItemDiscounts(ctx context.Context, storage db.Querier, voucherCode *string) (itemDiscounts, error) {
+	var zero itemDiscounts
+
+	promos, err := b.ListActivePromotion(ctx, storage, ListActivePromotionParams{
+		VoucherCode: voucherCode,
+	})
+	if err != nil {
+		return zero, err
+	}
+
+	var discountPromos []db.PromotionBase
+	for _, promo := range promos {
+		if promo.Type == db.PromotionTypeDiscount {
+			discountPromos = append(discountPromos, promo)
+		}
+	}
+	details, err := b.listDetails(ctx, storage, discountPromos)
+	if err != nil {
+		return zero, err
+	}
+
+	// Promotions that used up their quota (flash sales) are back to the normal price
+	discountIDs := make([]int64, 0, len(discountPromos))
+	for _, promo := range discountPromos {
+		discountIDs = append(discountIDs, promo.ID)
+	}
+	quotas, err := b.inventoryBiz.GetPromotionQuotas(ctx, storage, discountIDs)
+	if err != nil {
+		return zero, err
+	}
+
+	return itemDiscounts{
+		promos:    discountPromos,
+		discounts: details.discounts,
+		quotas:    quotas,
+	}, nil
+}
+
+// forItem returns the discounts targeting the product, order-wide discounts are priced on the whole order
+func (d itemDiscounts) forItem(product promotionmodel.ProductRef) []promotionmodel.ItemDiscount {
+	var result []promotionmodel.ItemDiscount
+	for _, promo := range d.promos {
+		discount, ok := d.discounts[promo.ID]
+		if !ok || discount.OrderWide || !promotionmodel.IsPromotionApplicable(promo, product) {
+			continue
+		}
+		left, limited := d.quotas[promo.ID]
+		result = append(result, promotionmodel.ItemDiscount{
+			Promotion: promo,
+			Discount:  discount,
+			Exhausted: limited && left <= 0,
+		})
+	}
+	return result
+}
+
+// newStackingPolicy builds the stacking policy from the config, unset fields keep the default policy
+func newStackingPolicy(cfg config.Promotion) promotionmodel.StackingPolicy {
+	policy := promotionmodel.DefaultStackingPolicy
+	if cfg.MaxVendorDiscounts > 0 {
+		policy.MaxVendor = cfg.MaxVendorDiscounts
+	}
+	if cfg.MaxPlatformDiscounts > 0 {
+		policy.MaxPlatform = cfg.MaxPlatformDiscounts
+	}
+	if len(cfg.StackingOrder) > 0 {
+		policy.Order = make([]promotionmodel.PromotionSource, 0, len(cfg.StackingOrder))
+		for _, source := range cfg.StackingOrder {
+			policy.Order = append(policy.Order, promotionmodel.PromotionSource(source))
+		}
+	}
+	return policy
+}
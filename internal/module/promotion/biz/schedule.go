@@ -0,0 +1,100 @@
+package promotionbiz
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"shopnexus-remastered/internal/db"
+	promotionmodel "shopnexus-remastered/internal/module/promotion/model"
+	"shopnexus-remastered/internal/utils/pgutil"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// maxPromotionWindows caps how many windows GetPromotionWindows returns
+const maxPromotionWindows = 50
+
+type ScheduleParams struct {
+	Cron     string // 5-field cron expression of when each window starts, e.g. "0 9 * * 1" for every Monday 9AM
+	Timezone string // IANA timezone the cron expression is evaluated in, e.g. "Asia/Ho_Chi_Minh"
+	Duration int32  // Length of each window in minutes
+}
+
+type ListActivePromotionParams struct {
+	VoucherCode *string // Voucher entered by the customer, other vouchers are left out
+}
+
+// ListActivePromotion returns the promotions applying now: active, within their period and inside a window of their schedule if any.
+// Vouchers only apply when the customer entered their code.
+func (b *PromotionBiz) ListActivePromotion(ctx context.Context, storage db.Querier, params ListActivePromotionParams) ([]db.PromotionBase, error) {
+	promos, err := storage.ListActivePromotion(ctx, db.ListActivePromotionParams{})
+	if err != nil {
+		return nil, err
+	}
+	return filterVouchers(ctx, storage, promotionmodel.FilterPromotionsInSchedule(promos, time.Now()), params.VoucherCode)
+}
+
+type GetPromotionWindowsParams struct {
+	ID    int64
+	Count int // Number of windows to return, capped at 50
+}
+
+// GetPromotionWindows returns the window of a scheduled promotion open now, if any, and the next ones within its period
+func (b *PromotionBiz) GetPromotionWindows(ctx context.Context, params GetPromotionWindowsParams) ([]promotionmodel.ScheduleWindow, error) {
+	promo, err := b.storage.GetPromotionBase(ctx, db.GetPromotionBaseParams{
+		ID: pgutil.Int64ToPgInt8(params.ID),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, promotionmodel.ErrPromotionNotFound
+		}
+		return nil, err
+	}
+	if !promo.IsActive {
+		return nil, promotionmodel.ErrPromotionNotFound
+	}
+
+	schedule, ok := promotionmodel.PromotionSchedule(promo)
+	if !ok {
+		return nil, promotionmodel.ErrPromotionNotScheduled
+	}
+
+	// Windows before the promotion starts do not count, nor those starting once it has ended
+	now := time.Now()
+	from := now
+	if promo.DateStarted.Valid && promo.DateStarted.Time.After(now) {
+		from = promo.DateStarted.Time
+	}
+
+	count := min(max(params.Count, 1), maxPromotionWindows)
+	windows := schedule.Windows(from, count)
+
+	result := make([]promotionmodel.ScheduleWindow, 0, len(windows))
+	for _, window := range windows {
+		if promo.DateEnded.Valid && !window.Start.Before(promo.DateEnded.Time) {
+			break
+		}
+		result = append(result, window)
+	}
+
+	return result, nil
+}
+
+// scheduleToPgtype validates a schedule and returns its columns, all null when params is nil
+func scheduleToPgtype(params *ScheduleParams) (cron pgtype.Text, tz pgtype.Text, duration pgtype.Int4, err error) {
+	if params == nil {
+		return cron, tz, duration, nil
+	}
+
+	schedule, err := promotionmodel.ParseSchedule(params.Cron, params.Timezone, params.Duration)
+	if err != nil {
+		return cron, tz, duration, promotionmodel.ErrInvalidSchedule
+	}
+	if _, ok := schedule.Next(time.Now()); !ok {
+		return cron, tz, duration, promotionmodel.ErrInvalidSchedule
+	}
+
+	return pgutil.StringToPgText(params.Cron), pgutil.StringToPgText(params.Timezone), pgutil.Int32ToPgInt4(params.Duration), nil
+}
@@ -0,0 +1,123 @@
+package promotionbiz
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"shopnexus-remastered/internal/db"
+	promotionmodel "shopnexus-remastered/internal/module/promotion/model"
+)
+
+// categoryTreeTTL is how long the category tree stays cached, categories rarely change
+const categoryTreeTTL = 5 * time.Minute
+
+// categoryTree caches the ancestors of every category, so matching category promotions needs no query per product
+type categoryTree struct {
+	mu        sync.Mutex
+	ancestors map[int64][]int64 // map[categoryID][]categoryID, the category then its ancestors up to the root
+	loadedAt  time.Time
+}
+
+// get returns the ancestors of every category, loading the tree when the cache is empty or stale
+func (t *categoryTree) get(ctx context.Context, storage db.Querier) (map[int64][]int64, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.ancestors != nil && time.Since(t.loadedAt) < categoryTreeTTL {
+		return t.ancestors, nil
+	}
+
+	categories, err := storage.ListCatalogCategory(ctx, db.ListCatalogCategoryParams{})
+	if err != nil {
+		return nil, err
+	}
+	parents := make(map[int64]int64, len(categories)) // map[categoryID]parentID, roots are missing
+	for _, category := range categories {
+		if category.ParentID.Valid {
+			parents[category.ID] = category.ParentID.Int64
+		}
+	}
+
+	ancestors := make(map[int64][]int64, len(categories))
+	for _, category := range categories {
+		chain := []int64{category.ID}
+		seen := map[int64]bool{category.ID: true}
+		for id := category.ID; ; {
+			parent, ok := parents[id]
+			if !ok || seen[parent] {
+				// Stop at the root, or at a cycle left by a bad parent_id
+				break
+			}
+			chain = append(chain, parent)
+			seen[parent] = true
+			id = parent
+		}
+		ancestors[category.ID] = chain
+	}
+
+	t.ancestors = ancestors
+	t.loadedAt = time.Now()
+	return ancestors, nil
+}
+
+// invalidate drops the cached tree, the next get loads it again
+func (t *categoryTree) invalidate() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.ancestors = nil
+}
+
+// InvalidateCategoryTree drops the cached category tree, to call once categories are created, moved or deleted
+func (b *PromotionBiz) InvalidateCategoryTree() {
+	b.categories.invalidate()
+}
+
+type ProductRefParams struct {
+	Spu   db.CatalogProductSpu
+	SkuID int64
+}
+
+// ListProductRefs returns what promotions can target on each SKU (its category ancestors and tags), in the order of the params
+func (b *PromotionBiz) ListProductRefs(ctx context.Context, storage db.Querier, params []ProductRefParams) ([]promotionmodel.ProductRef, error) {
+	if len(params) == 0 {
+		return []promotionmodel.ProductRef{}, nil
+	}
+
+	ancestors, err := b.categories.get(ctx, storage)
+	if err != nil {
+		return nil, err
+	}
+
+	spuIDs := make([]int64, 0, len(params))
+	for _, param := range params {
+		spuIDs = append(spuIDs, param.Spu.ID)
+	}
+	spuTags, err := storage.ListCatalogProductSpuTag(ctx, db.ListCatalogProductSpuTagParams{
+		SpuID: spuIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	tagMap := make(map[int64][]int64) // map[spuID][]tagID
+	for _, spuTag := range spuTags {
+		tagMap[spuTag.SpuID] = append(tagMap[spuTag.SpuID], spuTag.TagID)
+	}
+
+	result := make([]promotionmodel.ProductRef, 0, len(params))
+	for _, param := range params {
+		categories, ok := ancestors[param.Spu.CategoryID]
+		if !ok {
+			// Category created after the tree was cached, its own promotions still match
+			categories = []int64{param.Spu.CategoryID}
+		}
+		result = append(result, promotionmodel.ProductRef{
+			Spu:        param.Spu,
+			SkuID:      param.SkuID,
+			Categories: categories,
+			TagIDs:     tagMap[param.Spu.ID],
+		})
+	}
+
+	return result, nil
+}
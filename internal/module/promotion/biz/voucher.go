@@ -0,0 +1,240 @@
+package promotionbiz
+
+import (
+	"context"
+	"errors"
+
+	"shopnexus-remastered/internal/db"
+	promotionmodel "shopnexus-remastered/internal/module/promotion/model"
+	"shopnexus-remastered/internal/utils/pgutil"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// A promotion with a voucher is not applied automatically, the customer enters its code at checkout to get it.
+
+type VoucherParams struct {
+	UsageLimit       int64 // Total redemptions allowed, 0 means no limit
+	PerCustomerLimit int64 // Redemptions allowed per customer, 0 means no limit
+	FirstOrderOnly   bool  // Only customers without any other order can redeem it
+	MinSpend         int64 // Minimum order amount before promotions, 0 means no minimum
+}
+
+type UpdateVoucherParams struct {
+	UsageLimit       *int64
+	PerCustomerLimit *int64
+	FirstOrderOnly   *bool
+	MinSpend         *int64
+}
+
+// createVoucher makes a new promotion a voucher
+func (b *PromotionBiz) createVoucher(ctx context.Context, storage db.Querier, promo db.PromotionBase, params VoucherParams) error {
+	if err := validateVoucher(params.UsageLimit, params.PerCustomerLimit, params.MinSpend); err != nil {
+		return err
+	}
+
+	_, err := storage.CreatePromotionVoucher(ctx, []db.CreatePromotionVoucherParams{{
+		ID:               promo.ID,
+		UsageLimit:       params.UsageLimit,
+		PerCustomerLimit: params.PerCustomerLimit,
+		FirstOrderOnly:   params.FirstOrderOnly,
+		MinSpend:         params.MinSpend,
+	}})
+	return err
+}
+
+// updateVoucher changes the rules of the voucher of a promotion, making the promotion a voucher if it is not one yet
+func (b *PromotionBiz) updateVoucher(ctx context.Context, storage db.Querier, promo db.PromotionBase, params UpdateVoucherParams) error {
+	voucher, err := storage.GetPromotionVoucher(ctx, pgutil.Int64ToPgInt8(promo.ID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		var create VoucherParams
+		if params.UsageLimit != nil {
+			create.UsageLimit = *params.UsageLimit
+		}
+		if params.PerCustomerLimit != nil {
+			create.PerCustomerLimit = *params.PerCustomerLimit
+		}
+		if params.FirstOrderOnly != nil {
+			create.FirstOrderOnly = *params.FirstOrderOnly
+		}
+		if params.MinSpend != nil {
+			create.MinSpend = *params.MinSpend
+		}
+		return b.createVoucher(ctx, storage, promo, create)
+	}
+	if err != nil {
+		return err
+	}
+
+	usageLimit, perCustomerLimit, minSpend := voucher.UsageLimit, voucher.PerCustomerLimit, voucher.MinSpend
+	if params.UsageLimit != nil {
+		usageLimit = *params.UsageLimit
+	}
+	if params.PerCustomerLimit != nil {
+		perCustomerLimit = *params.PerCustomerLimit
+	}
+	if params.MinSpend != nil {
+		minSpend = *params.MinSpend
+	}
+	if err = validateVoucher(usageLimit, perCustomerLimit, minSpend); err != nil {
+		return err
+	}
+
+	// Lowering the usage limit below the redemptions made stops new ones, past ones stay
+	_, err = storage.UpdatePromotionVoucher(ctx, db.UpdatePromotionVoucherParams{
+		ID:               pgutil.Int64ToPgInt8(promo.ID),
+		UsageLimit:       pgutil.PtrToPgtype(params.UsageLimit, pgutil.Int64ToPgInt8),
+		PerCustomerLimit: pgutil.PtrToPgtype(params.PerCustomerLimit, pgutil.Int64ToPgInt8),
+		FirstOrderOnly:   pgutil.PtrToPgtype(params.FirstOrderOnly, pgutil.BoolToPgBool),
+		MinSpend:         pgutil.PtrToPgtype(params.MinSpend, pgutil.Int64ToPgInt8),
+	})
+	return err
+}
+
+// deleteVoucher makes a promotion apply automatically again, its redemptions go with the voucher
+func (b *PromotionBiz) deleteVoucher(ctx context.Context, storage db.Querier, promo db.PromotionBase) error {
+	return storage.DeletePromotionVoucher(ctx, pgutil.Int64ToPgInt8(promo.ID))
+}
+
+// GetActiveVoucher returns the promotion of a voucher code, ErrVoucherNotFound unless it applies now
+func (b *PromotionBiz) GetActiveVoucher(ctx context.Context, storage db.Querier, code string) (db.PromotionBase, error) {
+	var zero db.PromotionBase
+
+	promos, err := b.ListActivePromotion(ctx, storage, ListActivePromotionParams{
+		VoucherCode: &code,
+	})
+	if err != nil {
+		return zero, err
+	}
+	for _, promo := range promos {
+		if promo.Code != code {
+			continue
+		}
+		if _, err = storage.GetPromotionVoucher(ctx, pgutil.Int64ToPgInt8(promo.ID)); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return zero, promotionmodel.ErrVoucherNotFound
+			}
+			return zero, err
+		}
+		return promo, nil
+	}
+
+	return zero, promotionmodel.ErrVoucherNotFound
+}
+
+type RedeemVoucherParams struct {
+	PromotionID int64
+	AccountID   int64 // Customer placing the order
+	OrderID     int64 // Order being placed, already created in the transaction
+	Spend       int64 // Order amount before promotions
+}
+
+// RedeemVoucher checks the rules of the voucher and records its use by the order. It must run in the checkout
+// transaction: taking a use locks the voucher until the transaction ends, so concurrent checkouts with the same
+// voucher are checked one after the other and never go over its limits.
+func (b *PromotionBiz) RedeemVoucher(ctx context.Context, storage db.Querier, params RedeemVoucherParams) error {
+	voucher, err := storage.GetPromotionVoucher(ctx, pgutil.Int64ToPgInt8(params.PromotionID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return promotionmodel.ErrVoucherNotFound
+		}
+		return err
+	}
+	if params.Spend < voucher.MinSpend {
+		return promotionmodel.ErrVoucherMinSpend
+	}
+
+	if voucher, err = storage.RedeemPromotionVoucher(ctx, params.PromotionID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return promotionmodel.ErrVoucherUsedUp
+		}
+		return err
+	}
+
+	if voucher.PerCustomerLimit > 0 {
+		redeemed, err := storage.CountPromotionVoucherRedemption(ctx, db.CountPromotionVoucherRedemptionParams{
+			VoucherID: []int64{voucher.ID},
+			AccountID: []int64{params.AccountID},
+			Status:    []db.SharedStatus{db.SharedStatusSuccess},
+		})
+		if err != nil {
+			return err
+		}
+		if redeemed >= voucher.PerCustomerLimit {
+			return promotionmodel.ErrVoucherCustomerLimit
+		}
+	}
+
+	if voucher.FirstOrderOnly {
+		// The order being placed counts, canceled and failed orders do not
+		orders, err := storage.CountOrderBase(ctx, db.CountOrderBaseParams{
+			CustomerID: []int64{params.AccountID},
+			Status:     []db.SharedStatus{db.SharedStatusPending, db.SharedStatusProcessing, db.SharedStatusSuccess},
+		})
+		if err != nil {
+			return err
+		}
+		if orders > 1 {
+			return promotionmodel.ErrVoucherFirstOrderOnly
+		}
+	}
+
+	_, err = storage.CreateDefaultPromotionVoucherRedemption(ctx, []db.CreateDefaultPromotionVoucherRedemptionParams{{
+		VoucherID: voucher.ID,
+		AccountID: params.AccountID,
+		OrderID:   params.OrderID,
+	}})
+	return err
+}
+
+// CancelVoucherRedemptions gives back the voucher uses of an order that will never be delivered
+func (b *PromotionBiz) CancelVoucherRedemptions(ctx context.Context, storage db.Querier, orderID int64) error {
+	redemptions, err := storage.CancelVoucherRedemption(ctx, orderID)
+	if err != nil {
+		return err
+	}
+	for _, redemption := range redemptions {
+		if err = storage.ReleasePromotionVoucher(ctx, redemption.VoucherID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// filterVouchers drops the vouchers from the promotions, except the one of the code entered by the customer
+func filterVouchers(ctx context.Context, storage db.Querier, promos []db.PromotionBase, code *string) ([]db.PromotionBase, error) {
+	if len(promos) == 0 {
+		return promos, nil
+	}
+
+	ids := make([]int64, len(promos))
+	for i, promo := range promos {
+		ids[i] = promo.ID
+	}
+	vouchers, err := storage.ListPromotionVoucher(ctx, db.ListPromotionVoucherParams{
+		ID: ids,
+	})
+	if err != nil {
+		return nil, err
+	}
+	isVoucher := make(map[int64]bool, len(vouchers)) // map[promoID]isVoucher
+	for _, voucher := range vouchers {
+		isVoucher[voucher.ID] = true
+	}
+
+	result := make([]db.PromotionBase, 0, len(promos))
+	for _, promo := range promos {
+		if isVoucher[promo.ID] && (code == nil || promo.Code != *code) {
+			continue
+		}
+		result = append(result, promo)
+	}
+	return result, nil
+}
+
+func validateVoucher(usageLimit, perCustomerLimit, minSpend int64) error {
+	if usageLimit < 0 || perCustomerLimit < 0 || minSpend < 0 {
+		return promotionmodel.ErrInvalidVoucher
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package promotionmodel
+
+import (
+	"shopnexus-remastered/internal/db"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// PriceBreakdown is how a discount changes an amount
+type PriceBreakdown struct {
+	Original   int64 `json:"original"`    // Amount before the discount
+	Discount   int64 `json:"discount"`    // Amount taken off, after the cap
+	CapApplied bool  `json:"cap_applied"` // Whether max discount lowered the discount
+	Final      int64 `json:"final"`       // Original minus discount, never below 0
+}
+
+// ApplyDiscount computes the breakdown of a discount on an amount.
+// The amount must reach min spend (0 means applied immediately), and max discount caps the discount (0 means no limit).
+func ApplyDiscount(amount int64, discount db.PromotionDiscount) PriceBreakdown {
+	return applyRate(amount, discount.MinSpend, discount.MaxDiscount, discount.DiscountPercent, discount.DiscountPrice)
+}
+
+// ApplyCashback computes the breakdown of a cashback on an amount, the discount being the amount credited back.
+// The amount must reach min spend (0 means applied immediately), and max cashback caps the cashback (0 means no limit).
+func ApplyCashback(amount int64, cashback db.PromotionCashback) PriceBreakdown {
+	return applyRate(amount, cashback.MinSpend, cashback.MaxCashback, cashback.CashbackPercent, cashback.CashbackPrice)
+}
+
+// applyRate takes a percent or a fixed price off an amount that reaches min spend, capped at max amount (0 means no limit)
+func applyRate(amount int64, minSpend int64, maxAmount int64, percent pgtype.Int4, price pgtype.Int8) PriceBreakdown {
+	breakdown := PriceBreakdown{
+		Original: amount,
+		Final:    amount,
+	}
+	if amount <= 0 || amount < minSpend {
+		return breakdown
+	}
+
+	var discountAmount int64
+	if percent.Valid {
+		discountAmount = amount * int64(percent.Int32) / 100
+	} else if price.Valid {
+		discountAmount = price.Int64
+	}
+
+	if maxAmount > 0 && discountAmount > maxAmount {
+		discountAmount = maxAmount
+		breakdown.CapApplied = true
+	}
+
+	breakdown.Discount = max(min(discountAmount, amount), 0)
+	breakdown.Final = amount - breakdown.Discount
+	return breakdown
+}
+
+// CalculateDiscountedItemPrice returns the breakdown of an item discount on a unit price.
+// Order-wide discounts leave the unit price as is, they are priced by CalculateDiscountedOrderPrice.
+func CalculateDiscountedItemPrice(originalPrice int64, discount db.PromotionDiscount) PriceBreakdown {
+	if discount.OrderWide {
+		return PriceBreakdown{
+			Original: originalPrice,
+			Final:    originalPrice,
+		}
+	}
+	return ApplyDiscount(originalPrice, discount)
+}
@@ -0,0 +1,122 @@
+package promotionmodel
+
+import (
+	"testing"
+
+	"shopnexus-remastered/internal/db"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func percentDiscount(percent int32) db.PromotionDiscount {
+	return db.PromotionDiscount{DiscountPercent: pgtype.Int4{Int32: percent, Valid: true}}
+}
+
+func priceDiscount(price int64) db.PromotionDiscount {
+	return db.PromotionDiscount{DiscountPrice: pgtype.Int8{Int64: price, Valid: true}}
+}
+
+func withLimits(discount db.PromotionDiscount, minSpend, maxDiscount int64) db.PromotionDiscount {
+	discount.MinSpend = minSpend
+	discount.MaxDiscount = maxDiscount
+	return discount
+}
+
+func TestApplyDiscount(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   int64
+		discount db.PromotionDiscount
+		want     PriceBreakdown
+	}{
+		{
+			name:     "percent",
+			amount:   1000,
+			discount: percentDiscount(10),
+			want:     PriceBreakdown{Original: 1000, Discount: 100, Final: 900},
+		},
+		{
+			name:     "fixed price",
+			amount:   1000,
+			discount: priceDiscount(250),
+			want:     PriceBreakdown{Original: 1000, Discount: 250, Final: 750},
+		},
+		{
+			name:     "max discount hit",
+			amount:   1000,
+			discount: withLimits(percentDiscount(50), 0, 300),
+			want:     PriceBreakdown{Original: 1000, Discount: 300, CapApplied: true, Final: 700},
+		},
+		{
+			name:     "max discount not hit",
+			amount:   1000,
+			discount: withLimits(percentDiscount(20), 0, 300),
+			want:     PriceBreakdown{Original: 1000, Discount: 200, Final: 800},
+		},
+		{
+			name:     "max discount 0 is no cap",
+			amount:   1000,
+			discount: withLimits(percentDiscount(90), 0, 0),
+			want:     PriceBreakdown{Original: 1000, Discount: 900, Final: 100},
+		},
+		{
+			name:     "spend below min spend",
+			amount:   999,
+			discount: withLimits(percentDiscount(10), 1000, 0),
+			want:     PriceBreakdown{Original: 999, Final: 999},
+		},
+		{
+			name:     "spend exactly at min spend",
+			amount:   1000,
+			discount: withLimits(percentDiscount(10), 1000, 0),
+			want:     PriceBreakdown{Original: 1000, Discount: 100, Final: 900},
+		},
+		{
+			name:     "discount larger than the price",
+			amount:   1000,
+			discount: priceDiscount(1500),
+			want:     PriceBreakdown{Original: 1000, Discount: 1000, Final: 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ApplyDiscount(tt.amount, tt.discount); got != tt.want {
+				t.Errorf("ApplyDiscount(%d) = %+v, want %+v", tt.amount, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCalculateDiscountedItemPrice(t *testing.T) {
+	orderWide := percentDiscount(50)
+	orderWide.OrderWide = true
+
+	tests := []struct {
+		name     string
+		price    int64
+		discount db.PromotionDiscount
+		want     PriceBreakdown
+	}{
+		{
+			name:     "item discount",
+			price:    1000,
+			discount: percentDiscount(50),
+			want:     PriceBreakdown{Original: 1000, Discount: 500, Final: 500},
+		},
+		{
+			name:     "order-wide passthrough",
+			price:    1000,
+			discount: orderWide,
+			want:     PriceBreakdown{Original: 1000, Final: 1000},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CalculateDiscountedItemPrice(tt.price, tt.discount); got != tt.want {
+				t.Errorf("CalculateDiscountedItemPrice(%d) = %+v, want %+v", tt.price, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package promotionmodel
+
+import sharedmodel "shopnexus-remastered/internal/module/shared/model"
+
+var (
+	ErrPromotionNotFound        = sharedmodel.NewError("promotion.not_found", "Promotion not found")
+	ErrPromotionCodeExists      = sharedmodel.NewError("promotion.code_exists", "Promotion code is already used")
+	ErrInvalidPromotionRef      = sharedmodel.NewError("promotion.invalid_ref", "Promotion target needs a ref_id, except for All")
+	ErrPromotionRefNotFound     = sharedmodel.NewError("promotion.ref_not_found", "Promotion target not found")
+	ErrPromotionRefNotOwned     = sharedmodel.NewError("promotion.ref_not_owned", "Vendor promotions can only target products of the vendor")
+	ErrInvalidPromotionPeriod   = sharedmodel.NewError("promotion.invalid_period", "Promotion must end after it starts")
+	ErrPromotionDetailsRequired = sharedmodel.NewError("promotion.details_required", "Promotions need the details of their type")
+	ErrPromotionTypeMismatch    = sharedmodel.NewError("promotion.type_mismatch", "Promotion details do not match the promotion type")
+	ErrInvalidDiscountValue     = sharedmodel.NewError("promotion.invalid_discount_value", "Exactly one of discount_percent (1-100) or discount_price (positive) must be set")
+	ErrInvalidCashbackValue     = sharedmodel.NewError("promotion.invalid_cashback_value", "Exactly one of cashback_percent (1-100) or cashback_price (positive) must be set")
+	ErrInvalidBundle            = sharedmodel.NewError("promotion.invalid_bundle", "Bundles need a positive price and distinct SKUs with positive quantities")
+	ErrInvalidBuyXGetY          = sharedmodel.NewError("promotion.invalid_buy_x_get_y", "Buy and get quantities must be positive")
+	ErrInvalidSchedule          = sharedmodel.NewError("promotion.invalid_schedule", "Schedule needs a 5-field cron expression that matches, an IANA timezone and a positive duration in minutes")
+	ErrPromotionNotScheduled    = sharedmodel.NewError("promotion.not_scheduled", "Promotion has no recurring schedule")
+	ErrInvalidVoucher           = sharedmodel.NewError("promotion.invalid_voucher", "Voucher limits and min spend cannot be negative")
+	ErrVoucherNotFound          = sharedmodel.NewError("promotion.voucher_not_found", "Voucher code not found or not valid now")
+	ErrVoucherNotApplicable     = sharedmodel.NewError("promotion.voucher_not_applicable", "Voucher does not apply to any item of the order")
+	ErrVoucherMinSpend          = sharedmodel.NewError("promotion.voucher_min_spend", "Order does not reach the minimum spend of the voucher")
+	ErrVoucherUsedUp            = sharedmodel.NewError("promotion.voucher_used_up", "Voucher has reached its usage limit")
+	ErrVoucherCustomerLimit     = sharedmodel.NewError("promotion.voucher_customer_limit", "You have already used this voucher the maximum number of times")
+	ErrVoucherFirstOrderOnly    = sharedmodel.NewError("promotion.voucher_first_order_only", "Voucher is only valid on your first order")
+	ErrPreviewOrderWide         = sharedmodel.NewError("promotion.preview_order_wide", "Order-wide discounts do not change item prices and cannot be previewed")
+)
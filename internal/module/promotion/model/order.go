@@ -0,0 +1,364 @@
+package promotionmodel
+
+import (
+	"cmp"
+	"slices"
+
+	"shopnexus-remastered/internal/db"
+)
+
+// OrderLine is a line of the order to price
+type OrderLine struct {
+	Product  ProductRef
+	Quantity int64
+	Total    int64 // Amount of the line after its item discount
+}
+
+// OrderDiscount is an order-wide discount promotion
+type OrderDiscount struct {
+	Promotion db.PromotionBase
+	Discount  db.PromotionDiscount
+}
+
+// OrderBundle is a bundle promotion with the SKUs of one set
+type OrderBundle struct {
+	Promotion db.PromotionBase
+	Bundle    db.PromotionBundle
+	Items     []db.PromotionBundleItem
+}
+
+// OrderBuyXGetY is a buy X get Y promotion
+type OrderBuyXGetY struct {
+	Promotion db.PromotionBase
+	BuyXGetY  db.PromotionBuyXGetY
+}
+
+// OrderCashback is a cashback promotion
+type OrderCashback struct {
+	Promotion db.PromotionBase
+	Cashback  db.PromotionCashback
+}
+
+// OrderPromotions are the promotions priced on the whole order rather than on a unit price
+type OrderPromotions struct {
+	Discounts []OrderDiscount // Order-wide discounts only
+	Bundles   []OrderBundle
+	BuyXGetYs []OrderBuyXGetY
+	Cashbacks []OrderCashback
+}
+
+// AppliedPromotion is a promotion applied to the order
+type AppliedPromotion struct {
+	PromotionID int64            `json:"promotion_id"`
+	Type        db.PromotionType `json:"type"`
+	Amount      int64            `json:"amount"`              // Taken from the order, or credited back for Cashback
+	Breakdown   *PriceBreakdown  `json:"breakdown,omitempty"` // Set for Discount and Cashback, on the total of the lines they target
+}
+
+// OrderPrice is the price of an order after the promotions priced on the whole order
+type OrderPrice struct {
+	Subtotal int64              `json:"subtotal"` // Total of the lines before the order promotions
+	Discount int64              `json:"discount"` // Amount taken by the order promotions
+	Total    int64              `json:"total"`    // Subtotal minus discount
+	Cashback int64              `json:"cashback"` // Credited to the customer once the order succeeds
+	Applied  []AppliedPromotion `json:"applied"`
+	Lines    []int64            `json:"lines"` // Discount taken from each line, in the order of the lines
+}
+
+// CalculateDiscountedOrderPrice applies the promotions priced on the whole order, in this order:
+//   - Bundles: each complete set of the bundle SKUs costs the bundle price
+//   - Buy X get Y: the cheapest units of every Y among X + Y units of a targeted SKU are free, the best promotion per SKU
+//   - Order-wide discount: the best one, on the lines its promotion targets once they reach its min spend
+//   - Cashback: the best one, on what is left to pay for the lines its promotion targets
+//
+// A unit used by a bundle is not counted by buy X get Y.
+// Discounts are split across the lines they come from in proportion to their amounts, so a refunded line gives back its share.
+func CalculateDiscountedOrderPrice(lines []OrderLine, promotions OrderPromotions) OrderPrice {
+	price := OrderPrice{
+		Lines: make([]int64, len(lines)),
+	}
+	for _, line := range lines {
+		price.Subtotal += line.Total
+	}
+
+	// Units of each line not used by a bundle yet
+	unbundled := make([]int64, len(lines))
+	for i, line := range lines {
+		unbundled[i] = line.Quantity
+	}
+
+	for _, bundle := range promotions.Bundles {
+		if applied, ok := applyBundle(lines, unbundled, price.Lines, bundle); ok {
+			price.Applied = append(price.Applied, applied)
+		}
+	}
+
+	price.Applied = append(price.Applied, applyBuyXGetY(lines, unbundled, price.Lines, promotions.BuyXGetYs)...)
+
+	// Order-wide discounts and cashbacks count what is left to pay for each line
+	left := func() []int64 {
+		amounts := make([]int64, len(lines))
+		for i, line := range lines {
+			amounts[i] = line.Total - price.Lines[i]
+		}
+		return amounts
+	}
+
+	if applied, weights, ok := bestOrderDiscount(lines, left(), promotions.Discounts); ok {
+		allocate(applied.Amount, weights, price.Lines)
+		price.Applied = append(price.Applied, applied)
+	}
+
+	for _, discount := range price.Lines {
+		price.Discount += discount
+	}
+	price.Total = price.Subtotal - price.Discount
+
+	if applied, ok := bestOrderCashback(lines, left(), promotions.Cashbacks); ok {
+		price.Cashback = applied.Amount
+		price.Applied = append(price.Applied, applied)
+	}
+
+	return price
+}
+
+// applyBundle prices every complete set of the bundle SKUs at the bundle price, if that is cheaper
+func applyBundle(lines []OrderLine, unbundled []int64, discounts []int64, bundle OrderBundle) (AppliedPromotion, bool) {
+	if len(bundle.Items) == 0 {
+		return AppliedPromotion{}, false
+	}
+
+	// Number of complete sets in the order
+	sets := int64(-1)
+	for _, item := range bundle.Items {
+		if item.Quantity <= 0 {
+			return AppliedPromotion{}, false
+		}
+		var units int64
+		for i, line := range lines {
+			if line.Product.SkuID == item.SkuID {
+				units += unbundled[i]
+			}
+		}
+		if sets < 0 || units/item.Quantity < sets {
+			sets = units / item.Quantity
+		}
+	}
+	if sets <= 0 {
+		return AppliedPromotion{}, false
+	}
+
+	// Take the units of the sets from the lines, weighting each line by the amount of the units taken
+	used := make([]int64, len(lines))
+	weights := make([]int64, len(lines))
+	var regular int64
+	for _, item := range bundle.Items {
+		need := sets * item.Quantity
+		for i, line := range lines {
+			if need == 0 {
+				break
+			}
+			if line.Product.SkuID != item.SkuID || unbundled[i] == 0 {
+				continue
+			}
+			take := min(need, unbundled[i])
+			used[i] += take
+			weights[i] += line.Total * take / line.Quantity
+			regular += line.Total * take / line.Quantity
+			need -= take
+		}
+	}
+
+	savings := regular - sets*bundle.Bundle.Price
+	if savings <= 0 {
+		return AppliedPromotion{}, false
+	}
+
+	for i := range lines {
+		unbundled[i] -= used[i]
+	}
+	allocate(savings, weights, discounts)
+
+	return AppliedPromotion{
+		PromotionID: bundle.Promotion.ID,
+		Type:        db.PromotionTypeBundle,
+		Amount:      savings,
+	}, true
+}
+
+// applyBuyXGetY gives the best buy X get Y of each SKU, the free units being the cheapest units of the SKU
+func applyBuyXGetY(lines []OrderLine, unbundled []int64, discounts []int64, promotions []OrderBuyXGetY) []AppliedPromotion {
+	// Lines of each SKU, cheapest units first
+	var skuIDs []int64
+	skuLines := make(map[int64][]int) // map[skuID][]line index
+	for i, line := range lines {
+		if line.Quantity <= 0 {
+			continue
+		}
+		if _, ok := skuLines[line.Product.SkuID]; !ok {
+			skuIDs = append(skuIDs, line.Product.SkuID)
+		}
+		skuLines[line.Product.SkuID] = append(skuLines[line.Product.SkuID], i)
+	}
+
+	var result []AppliedPromotion
+	amounts := make(map[int64]int64) // map[promoID]amount
+	for _, skuID := range skuIDs {
+		indexes := skuLines[skuID]
+		slices.SortStableFunc(indexes, func(a, b int) int {
+			return cmp.Compare(lines[a].Total*lines[b].Quantity, lines[b].Total*lines[a].Quantity)
+		})
+
+		var units int64
+		for _, i := range indexes {
+			units += unbundled[i]
+		}
+		if units == 0 {
+			continue
+		}
+		line := lines[indexes[0]]
+
+		// Pick the promotion saving the most on this SKU
+		var (
+			best      *OrderBuyXGetY
+			bestFree  int64
+			bestSaved int64
+		)
+		for p, promo := range promotions {
+			group := promo.BuyXGetY.BuyQuantity + promo.BuyXGetY.GetQuantity
+			if promo.BuyXGetY.BuyQuantity <= 0 || promo.BuyXGetY.GetQuantity <= 0 || !IsPromotionApplicable(promo.Promotion, line.Product) {
+				continue
+			}
+
+			freeUnits := units / group * promo.BuyXGetY.GetQuantity
+			if saved := cheapestUnitsAmount(lines, unbundled, indexes, freeUnits, nil); saved > bestSaved {
+				best = &promotions[p]
+				bestFree = freeUnits
+				bestSaved = saved
+			}
+		}
+		if best == nil {
+			continue
+		}
+
+		// The free units are exactly what each line gives, nothing to prorate
+		cheapestUnitsAmount(lines, unbundled, indexes, bestFree, discounts)
+		if _, ok := amounts[best.Promotion.ID]; !ok {
+			result = append(result, AppliedPromotion{
+				PromotionID: best.Promotion.ID,
+				Type:        db.PromotionTypeBuyXGetY,
+			})
+		}
+		amounts[best.Promotion.ID] += bestSaved
+	}
+
+	for i := range result {
+		result[i].Amount = amounts[result[i].PromotionID]
+	}
+	return result
+}
+
+// cheapestUnitsAmount returns the amount of the cheapest units among the lines, adding it to discounts if not nil
+func cheapestUnitsAmount(lines []OrderLine, unbundled []int64, indexes []int, units int64, discounts []int64) int64 {
+	var amount int64
+	for _, i := range indexes {
+		if units == 0 {
+			break
+		}
+		take := min(units, unbundled[i])
+		lineAmount := lines[i].Total * take / lines[i].Quantity
+		if discounts != nil {
+			discounts[i] += lineAmount
+		}
+		amount += lineAmount
+		units -= take
+	}
+	return amount
+}
+
+// bestOrderDiscount returns the order-wide discount taking the most, with the amount of each line it targets
+func bestOrderDiscount(lines []OrderLine, amounts []int64, discounts []OrderDiscount) (AppliedPromotion, []int64, bool) {
+	var (
+		best        AppliedPromotion
+		bestWeights []int64
+	)
+	for _, discount := range discounts {
+		if !discount.Discount.OrderWide {
+			continue
+		}
+
+		weights, eligible := targetedAmounts(lines, amounts, discount.Promotion)
+		if breakdown := ApplyDiscount(eligible, discount.Discount); breakdown.Discount > best.Amount {
+			best = AppliedPromotion{
+				PromotionID: discount.Promotion.ID,
+				Type:        db.PromotionTypeDiscount,
+				Amount:      breakdown.Discount,
+				Breakdown:   &breakdown,
+			}
+			bestWeights = weights
+		}
+	}
+	return best, bestWeights, best.Amount > 0
+}
+
+// bestOrderCashback returns the cashback crediting the most
+func bestOrderCashback(lines []OrderLine, amounts []int64, cashbacks []OrderCashback) (AppliedPromotion, bool) {
+	var best AppliedPromotion
+	for _, cashback := range cashbacks {
+		_, eligible := targetedAmounts(lines, amounts, cashback.Promotion)
+		if breakdown := ApplyCashback(eligible, cashback.Cashback); breakdown.Discount > best.Amount {
+			best = AppliedPromotion{
+				PromotionID: cashback.Promotion.ID,
+				Type:        db.PromotionTypeCashback,
+				Amount:      breakdown.Discount,
+				Breakdown:   &breakdown,
+			}
+		}
+	}
+	return best, best.Amount > 0
+}
+
+// targetedAmounts returns the amount of each line the promotion targets (0 for the others) and their sum
+func targetedAmounts(lines []OrderLine, amounts []int64, promo db.PromotionBase) ([]int64, int64) {
+	weights := make([]int64, len(lines))
+	var total int64
+	for i, line := range lines {
+		if IsPromotionApplicable(promo, line.Product) {
+			weights[i] = amounts[i]
+			total += amounts[i]
+		}
+	}
+	return weights, total
+}
+
+// allocate adds the amount to the discounts of the lines in proportion to their weights, then hands out what the
+// rounding left one unit at a time. A line never gets more than its weight, the amount must not exceed their sum.
+func allocate(amount int64, weights []int64, discounts []int64) {
+	var total int64
+	for _, weight := range weights {
+		total += max(weight, 0)
+	}
+	if amount <= 0 || total <= 0 {
+		return
+	}
+	amount = min(amount, total)
+
+	shares := make([]int64, len(weights))
+	var allocated int64
+	for i, weight := range weights {
+		if weight > 0 {
+			shares[i] = amount * weight / total
+			allocated += shares[i]
+		}
+	}
+	for i := 0; allocated < amount; i = (i + 1) % len(weights) {
+		if shares[i] < weights[i] {
+			shares[i]++
+			allocated++
+		}
+	}
+
+	for i, share := range shares {
+		discounts[i] += share
+	}
+}
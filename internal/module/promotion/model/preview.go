@@ -0,0 +1,92 @@
+package promotionmodel
+
+import "shopnexus-remastered/internal/db"
+
+// ConflictKind is how an active promotion interacts with a draft promotion on a price
+type ConflictKind string
+
+const (
+	ConflictDisplaced ConflictKind = "displaced" // Applied to the price now, the draft would take its place
+	ConflictBlocking  ConflictKind = "blocking"  // Keeps the draft from applying to the price
+	ConflictStacking  ConflictKind = "stacking"  // Applied together with the draft, on top of or below it
+)
+
+// PromotionConflict is an active promotion interacting with a draft promotion on a price
+type PromotionConflict struct {
+	Promotion db.PromotionBase `json:"promotion"`
+	Kind      ConflictKind     `json:"kind"`
+}
+
+// PreviewPrice is the price of an item before and after publishing a draft promotion
+type PreviewPrice struct {
+	Before    ResolvedPrice       `json:"before"`             // With the active promotions only
+	After     ResolvedPrice       `json:"after"`              // With the draft added to the active promotions
+	Applied   bool                `json:"applied"`            // Whether the draft is applied to the price
+	Rejected  RejectReason        `json:"rejected,omitempty"` // Why the draft is not applied
+	Conflicts []PromotionConflict `json:"conflicts"`
+}
+
+// PromotionPreviewItem is a SKU targeted by a draft promotion
+type PromotionPreviewItem struct {
+	Sku     db.CatalogProductSku `json:"sku"`
+	SpuName string               `json:"spu_name"`
+	PreviewPrice
+}
+
+// PreviewItemPrice prices an item with the active discounts targeting it, then again with the draft discount added,
+// the same way ResolveItemPrice prices it once the draft is published. The draft promotion must have an ID no active
+// promotion has, e.g. 0.
+func PreviewItemPrice(price int64, active []ItemDiscount, draft ItemDiscount, policy StackingPolicy) PreviewPrice {
+	result := PreviewPrice{
+		Before:    ResolveItemPrice(price, active, policy),
+		After:     ResolveItemPrice(price, append(active[:len(active):len(active)], draft), policy),
+		Conflicts: []PromotionConflict{},
+	}
+	draftID := draft.Promotion.ID
+
+	for _, applied := range result.After.Applied {
+		if applied.Promotion.ID == draftID {
+			result.Applied = true
+		}
+	}
+	for _, rejected := range result.After.Rejected {
+		if rejected.Promotion.ID == draftID {
+			result.Rejected = rejected.Reason
+		}
+	}
+
+	if result.Applied {
+		appliedAfter := make(map[int64]bool)
+		for _, applied := range result.After.Applied {
+			appliedAfter[applied.Promotion.ID] = true
+		}
+		for _, applied := range result.Before.Applied {
+			kind := ConflictDisplaced
+			if appliedAfter[applied.Promotion.ID] {
+				kind = ConflictStacking
+			}
+			result.Conflicts = append(result.Conflicts, PromotionConflict{
+				Promotion: applied.Promotion,
+				Kind:      kind,
+			})
+		}
+		return result
+	}
+
+	// The draft lost to the applied promotions, a quota or a saving issue is the draft's own
+	switch result.Rejected {
+	case RejectExclusive, RejectOutperformed, RejectStackingLimit:
+		draftSource := SourceOf(draft.Promotion)
+		for _, applied := range result.After.Applied {
+			if result.Rejected == RejectStackingLimit && applied.Source != draftSource {
+				// Only promotions of the same source take the places of the draft
+				continue
+			}
+			result.Conflicts = append(result.Conflicts, PromotionConflict{
+				Promotion: applied.Promotion,
+				Kind:      ConflictBlocking,
+			})
+		}
+	}
+	return result
+}
@@ -1,8 +1,74 @@
 package promotionmodel
 
-import "shopnexus-remastered/internal/db"
+import (
+	"slices"
+
+	"shopnexus-remastered/internal/db"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Promotion is a promotion with the details of its type
+type Promotion struct {
+	ID          int64               `json:"id"`
+	Code        string              `json:"code"`
+	OwnerID     pgtype.Int8         `json:"owner_id"` // Null for system promotions
+	RefType     db.PromotionRefType `json:"ref_type"`
+	RefID       pgtype.Int8         `json:"ref_id"`
+	Type        db.PromotionType    `json:"type"`
+	Title       string              `json:"title"`
+	Description pgtype.Text         `json:"description"`
+	IsActive    bool                `json:"is_active"`
+	Exclusive   bool                `json:"exclusive"` // Never combined with another promotion on the same price
+	DateStarted pgtype.Timestamptz  `json:"date_started"`
+	DateEnded   pgtype.Timestamptz  `json:"date_ended"`
+	DateCreated pgtype.Timestamptz  `json:"date_created"`
+	DateUpdated pgtype.Timestamptz  `json:"date_updated"`
+
+	Discount    *db.PromotionDiscount    `json:"discount,omitempty"`     // Set for Discount promotions
+	Bundle      *db.PromotionBundle      `json:"bundle,omitempty"`       // Set for Bundle promotions
+	BundleItems []db.PromotionBundleItem `json:"bundle_items,omitempty"` // SKUs of one set of a Bundle promotion
+	BuyXGetY    *db.PromotionBuyXGetY    `json:"buy_x_get_y,omitempty"`  // Set for BuyXGetY promotions
+	Cashback    *db.PromotionCashback    `json:"cashback,omitempty"`     // Set for Cashback promotions
+	Voucher     *db.PromotionVoucher     `json:"voucher,omitempty"`      // Set when the promotion only applies to orders entering its code
+}
+
+// NewPromotion builds a Promotion from its base row, the details of its type are set by the caller
+func NewPromotion(base db.PromotionBase) Promotion {
+	return Promotion{
+		ID:          base.ID,
+		Code:        base.Code,
+		OwnerID:     base.OwnerID,
+		RefType:     base.RefType,
+		RefID:       base.RefID,
+		Type:        base.Type,
+		Title:       base.Title,
+		Description: base.Description,
+		IsActive:    base.IsActive,
+		Exclusive:   base.Exclusive,
+		DateStarted: base.DateStarted,
+		DateEnded:   base.DateEnded,
+		DateCreated: base.DateCreated,
+		DateUpdated: base.DateUpdated,
+	}
+}
+
+// ProductRef is a SKU with what promotions can target on it
+type ProductRef struct {
+	Spu        db.CatalogProductSpu
+	SkuID      int64
+	Categories []int64 // Category of the SPU then its ancestors up to the root
+	TagIDs     []int64 // Tags of the SPU
+}
+
+// IsPromotionApplicable reports whether the promotion targets the SKU, vendor promotions only target products of the vendor.
+// A category promotion targets the products of the category and of all its subcategories.
+func IsPromotionApplicable(promo db.PromotionBase, product ProductRef) bool {
+	spu := product.Spu
+	if promo.OwnerID.Valid && promo.OwnerID.Int64 != spu.AccountID {
+		return false
+	}
 
-func IsPromotionApplicable(promo db.PromotionBase, spu db.CatalogProductSpu, skuID int64) bool {
 	if !promo.RefID.Valid {
 		return promo.RefType == db.PromotionRefTypeAll
 	}
@@ -10,39 +76,18 @@ func IsPromotionApplicable(promo db.PromotionBase, spu db.CatalogProductSpu, sku
 	refID := promo.RefID.Int64
 	switch promo.RefType {
 	case db.PromotionRefTypeCategory:
-		return refID == spu.CategoryID
+		return refID == spu.CategoryID || slices.Contains(product.Categories, refID)
 	case db.PromotionRefTypeBrand:
 		return refID == spu.BrandID
+	case db.PromotionRefTypeTag:
+		return slices.Contains(product.TagIDs, refID)
 	case db.PromotionRefTypeProductSpu:
 		return refID == spu.ID
 	case db.PromotionRefTypeProductSku:
-		return refID == skuID
+		return refID == product.SkuID
 	case db.PromotionRefTypeAll:
 		return true // shouldn't happen since RefID should be null for "all"
 	default:
 		return false
 	}
 }
-
-func CalculateDiscountedItemPrice(originalPrice int64, discount db.PromotionDiscount) int64 {
-	discountedPrice := originalPrice
-
-	// If the order is apply to specific item and original price is less than the minimum spend, return the original price
-	if !discount.OrderWide && originalPrice < discount.MinSpend {
-		return originalPrice
-	}
-
-	if discount.DiscountPercent.Valid {
-		discountAmount := originalPrice * int64(discount.DiscountPercent.Int32) / 100
-		discountedPrice -= min(discountAmount, discount.MaxDiscount)
-	} else if discount.DiscountPrice.Valid {
-		discountedPrice -= min(discount.DiscountPrice.Int64, discount.MaxDiscount)
-	}
-
-	if discountedPrice < 0 {
-		return 0
-	}
-	return discountedPrice
-}
-
-//func CaculateDiscountedOrderPrice
@@ -0,0 +1,206 @@
+package promotionmodel
+
+import "shopnexus-remastered/internal/db"
+
+// PromotionSource is who runs a promotion
+type PromotionSource string
+
+const (
+	PromotionSourceVendor   PromotionSource = "Vendor"
+	PromotionSourcePlatform PromotionSource = "Platform"
+)
+
+// SourceOf returns the source of the promotion, system promotions are the platform's
+func SourceOf(promo db.PromotionBase) PromotionSource {
+	if promo.OwnerID.Valid {
+		return PromotionSourceVendor
+	}
+	return PromotionSourcePlatform
+}
+
+// StackingPolicy is how discounts combine on one price
+type StackingPolicy struct {
+	MaxVendor   int               // Vendor discounts stacked on one price
+	MaxPlatform int               // Platform discounts stacked on one price
+	Order       []PromotionSource // Sources in the order they apply, each on the price left by the previous. Missing sources never apply.
+}
+
+// DefaultStackingPolicy takes the best vendor discount, then the best platform discount on what is left
+var DefaultStackingPolicy = StackingPolicy{
+	MaxVendor:   1,
+	MaxPlatform: 1,
+	Order:       []PromotionSource{PromotionSourceVendor, PromotionSourcePlatform},
+}
+
+func (p StackingPolicy) maxOf(source PromotionSource) int {
+	switch source {
+	case PromotionSourceVendor:
+		return p.MaxVendor
+	case PromotionSourcePlatform:
+		return p.MaxPlatform
+	default:
+		return 0
+	}
+}
+
+// RejectReason is why a promotion targeting an item was not applied to its price
+type RejectReason string
+
+const (
+	RejectQuotaExhausted RejectReason = "quota_exhausted" // All the units of the promotion quota are sold
+	RejectNoSaving       RejectReason = "no_saving"       // The promotion does not lower the price, e.g. its min spend is not reached
+	RejectStackingLimit  RejectReason = "stacking_limit"  // Enough promotions of its source are applied already
+	RejectExclusive      RejectReason = "exclusive"       // An exclusive promotion alone gives a lower price
+	RejectOutperformed   RejectReason = "outperformed"    // The promotion is exclusive and other promotions give a lower price
+)
+
+// ItemDiscount is a discount promotion targeting an item
+type ItemDiscount struct {
+	Promotion db.PromotionBase
+	Discount  db.PromotionDiscount
+	Exhausted bool // No unit left in the quota of the promotion
+}
+
+// ResolvedPromotion is a promotion applied to a price
+type ResolvedPromotion struct {
+	Promotion db.PromotionBase `json:"promotion"`
+	Source    PromotionSource  `json:"source"`
+	Breakdown PriceBreakdown   `json:"breakdown"` // On the price left by the promotions applied before it
+}
+
+// RejectedPromotion is a promotion targeting an item that was not applied to its price
+type RejectedPromotion struct {
+	Promotion db.PromotionBase `json:"promotion"`
+	Reason    RejectReason     `json:"reason"`
+}
+
+// ResolvedPrice is the price of an item after the promotions applied to it
+type ResolvedPrice struct {
+	Original int64               `json:"original"`
+	Final    int64               `json:"final"`
+	Applied  []ResolvedPromotion `json:"applied"` // In the order they apply
+	Rejected []RejectedPromotion `json:"rejected"`
+}
+
+// ResolveItemPrice applies the discounts targeting an item to its unit price, following the policy:
+//   - Each source in the policy order takes its best discounts, up to its limit, each on the price left by the previous ones
+//   - An exclusive discount applies alone, it is taken instead when it gives a lower price than the stacked ones
+//
+// Order-wide discounts leave the unit price as is, they are rejected with no saving.
+func ResolveItemPrice(price int64, discounts []ItemDiscount, policy StackingPolicy) ResolvedPrice {
+	result := ResolvedPrice{
+		Original: price,
+		Final:    price,
+		Applied:  []ResolvedPromotion{},
+		Rejected: []RejectedPromotion{},
+	}
+	reject := func(discount ItemDiscount, reason RejectReason) {
+		result.Rejected = append(result.Rejected, RejectedPromotion{
+			Promotion: discount.Promotion,
+			Reason:    reason,
+		})
+	}
+
+	var available []ItemDiscount
+	for _, discount := range discounts {
+		switch {
+		case discount.Exhausted:
+			reject(discount, RejectQuotaExhausted)
+		case CalculateDiscountedItemPrice(price, discount.Discount).Discount <= 0:
+			reject(discount, RejectNoSaving)
+		default:
+			available = append(available, discount)
+		}
+	}
+
+	stacked, used := stackDiscounts(price, available, policy)
+	stackedPrice := price
+	if len(stacked) > 0 {
+		stackedPrice = stacked[len(stacked)-1].Breakdown.Final
+	}
+
+	// The best exclusive discount alone, ties go to the stacked discounts
+	exclusive := -1
+	exclusivePrice := stackedPrice
+	for i, discount := range available {
+		if !discount.Promotion.Exclusive {
+			continue
+		}
+		if final := CalculateDiscountedItemPrice(price, discount.Discount).Final; final < exclusivePrice {
+			exclusive = i
+			exclusivePrice = final
+		}
+	}
+
+	if exclusive >= 0 {
+		discount := available[exclusive]
+		result.Applied = append(result.Applied, ResolvedPromotion{
+			Promotion: discount.Promotion,
+			Source:    SourceOf(discount.Promotion),
+			Breakdown: CalculateDiscountedItemPrice(price, discount.Discount),
+		})
+		result.Final = exclusivePrice
+		for i, discount := range available {
+			switch {
+			case i == exclusive:
+			case discount.Promotion.Exclusive:
+				reject(discount, RejectOutperformed)
+			default:
+				reject(discount, RejectExclusive)
+			}
+		}
+		return result
+	}
+
+	result.Applied = append(result.Applied, stacked...)
+	result.Final = stackedPrice
+	for i, discount := range available {
+		switch {
+		case used[i]:
+		case discount.Promotion.Exclusive:
+			reject(discount, RejectOutperformed)
+		case CalculateDiscountedItemPrice(stackedPrice, discount.Discount).Discount <= 0:
+			// It would save something on the original price, but not on what is left after the others
+			reject(discount, RejectNoSaving)
+		default:
+			reject(discount, RejectStackingLimit)
+		}
+	}
+	return result
+}
+
+// stackDiscounts applies the non-exclusive discounts source by source in the policy order, the best saving first,
+// it returns the applied discounts and which of the discounts were used
+func stackDiscounts(price int64, discounts []ItemDiscount, policy StackingPolicy) ([]ResolvedPromotion, []bool) {
+	var result []ResolvedPromotion
+	used := make([]bool, len(discounts))
+
+	for _, source := range policy.Order {
+		for range policy.maxOf(source) {
+			best := -1
+			var bestBreakdown PriceBreakdown
+			for i, discount := range discounts {
+				if used[i] || discount.Promotion.Exclusive || SourceOf(discount.Promotion) != source {
+					continue
+				}
+				if breakdown := CalculateDiscountedItemPrice(price, discount.Discount); breakdown.Discount > bestBreakdown.Discount {
+					best = i
+					bestBreakdown = breakdown
+				}
+			}
+			if best < 0 {
+				break
+			}
+
+			used[best] = true
+			result = append(result, ResolvedPromotion{
+				Promotion: discounts[best].Promotion,
+				Source:    source,
+				Breakdown: bestBreakdown,
+			})
+			price = bestBreakdown.Final
+		}
+	}
+
+	return result, used
+}
@@ -0,0 +1,282 @@
+package promotionmodel
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"shopnexus-remastered/internal/db"
+)
+
+// maxScheduleSearch bounds how far ahead a window start is searched, a cron expression that never matches
+// (e.g. "0 0 31 2 *") gives up after it
+const maxScheduleSearch = 5 * 366 * 24 * time.Hour
+
+// locations caches the timezones of the schedules, map[name]*time.Location. Schedules are parsed for every promotion
+// on every price, loading the timezone each time reads the tz database.
+var locations sync.Map
+
+// loadLocation returns the IANA timezone of the name, loaded once
+func loadLocation(name string) (*time.Location, error) {
+	if location, ok := locations.Load(name); ok {
+		return location.(*time.Location), nil
+	}
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, err
+	}
+	locations.Store(name, location)
+	return location, nil
+}
+
+// Schedule is a recurring flash sale: a window of Duration opens at every time matching the cron expression in Location
+type Schedule struct {
+	cron     cronExpr
+	Location *time.Location
+	Duration time.Duration
+}
+
+// ScheduleWindow is a period the promotion of a schedule applies in
+type ScheduleWindow struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// ParseSchedule parses a 5-field cron expression (minute hour day-of-month month day-of-week) evaluated in the IANA timezone,
+// each window lasting duration minutes
+func ParseSchedule(expr string, tz string, duration int32) (Schedule, error) {
+	var zero Schedule
+
+	cron, err := parseCron(expr)
+	if err != nil {
+		return zero, err
+	}
+	location, err := loadLocation(tz)
+	if err != nil {
+		return zero, fmt.Errorf("invalid timezone %q: %w", tz, err)
+	}
+	if duration <= 0 {
+		return zero, fmt.Errorf("schedule duration must be positive")
+	}
+
+	return Schedule{
+		cron:     cron,
+		Location: location,
+		Duration: time.Duration(duration) * time.Minute,
+	}, nil
+}
+
+// PromotionSchedule returns the schedule of the promotion, false if it has none or it is invalid
+func PromotionSchedule(promo db.PromotionBase) (Schedule, bool) {
+	if !promo.ScheduleStart.Valid || !promo.ScheduleTz.Valid || !promo.ScheduleDuration.Valid {
+		return Schedule{}, false
+	}
+	schedule, err := ParseSchedule(promo.ScheduleStart.String, promo.ScheduleTz.String, promo.ScheduleDuration.Int32)
+	if err != nil {
+		return Schedule{}, false
+	}
+	return schedule, true
+}
+
+// Next returns the first window start strictly after t, false if there is none in the search range
+func (s Schedule) Next(t time.Time) (time.Time, bool) {
+	return s.cron.next(t.In(s.Location), t.Add(maxScheduleSearch))
+}
+
+// WindowAt returns the window open at t, false if t is outside every window
+func (s Schedule) WindowAt(t time.Time) (ScheduleWindow, bool) {
+	// The window open at t is the one starting last in (t - duration, t]
+	start, ok := s.Next(t.Add(-s.Duration))
+	if !ok || start.After(t) {
+		return ScheduleWindow{}, false
+	}
+	for {
+		next, ok := s.Next(start)
+		if !ok || next.After(t) {
+			break
+		}
+		start = next
+	}
+	return ScheduleWindow{Start: start, End: start.Add(s.Duration)}, true
+}
+
+// Windows returns up to count windows open at t or starting after it
+func (s Schedule) Windows(t time.Time, count int) []ScheduleWindow {
+	var result []ScheduleWindow
+	from := t
+	if window, ok := s.WindowAt(t); ok {
+		result = append(result, window)
+		from = window.Start
+	}
+	for len(result) < count {
+		start, ok := s.Next(from)
+		if !ok {
+			break
+		}
+		result = append(result, ScheduleWindow{Start: start, End: start.Add(s.Duration)})
+		from = start
+	}
+	return result
+}
+
+// IsPromotionInSchedule reports whether the promotion applies at t: always for promotions without a schedule,
+// only inside a window for scheduled ones. Promotions with an invalid schedule never apply.
+func IsPromotionInSchedule(promo db.PromotionBase, t time.Time) bool {
+	if !promo.ScheduleStart.Valid && !promo.ScheduleTz.Valid && !promo.ScheduleDuration.Valid {
+		return true
+	}
+	schedule, ok := PromotionSchedule(promo)
+	if !ok {
+		return false
+	}
+	_, ok = schedule.WindowAt(t)
+	return ok
+}
+
+// FilterPromotionsInSchedule keeps the promotions that apply at t
+func FilterPromotionsInSchedule(promos []db.PromotionBase, t time.Time) []db.PromotionBase {
+	result := make([]db.PromotionBase, 0, len(promos))
+	for _, promo := range promos {
+		if IsPromotionInSchedule(promo, t) {
+			result = append(result, promo)
+		}
+	}
+	return result
+}
+
+// cronExpr is a parsed cron expression, each field being the set of values it matches
+type cronExpr struct {
+	minute, hour, dom, month, dow uint64
+	domAny, dowAny                bool // Whether the day fields start with "*", cron matches either day field when both are restricted
+}
+
+// cronField is the range of a cron field
+type cronField struct {
+	name     string
+	min, max int
+}
+
+var (
+	cronMinute = cronField{"minute", 0, 59}
+	cronHour   = cronField{"hour", 0, 23}
+	cronDom    = cronField{"day of month", 1, 31}
+	cronMonth  = cronField{"month", 1, 12}
+	cronDow    = cronField{"day of week", 0, 7} // 0 and 7 are both Sunday
+)
+
+func parseCron(expr string) (cronExpr, error) {
+	var zero cronExpr
+
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return zero, fmt.Errorf("cron expression %q must have 5 fields", expr)
+	}
+
+	var (
+		result cronExpr
+		err    error
+	)
+	if result.minute, err = parseCronField(fields[0], cronMinute); err != nil {
+		return zero, err
+	}
+	if result.hour, err = parseCronField(fields[1], cronHour); err != nil {
+		return zero, err
+	}
+	if result.dom, err = parseCronField(fields[2], cronDom); err != nil {
+		return zero, err
+	}
+	if result.month, err = parseCronField(fields[3], cronMonth); err != nil {
+		return zero, err
+	}
+	if result.dow, err = parseCronField(fields[4], cronDow); err != nil {
+		return zero, err
+	}
+	if result.dow&(1<<7) != 0 {
+		result.dow |= 1
+	}
+	result.domAny = strings.HasPrefix(fields[2], "*")
+	result.dowAny = strings.HasPrefix(fields[4], "*")
+
+	return result, nil
+}
+
+// parseCronField parses a comma separated list of "*", "n" or "n-m", each optionally followed by "/step"
+func parseCronField(field string, spec cronField) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+
+		step := 1
+		if hasStep {
+			n, err := strconv.Atoi(stepPart)
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("invalid step %q in cron %s field", stepPart, spec.name)
+			}
+			step = n
+		}
+
+		low, high := spec.min, spec.max
+		if rangePart != "*" {
+			lowPart, highPart, isRange := strings.Cut(rangePart, "-")
+			n, err := strconv.Atoi(lowPart)
+			if err != nil {
+				return 0, fmt.Errorf("invalid value %q in cron %s field", lowPart, spec.name)
+			}
+			low, high = n, n
+			if isRange {
+				if high, err = strconv.Atoi(highPart); err != nil {
+					return 0, fmt.Errorf("invalid value %q in cron %s field", highPart, spec.name)
+				}
+			} else if hasStep {
+				high = spec.max // "n/step" means from n to the end of the range
+			}
+		}
+		if low < spec.min || high > spec.max || low > high {
+			return 0, fmt.Errorf("value out of range in cron %s field %q", spec.name, part)
+		}
+
+		for v := low; v <= high; v += step {
+			bits |= 1 << v
+		}
+	}
+	return bits, nil
+}
+
+// next returns the first time matching the expression strictly after t, in the location of t, giving up after limit
+func (c cronExpr) next(t time.Time, limit time.Time) (time.Time, bool) {
+	loc := t.Location()
+	t = t.Truncate(time.Minute).Add(time.Minute)
+
+	// Jump to the start of the next matching month, day, hour then minute, restarting whenever a field wraps
+	for t.Before(limit) {
+		if c.month&(1<<int(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !c.matchDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if c.hour&(1<<t.Hour()) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			continue
+		}
+		if c.minute&(1<<t.Minute()) == 0 {
+			t = t.Truncate(time.Minute).Add(time.Minute)
+			continue
+		}
+		return t, true
+	}
+	return time.Time{}, false
+}
+
+func (c cronExpr) matchDay(t time.Time) bool {
+	domMatch := c.dom&(1<<t.Day()) != 0
+	dowMatch := c.dow&(1<<int(t.Weekday())) != 0
+	if c.domAny || c.dowAny {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
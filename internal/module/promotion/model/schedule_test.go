@@ -0,0 +1,201 @@
+package promotionmodel
+
+import (
+	"testing"
+	"time"
+)
+
+func bitsOf(values ...int) uint64 {
+	var bits uint64
+	for _, v := range values {
+		bits |= 1 << v
+	}
+	return bits
+}
+
+func bitsRange(low, high, step int) uint64 {
+	var bits uint64
+	for v := low; v <= high; v += step {
+		bits |= 1 << v
+	}
+	return bits
+}
+
+func TestParseCronField(t *testing.T) {
+	tests := []struct {
+		name    string
+		field   string
+		spec    cronField
+		want    uint64
+		wantErr bool
+	}{
+		{name: "any", field: "*", spec: cronMinute, want: bitsRange(0, 59, 1)},
+		{name: "value", field: "5", spec: cronMinute, want: bitsOf(5)},
+		{name: "list", field: "1,15,30", spec: cronMinute, want: bitsOf(1, 15, 30)},
+		{name: "range", field: "9-17", spec: cronHour, want: bitsRange(9, 17, 1)},
+		{name: "any with step", field: "*/15", spec: cronMinute, want: bitsOf(0, 15, 30, 45)},
+		{name: "range with step", field: "1-10/3", spec: cronDom, want: bitsOf(1, 4, 7, 10)},
+		{name: "value with step runs to the end", field: "10/20", spec: cronMinute, want: bitsOf(10, 30, 50)},
+		{name: "list of ranges", field: "1-2,5-6", spec: cronMonth, want: bitsOf(1, 2, 5, 6)},
+		{name: "sunday as 7", field: "7", spec: cronDow, want: bitsOf(7)},
+		{name: "value above max", field: "60", spec: cronMinute, wantErr: true},
+		{name: "value below min", field: "0", spec: cronDom, wantErr: true},
+		{name: "reversed range", field: "5-1", spec: cronHour, wantErr: true},
+		{name: "zero step", field: "*/0", spec: cronMinute, wantErr: true},
+		{name: "negative step", field: "*/-1", spec: cronMinute, wantErr: true},
+		{name: "not a number", field: "a", spec: cronMinute, wantErr: true},
+		{name: "empty", field: "", spec: cronMinute, wantErr: true},
+		{name: "empty list item", field: "1,", spec: cronMinute, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseCronField(tt.field, tt.spec)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseCronField(%q) error = nil, want an error", tt.field)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseCronField(%q) error = %v", tt.field, err)
+			}
+			if got != tt.want {
+				t.Errorf("parseCronField(%q) = %b, want %b", tt.field, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseCron(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		wantErr bool
+	}{
+		{name: "every minute", expr: "* * * * *"},
+		{name: "extra spaces", expr: " 0  12 * * 1-5 "},
+		{name: "too few fields", expr: "0 12 * *", wantErr: true},
+		{name: "too many fields", expr: "0 0 12 * * *", wantErr: true},
+		{name: "invalid field", expr: "0 24 * * *", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseCron(tt.expr)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseCron(%q) error = %v, wantErr %v", tt.expr, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCronNext(t *testing.T) {
+	// 2025-01-01 is a Wednesday
+	date := func(month time.Month, day, hour, minute int) time.Time {
+		return time.Date(2025, month, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name   string
+		expr   string
+		from   time.Time
+		want   time.Time
+		wantOk bool
+	}{
+		{name: "next minute", expr: "* * * * *", from: date(1, 1, 10, 0), want: date(1, 1, 10, 1), wantOk: true},
+		{name: "strictly after", expr: "0 12 * * *", from: date(1, 1, 12, 0), want: date(1, 2, 12, 0), wantOk: true},
+		{name: "seconds are dropped", expr: "30 10 * * *", from: date(1, 1, 10, 29).Add(59 * time.Second), want: date(1, 1, 10, 30), wantOk: true},
+		{name: "later the same day", expr: "0 20 * * *", from: date(1, 1, 12, 0), want: date(1, 1, 20, 0), wantOk: true},
+		{name: "minute step", expr: "*/15 * * * *", from: date(1, 1, 10, 16), want: date(1, 1, 10, 30), wantOk: true},
+		{name: "hour wraps to the next day", expr: "0 9 * * *", from: date(1, 1, 23, 30), want: date(1, 2, 9, 0), wantOk: true},
+		{name: "month wraps to the next year", expr: "0 0 1 1 *", from: date(1, 1, 0, 0), want: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), wantOk: true},
+		{name: "day of week", expr: "0 0 * * 5", from: date(1, 1, 0, 0), want: date(1, 3, 0, 0), wantOk: true},
+		{name: "sunday as 7", expr: "0 0 * * 7", from: date(1, 1, 0, 0), want: date(1, 5, 0, 0), wantOk: true},
+		{name: "sunday as 0", expr: "0 0 * * 0", from: date(1, 1, 0, 0), want: date(1, 5, 0, 0), wantOk: true},
+		{name: "day of month", expr: "0 0 15 * *", from: date(1, 1, 0, 0), want: date(1, 15, 0, 0), wantOk: true},
+		{name: "both day fields restricted match either", expr: "0 0 15 * 5", from: date(1, 1, 0, 0), want: date(1, 3, 0, 0), wantOk: true},
+		{name: "both day fields restricted, day of month first", expr: "0 0 2 * 5", from: date(1, 1, 0, 0), want: date(1, 2, 0, 0), wantOk: true},
+		{name: "day of month step is unrestricted", expr: "0 0 */10 * 5", from: date(1, 1, 0, 0), want: date(1, 31, 0, 0), wantOk: true},
+		{name: "day of week step with day of month", expr: "0 0 15 * */1", from: date(1, 1, 0, 0), want: date(1, 15, 0, 0), wantOk: true},
+		{name: "skips short months", expr: "0 0 31 * *", from: date(2, 1, 0, 0), want: date(3, 31, 0, 0), wantOk: true},
+		{name: "leap day", expr: "0 0 29 2 *", from: date(1, 1, 0, 0), want: time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC), wantOk: true},
+		{name: "never matches", expr: "0 0 31 2 *", from: date(1, 1, 0, 0), wantOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cron, err := parseCron(tt.expr)
+			if err != nil {
+				t.Fatalf("parseCron(%q) error = %v", tt.expr, err)
+			}
+			got, ok := cron.next(tt.from, tt.from.Add(maxScheduleSearch))
+			if ok != tt.wantOk {
+				t.Fatalf("next(%q, %v) ok = %v, want %v", tt.expr, tt.from, ok, tt.wantOk)
+			}
+			if ok && !got.Equal(tt.want) {
+				t.Errorf("next(%q, %v) = %v, want %v", tt.expr, tt.from, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScheduleWindowAt(t *testing.T) {
+	// Daily flash sale at 20:00 in Ho Chi Minh City (UTC+7) for 2 hours
+	schedule, err := ParseSchedule("0 20 * * *", "Asia/Ho_Chi_Minh", 120)
+	if err != nil {
+		t.Fatalf("ParseSchedule() error = %v", err)
+	}
+	start := time.Date(2025, 1, 1, 13, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		at     time.Time
+		wantOk bool
+	}{
+		{name: "before the window", at: start.Add(-time.Minute)},
+		{name: "window start", at: start, wantOk: true},
+		{name: "inside the window", at: start.Add(time.Hour), wantOk: true},
+		{name: "window end", at: start.Add(2 * time.Hour)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			window, ok := schedule.WindowAt(tt.at)
+			if ok != tt.wantOk {
+				t.Fatalf("WindowAt(%v) ok = %v, want %v", tt.at, ok, tt.wantOk)
+			}
+			if ok && (!window.Start.Equal(start) || !window.End.Equal(start.Add(2*time.Hour))) {
+				t.Errorf("WindowAt(%v) = %+v, want %v to %v", tt.at, window, start, start.Add(2*time.Hour))
+			}
+		})
+	}
+}
+
+func TestParseSchedule(t *testing.T) {
+	tests := []struct {
+		name     string
+		expr     string
+		tz       string
+		duration int32
+		wantErr  bool
+	}{
+		{name: "valid", expr: "0 20 * * *", tz: "Asia/Ho_Chi_Minh", duration: 60},
+		{name: "same timezone again", expr: "0 8 * * 1", tz: "Asia/Ho_Chi_Minh", duration: 30},
+		{name: "invalid timezone", expr: "0 20 * * *", tz: "Mars/Olympus", duration: 60, wantErr: true},
+		{name: "invalid cron", expr: "0 20 * *", tz: "UTC", duration: 60, wantErr: true},
+		{name: "zero duration", expr: "0 20 * * *", tz: "UTC", duration: 0, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := ParseSchedule(tt.expr, tt.tz, tt.duration)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseSchedule() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && schedule.Location.String() != tt.tz {
+				t.Errorf("Location = %v, want %v", schedule.Location, tt.tz)
+			}
+		})
+	}
+}
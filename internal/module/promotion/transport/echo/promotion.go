@@ -1,19 +1,529 @@
-package catalogecho
+package promotionecho
 
 import (
-	catalogbiz "shopnexus-remastered/internal/module/catalog/biz"
+	"errors"
+	"net/http"
+	"time"
+
+	"shopnexus-remastered/config"
+	"shopnexus-remastered/internal/db"
+	authbiz "shopnexus-remastered/internal/module/auth/biz"
+	promotionbiz "shopnexus-remastered/internal/module/promotion/biz"
+	sharedmodel "shopnexus-remastered/internal/module/shared/model"
+	"shopnexus-remastered/internal/module/shared/transport/echo/middleware"
+	"shopnexus-remastered/internal/module/shared/transport/echo/response"
 
 	"github.com/labstack/echo/v4"
 )
 
+// systemOwnerKey marks requests managing system promotions instead of the promotions of the vendor
+const systemOwnerKey = "promotion.system"
+
 type Handler struct {
-	biz *catalogbiz.CatalogBiz
+	biz *promotionbiz.PromotionBiz
 }
 
-func NewHandler(e *echo.Echo, catalogbiz *catalogbiz.CatalogBiz) *Handler {
-	h := &Handler{biz: catalogbiz}
-	api := e.Group("/api/v1/catalog")
-	_ = api
+func NewHandler(e *echo.Echo, biz *promotionbiz.PromotionBiz, cfg *config.Config) *Handler {
+	h := &Handler{
+		biz: biz,
+	}
+
+	// Promotions of the vendor
+	api := e.Group("/api/v1/promotion")
+	api.POST("", h.CreatePromotion)
+	api.POST("/preview", h.PreviewPromotion)
+	api.GET("", h.ListPromotion)
+	api.GET("/:id", h.GetPromotion)
+	api.PATCH("/:id", h.UpdatePromotion)
+	api.POST("/:id/deactivate", h.DeactivatePromotion)
+	api.GET("/:id/windows", h.GetPromotionWindows)
+
+	// System promotions, managed by the back office
+	system := api.Group("/system", middleware.RequireAdminToken(cfg.App.AdminToken), markSystemOwner)
+	system.POST("", h.CreatePromotion)
+	system.POST("/preview", h.PreviewPromotion)
+	system.GET("", h.ListPromotion)
+	system.GET("/:id", h.GetPromotion)
+	system.PATCH("/:id", h.UpdatePromotion)
+	system.POST("/:id/deactivate", h.DeactivatePromotion)
 
 	return h
 }
+
+// markSystemOwner makes the requests of the back office manage system promotions
+func markSystemOwner(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		c.Set(systemOwnerKey, true)
+		return next(c)
+	}
+}
+
+// getOwner returns the owner of the promotions the request manages: the vendor, or nil for system promotions
+func (h *Handler) getOwner(c echo.Context) (*int64, int, error) {
+	if system, _ := c.Get(systemOwnerKey).(bool); system {
+		return nil, 0, nil
+	}
+
+	claims, err := authbiz.GetClaims(c.Request())
+	if err != nil {
+		return nil, http.StatusUnauthorized, err
+	}
+	if claims.Type != db.AccountTypeVendor {
+		return nil, http.StatusForbidden, errors.New("only vendors can manage promotions")
+	}
+
+	vendorID := claims.AccountID()
+	return &vendorID, 0, nil
+}
+
+type DiscountRequest struct {
+	OrderWide       bool   `json:"order_wide"`
+	MinSpend        int64  `json:"min_spend" validate:"gte=0"`
+	MaxDiscount     int64  `json:"max_discount" validate:"gte=0"`
+	DiscountPercent *int32 `json:"discount_percent" validate:"omitempty,gt=0,lte=100"`
+	DiscountPrice   *int64 `json:"discount_price" validate:"omitempty,gt=0"`
+}
+
+type BundleItemRequest struct {
+	SkuID    int64 `json:"sku_id" validate:"required,gt=0"`
+	Quantity int64 `json:"quantity" validate:"required,gt=0"`
+}
+
+type BundleRequest struct {
+	Price int64               `json:"price" validate:"required,gt=0"`
+	Items []BundleItemRequest `json:"items" validate:"required,min=1,max=50,dive"`
+}
+
+type BuyXGetYRequest struct {
+	BuyQuantity int64 `json:"buy_quantity" validate:"required,gt=0"`
+	GetQuantity int64 `json:"get_quantity" validate:"required,gt=0"`
+}
+
+type CashbackRequest struct {
+	MinSpend        int64  `json:"min_spend" validate:"gte=0"`
+	MaxCashback     int64  `json:"max_cashback" validate:"gte=0"`
+	CashbackPercent *int32 `json:"cashback_percent" validate:"omitempty,gt=0,lte=100"`
+	CashbackPrice   *int64 `json:"cashback_price" validate:"omitempty,gt=0"`
+}
+
+type ScheduleRequest struct {
+	Cron     string `json:"cron" validate:"required,max=100"`
+	Timezone string `json:"timezone" validate:"required,max=64"`
+	Duration int32  `json:"duration" validate:"required,gt=0"` // Minutes
+}
+
+type VoucherRequest struct {
+	UsageLimit       int64 `json:"usage_limit" validate:"gte=0"`        // 0 means no limit
+	PerCustomerLimit int64 `json:"per_customer_limit" validate:"gte=0"` // 0 means no limit
+	FirstOrderOnly   bool  `json:"first_order_only"`
+	MinSpend         int64 `json:"min_spend" validate:"gte=0"`
+}
+
+type CreatePromotionRequest struct {
+	Code        string              `json:"code" validate:"required,max=100"`
+	RefType     db.PromotionRefType `json:"ref_type" validate:"required,oneof=All ProductSpu ProductSku Category Brand Tag"`
+	RefID       *int64              `json:"ref_id" validate:"omitempty,gt=0"`
+	Type        db.PromotionType    `json:"type" validate:"required,oneof=Discount Bundle BuyXGetY Cashback"`
+	Title       string              `json:"title" validate:"required,max=255"`
+	Description *string             `json:"description" validate:"omitempty,max=1000"`
+	Exclusive   bool                `json:"exclusive"`
+	DateStarted *time.Time          `json:"date_started"`
+	DateEnded   *time.Time          `json:"date_ended"`
+	Schedule    *ScheduleRequest    `json:"schedule"`
+	Voucher     *VoucherRequest     `json:"voucher"` // Only orders entering the code get the promotion
+	Discount    *DiscountRequest    `json:"discount"`
+	Bundle      *BundleRequest      `json:"bundle"`
+	BuyXGetY    *BuyXGetYRequest    `json:"buy_x_get_y"`
+	Cashback    *CashbackRequest    `json:"cashback"`
+}
+
+func (h *Handler) CreatePromotion(c echo.Context) error {
+	var req CreatePromotionRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	ownerID, status, err := h.getOwner(c)
+	if err != nil {
+		return response.FromError(c.Response().Writer, status, err)
+	}
+
+	params := promotionbiz.CreatePromotionParams{
+		OwnerID:     ownerID,
+		Code:        req.Code,
+		RefType:     req.RefType,
+		RefID:       req.RefID,
+		Type:        req.Type,
+		Title:       req.Title,
+		Description: req.Description,
+		Exclusive:   req.Exclusive,
+		DateStarted: req.DateStarted,
+		DateEnded:   req.DateEnded,
+		Schedule:    toScheduleParams(req.Schedule),
+	}
+	if req.Voucher != nil {
+		params.Voucher = &promotionbiz.VoucherParams{
+			UsageLimit:       req.Voucher.UsageLimit,
+			PerCustomerLimit: req.Voucher.PerCustomerLimit,
+			FirstOrderOnly:   req.Voucher.FirstOrderOnly,
+			MinSpend:         req.Voucher.MinSpend,
+		}
+	}
+	if req.Discount != nil {
+		params.Discount = &promotionbiz.DiscountParams{
+			OrderWide:       req.Discount.OrderWide,
+			MinSpend:        req.Discount.MinSpend,
+			MaxDiscount:     req.Discount.MaxDiscount,
+			DiscountPercent: req.Discount.DiscountPercent,
+			DiscountPrice:   req.Discount.DiscountPrice,
+		}
+	}
+	if req.Bundle != nil {
+		params.Bundle = &promotionbiz.BundleParams{
+			Price: req.Bundle.Price,
+			Items: toBundleItemParams(req.Bundle.Items),
+		}
+	}
+	if req.BuyXGetY != nil {
+		params.BuyXGetY = &promotionbiz.BuyXGetYParams{
+			BuyQuantity: req.BuyXGetY.BuyQuantity,
+			GetQuantity: req.BuyXGetY.GetQuantity,
+		}
+	}
+	if req.Cashback != nil {
+		params.Cashback = &promotionbiz.CashbackParams{
+			MinSpend:        req.Cashback.MinSpend,
+			MaxCashback:     req.Cashback.MaxCashback,
+			CashbackPercent: req.Cashback.CashbackPercent,
+			CashbackPrice:   req.Cashback.CashbackPrice,
+		}
+	}
+
+	result, err := h.biz.CreatePromotion(c.Request().Context(), params)
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusCreated, result)
+}
+
+type PreviewPromotionRequest struct {
+	sharedmodel.PaginationParams
+	RefType   db.PromotionRefType `json:"ref_type" validate:"required,oneof=All ProductSpu ProductSku Category Brand Tag"`
+	RefID     *int64              `json:"ref_id" validate:"omitempty,gt=0"`
+	Exclusive bool                `json:"exclusive"`
+	Discount  DiscountRequest     `json:"discount"`
+}
+
+// PreviewPromotion prices the SKUs a draft discount would target before it is created, nothing is saved
+func (h *Handler) PreviewPromotion(c echo.Context) error {
+	var req PreviewPromotionRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+	// Bind skips the query string of POST requests, the pagination is there
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	ownerID, status, err := h.getOwner(c)
+	if err != nil {
+		return response.FromError(c.Response().Writer, status, err)
+	}
+
+	result, err := h.biz.PreviewPromotion(c.Request().Context(), promotionbiz.PreviewPromotionParams{
+		PaginationParams: req.PaginationParams,
+		OwnerID:          ownerID,
+		RefType:          req.RefType,
+		RefID:            req.RefID,
+		Exclusive:        req.Exclusive,
+		Discount: promotionbiz.DiscountParams{
+			OrderWide:       req.Discount.OrderWide,
+			MinSpend:        req.Discount.MinSpend,
+			MaxDiscount:     req.Discount.MaxDiscount,
+			DiscountPercent: req.Discount.DiscountPercent,
+			DiscountPrice:   req.Discount.DiscountPrice,
+		},
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromPaginate(c.Response().Writer, result)
+}
+
+type ListPromotionRequest struct {
+	sharedmodel.PaginationParams
+	IsActive []bool `query:"is_active" comma_separated:"true" validate:"omitempty,dive"`
+}
+
+func (h *Handler) ListPromotion(c echo.Context) error {
+	var req ListPromotionRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	ownerID, status, err := h.getOwner(c)
+	if err != nil {
+		return response.FromError(c.Response().Writer, status, err)
+	}
+
+	result, err := h.biz.ListPromotion(c.Request().Context(), promotionbiz.ListPromotionParams{
+		PaginationParams: req.PaginationParams,
+		OwnerID:          ownerID,
+		IsActive:         req.IsActive,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromPaginate(c.Response().Writer, result)
+}
+
+type GetPromotionRequest struct {
+	ID int64 `param:"id" validate:"required,gt=0"`
+}
+
+func (h *Handler) GetPromotion(c echo.Context) error {
+	var req GetPromotionRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	ownerID, status, err := h.getOwner(c)
+	if err != nil {
+		return response.FromError(c.Response().Writer, status, err)
+	}
+
+	result, err := h.biz.GetPromotion(c.Request().Context(), promotionbiz.GetPromotionParams{
+		OwnerID: ownerID,
+		ID:      req.ID,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, result)
+}
+
+type UpdateDiscountRequest struct {
+	OrderWide       *bool  `json:"order_wide"`
+	MinSpend        *int64 `json:"min_spend" validate:"omitempty,gte=0"`
+	MaxDiscount     *int64 `json:"max_discount" validate:"omitempty,gte=0"`
+	DiscountPercent *int32 `json:"discount_percent" validate:"omitempty,gt=0,lte=100"`
+	DiscountPrice   *int64 `json:"discount_price" validate:"omitempty,gt=0"`
+}
+
+type UpdateBundleRequest struct {
+	Price *int64              `json:"price" validate:"omitempty,gt=0"`
+	Items []BundleItemRequest `json:"items" validate:"omitempty,min=1,max=50,dive"` // Replaces the items, omit to keep them
+}
+
+type UpdateBuyXGetYRequest struct {
+	BuyQuantity *int64 `json:"buy_quantity" validate:"omitempty,gt=0"`
+	GetQuantity *int64 `json:"get_quantity" validate:"omitempty,gt=0"`
+}
+
+type UpdateCashbackRequest struct {
+	MinSpend        *int64 `json:"min_spend" validate:"omitempty,gte=0"`
+	MaxCashback     *int64 `json:"max_cashback" validate:"omitempty,gte=0"`
+	CashbackPercent *int32 `json:"cashback_percent" validate:"omitempty,gt=0,lte=100"`
+	CashbackPrice   *int64 `json:"cashback_price" validate:"omitempty,gt=0"`
+}
+
+type UpdateVoucherRequest struct {
+	UsageLimit       *int64 `json:"usage_limit" validate:"omitempty,gte=0"`
+	PerCustomerLimit *int64 `json:"per_customer_limit" validate:"omitempty,gte=0"`
+	FirstOrderOnly   *bool  `json:"first_order_only"`
+	MinSpend         *int64 `json:"min_spend" validate:"omitempty,gte=0"`
+}
+
+type UpdatePromotionRequest struct {
+	ID            int64                  `param:"id" validate:"required,gt=0"`
+	RefType       *db.PromotionRefType   `json:"ref_type" validate:"omitempty,oneof=All ProductSpu ProductSku Category Brand Tag"`
+	RefID         *int64                 `json:"ref_id" validate:"omitempty,gt=0"`
+	Title         *string                `json:"title" validate:"omitempty,max=255"`
+	Description   *string                `json:"description" validate:"omitempty,max=1000"`
+	IsActive      *bool                  `json:"is_active"`
+	Exclusive     *bool                  `json:"exclusive"`
+	DateStarted   *time.Time             `json:"date_started"`
+	DateEnded     *time.Time             `json:"date_ended"`
+	Schedule      *ScheduleRequest       `json:"schedule"`
+	ClearSchedule bool                   `json:"clear_schedule"`
+	Voucher       *UpdateVoucherRequest  `json:"voucher"`
+	ClearVoucher  bool                   `json:"clear_voucher"`
+	Discount      *UpdateDiscountRequest `json:"discount"`
+	Bundle        *UpdateBundleRequest   `json:"bundle"`
+	BuyXGetY      *UpdateBuyXGetYRequest `json:"buy_x_get_y"`
+	Cashback      *UpdateCashbackRequest `json:"cashback"`
+}
+
+func (h *Handler) UpdatePromotion(c echo.Context) error {
+	var req UpdatePromotionRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	ownerID, status, err := h.getOwner(c)
+	if err != nil {
+		return response.FromError(c.Response().Writer, status, err)
+	}
+
+	params := promotionbiz.UpdatePromotionParams{
+		OwnerID:       ownerID,
+		ID:            req.ID,
+		RefType:       req.RefType,
+		RefID:         req.RefID,
+		Title:         req.Title,
+		Description:   req.Description,
+		IsActive:      req.IsActive,
+		Exclusive:     req.Exclusive,
+		DateStarted:   req.DateStarted,
+		DateEnded:     req.DateEnded,
+		Schedule:      toScheduleParams(req.Schedule),
+		ClearSchedule: req.ClearSchedule,
+		ClearVoucher:  req.ClearVoucher,
+	}
+	if req.Voucher != nil {
+		params.Voucher = &promotionbiz.UpdateVoucherParams{
+			UsageLimit:       req.Voucher.UsageLimit,
+			PerCustomerLimit: req.Voucher.PerCustomerLimit,
+			FirstOrderOnly:   req.Voucher.FirstOrderOnly,
+			MinSpend:         req.Voucher.MinSpend,
+		}
+	}
+	if req.Discount != nil {
+		params.Discount = &promotionbiz.UpdateDiscountParams{
+			OrderWide:       req.Discount.OrderWide,
+			MinSpend:        req.Discount.MinSpend,
+			MaxDiscount:     req.Discount.MaxDiscount,
+			DiscountPercent: req.Discount.DiscountPercent,
+			DiscountPrice:   req.Discount.DiscountPrice,
+		}
+	}
+	if req.Bundle != nil {
+		params.Bundle = &promotionbiz.UpdateBundleParams{
+			Price: req.Bundle.Price,
+			Items: toBundleItemParams(req.Bundle.Items),
+		}
+	}
+	if req.BuyXGetY != nil {
+		params.BuyXGetY = &promotionbiz.UpdateBuyXGetYParams{
+			BuyQuantity: req.BuyXGetY.BuyQuantity,
+			GetQuantity: req.BuyXGetY.GetQuantity,
+		}
+	}
+	if req.Cashback != nil {
+		params.Cashback = &promotionbiz.UpdateCashbackParams{
+			MinSpend:        req.Cashback.MinSpend,
+			MaxCashback:     req.Cashback.MaxCashback,
+			CashbackPercent: req.Cashback.CashbackPercent,
+			CashbackPrice:   req.Cashback.CashbackPrice,
+		}
+	}
+
+	result, err := h.biz.UpdatePromotion(c.Request().Context(), params)
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, result)
+}
+
+type DeactivatePromotionRequest struct {
+	ID int64 `param:"id" validate:"required,gt=0"`
+}
+
+func (h *Handler) DeactivatePromotion(c echo.Context) error {
+	var req DeactivatePromotionRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+
+	ownerID, status, err := h.getOwner(c)
+	if err != nil {
+		return response.FromError(c.Response().Writer, status, err)
+	}
+
+	if err = h.biz.DeactivatePromotion(c.Request().Context(), promotionbiz.DeactivatePromotionParams{
+		OwnerID: ownerID,
+		ID:      req.ID,
+	}); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromMessage(c.Response().Writer, http.StatusOK, "Promotion deactivated successfully")
+}
+
+type GetPromotionWindowsRequest struct {
+	ID    int64 `param:"id" validate:"required,gt=0"`
+	Count int   `query:"count" validate:"omitempty,gt=0,lte=50"`
+}
+
+// GetPromotionWindows returns the open and upcoming windows of a recurring flash sale, for anyone to display
+func (h *Handler) GetPromotionWindows(c echo.Context) error {
+	var req GetPromotionWindowsRequest
+	if err := c.Bind(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+	if err := c.Validate(&req); err != nil {
+		return response.FromError(c.Response().Writer, http.StatusBadRequest, err)
+	}
+	if req.Count == 0 {
+		req.Count = 5
+	}
+
+	result, err := h.biz.GetPromotionWindows(c.Request().Context(), promotionbiz.GetPromotionWindowsParams{
+		ID:    req.ID,
+		Count: req.Count,
+	})
+	if err != nil {
+		return response.FromError(c.Response().Writer, http.StatusInternalServerError, err)
+	}
+
+	return response.FromDTO(c.Response().Writer, http.StatusOK, result)
+}
+
+func toScheduleParams(schedule *ScheduleRequest) *promotionbiz.ScheduleParams {
+	if schedule == nil {
+		return nil
+	}
+	return &promotionbiz.ScheduleParams{
+		Cron:     schedule.Cron,
+		Timezone: schedule.Timezone,
+		Duration: schedule.Duration,
+	}
+}
+
+// toBundleItemParams keeps nil as nil, so that an update without items leaves them unchanged
+func toBundleItemParams(items []BundleItemRequest) []promotionbiz.BundleItemParams {
+	if items == nil {
+		return nil
+	}
+	result := make([]promotionbiz.BundleItemParams, 0, len(items))
+	for _, item := range items {
+		result = append(result, promotionbiz.BundleItemParams{
+			SkuID:    item.SkuID,
+			Quantity: item.Quantity,
+		})
+	}
+	return result
+}
@@ -1,5 +1,7 @@
 package sharedmodel
 
+import "strconv"
+
 // PaginationParams represents the pagination parameters
 type PaginationParams struct {
 	Page  int32 `query:"page" validate:"omitempty,gt=0"`
@@ -60,6 +62,31 @@ func (p *PaginationParams) NextCursor(lastID int64) *string {
 	return nil
 }
 
+// CursorParams represents the cursor pagination parameters, the cursor is the id of the last item already seen
+type CursorParams struct {
+	Cursor int64 `query:"cursor" validate:"omitempty,gt=0"`
+	Limit  int32 `query:"limit" validate:"omitempty,gt=0,lte=100"`
+}
+
+func (p *CursorParams) GetLimit() int32 {
+	if p.Limit <= 0 {
+		return 10 // default limit
+	}
+	if p.Limit > 100 {
+		return 100 // max limit
+	}
+	return p.Limit
+}
+
+// NextCursor returns the cursor of the next page from the number of items and the id of the last item of this page
+func (p *CursorParams) NextCursor(count int, lastID int64) *string {
+	if int32(count) < p.GetLimit() {
+		return nil
+	}
+	cursor := strconv.FormatInt(lastID, 10)
+	return &cursor
+}
+
 // PaginateResult represents a paginated result set
 type PaginateResult[T any] struct {
 	Data       []T     `json:"data"`
@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"errors"
+	"net/http"
+
+	"shopnexus-remastered/internal/module/shared/transport/echo/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+// AdminTokenHeader carries the token of the back office
+const AdminTokenHeader = "X-Admin-Token"
+
+// RequireAdminToken only lets the back office through, an empty token lets nobody through
+func RequireAdminToken(adminToken string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			token := c.Request().Header.Get(AdminTokenHeader)
+			if adminToken == "" || subtle.ConstantTimeCompare([]byte(token), []byte(adminToken)) != 1 {
+				return response.FromError(c.Response().Writer, http.StatusUnauthorized, errors.New("invalid admin token"))
+			}
+			return next(c)
+		}
+	}
+}
@@ -135,10 +135,13 @@ func SeedPaymentSchema(ctx context.Context, storage db.Querier, fake *faker.Fake
 
 			itemCode := generateUniqueCodeWithTracker(fake, "ITEM", tracker)
 			orderItemParam := db.CreateOrderItemParams{
-				Code:     itemCode,
-				OrderID:  order.ID,
-				SkuID:    sku.ID,
-				Quantity: quantity,
+				Code:      itemCode,
+				OrderID:   order.ID,
+				SkuID:     sku.ID,
+				Quantity:  quantity,
+				UnitPrice: sku.Price,
+				Subtotal:  sku.Price * quantity,
+				Total:     sku.Price * quantity,
 			}
 			orderItemParams = append(orderItemParams, orderItemParam)
 			currentOrderItems = append(currentOrderItems, orderItemParam)
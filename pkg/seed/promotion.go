@@ -101,6 +101,14 @@ func SeedPromotionSchema(ctx context.Context, storage db.Querier, fake *faker.Fa
 				ownerID = &vendor.ID
 				refID = &brand.ID
 			}
+		case "Tag":
+			// Vendor-owned promotion for tag
+			if len(accountData.Vendors) > 0 && len(catalogData.Tags) > 0 {
+				vendor := accountData.Vendors[fake.RandomDigit()%len(accountData.Vendors)]
+				tag := catalogData.Tags[fake.RandomDigit()%len(catalogData.Tags)]
+				ownerID = &vendor.ID
+				refID = &tag.ID
+			}
 		}
 
 		// Generate title and description
@@ -109,7 +117,7 @@ func SeedPromotionSchema(ctx context.Context, storage db.Querier, fake *faker.Fa
 
 		// Generate schedule fields for flash sale (Discount type with schedule)
 		var scheduleTz *string
-		var scheduleStart *time.Time
+		var scheduleStart *string
 		var scheduleDuration *int32
 
 		if promotionType == "Discount" && fake.Boolean().Bool() {
@@ -117,9 +125,9 @@ func SeedPromotionSchema(ctx context.Context, storage db.Querier, fake *faker.Fa
 			tz := "Asia/Ho_Chi_Minh" // Default timezone
 			scheduleTz = &tz
 
-			// Schedule start time (next few days)
-			nextStart := now.AddDate(0, 0, fake.RandomDigit()%7+1)
-			scheduleStart = &nextStart
+			// Weekly window start, e.g. "0 9 * * 1" for every Monday 9AM
+			cron := fmt.Sprintf("0 %d * * %d", fake.RandomDigit()%24, fake.RandomDigit()%7)
+			scheduleStart = &cron
 
 			// Duration in minutes (30-480 minutes = 30min to 8hours)
 			duration := int32(fake.RandomDigit()%450 + 30)
@@ -138,7 +146,7 @@ func SeedPromotionSchema(ctx context.Context, storage db.Querier, fake *faker.Fa
 			DateStarted:      pgtype.Timestamptz{Time: startDate, Valid: true},
 			DateEnded:        pgtype.Timestamptz{Time: endDate, Valid: true},
 			ScheduleTz:       pgtype.Text{String: ptr.DerefDefault(scheduleTz, ""), Valid: scheduleTz != nil},
-			ScheduleStart:    pgtype.Timestamptz{Time: ptr.DerefDefault(scheduleStart, time.Time{}), Valid: scheduleStart != nil},
+			ScheduleStart:    pgtype.Text{String: ptr.DerefDefault(scheduleStart, ""), Valid: scheduleStart != nil},
 			ScheduleDuration: pgtype.Int4{Int32: ptr.DerefDefault(scheduleDuration, 0), Valid: scheduleDuration != nil},
 			DateCreated:      pgtype.Timestamptz{Time: now, Valid: true},
 			DateUpdated:      pgtype.Timestamptz{Time: now, Valid: true},
@@ -50,16 +50,16 @@ func showHelp() {
 	fmt.Println()
 	fmt.Println("Examples:")
 	fmt.Println("  # Generate queries for all tables")
-	fmt.Println("  go run tool/main.go -schema prisma/migrations/0_init/migration.sql")
+	fmt.Println("  go run tool/main.go -schema prisma/schema.sql")
 	fmt.Println()
 	fmt.Println("  # Generate queries for specific table")
-	fmt.Println("  go run tool/main.go -schema prisma/migrations/0_init/migration.sql -table account.account")
+	fmt.Println("  go run tool/main.go -schema prisma/schema.sql -table account.account")
 	fmt.Println()
 	fmt.Println("  # Custom output directory")
-	fmt.Println("  go run tool/main.go -schema prisma/migrations/0_init/migration.sql -output generated_queries")
+	fmt.Println("  go run tool/main.go -schema prisma/schema.sql -output generated_queries")
 	fmt.Println()
 	fmt.Println("  # Generate all queries into a single file")
-	fmt.Println("  go run tool/main.go -schema prisma/migrations/0_init/migration.sql -single-file")
+	fmt.Println("  go run tool/main.go -schema prisma/schema.sql -single-file")
 }
 
 type QueryGenerator struct {
@@ -0,0 +1,9 @@
+-- AlterTable
+-- Items created before this migration are priced at 0, the defaults only fill the existing rows
+ALTER TABLE "order"."item" ADD COLUMN     "unit_price" BIGINT NOT NULL DEFAULT 0,
+ADD COLUMN     "subtotal" BIGINT NOT NULL DEFAULT 0,
+ADD COLUMN     "total" BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE "order"."item" ALTER COLUMN "unit_price" DROP DEFAULT,
+ALTER COLUMN "subtotal" DROP DEFAULT,
+ALTER COLUMN "total" DROP DEFAULT;
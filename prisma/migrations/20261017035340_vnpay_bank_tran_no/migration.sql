@@ -0,0 +1,2 @@
+-- AlterTable
+ALTER TABLE "order"."vnpay" ADD COLUMN     "vnp_BankTranNo" TEXT;
@@ -0,0 +1,29 @@
+-- CreateEnum
+CREATE TYPE "inventory"."reservation_type" AS ENUM ('Cart', 'Order');
+
+-- CreateTable
+CREATE TABLE "inventory"."stock_reservation" (
+    "id" BIGSERIAL NOT NULL,
+    "stock_id" BIGINT NOT NULL,
+    "ref_type" "inventory"."reservation_type" NOT NULL,
+    "ref_id" BIGINT NOT NULL,
+    "quantity" BIGINT NOT NULL,
+    "status" "shared"."status" NOT NULL DEFAULT 'Pending',
+    "date_expired" TIMESTAMPTZ(3) NOT NULL,
+    "date_created" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "date_updated" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "stock_reservation_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE INDEX "stock_reservation_stock_id_idx" ON "inventory"."stock_reservation"("stock_id");
+
+-- CreateIndex
+CREATE INDEX "stock_reservation_ref_id_ref_type_idx" ON "inventory"."stock_reservation"("ref_id", "ref_type");
+
+-- CreateIndex
+CREATE INDEX "stock_reservation_status_date_expired_idx" ON "inventory"."stock_reservation"("status", "date_expired");
+
+-- AddForeignKey
+ALTER TABLE "inventory"."stock_reservation" ADD CONSTRAINT "stock_reservation_stock_id_fkey" FOREIGN KEY ("stock_id") REFERENCES "inventory"."stock"("id") ON DELETE CASCADE ON UPDATE CASCADE;
@@ -0,0 +1,3 @@
+-- AlterTable
+ALTER TABLE "inventory"."stock_history" ADD COLUMN     "account_id" BIGINT,
+ADD COLUMN     "reason" VARCHAR(255);
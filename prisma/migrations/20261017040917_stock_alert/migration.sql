@@ -0,0 +1,7 @@
+-- CreateEnum
+CREATE TYPE "inventory"."stock_alert" AS ENUM ('LowStock', 'OutOfStock');
+
+-- AlterTable
+ALTER TABLE "inventory"."stock" ADD COLUMN     "low_stock_threshold" BIGINT NOT NULL DEFAULT 0,
+ADD COLUMN     "alert" "inventory"."stock_alert",
+ADD COLUMN     "date_alerted" TIMESTAMPTZ(3);
@@ -0,0 +1,87 @@
+-- CreateTable
+CREATE TABLE "promotion"."bundle" (
+    "id" BIGINT NOT NULL,
+    "price" BIGINT NOT NULL,
+
+    CONSTRAINT "bundle_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "promotion"."bundle_item" (
+    "id" BIGSERIAL NOT NULL,
+    "bundle_id" BIGINT NOT NULL,
+    "sku_id" BIGINT NOT NULL,
+    "quantity" BIGINT NOT NULL DEFAULT 1,
+
+    CONSTRAINT "bundle_item_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "promotion"."buy_x_get_y" (
+    "id" BIGINT NOT NULL,
+    "buy_quantity" BIGINT NOT NULL,
+    "get_quantity" BIGINT NOT NULL,
+
+    CONSTRAINT "buy_x_get_y_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "promotion"."cashback" (
+    "id" BIGINT NOT NULL,
+    "min_spend" BIGINT NOT NULL DEFAULT 0,
+    "max_cashback" BIGINT NOT NULL DEFAULT 0,
+    "cashback_percent" INTEGER,
+    "cashback_price" BIGINT,
+
+    CONSTRAINT "cashback_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "promotion"."cashback_credit" (
+    "id" BIGSERIAL NOT NULL,
+    "account_id" BIGINT NOT NULL,
+    "order_id" BIGINT NOT NULL,
+    "promotion_id" BIGINT NOT NULL,
+    "amount" BIGINT NOT NULL,
+    "status" "shared"."status" NOT NULL DEFAULT 'Pending',
+    "date_created" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "date_updated" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "cashback_credit_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE INDEX "bundle_item_sku_id_idx" ON "promotion"."bundle_item"("sku_id");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "bundle_item_bundle_id_sku_id_key" ON "promotion"."bundle_item"("bundle_id", "sku_id");
+
+-- CreateIndex
+CREATE INDEX "cashback_credit_account_id_status_idx" ON "promotion"."cashback_credit"("account_id", "status");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "cashback_credit_order_id_promotion_id_key" ON "promotion"."cashback_credit"("order_id", "promotion_id");
+
+-- AddForeignKey
+ALTER TABLE "promotion"."bundle" ADD CONSTRAINT "bundle_id_fkey" FOREIGN KEY ("id") REFERENCES "promotion"."base"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "promotion"."bundle_item" ADD CONSTRAINT "bundle_item_bundle_id_fkey" FOREIGN KEY ("bundle_id") REFERENCES "promotion"."bundle"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "promotion"."bundle_item" ADD CONSTRAINT "bundle_item_sku_id_fkey" FOREIGN KEY ("sku_id") REFERENCES "catalog"."product_sku"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "promotion"."buy_x_get_y" ADD CONSTRAINT "buy_x_get_y_id_fkey" FOREIGN KEY ("id") REFERENCES "promotion"."base"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "promotion"."cashback" ADD CONSTRAINT "cashback_id_fkey" FOREIGN KEY ("id") REFERENCES "promotion"."base"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "promotion"."cashback_credit" ADD CONSTRAINT "cashback_credit_account_id_fkey" FOREIGN KEY ("account_id") REFERENCES "account"."customer"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "promotion"."cashback_credit" ADD CONSTRAINT "cashback_credit_order_id_fkey" FOREIGN KEY ("order_id") REFERENCES "order"."base"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "promotion"."cashback_credit" ADD CONSTRAINT "cashback_credit_promotion_id_fkey" FOREIGN KEY ("promotion_id") REFERENCES "promotion"."base"("id") ON DELETE CASCADE ON UPDATE CASCADE;
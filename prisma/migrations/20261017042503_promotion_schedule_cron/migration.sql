@@ -0,0 +1,5 @@
+-- A schedule start timestamp cannot be turned into a cron expression, the existing schedules are cleared
+UPDATE "promotion"."base" SET "schedule_tz" = NULL, "schedule_start" = NULL, "schedule_duration" = NULL;
+
+-- AlterTable
+ALTER TABLE "promotion"."base" ALTER COLUMN "schedule_start" SET DATA TYPE VARCHAR(100) USING NULL;
@@ -0,0 +1,45 @@
+-- CreateTable
+CREATE TABLE "promotion"."voucher" (
+    "id" BIGINT NOT NULL,
+    "usage_limit" BIGINT NOT NULL DEFAULT 0,
+    "per_customer_limit" BIGINT NOT NULL DEFAULT 0,
+    "first_order_only" BOOLEAN NOT NULL DEFAULT false,
+    "min_spend" BIGINT NOT NULL DEFAULT 0,
+    "used" BIGINT NOT NULL DEFAULT 0,
+
+    CONSTRAINT "voucher_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "promotion"."voucher_redemption" (
+    "id" BIGSERIAL NOT NULL,
+    "voucher_id" BIGINT NOT NULL,
+    "account_id" BIGINT NOT NULL,
+    "order_id" BIGINT NOT NULL,
+    "status" "shared"."status" NOT NULL DEFAULT 'Success',
+    "date_created" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "date_updated" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "voucher_redemption_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE INDEX "voucher_redemption_voucher_id_account_id_idx" ON "promotion"."voucher_redemption"("voucher_id", "account_id");
+
+-- CreateIndex
+CREATE INDEX "voucher_redemption_order_id_idx" ON "promotion"."voucher_redemption"("order_id");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "voucher_redemption_voucher_id_order_id_key" ON "promotion"."voucher_redemption"("voucher_id", "order_id");
+
+-- AddForeignKey
+ALTER TABLE "promotion"."voucher" ADD CONSTRAINT "voucher_id_fkey" FOREIGN KEY ("id") REFERENCES "promotion"."base"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "promotion"."voucher_redemption" ADD CONSTRAINT "voucher_redemption_voucher_id_fkey" FOREIGN KEY ("voucher_id") REFERENCES "promotion"."voucher"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "promotion"."voucher_redemption" ADD CONSTRAINT "voucher_redemption_account_id_fkey" FOREIGN KEY ("account_id") REFERENCES "account"."customer"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "promotion"."voucher_redemption" ADD CONSTRAINT "voucher_redemption_order_id_fkey" FOREIGN KEY ("order_id") REFERENCES "order"."base"("id") ON DELETE CASCADE ON UPDATE CASCADE;
@@ -0,0 +1,2 @@
+-- AlterTable
+ALTER TABLE "promotion"."base" ADD COLUMN "exclusive" BOOLEAN NOT NULL DEFAULT false;
@@ -0,0 +1,2 @@
+-- AlterEnum
+ALTER TYPE "promotion"."ref_type" ADD VALUE 'Tag';
@@ -0,0 +1,7 @@
+-- name: GetLatestAccountIncomeHistory :one
+SELECT *
+FROM "account"."income_history"
+WHERE "account_id" = sqlc.arg('account_id')
+ORDER BY "id" DESC
+LIMIT 1
+FOR UPDATE;
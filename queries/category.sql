@@ -0,0 +1,37 @@
+-- name: ListCatalogCategoryAncestor :many
+-- The category then its parents up to the root. The depth limit ends a cycle left by a bad parent_id.
+WITH RECURSIVE "ancestor" AS (
+    SELECT "id", "name", "description", "parent_id", 0 AS "depth"
+    FROM "catalog"."category"
+    WHERE "id" = sqlc.arg('id')::bigint
+    UNION ALL
+    SELECT c."id", c."name", c."description", c."parent_id", a."depth" + 1
+    FROM "catalog"."category" c
+    JOIN "ancestor" a ON c."id" = a."parent_id"
+    WHERE a."depth" < 100
+)
+SELECT "id", "name", "description", "parent_id"
+FROM "ancestor"
+ORDER BY "depth" DESC;
+
+-- name: ListCatalogCategoryDescendant :many
+-- The category and every category under it. UNION skips the categories already reached, which ends a cycle.
+WITH RECURSIVE "subtree" AS (
+    SELECT "id", "name", "description", "parent_id"
+    FROM "catalog"."category"
+    WHERE "id" = sqlc.arg('id')::bigint
+    UNION
+    SELECT c."id", c."name", c."description", c."parent_id"
+    FROM "catalog"."category" c
+    JOIN "subtree" s ON c."parent_id" = s."id"
+)
+SELECT "id", "name", "description", "parent_id"
+FROM "subtree"
+ORDER BY "id";
+
+-- name: LockCatalogCategory :many
+-- Locks the whole category tree until the end of the transaction, so concurrent moves cannot create a cycle together
+SELECT *
+FROM "catalog"."category"
+ORDER BY "id"
+FOR UPDATE;
@@ -0,0 +1,84 @@
+-- name: ListAvailableSkuSerial :many
+SELECT *
+FROM "inventory"."sku_serial"
+WHERE "sku_id" = sqlc.arg('sku_id') AND "status" = 'Active'
+ORDER BY "id"
+LIMIT sqlc.arg('limit')::int
+FOR UPDATE SKIP LOCKED;
+
+-- name: UpdateSkuSerialStatus :exec
+UPDATE "inventory"."sku_serial"
+SET "status" = sqlc.arg('status')
+WHERE "id" = ANY(sqlc.arg('id')::bigint[]);
+
+-- name: ReserveStock :one
+UPDATE "inventory"."stock"
+SET "current_stock" = "current_stock" - sqlc.arg('quantity')
+WHERE "ref_type" = sqlc.arg('ref_type') AND "ref_id" = sqlc.arg('ref_id') AND "current_stock" >= sqlc.arg('quantity')
+RETURNING *;
+
+-- name: AdjustStock :one
+UPDATE "inventory"."stock"
+SET "current_stock" = "current_stock" + sqlc.arg('stock_change')::bigint, "sold" = "sold" + sqlc.arg('sold_change')::bigint
+WHERE "id" = sqlc.arg('id')
+RETURNING *;
+
+-- name: UpdateStockReservationStatus :one
+UPDATE "inventory"."stock_reservation"
+SET "status" = sqlc.arg('new_status'), "date_updated" = NOW()
+WHERE "id" = sqlc.arg('id') AND "status" = sqlc.arg('old_status')
+RETURNING *;
+
+-- name: ExtendStockReservation :exec
+UPDATE "inventory"."stock_reservation"
+SET "date_expired" = sqlc.arg('date_expired'), "date_updated" = NOW()
+WHERE "ref_type" = sqlc.arg('ref_type') AND "ref_id" = sqlc.arg('ref_id') AND "status" = 'Pending';
+
+-- name: ListExpiredStockReservation :many
+SELECT *
+FROM "inventory"."stock_reservation"
+WHERE "status" = 'Pending' AND "date_expired" < sqlc.arg('date_expired')
+ORDER BY "id"
+LIMIT sqlc.arg('limit')::int;
+
+-- name: UpdateInventorySkuSerialStatus :one
+UPDATE "inventory"."sku_serial"
+SET "status" = sqlc.arg('new_status')
+WHERE "id" = sqlc.arg('id') AND "status" = sqlc.arg('old_status')
+RETURNING *;
+
+-- name: ListStockToAlert :many
+-- Stocks that never changed were never stocked (e.g. created to hold a threshold), they are not alerted
+SELECT *
+FROM "inventory"."stock"
+WHERE "ref_type" = 'ProductSKU' AND (
+    ("current_stock" <= 0 AND "alert" IS DISTINCT FROM 'OutOfStock') OR
+    ("current_stock" > 0 AND "current_stock" < "low_stock_threshold" AND "alert" IS NULL)
+) AND EXISTS (SELECT 1 FROM "inventory"."stock_history" "h" WHERE "h"."stock_id" = "stock"."id")
+ORDER BY "id"
+LIMIT sqlc.arg('limit')::int
+FOR UPDATE SKIP LOCKED;
+
+-- name: ClearStockAlert :exec
+UPDATE "inventory"."stock"
+SET "alert" = CASE WHEN "current_stock" < "low_stock_threshold" THEN 'LowStock'::"inventory"."stock_alert" END
+WHERE "alert" IS NOT NULL AND "current_stock" > 0 AND ("alert" = 'OutOfStock' OR "current_stock" >= "low_stock_threshold");
+
+-- name: ListStockReconciliation :many
+SELECT "s"."id", "s"."ref_id", "s"."current_stock", "s"."sold",
+    COALESCE((SELECT SUM("h"."change") FROM "inventory"."stock_history" "h" WHERE "h"."stock_id" = "s"."id"), 0)::bigint AS "history_stock",
+    COALESCE((SELECT SUM("i"."quantity") FROM "order"."item" "i" JOIN "order"."base" "o" ON "o"."id" = "i"."order_id" WHERE "i"."sku_id" = "s"."ref_id" AND "o"."status" IN ('Processing', 'Success')), 0)::bigint AS "sold_items",
+    COALESCE((SELECT SUM("i"."quantity") FROM "order"."item" "i" JOIN "order"."base" "o" ON "o"."id" = "i"."order_id" WHERE "i"."sku_id" = "s"."ref_id" AND "o"."status" = 'Pending'), 0)::bigint AS "pending_items",
+    (SELECT COUNT(*) FROM "inventory"."sku_serial" "ss" WHERE "ss"."sku_id" = "s"."ref_id")::bigint AS "serials",
+    (SELECT COUNT(*) FROM "inventory"."sku_serial" "ss" WHERE "ss"."sku_id" = "s"."ref_id" AND "ss"."status" = 'Sold')::bigint AS "sold_serials"
+FROM "inventory"."stock" "s"
+WHERE "s"."ref_type" = 'ProductSKU' AND "s"."id" > sqlc.arg('cursor')::bigint
+ORDER BY "s"."id"
+LIMIT sqlc.arg('limit')::int
+FOR UPDATE OF "s";
+
+-- name: GetStockForUpdate :one
+SELECT *
+FROM "inventory"."stock"
+WHERE "ref_type" = sqlc.arg('ref_type') AND "ref_id" = sqlc.arg('ref_id')
+FOR UPDATE;
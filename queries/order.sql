@@ -1,4 +1,23 @@
 -- name: ExistsCartItems :one
 SELECT EXISTS(
     SELECT 1 FROM account.cart_item WHERE sku_id = ANY(sqlc.arg('sku_ids')::bigint[])
-) AS "exists";
\ No newline at end of file
+) AS "exists";
+
+-- name: UpdateOrderBaseStatus :one
+UPDATE "order"."base"
+SET "status" = sqlc.arg('new_status'), "date_updated" = NOW()
+WHERE "id" = sqlc.arg('id') AND "status" = sqlc.arg('old_status')
+RETURNING *;
+
+-- name: UpdateOrderRefundStatus :one
+UPDATE "order"."refund"
+SET "status" = sqlc.arg('new_status'), "reviewed_by_id" = sqlc.arg('reviewed_by_id')
+WHERE "id" = sqlc.arg('id') AND "status" = sqlc.arg('old_status')
+RETURNING *;
+
+-- name: GetOrderItemForUpdate :one
+-- Locks the order item until the end of the transaction, so the refunds of the item are approved one at a time
+SELECT *
+FROM "order"."item"
+WHERE "id" = sqlc.arg('id')
+FOR UPDATE;
@@ -2,7 +2,47 @@
 SELECT *
 FROM promotion.base
 WHERE is_active = true
+  AND date_started <= NOW()
   AND (date_ended IS NULL OR date_ended > NOW())
-  AND ("ref_type" = (sqlc.narg('ref_type')) OR sqlc.slice('ref_type') IS NULL)
-  AND ("ref_id" = ANY(sqlc.narg('ref_id')) OR sqlc.narg('ref_id') IS NULL)
+  AND ("ref_type" = ANY(sqlc.slice('ref_type')) OR sqlc.slice('ref_type') IS NULL)
+  AND ("ref_id" = ANY(sqlc.slice('ref_id')) OR sqlc.slice('ref_id') IS NULL)
   AND ("type" = ANY(sqlc.slice('type')) OR sqlc.slice('type') IS NULL);
+
+-- name: ListOwnerPromotion :many
+SELECT *
+FROM "promotion"."base"
+WHERE "owner_id" IS NOT DISTINCT FROM sqlc.narg('owner_id')
+  AND ("is_active" = ANY(sqlc.slice('is_active')) OR sqlc.slice('is_active') IS NULL)
+ORDER BY "id"
+LIMIT sqlc.narg('limit')
+OFFSET sqlc.narg('offset');
+
+-- name: CountOwnerPromotion :one
+SELECT COUNT(*)
+FROM "promotion"."base"
+WHERE "owner_id" IS NOT DISTINCT FROM sqlc.narg('owner_id')
+  AND ("is_active" = ANY(sqlc.slice('is_active')) OR sqlc.slice('is_active') IS NULL);
+
+-- name: SettleCashbackCredit :many
+UPDATE "promotion"."cashback_credit"
+SET "status" = sqlc.arg('status'), "date_updated" = NOW()
+WHERE "order_id" = sqlc.arg('order_id') AND "status" = 'Pending'
+RETURNING *;
+
+-- name: RedeemPromotionVoucher :one
+-- Takes one use of the voucher, no row when its usage limit is reached. The row stays locked until the transaction ends.
+UPDATE "promotion"."voucher"
+SET "used" = "used" + 1
+WHERE "id" = sqlc.arg('id') AND ("usage_limit" = 0 OR "used" < "usage_limit")
+RETURNING *;
+
+-- name: ReleasePromotionVoucher :exec
+UPDATE "promotion"."voucher"
+SET "used" = "used" - 1
+WHERE "id" = sqlc.arg('id') AND "used" > 0;
+
+-- name: CancelVoucherRedemption :many
+UPDATE "promotion"."voucher_redemption"
+SET "status" = 'Canceled', "date_updated" = NOW()
+WHERE "order_id" = sqlc.arg('order_id') AND "status" <> 'Canceled'
+RETURNING *;
@@ -1764,6 +1764,13 @@ WHERE (
     ("sold" = ANY(sqlc.slice('sold')) OR sqlc.slice('sold') IS NULL) AND
     ("sold" >= sqlc.narg('sold_from') OR sqlc.narg('sold_from') IS NULL) AND
     ("sold" <= sqlc.narg('sold_to') OR sqlc.narg('sold_to') IS NULL) AND
+    ("low_stock_threshold" = ANY(sqlc.slice('low_stock_threshold')) OR sqlc.slice('low_stock_threshold') IS NULL) AND
+    ("low_stock_threshold" >= sqlc.narg('low_stock_threshold_from') OR sqlc.narg('low_stock_threshold_from') IS NULL) AND
+    ("low_stock_threshold" <= sqlc.narg('low_stock_threshold_to') OR sqlc.narg('low_stock_threshold_to') IS NULL) AND
+    ("alert" = ANY(sqlc.slice('alert')) OR sqlc.slice('alert') IS NULL) AND
+    ("date_alerted" = ANY(sqlc.slice('date_alerted')) OR sqlc.slice('date_alerted') IS NULL) AND
+    ("date_alerted" >= sqlc.narg('date_alerted_from') OR sqlc.narg('date_alerted_from') IS NULL) AND
+    ("date_alerted" <= sqlc.narg('date_alerted_to') OR sqlc.narg('date_alerted_to') IS NULL) AND
     ("date_created" = ANY(sqlc.slice('date_created')) OR sqlc.slice('date_created') IS NULL) AND
     ("date_created" >= sqlc.narg('date_created_from') OR sqlc.narg('date_created_from') IS NULL) AND
     ("date_created" <= sqlc.narg('date_created_to') OR sqlc.narg('date_created_to') IS NULL)
@@ -1787,6 +1794,13 @@ WHERE (
     ("sold" = ANY(sqlc.slice('sold')) OR sqlc.slice('sold') IS NULL) AND
     ("sold" >= sqlc.narg('sold_from') OR sqlc.narg('sold_from') IS NULL) AND
     ("sold" <= sqlc.narg('sold_to') OR sqlc.narg('sold_to') IS NULL) AND
+    ("low_stock_threshold" = ANY(sqlc.slice('low_stock_threshold')) OR sqlc.slice('low_stock_threshold') IS NULL) AND
+    ("low_stock_threshold" >= sqlc.narg('low_stock_threshold_from') OR sqlc.narg('low_stock_threshold_from') IS NULL) AND
+    ("low_stock_threshold" <= sqlc.narg('low_stock_threshold_to') OR sqlc.narg('low_stock_threshold_to') IS NULL) AND
+    ("alert" = ANY(sqlc.slice('alert')) OR sqlc.slice('alert') IS NULL) AND
+    ("date_alerted" = ANY(sqlc.slice('date_alerted')) OR sqlc.slice('date_alerted') IS NULL) AND
+    ("date_alerted" >= sqlc.narg('date_alerted_from') OR sqlc.narg('date_alerted_from') IS NULL) AND
+    ("date_alerted" <= sqlc.narg('date_alerted_to') OR sqlc.narg('date_alerted_to') IS NULL) AND
     ("date_created" = ANY(sqlc.slice('date_created')) OR sqlc.slice('date_created') IS NULL) AND
     ("date_created" >= sqlc.narg('date_created_from') OR sqlc.narg('date_created_from') IS NULL) AND
     ("date_created" <= sqlc.narg('date_created_to') OR sqlc.narg('date_created_to') IS NULL)
@@ -1809,6 +1823,13 @@ WHERE (
     ("sold" = ANY(sqlc.slice('sold')) OR sqlc.slice('sold') IS NULL) AND
     ("sold" >= sqlc.narg('sold_from') OR sqlc.narg('sold_from') IS NULL) AND
     ("sold" <= sqlc.narg('sold_to') OR sqlc.narg('sold_to') IS NULL) AND
+    ("low_stock_threshold" = ANY(sqlc.slice('low_stock_threshold')) OR sqlc.slice('low_stock_threshold') IS NULL) AND
+    ("low_stock_threshold" >= sqlc.narg('low_stock_threshold_from') OR sqlc.narg('low_stock_threshold_from') IS NULL) AND
+    ("low_stock_threshold" <= sqlc.narg('low_stock_threshold_to') OR sqlc.narg('low_stock_threshold_to') IS NULL) AND
+    ("alert" = ANY(sqlc.slice('alert')) OR sqlc.slice('alert') IS NULL) AND
+    ("date_alerted" = ANY(sqlc.slice('date_alerted')) OR sqlc.slice('date_alerted') IS NULL) AND
+    ("date_alerted" >= sqlc.narg('date_alerted_from') OR sqlc.narg('date_alerted_from') IS NULL) AND
+    ("date_alerted" <= sqlc.narg('date_alerted_to') OR sqlc.narg('date_alerted_to') IS NULL) AND
     ("date_created" = ANY(sqlc.slice('date_created')) OR sqlc.slice('date_created') IS NULL) AND
     ("date_created" >= sqlc.narg('date_created_from') OR sqlc.narg('date_created_from') IS NULL) AND
     ("date_created" <= sqlc.narg('date_created_to') OR sqlc.narg('date_created_to') IS NULL)
@@ -1819,12 +1840,12 @@ OFFSET sqlc.narg('offset');
 
 
 -- name: CreateInventoryStock :copyfrom
-INSERT INTO "inventory"."stock" ("ref_type", "ref_id", "current_stock", "sold", "date_created")
-VALUES ($1, $2, $3, $4, $5);
+INSERT INTO "inventory"."stock" ("ref_type", "ref_id", "current_stock", "sold", "low_stock_threshold", "alert", "date_alerted", "date_created")
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
 
 -- name: CreateDefaultInventoryStock :copyfrom
-INSERT INTO "inventory"."stock" ("ref_type", "ref_id")
-VALUES ($1, $2);
+INSERT INTO "inventory"."stock" ("ref_type", "ref_id", "alert", "date_alerted")
+VALUES ($1, $2, $3, $4);
 
 -- name: UpdateInventoryStock :one
 UPDATE "inventory"."stock"
@@ -1832,6 +1853,9 @@ SET "ref_type" = COALESCE(sqlc.narg('ref_type'), "ref_type"),
     "ref_id" = COALESCE(sqlc.narg('ref_id'), "ref_id"),
     "current_stock" = COALESCE(sqlc.narg('current_stock'), "current_stock"),
     "sold" = COALESCE(sqlc.narg('sold'), "sold"),
+    "low_stock_threshold" = COALESCE(sqlc.narg('low_stock_threshold'), "low_stock_threshold"),
+    "alert" = CASE WHEN sqlc.arg('null_alert')::bool = TRUE THEN NULL ELSE COALESCE(sqlc.narg('alert'), "alert") END,
+    "date_alerted" = CASE WHEN sqlc.arg('null_date_alerted')::bool = TRUE THEN NULL ELSE COALESCE(sqlc.narg('date_alerted'), "date_alerted") END,
     "date_created" = COALESCE(sqlc.narg('date_created'), "date_created")
 WHERE ("id" = sqlc.narg('id')) OR ("ref_id" = sqlc.narg('ref_id') AND "ref_type" = sqlc.narg('ref_type'))
 RETURNING *;
@@ -1865,6 +1889,10 @@ WHERE (
     ("change" = ANY(sqlc.slice('change')) OR sqlc.slice('change') IS NULL) AND
     ("change" >= sqlc.narg('change_from') OR sqlc.narg('change_from') IS NULL) AND
     ("change" <= sqlc.narg('change_to') OR sqlc.narg('change_to') IS NULL) AND
+    ("account_id" = ANY(sqlc.slice('account_id')) OR sqlc.slice('account_id') IS NULL) AND
+    ("account_id" >= sqlc.narg('account_id_from') OR sqlc.narg('account_id_from') IS NULL) AND
+    ("account_id" <= sqlc.narg('account_id_to') OR sqlc.narg('account_id_to') IS NULL) AND
+    ("reason" = ANY(sqlc.slice('reason')) OR sqlc.slice('reason') IS NULL) AND
     ("date_created" = ANY(sqlc.slice('date_created')) OR sqlc.slice('date_created') IS NULL) AND
     ("date_created" >= sqlc.narg('date_created_from') OR sqlc.narg('date_created_from') IS NULL) AND
     ("date_created" <= sqlc.narg('date_created_to') OR sqlc.narg('date_created_to') IS NULL)
@@ -1884,6 +1912,10 @@ WHERE (
     ("change" = ANY(sqlc.slice('change')) OR sqlc.slice('change') IS NULL) AND
     ("change" >= sqlc.narg('change_from') OR sqlc.narg('change_from') IS NULL) AND
     ("change" <= sqlc.narg('change_to') OR sqlc.narg('change_to') IS NULL) AND
+    ("account_id" = ANY(sqlc.slice('account_id')) OR sqlc.slice('account_id') IS NULL) AND
+    ("account_id" >= sqlc.narg('account_id_from') OR sqlc.narg('account_id_from') IS NULL) AND
+    ("account_id" <= sqlc.narg('account_id_to') OR sqlc.narg('account_id_to') IS NULL) AND
+    ("reason" = ANY(sqlc.slice('reason')) OR sqlc.slice('reason') IS NULL) AND
     ("date_created" = ANY(sqlc.slice('date_created')) OR sqlc.slice('date_created') IS NULL) AND
     ("date_created" >= sqlc.narg('date_created_from') OR sqlc.narg('date_created_from') IS NULL) AND
     ("date_created" <= sqlc.narg('date_created_to') OR sqlc.narg('date_created_to') IS NULL)
@@ -1902,6 +1934,10 @@ WHERE (
     ("change" = ANY(sqlc.slice('change')) OR sqlc.slice('change') IS NULL) AND
     ("change" >= sqlc.narg('change_from') OR sqlc.narg('change_from') IS NULL) AND
     ("change" <= sqlc.narg('change_to') OR sqlc.narg('change_to') IS NULL) AND
+    ("account_id" = ANY(sqlc.slice('account_id')) OR sqlc.slice('account_id') IS NULL) AND
+    ("account_id" >= sqlc.narg('account_id_from') OR sqlc.narg('account_id_from') IS NULL) AND
+    ("account_id" <= sqlc.narg('account_id_to') OR sqlc.narg('account_id_to') IS NULL) AND
+    ("reason" = ANY(sqlc.slice('reason')) OR sqlc.slice('reason') IS NULL) AND
     ("date_created" = ANY(sqlc.slice('date_created')) OR sqlc.slice('date_created') IS NULL) AND
     ("date_created" >= sqlc.narg('date_created_from') OR sqlc.narg('date_created_from') IS NULL) AND
     ("date_created" <= sqlc.narg('date_created_to') OR sqlc.narg('date_created_to') IS NULL)
@@ -1912,17 +1948,19 @@ OFFSET sqlc.narg('offset');
 
 
 -- name: CreateInventoryStockHistory :copyfrom
-INSERT INTO "inventory"."stock_history" ("stock_id", "change", "date_created")
-VALUES ($1, $2, $3);
+INSERT INTO "inventory"."stock_history" ("stock_id", "change", "account_id", "reason", "date_created")
+VALUES ($1, $2, $3, $4, $5);
 
 -- name: CreateDefaultInventoryStockHistory :copyfrom
-INSERT INTO "inventory"."stock_history" ("stock_id", "change")
-VALUES ($1, $2);
+INSERT INTO "inventory"."stock_history" ("stock_id", "change", "account_id", "reason")
+VALUES ($1, $2, $3, $4);
 
 -- name: UpdateInventoryStockHistory :one
 UPDATE "inventory"."stock_history"
 SET "stock_id" = COALESCE(sqlc.narg('stock_id'), "stock_id"),
     "change" = COALESCE(sqlc.narg('change'), "change"),
+    "account_id" = CASE WHEN sqlc.arg('null_account_id')::bool = TRUE THEN NULL ELSE COALESCE(sqlc.narg('account_id'), "account_id") END,
+    "reason" = CASE WHEN sqlc.arg('null_reason')::bool = TRUE THEN NULL ELSE COALESCE(sqlc.narg('reason'), "reason") END,
     "date_created" = COALESCE(sqlc.narg('date_created'), "date_created")
 WHERE ("id" = sqlc.narg('id'))
 RETURNING *;
@@ -1933,6 +1971,135 @@ WHERE ("id" = sqlc.narg('id'));
 
 -- ========================================
 
+-- Queries for table: inventory.stock_reservation
+
+-- ========================================
+
+-- name: GetInventoryStockReservation :one
+SELECT *
+FROM "inventory"."stock_reservation"
+WHERE ("id" = sqlc.narg('id'));
+
+-- name: ExistsInventoryStockReservation :one
+SELECT EXISTS (
+SELECT 1
+FROM "inventory"."stock_reservation"
+WHERE (
+    ("id" = ANY(sqlc.slice('id')) OR sqlc.slice('id') IS NULL) AND
+    ("id" >= sqlc.narg('id_from') OR sqlc.narg('id_from') IS NULL) AND
+    ("id" <= sqlc.narg('id_to') OR sqlc.narg('id_to') IS NULL) AND
+    ("stock_id" = ANY(sqlc.slice('stock_id')) OR sqlc.slice('stock_id') IS NULL) AND
+    ("stock_id" >= sqlc.narg('stock_id_from') OR sqlc.narg('stock_id_from') IS NULL) AND
+    ("stock_id" <= sqlc.narg('stock_id_to') OR sqlc.narg('stock_id_to') IS NULL) AND
+    ("ref_type" = ANY(sqlc.slice('ref_type')) OR sqlc.slice('ref_type') IS NULL) AND
+    ("ref_id" = ANY(sqlc.slice('ref_id')) OR sqlc.slice('ref_id') IS NULL) AND
+    ("ref_id" >= sqlc.narg('ref_id_from') OR sqlc.narg('ref_id_from') IS NULL) AND
+    ("ref_id" <= sqlc.narg('ref_id_to') OR sqlc.narg('ref_id_to') IS NULL) AND
+    ("quantity" = ANY(sqlc.slice('quantity')) OR sqlc.slice('quantity') IS NULL) AND
+    ("quantity" >= sqlc.narg('quantity_from') OR sqlc.narg('quantity_from') IS NULL) AND
+    ("quantity" <= sqlc.narg('quantity_to') OR sqlc.narg('quantity_to') IS NULL) AND
+    ("status" = ANY(sqlc.slice('status')) OR sqlc.slice('status') IS NULL) AND
+    ("date_expired" = ANY(sqlc.slice('date_expired')) OR sqlc.slice('date_expired') IS NULL) AND
+    ("date_expired" >= sqlc.narg('date_expired_from') OR sqlc.narg('date_expired_from') IS NULL) AND
+    ("date_expired" <= sqlc.narg('date_expired_to') OR sqlc.narg('date_expired_to') IS NULL) AND
+    ("date_created" = ANY(sqlc.slice('date_created')) OR sqlc.slice('date_created') IS NULL) AND
+    ("date_created" >= sqlc.narg('date_created_from') OR sqlc.narg('date_created_from') IS NULL) AND
+    ("date_created" <= sqlc.narg('date_created_to') OR sqlc.narg('date_created_to') IS NULL) AND
+    ("date_updated" = ANY(sqlc.slice('date_updated')) OR sqlc.slice('date_updated') IS NULL) AND
+    ("date_updated" >= sqlc.narg('date_updated_from') OR sqlc.narg('date_updated_from') IS NULL) AND
+    ("date_updated" <= sqlc.narg('date_updated_to') OR sqlc.narg('date_updated_to') IS NULL)
+)
+) as exists;
+
+-- name: CountInventoryStockReservation :one
+SELECT COUNT(*)
+FROM "inventory"."stock_reservation"
+WHERE (
+    ("id" = ANY(sqlc.slice('id')) OR sqlc.slice('id') IS NULL) AND
+    ("id" >= sqlc.narg('id_from') OR sqlc.narg('id_from') IS NULL) AND
+    ("id" <= sqlc.narg('id_to') OR sqlc.narg('id_to') IS NULL) AND
+    ("stock_id" = ANY(sqlc.slice('stock_id')) OR sqlc.slice('stock_id') IS NULL) AND
+    ("stock_id" >= sqlc.narg('stock_id_from') OR sqlc.narg('stock_id_from') IS NULL) AND
+    ("stock_id" <= sqlc.narg('stock_id_to') OR sqlc.narg('stock_id_to') IS NULL) AND
+    ("ref_type" = ANY(sqlc.slice('ref_type')) OR sqlc.slice('ref_type') IS NULL) AND
+    ("ref_id" = ANY(sqlc.slice('ref_id')) OR sqlc.slice('ref_id') IS NULL) AND
+    ("ref_id" >= sqlc.narg('ref_id_from') OR sqlc.narg('ref_id_from') IS NULL) AND
+    ("ref_id" <= sqlc.narg('ref_id_to') OR sqlc.narg('ref_id_to') IS NULL) AND
+    ("quantity" = ANY(sqlc.slice('quantity')) OR sqlc.slice('quantity') IS NULL) AND
+    ("quantity" >= sqlc.narg('quantity_from') OR sqlc.narg('quantity_from') IS NULL) AND
+    ("quantity" <= sqlc.narg('quantity_to') OR sqlc.narg('quantity_to') IS NULL) AND
+    ("status" = ANY(sqlc.slice('status')) OR sqlc.slice('status') IS NULL) AND
+    ("date_expired" = ANY(sqlc.slice('date_expired')) OR sqlc.slice('date_expired') IS NULL) AND
+    ("date_expired" >= sqlc.narg('date_expired_from') OR sqlc.narg('date_expired_from') IS NULL) AND
+    ("date_expired" <= sqlc.narg('date_expired_to') OR sqlc.narg('date_expired_to') IS NULL) AND
+    ("date_created" = ANY(sqlc.slice('date_created')) OR sqlc.slice('date_created') IS NULL) AND
+    ("date_created" >= sqlc.narg('date_created_from') OR sqlc.narg('date_created_from') IS NULL) AND
+    ("date_created" <= sqlc.narg('date_created_to') OR sqlc.narg('date_created_to') IS NULL) AND
+    ("date_updated" = ANY(sqlc.slice('date_updated')) OR sqlc.slice('date_updated') IS NULL) AND
+    ("date_updated" >= sqlc.narg('date_updated_from') OR sqlc.narg('date_updated_from') IS NULL) AND
+    ("date_updated" <= sqlc.narg('date_updated_to') OR sqlc.narg('date_updated_to') IS NULL)
+);
+
+-- name: ListInventoryStockReservation :many
+SELECT *
+FROM "inventory"."stock_reservation"
+WHERE (
+    ("id" = ANY(sqlc.slice('id')) OR sqlc.slice('id') IS NULL) AND
+    ("id" >= sqlc.narg('id_from') OR sqlc.narg('id_from') IS NULL) AND
+    ("id" <= sqlc.narg('id_to') OR sqlc.narg('id_to') IS NULL) AND
+    ("stock_id" = ANY(sqlc.slice('stock_id')) OR sqlc.slice('stock_id') IS NULL) AND
+    ("stock_id" >= sqlc.narg('stock_id_from') OR sqlc.narg('stock_id_from') IS NULL) AND
+    ("stock_id" <= sqlc.narg('stock_id_to') OR sqlc.narg('stock_id_to') IS NULL) AND
+    ("ref_type" = ANY(sqlc.slice('ref_type')) OR sqlc.slice('ref_type') IS NULL) AND
+    ("ref_id" = ANY(sqlc.slice('ref_id')) OR sqlc.slice('ref_id') IS NULL) AND
+    ("ref_id" >= sqlc.narg('ref_id_from') OR sqlc.narg('ref_id_from') IS NULL) AND
+    ("ref_id" <= sqlc.narg('ref_id_to') OR sqlc.narg('ref_id_to') IS NULL) AND
+    ("quantity" = ANY(sqlc.slice('quantity')) OR sqlc.slice('quantity') IS NULL) AND
+    ("quantity" >= sqlc.narg('quantity_from') OR sqlc.narg('quantity_from') IS NULL) AND
+    ("quantity" <= sqlc.narg('quantity_to') OR sqlc.narg('quantity_to') IS NULL) AND
+    ("status" = ANY(sqlc.slice('status')) OR sqlc.slice('status') IS NULL) AND
+    ("date_expired" = ANY(sqlc.slice('date_expired')) OR sqlc.slice('date_expired') IS NULL) AND
+    ("date_expired" >= sqlc.narg('date_expired_from') OR sqlc.narg('date_expired_from') IS NULL) AND
+    ("date_expired" <= sqlc.narg('date_expired_to') OR sqlc.narg('date_expired_to') IS NULL) AND
+    ("date_created" = ANY(sqlc.slice('date_created')) OR sqlc.slice('date_created') IS NULL) AND
+    ("date_created" >= sqlc.narg('date_created_from') OR sqlc.narg('date_created_from') IS NULL) AND
+    ("date_created" <= sqlc.narg('date_created_to') OR sqlc.narg('date_created_to') IS NULL) AND
+    ("date_updated" = ANY(sqlc.slice('date_updated')) OR sqlc.slice('date_updated') IS NULL) AND
+    ("date_updated" >= sqlc.narg('date_updated_from') OR sqlc.narg('date_updated_from') IS NULL) AND
+    ("date_updated" <= sqlc.narg('date_updated_to') OR sqlc.narg('date_updated_to') IS NULL)
+)
+ORDER BY "id"
+LIMIT sqlc.narg('limit')
+OFFSET sqlc.narg('offset');
+
+
+-- name: CreateInventoryStockReservation :copyfrom
+INSERT INTO "inventory"."stock_reservation" ("stock_id", "ref_type", "ref_id", "quantity", "status", "date_expired", "date_created", "date_updated")
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: CreateDefaultInventoryStockReservation :copyfrom
+INSERT INTO "inventory"."stock_reservation" ("stock_id", "ref_type", "ref_id", "quantity", "date_expired")
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: UpdateInventoryStockReservation :one
+UPDATE "inventory"."stock_reservation"
+SET "stock_id" = COALESCE(sqlc.narg('stock_id'), "stock_id"),
+    "ref_type" = COALESCE(sqlc.narg('ref_type'), "ref_type"),
+    "ref_id" = COALESCE(sqlc.narg('ref_id'), "ref_id"),
+    "quantity" = COALESCE(sqlc.narg('quantity'), "quantity"),
+    "status" = COALESCE(sqlc.narg('status'), "status"),
+    "date_expired" = COALESCE(sqlc.narg('date_expired'), "date_expired"),
+    "date_created" = COALESCE(sqlc.narg('date_created'), "date_created"),
+    "date_updated" = COALESCE(sqlc.narg('date_updated'), "date_updated")
+WHERE ("id" = sqlc.narg('id'))
+RETURNING *;
+
+-- name: DeleteInventoryStockReservation :exec
+DELETE FROM "inventory"."stock_reservation"
+WHERE ("id" = sqlc.narg('id'));
+
+-- ========================================
+
 -- Queries for table: order.base
 
 -- ========================================
@@ -2063,7 +2230,16 @@ WHERE (
     ("sku_id" <= sqlc.narg('sku_id_to') OR sqlc.narg('sku_id_to') IS NULL) AND
     ("quantity" = ANY(sqlc.slice('quantity')) OR sqlc.slice('quantity') IS NULL) AND
     ("quantity" >= sqlc.narg('quantity_from') OR sqlc.narg('quantity_from') IS NULL) AND
-    ("quantity" <= sqlc.narg('quantity_to') OR sqlc.narg('quantity_to') IS NULL)
+    ("quantity" <= sqlc.narg('quantity_to') OR sqlc.narg('quantity_to') IS NULL) AND
+    ("unit_price" = ANY(sqlc.slice('unit_price')) OR sqlc.slice('unit_price') IS NULL) AND
+    ("unit_price" >= sqlc.narg('unit_price_from') OR sqlc.narg('unit_price_from') IS NULL) AND
+    ("unit_price" <= sqlc.narg('unit_price_to') OR sqlc.narg('unit_price_to') IS NULL) AND
+    ("subtotal" = ANY(sqlc.slice('subtotal')) OR sqlc.slice('subtotal') IS NULL) AND
+    ("subtotal" >= sqlc.narg('subtotal_from') OR sqlc.narg('subtotal_from') IS NULL) AND
+    ("subtotal" <= sqlc.narg('subtotal_to') OR sqlc.narg('subtotal_to') IS NULL) AND
+    ("total" = ANY(sqlc.slice('total')) OR sqlc.slice('total') IS NULL) AND
+    ("total" >= sqlc.narg('total_from') OR sqlc.narg('total_from') IS NULL) AND
+    ("total" <= sqlc.narg('total_to') OR sqlc.narg('total_to') IS NULL)
 )
 ) as exists;
 
@@ -2083,7 +2259,16 @@ WHERE (
     ("sku_id" <= sqlc.narg('sku_id_to') OR sqlc.narg('sku_id_to') IS NULL) AND
     ("quantity" = ANY(sqlc.slice('quantity')) OR sqlc.slice('quantity') IS NULL) AND
     ("quantity" >= sqlc.narg('quantity_from') OR sqlc.narg('quantity_from') IS NULL) AND
-    ("quantity" <= sqlc.narg('quantity_to') OR sqlc.narg('quantity_to') IS NULL)
+    ("quantity" <= sqlc.narg('quantity_to') OR sqlc.narg('quantity_to') IS NULL) AND
+    ("unit_price" = ANY(sqlc.slice('unit_price')) OR sqlc.slice('unit_price') IS NULL) AND
+    ("unit_price" >= sqlc.narg('unit_price_from') OR sqlc.narg('unit_price_from') IS NULL) AND
+    ("unit_price" <= sqlc.narg('unit_price_to') OR sqlc.narg('unit_price_to') IS NULL) AND
+    ("subtotal" = ANY(sqlc.slice('subtotal')) OR sqlc.slice('subtotal') IS NULL) AND
+    ("subtotal" >= sqlc.narg('subtotal_from') OR sqlc.narg('subtotal_from') IS NULL) AND
+    ("subtotal" <= sqlc.narg('subtotal_to') OR sqlc.narg('subtotal_to') IS NULL) AND
+    ("total" = ANY(sqlc.slice('total')) OR sqlc.slice('total') IS NULL) AND
+    ("total" >= sqlc.narg('total_from') OR sqlc.narg('total_from') IS NULL) AND
+    ("total" <= sqlc.narg('total_to') OR sqlc.narg('total_to') IS NULL)
 );
 
 -- name: ListOrderItem :many
@@ -2102,7 +2287,16 @@ WHERE (
     ("sku_id" <= sqlc.narg('sku_id_to') OR sqlc.narg('sku_id_to') IS NULL) AND
     ("quantity" = ANY(sqlc.slice('quantity')) OR sqlc.slice('quantity') IS NULL) AND
     ("quantity" >= sqlc.narg('quantity_from') OR sqlc.narg('quantity_from') IS NULL) AND
-    ("quantity" <= sqlc.narg('quantity_to') OR sqlc.narg('quantity_to') IS NULL)
+    ("quantity" <= sqlc.narg('quantity_to') OR sqlc.narg('quantity_to') IS NULL) AND
+    ("unit_price" = ANY(sqlc.slice('unit_price')) OR sqlc.slice('unit_price') IS NULL) AND
+    ("unit_price" >= sqlc.narg('unit_price_from') OR sqlc.narg('unit_price_from') IS NULL) AND
+    ("unit_price" <= sqlc.narg('unit_price_to') OR sqlc.narg('unit_price_to') IS NULL) AND
+    ("subtotal" = ANY(sqlc.slice('subtotal')) OR sqlc.slice('subtotal') IS NULL) AND
+    ("subtotal" >= sqlc.narg('subtotal_from') OR sqlc.narg('subtotal_from') IS NULL) AND
+    ("subtotal" <= sqlc.narg('subtotal_to') OR sqlc.narg('subtotal_to') IS NULL) AND
+    ("total" = ANY(sqlc.slice('total')) OR sqlc.slice('total') IS NULL) AND
+    ("total" >= sqlc.narg('total_from') OR sqlc.narg('total_from') IS NULL) AND
+    ("total" <= sqlc.narg('total_to') OR sqlc.narg('total_to') IS NULL)
 )
 ORDER BY "id"
 LIMIT sqlc.narg('limit')
@@ -2110,19 +2304,22 @@ OFFSET sqlc.narg('offset');
 
 
 -- name: CreateOrderItem :copyfrom
-INSERT INTO "order"."item" ("code", "order_id", "sku_id", "quantity")
-VALUES ($1, $2, $3, $4);
+INSERT INTO "order"."item" ("code", "order_id", "sku_id", "quantity", "unit_price", "subtotal", "total")
+VALUES ($1, $2, $3, $4, $5, $6, $7);
 
 -- name: CreateDefaultOrderItem :copyfrom
-INSERT INTO "order"."item" ("code", "order_id", "sku_id", "quantity")
-VALUES ($1, $2, $3, $4);
+INSERT INTO "order"."item" ("code", "order_id", "sku_id", "quantity", "unit_price", "subtotal", "total")
+VALUES ($1, $2, $3, $4, $5, $6, $7);
 
 -- name: UpdateOrderItem :one
 UPDATE "order"."item"
 SET "code" = COALESCE(sqlc.narg('code'), "code"),
     "order_id" = COALESCE(sqlc.narg('order_id'), "order_id"),
     "sku_id" = COALESCE(sqlc.narg('sku_id'), "sku_id"),
-    "quantity" = COALESCE(sqlc.narg('quantity'), "quantity")
+    "quantity" = COALESCE(sqlc.narg('quantity'), "quantity"),
+    "unit_price" = COALESCE(sqlc.narg('unit_price'), "unit_price"),
+    "subtotal" = COALESCE(sqlc.narg('subtotal'), "subtotal"),
+    "total" = COALESCE(sqlc.narg('total'), "total")
 WHERE ("id" = sqlc.narg('id')) OR ("code" = sqlc.narg('code'))
 RETURNING *;
 
@@ -2256,17 +2453,18 @@ OFFSET sqlc.narg('offset');
 
 
 -- name: CreateOrderVnpay :copyfrom
-INSERT INTO "order"."vnpay" ("id", "vnp_Amount", "vnp_BankCode", "vnp_CardType", "vnp_OrderInfo", "vnp_PayDate", "vnp_ResponseCode", "vnp_SecureHash", "vnp_TmnCode", "vnp_TransactionNo", "vnp_TransactionStatus", "vnp_TxnRef")
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12);
+INSERT INTO "order"."vnpay" ("id", "vnp_Amount", "vnp_BankCode", "vnp_BankTranNo", "vnp_CardType", "vnp_OrderInfo", "vnp_PayDate", "vnp_ResponseCode", "vnp_SecureHash", "vnp_TmnCode", "vnp_TransactionNo", "vnp_TransactionStatus", "vnp_TxnRef")
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13);
 
 -- name: CreateDefaultOrderVnpay :copyfrom
-INSERT INTO "order"."vnpay" ("id", "vnp_Amount", "vnp_BankCode", "vnp_CardType", "vnp_OrderInfo", "vnp_PayDate", "vnp_ResponseCode", "vnp_SecureHash", "vnp_TmnCode", "vnp_TransactionNo", "vnp_TransactionStatus", "vnp_TxnRef")
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12);
+INSERT INTO "order"."vnpay" ("id", "vnp_Amount", "vnp_BankCode", "vnp_BankTranNo", "vnp_CardType", "vnp_OrderInfo", "vnp_PayDate", "vnp_ResponseCode", "vnp_SecureHash", "vnp_TmnCode", "vnp_TransactionNo", "vnp_TransactionStatus", "vnp_TxnRef")
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13);
 
 -- name: UpdateOrderVnpay :one
 UPDATE "order"."vnpay"
 SET "vnp_Amount" = COALESCE(sqlc.narg('vnp_Amount'), "vnp_Amount"),
     "vnp_BankCode" = COALESCE(sqlc.narg('vnp_BankCode'), "vnp_BankCode"),
+    "vnp_BankTranNo" = CASE WHEN sqlc.arg('null_vnp_BankTranNo')::bool = TRUE THEN NULL ELSE COALESCE(sqlc.narg('vnp_BankTranNo'), "vnp_BankTranNo") END,
     "vnp_CardType" = COALESCE(sqlc.narg('vnp_CardType'), "vnp_CardType"),
     "vnp_OrderInfo" = COALESCE(sqlc.narg('vnp_OrderInfo'), "vnp_OrderInfo"),
     "vnp_PayDate" = COALESCE(sqlc.narg('vnp_PayDate'), "vnp_PayDate"),
@@ -2794,6 +2992,7 @@ WHERE (
     ("ref_id" <= sqlc.narg('ref_id_to') OR sqlc.narg('ref_id_to') IS NULL) AND
     ("type" = ANY(sqlc.slice('type')) OR sqlc.slice('type') IS NULL) AND
     ("is_active" = ANY(sqlc.slice('is_active')) OR sqlc.slice('is_active') IS NULL) AND
+    ("exclusive" = ANY(sqlc.slice('exclusive')) OR sqlc.slice('exclusive') IS NULL) AND
     ("date_started" = ANY(sqlc.slice('date_started')) OR sqlc.slice('date_started') IS NULL) AND
     ("date_started" >= sqlc.narg('date_started_from') OR sqlc.narg('date_started_from') IS NULL) AND
     ("date_started" <= sqlc.narg('date_started_to') OR sqlc.narg('date_started_to') IS NULL) AND
@@ -2801,8 +3000,6 @@ WHERE (
     ("date_ended" >= sqlc.narg('date_ended_from') OR sqlc.narg('date_ended_from') IS NULL) AND
     ("date_ended" <= sqlc.narg('date_ended_to') OR sqlc.narg('date_ended_to') IS NULL) AND
     ("schedule_start" = ANY(sqlc.slice('schedule_start')) OR sqlc.slice('schedule_start') IS NULL) AND
-    ("schedule_start" >= sqlc.narg('schedule_start_from') OR sqlc.narg('schedule_start_from') IS NULL) AND
-    ("schedule_start" <= sqlc.narg('schedule_start_to') OR sqlc.narg('schedule_start_to') IS NULL) AND
     ("schedule_duration" = ANY(sqlc.slice('schedule_duration')) OR sqlc.slice('schedule_duration') IS NULL) AND
     ("schedule_duration" >= sqlc.narg('schedule_duration_from') OR sqlc.narg('schedule_duration_from') IS NULL) AND
     ("schedule_duration" <= sqlc.narg('schedule_duration_to') OR sqlc.narg('schedule_duration_to') IS NULL) AND
@@ -2832,6 +3029,7 @@ WHERE (
     ("ref_id" <= sqlc.narg('ref_id_to') OR sqlc.narg('ref_id_to') IS NULL) AND
     ("type" = ANY(sqlc.slice('type')) OR sqlc.slice('type') IS NULL) AND
     ("is_active" = ANY(sqlc.slice('is_active')) OR sqlc.slice('is_active') IS NULL) AND
+    ("exclusive" = ANY(sqlc.slice('exclusive')) OR sqlc.slice('exclusive') IS NULL) AND
     ("date_started" = ANY(sqlc.slice('date_started')) OR sqlc.slice('date_started') IS NULL) AND
     ("date_started" >= sqlc.narg('date_started_from') OR sqlc.narg('date_started_from') IS NULL) AND
     ("date_started" <= sqlc.narg('date_started_to') OR sqlc.narg('date_started_to') IS NULL) AND
@@ -2839,8 +3037,6 @@ WHERE (
     ("date_ended" >= sqlc.narg('date_ended_from') OR sqlc.narg('date_ended_from') IS NULL) AND
     ("date_ended" <= sqlc.narg('date_ended_to') OR sqlc.narg('date_ended_to') IS NULL) AND
     ("schedule_start" = ANY(sqlc.slice('schedule_start')) OR sqlc.slice('schedule_start') IS NULL) AND
-    ("schedule_start" >= sqlc.narg('schedule_start_from') OR sqlc.narg('schedule_start_from') IS NULL) AND
-    ("schedule_start" <= sqlc.narg('schedule_start_to') OR sqlc.narg('schedule_start_to') IS NULL) AND
     ("schedule_duration" = ANY(sqlc.slice('schedule_duration')) OR sqlc.slice('schedule_duration') IS NULL) AND
     ("schedule_duration" >= sqlc.narg('schedule_duration_from') OR sqlc.narg('schedule_duration_from') IS NULL) AND
     ("schedule_duration" <= sqlc.narg('schedule_duration_to') OR sqlc.narg('schedule_duration_to') IS NULL) AND
@@ -2869,6 +3065,7 @@ WHERE (
     ("ref_id" <= sqlc.narg('ref_id_to') OR sqlc.narg('ref_id_to') IS NULL) AND
     ("type" = ANY(sqlc.slice('type')) OR sqlc.slice('type') IS NULL) AND
     ("is_active" = ANY(sqlc.slice('is_active')) OR sqlc.slice('is_active') IS NULL) AND
+    ("exclusive" = ANY(sqlc.slice('exclusive')) OR sqlc.slice('exclusive') IS NULL) AND
     ("date_started" = ANY(sqlc.slice('date_started')) OR sqlc.slice('date_started') IS NULL) AND
     ("date_started" >= sqlc.narg('date_started_from') OR sqlc.narg('date_started_from') IS NULL) AND
     ("date_started" <= sqlc.narg('date_started_to') OR sqlc.narg('date_started_to') IS NULL) AND
@@ -2876,8 +3073,6 @@ WHERE (
     ("date_ended" >= sqlc.narg('date_ended_from') OR sqlc.narg('date_ended_from') IS NULL) AND
     ("date_ended" <= sqlc.narg('date_ended_to') OR sqlc.narg('date_ended_to') IS NULL) AND
     ("schedule_start" = ANY(sqlc.slice('schedule_start')) OR sqlc.slice('schedule_start') IS NULL) AND
-    ("schedule_start" >= sqlc.narg('schedule_start_from') OR sqlc.narg('schedule_start_from') IS NULL) AND
-    ("schedule_start" <= sqlc.narg('schedule_start_to') OR sqlc.narg('schedule_start_to') IS NULL) AND
     ("schedule_duration" = ANY(sqlc.slice('schedule_duration')) OR sqlc.slice('schedule_duration') IS NULL) AND
     ("schedule_duration" >= sqlc.narg('schedule_duration_from') OR sqlc.narg('schedule_duration_from') IS NULL) AND
     ("schedule_duration" <= sqlc.narg('schedule_duration_to') OR sqlc.narg('schedule_duration_to') IS NULL) AND
@@ -2894,8 +3089,8 @@ OFFSET sqlc.narg('offset');
 
 
 -- name: CreatePromotionBase :copyfrom
-INSERT INTO "promotion"."base" ("code", "owner_id", "ref_type", "ref_id", "type", "title", "description", "is_active", "date_started", "date_ended", "schedule_tz", "schedule_start", "schedule_duration", "date_created", "date_updated")
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15);
+INSERT INTO "promotion"."base" ("code", "owner_id", "ref_type", "ref_id", "type", "title", "description", "is_active", "exclusive", "date_started", "date_ended", "schedule_tz", "schedule_start", "schedule_duration", "date_created", "date_updated")
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16);
 
 -- name: CreateDefaultPromotionBase :copyfrom
 INSERT INTO "promotion"."base" ("code", "owner_id", "ref_type", "ref_id", "type", "title", "description", "date_ended", "schedule_tz", "schedule_start", "schedule_duration", "date_updated")
@@ -2911,6 +3106,7 @@ SET "code" = COALESCE(sqlc.narg('code'), "code"),
     "title" = COALESCE(sqlc.narg('title'), "title"),
     "description" = CASE WHEN sqlc.arg('null_description')::bool = TRUE THEN NULL ELSE COALESCE(sqlc.narg('description'), "description") END,
     "is_active" = COALESCE(sqlc.narg('is_active'), "is_active"),
+    "exclusive" = COALESCE(sqlc.narg('exclusive'), "exclusive"),
     "date_started" = COALESCE(sqlc.narg('date_started'), "date_started"),
     "date_ended" = CASE WHEN sqlc.arg('null_date_ended')::bool = TRUE THEN NULL ELSE COALESCE(sqlc.narg('date_ended'), "date_ended") END,
     "schedule_tz" = CASE WHEN sqlc.arg('null_schedule_tz')::bool = TRUE THEN NULL ELSE COALESCE(sqlc.narg('schedule_tz'), "schedule_tz") END,
@@ -3032,6 +3228,695 @@ WHERE ("id" = sqlc.narg('id'));
 
 -- ========================================
 
+-- Queries for table: promotion.bundle
+
+-- ========================================
+
+-- name: GetPromotionBundle :one
+SELECT *
+FROM "promotion"."bundle"
+WHERE ("id" = sqlc.narg('id'));
+
+-- name: ExistsPromotionBundle :one
+SELECT EXISTS (
+SELECT 1
+FROM "promotion"."bundle"
+WHERE (
+    ("id" = ANY(sqlc.slice('id')) OR sqlc.slice('id') IS NULL) AND
+    ("id" >= sqlc.narg('id_from') OR sqlc.narg('id_from') IS NULL) AND
+    ("id" <= sqlc.narg('id_to') OR sqlc.narg('id_to') IS NULL) AND
+    ("price" = ANY(sqlc.slice('price')) OR sqlc.slice('price') IS NULL) AND
+    ("price" >= sqlc.narg('price_from') OR sqlc.narg('price_from') IS NULL) AND
+    ("price" <= sqlc.narg('price_to') OR sqlc.narg('price_to') IS NULL)
+)
+) as exists;
+
+-- name: CountPromotionBundle :one
+SELECT COUNT(*)
+FROM "promotion"."bundle"
+WHERE (
+    ("id" = ANY(sqlc.slice('id')) OR sqlc.slice('id') IS NULL) AND
+    ("id" >= sqlc.narg('id_from') OR sqlc.narg('id_from') IS NULL) AND
+    ("id" <= sqlc.narg('id_to') OR sqlc.narg('id_to') IS NULL) AND
+    ("price" = ANY(sqlc.slice('price')) OR sqlc.slice('price') IS NULL) AND
+    ("price" >= sqlc.narg('price_from') OR sqlc.narg('price_from') IS NULL) AND
+    ("price" <= sqlc.narg('price_to') OR sqlc.narg('price_to') IS NULL)
+);
+
+-- name: ListPromotionBundle :many
+SELECT *
+FROM "promotion"."bundle"
+WHERE (
+    ("id" = ANY(sqlc.slice('id')) OR sqlc.slice('id') IS NULL) AND
+    ("id" >= sqlc.narg('id_from') OR sqlc.narg('id_from') IS NULL) AND
+    ("id" <= sqlc.narg('id_to') OR sqlc.narg('id_to') IS NULL) AND
+    ("price" = ANY(sqlc.slice('price')) OR sqlc.slice('price') IS NULL) AND
+    ("price" >= sqlc.narg('price_from') OR sqlc.narg('price_from') IS NULL) AND
+    ("price" <= sqlc.narg('price_to') OR sqlc.narg('price_to') IS NULL)
+)
+ORDER BY "id"
+LIMIT sqlc.narg('limit')
+OFFSET sqlc.narg('offset');
+
+
+-- name: CreatePromotionBundle :copyfrom
+INSERT INTO "promotion"."bundle" ("id", "price")
+VALUES ($1, $2);
+
+-- name: CreateDefaultPromotionBundle :copyfrom
+INSERT INTO "promotion"."bundle" ("id", "price")
+VALUES ($1, $2);
+
+-- name: UpdatePromotionBundle :one
+UPDATE "promotion"."bundle"
+SET "price" = COALESCE(sqlc.narg('price'), "price")
+WHERE ("id" = sqlc.narg('id'))
+RETURNING *;
+
+-- name: DeletePromotionBundle :exec
+DELETE FROM "promotion"."bundle"
+WHERE ("id" = sqlc.narg('id'));
+
+-- ========================================
+
+-- Queries for table: promotion.bundle_item
+
+-- ========================================
+
+-- name: GetPromotionBundleItem :one
+SELECT *
+FROM "promotion"."bundle_item"
+WHERE ("id" = sqlc.narg('id')) OR ("bundle_id" = sqlc.narg('bundle_id') AND "sku_id" = sqlc.narg('sku_id'));
+
+-- name: ExistsPromotionBundleItem :one
+SELECT EXISTS (
+SELECT 1
+FROM "promotion"."bundle_item"
+WHERE (
+    ("id" = ANY(sqlc.slice('id')) OR sqlc.slice('id') IS NULL) AND
+    ("id" >= sqlc.narg('id_from') OR sqlc.narg('id_from') IS NULL) AND
+    ("id" <= sqlc.narg('id_to') OR sqlc.narg('id_to') IS NULL) AND
+    ("bundle_id" = ANY(sqlc.slice('bundle_id')) OR sqlc.slice('bundle_id') IS NULL) AND
+    ("bundle_id" >= sqlc.narg('bundle_id_from') OR sqlc.narg('bundle_id_from') IS NULL) AND
+    ("bundle_id" <= sqlc.narg('bundle_id_to') OR sqlc.narg('bundle_id_to') IS NULL) AND
+    ("sku_id" = ANY(sqlc.slice('sku_id')) OR sqlc.slice('sku_id') IS NULL) AND
+    ("sku_id" >= sqlc.narg('sku_id_from') OR sqlc.narg('sku_id_from') IS NULL) AND
+    ("sku_id" <= sqlc.narg('sku_id_to') OR sqlc.narg('sku_id_to') IS NULL) AND
+    ("quantity" = ANY(sqlc.slice('quantity')) OR sqlc.slice('quantity') IS NULL) AND
+    ("quantity" >= sqlc.narg('quantity_from') OR sqlc.narg('quantity_from') IS NULL) AND
+    ("quantity" <= sqlc.narg('quantity_to') OR sqlc.narg('quantity_to') IS NULL)
+)
+) as exists;
+
+-- name: CountPromotionBundleItem :one
+SELECT COUNT(*)
+FROM "promotion"."bundle_item"
+WHERE (
+    ("id" = ANY(sqlc.slice('id')) OR sqlc.slice('id') IS NULL) AND
+    ("id" >= sqlc.narg('id_from') OR sqlc.narg('id_from') IS NULL) AND
+    ("id" <= sqlc.narg('id_to') OR sqlc.narg('id_to') IS NULL) AND
+    ("bundle_id" = ANY(sqlc.slice('bundle_id')) OR sqlc.slice('bundle_id') IS NULL) AND
+    ("bundle_id" >= sqlc.narg('bundle_id_from') OR sqlc.narg('bundle_id_from') IS NULL) AND
+    ("bundle_id" <= sqlc.narg('bundle_id_to') OR sqlc.narg('bundle_id_to') IS NULL) AND
+    ("sku_id" = ANY(sqlc.slice('sku_id')) OR sqlc.slice('sku_id') IS NULL) AND
+    ("sku_id" >= sqlc.narg('sku_id_from') OR sqlc.narg('sku_id_from') IS NULL) AND
+    ("sku_id" <= sqlc.narg('sku_id_to') OR sqlc.narg('sku_id_to') IS NULL) AND
+    ("quantity" = ANY(sqlc.slice('quantity')) OR sqlc.slice('quantity') IS NULL) AND
+    ("quantity" >= sqlc.narg('quantity_from') OR sqlc.narg('quantity_from') IS NULL) AND
+    ("quantity" <= sqlc.narg('quantity_to') OR sqlc.narg('quantity_to') IS NULL)
+);
+
+-- name: ListPromotionBundleItem :many
+SELECT *
+FROM "promotion"."bundle_item"
+WHERE (
+    ("id" = ANY(sqlc.slice('id')) OR sqlc.slice('id') IS NULL) AND
+    ("id" >= sqlc.narg('id_from') OR sqlc.narg('id_from') IS NULL) AND
+    ("id" <= sqlc.narg('id_to') OR sqlc.narg('id_to') IS NULL) AND
+    ("bundle_id" = ANY(sqlc.slice('bundle_id')) OR sqlc.slice('bundle_id') IS NULL) AND
+    ("bundle_id" >= sqlc.narg('bundle_id_from') OR sqlc.narg('bundle_id_from') IS NULL) AND
+    ("bundle_id" <= sqlc.narg('bundle_id_to') OR sqlc.narg('bundle_id_to') IS NULL) AND
+    ("sku_id" = ANY(sqlc.slice('sku_id')) OR sqlc.slice('sku_id') IS NULL) AND
+    ("sku_id" >= sqlc.narg('sku_id_from') OR sqlc.narg('sku_id_from') IS NULL) AND
+    ("sku_id" <= sqlc.narg('sku_id_to') OR sqlc.narg('sku_id_to') IS NULL) AND
+    ("quantity" = ANY(sqlc.slice('quantity')) OR sqlc.slice('quantity') IS NULL) AND
+    ("quantity" >= sqlc.narg('quantity_from') OR sqlc.narg('quantity_from') IS NULL) AND
+    ("quantity" <= sqlc.narg('quantity_to') OR sqlc.narg('quantity_to') IS NULL)
+)
+ORDER BY "id"
+LIMIT sqlc.narg('limit')
+OFFSET sqlc.narg('offset');
+
+
+-- name: CreatePromotionBundleItem :copyfrom
+INSERT INTO "promotion"."bundle_item" ("bundle_id", "sku_id", "quantity")
+VALUES ($1, $2, $3);
+
+-- name: CreateDefaultPromotionBundleItem :copyfrom
+INSERT INTO "promotion"."bundle_item" ("bundle_id", "sku_id")
+VALUES ($1, $2);
+
+-- name: UpdatePromotionBundleItem :one
+UPDATE "promotion"."bundle_item"
+SET "bundle_id" = COALESCE(sqlc.narg('bundle_id'), "bundle_id"),
+    "sku_id" = COALESCE(sqlc.narg('sku_id'), "sku_id"),
+    "quantity" = COALESCE(sqlc.narg('quantity'), "quantity")
+WHERE ("id" = sqlc.narg('id')) OR ("bundle_id" = sqlc.narg('bundle_id') AND "sku_id" = sqlc.narg('sku_id'))
+RETURNING *;
+
+-- name: DeletePromotionBundleItem :exec
+DELETE FROM "promotion"."bundle_item"
+WHERE ("id" = sqlc.narg('id')) OR ("bundle_id" = sqlc.narg('bundle_id') AND "sku_id" = sqlc.narg('sku_id'));
+
+-- ========================================
+
+-- Queries for table: promotion.buy_x_get_y
+
+-- ========================================
+
+-- name: GetPromotionBuyXGetY :one
+SELECT *
+FROM "promotion"."buy_x_get_y"
+WHERE ("id" = sqlc.narg('id'));
+
+-- name: ExistsPromotionBuyXGetY :one
+SELECT EXISTS (
+SELECT 1
+FROM "promotion"."buy_x_get_y"
+WHERE (
+    ("id" = ANY(sqlc.slice('id')) OR sqlc.slice('id') IS NULL) AND
+    ("id" >= sqlc.narg('id_from') OR sqlc.narg('id_from') IS NULL) AND
+    ("id" <= sqlc.narg('id_to') OR sqlc.narg('id_to') IS NULL) AND
+    ("buy_quantity" = ANY(sqlc.slice('buy_quantity')) OR sqlc.slice('buy_quantity') IS NULL) AND
+    ("buy_quantity" >= sqlc.narg('buy_quantity_from') OR sqlc.narg('buy_quantity_from') IS NULL) AND
+    ("buy_quantity" <= sqlc.narg('buy_quantity_to') OR sqlc.narg('buy_quantity_to') IS NULL) AND
+    ("get_quantity" = ANY(sqlc.slice('get_quantity')) OR sqlc.slice('get_quantity') IS NULL) AND
+    ("get_quantity" >= sqlc.narg('get_quantity_from') OR sqlc.narg('get_quantity_from') IS NULL) AND
+    ("get_quantity" <= sqlc.narg('get_quantity_to') OR sqlc.narg('get_quantity_to') IS NULL)
+)
+) as exists;
+
+-- name: CountPromotionBuyXGetY :one
+SELECT COUNT(*)
+FROM "promotion"."buy_x_get_y"
+WHERE (
+    ("id" = ANY(sqlc.slice('id')) OR sqlc.slice('id') IS NULL) AND
+    ("id" >= sqlc.narg('id_from') OR sqlc.narg('id_from') IS NULL) AND
+    ("id" <= sqlc.narg('id_to') OR sqlc.narg('id_to') IS NULL) AND
+    ("buy_quantity" = ANY(sqlc.slice('buy_quantity')) OR sqlc.slice('buy_quantity') IS NULL) AND
+    ("buy_quantity" >= sqlc.narg('buy_quantity_from') OR sqlc.narg('buy_quantity_from') IS NULL) AND
+    ("buy_quantity" <= sqlc.narg('buy_quantity_to') OR sqlc.narg('buy_quantity_to') IS NULL) AND
+    ("get_quantity" = ANY(sqlc.slice('get_quantity')) OR sqlc.slice('get_quantity') IS NULL) AND
+    ("get_quantity" >= sqlc.narg('get_quantity_from') OR sqlc.narg('get_quantity_from') IS NULL) AND
+    ("get_quantity" <= sqlc.narg('get_quantity_to') OR sqlc.narg('get_quantity_to') IS NULL)
+);
+
+-- name: ListPromotionBuyXGetY :many
+SELECT *
+FROM "promotion"."buy_x_get_y"
+WHERE (
+    ("id" = ANY(sqlc.slice('id')) OR sqlc.slice('id') IS NULL) AND
+    ("id" >= sqlc.narg('id_from') OR sqlc.narg('id_from') IS NULL) AND
+    ("id" <= sqlc.narg('id_to') OR sqlc.narg('id_to') IS NULL) AND
+    ("buy_quantity" = ANY(sqlc.slice('buy_quantity')) OR sqlc.slice('buy_quantity') IS NULL) AND
+    ("buy_quantity" >= sqlc.narg('buy_quantity_from') OR sqlc.narg('buy_quantity_from') IS NULL) AND
+    ("buy_quantity" <= sqlc.narg('buy_quantity_to') OR sqlc.narg('buy_quantity_to') IS NULL) AND
+    ("get_quantity" = ANY(sqlc.slice('get_quantity')) OR sqlc.slice('get_quantity') IS NULL) AND
+    ("get_quantity" >= sqlc.narg('get_quantity_from') OR sqlc.narg('get_quantity_from') IS NULL) AND
+    ("get_quantity" <= sqlc.narg('get_quantity_to') OR sqlc.narg('get_quantity_to') IS NULL)
+)
+ORDER BY "id"
+LIMIT sqlc.narg('limit')
+OFFSET sqlc.narg('offset');
+
+
+-- name: CreatePromotionBuyXGetY :copyfrom
+INSERT INTO "promotion"."buy_x_get_y" ("id", "buy_quantity", "get_quantity")
+VALUES ($1, $2, $3);
+
+-- name: CreateDefaultPromotionBuyXGetY :copyfrom
+INSERT INTO "promotion"."buy_x_get_y" ("id", "buy_quantity", "get_quantity")
+VALUES ($1, $2, $3);
+
+-- name: UpdatePromotionBuyXGetY :one
+UPDATE "promotion"."buy_x_get_y"
+SET "buy_quantity" = COALESCE(sqlc.narg('buy_quantity'), "buy_quantity"),
+    "get_quantity" = COALESCE(sqlc.narg('get_quantity'), "get_quantity")
+WHERE ("id" = sqlc.narg('id'))
+RETURNING *;
+
+-- name: DeletePromotionBuyXGetY :exec
+DELETE FROM "promotion"."buy_x_get_y"
+WHERE ("id" = sqlc.narg('id'));
+
+-- ========================================
+
+-- Queries for table: promotion.cashback
+
+-- ========================================
+
+-- name: GetPromotionCashback :one
+SELECT *
+FROM "promotion"."cashback"
+WHERE ("id" = sqlc.narg('id'));
+
+-- name: ExistsPromotionCashback :one
+SELECT EXISTS (
+SELECT 1
+FROM "promotion"."cashback"
+WHERE (
+    ("id" = ANY(sqlc.slice('id')) OR sqlc.slice('id') IS NULL) AND
+    ("id" >= sqlc.narg('id_from') OR sqlc.narg('id_from') IS NULL) AND
+    ("id" <= sqlc.narg('id_to') OR sqlc.narg('id_to') IS NULL) AND
+    ("min_spend" = ANY(sqlc.slice('min_spend')) OR sqlc.slice('min_spend') IS NULL) AND
+    ("min_spend" >= sqlc.narg('min_spend_from') OR sqlc.narg('min_spend_from') IS NULL) AND
+    ("min_spend" <= sqlc.narg('min_spend_to') OR sqlc.narg('min_spend_to') IS NULL) AND
+    ("max_cashback" = ANY(sqlc.slice('max_cashback')) OR sqlc.slice('max_cashback') IS NULL) AND
+    ("max_cashback" >= sqlc.narg('max_cashback_from') OR sqlc.narg('max_cashback_from') IS NULL) AND
+    ("max_cashback" <= sqlc.narg('max_cashback_to') OR sqlc.narg('max_cashback_to') IS NULL) AND
+    ("cashback_percent" = ANY(sqlc.slice('cashback_percent')) OR sqlc.slice('cashback_percent') IS NULL) AND
+    ("cashback_percent" >= sqlc.narg('cashback_percent_from') OR sqlc.narg('cashback_percent_from') IS NULL) AND
+    ("cashback_percent" <= sqlc.narg('cashback_percent_to') OR sqlc.narg('cashback_percent_to') IS NULL) AND
+    ("cashback_price" = ANY(sqlc.slice('cashback_price')) OR sqlc.slice('cashback_price') IS NULL) AND
+    ("cashback_price" >= sqlc.narg('cashback_price_from') OR sqlc.narg('cashback_price_from') IS NULL) AND
+    ("cashback_price" <= sqlc.narg('cashback_price_to') OR sqlc.narg('cashback_price_to') IS NULL)
+)
+) as exists;
+
+-- name: CountPromotionCashback :one
+SELECT COUNT(*)
+FROM "promotion"."cashback"
+WHERE (
+    ("id" = ANY(sqlc.slice('id')) OR sqlc.slice('id') IS NULL) AND
+    ("id" >= sqlc.narg('id_from') OR sqlc.narg('id_from') IS NULL) AND
+    ("id" <= sqlc.narg('id_to') OR sqlc.narg('id_to') IS NULL) AND
+    ("min_spend" = ANY(sqlc.slice('min_spend')) OR sqlc.slice('min_spend') IS NULL) AND
+    ("min_spend" >= sqlc.narg('min_spend_from') OR sqlc.narg('min_spend_from') IS NULL) AND
+    ("min_spend" <= sqlc.narg('min_spend_to') OR sqlc.narg('min_spend_to') IS NULL) AND
+    ("max_cashback" = ANY(sqlc.slice('max_cashback')) OR sqlc.slice('max_cashback') IS NULL) AND
+    ("max_cashback" >= sqlc.narg('max_cashback_from') OR sqlc.narg('max_cashback_from') IS NULL) AND
+    ("max_cashback" <= sqlc.narg('max_cashback_to') OR sqlc.narg('max_cashback_to') IS NULL) AND
+    ("cashback_percent" = ANY(sqlc.slice('cashback_percent')) OR sqlc.slice('cashback_percent') IS NULL) AND
+    ("cashback_percent" >= sqlc.narg('cashback_percent_from') OR sqlc.narg('cashback_percent_from') IS NULL) AND
+    ("cashback_percent" <= sqlc.narg('cashback_percent_to') OR sqlc.narg('cashback_percent_to') IS NULL) AND
+    ("cashback_price" = ANY(sqlc.slice('cashback_price')) OR sqlc.slice('cashback_price') IS NULL) AND
+    ("cashback_price" >= sqlc.narg('cashback_price_from') OR sqlc.narg('cashback_price_from') IS NULL) AND
+    ("cashback_price" <= sqlc.narg('cashback_price_to') OR sqlc.narg('cashback_price_to') IS NULL)
+);
+
+-- name: ListPromotionCashback :many
+SELECT *
+FROM "promotion"."cashback"
+WHERE (
+    ("id" = ANY(sqlc.slice('id')) OR sqlc.slice('id') IS NULL) AND
+    ("id" >= sqlc.narg('id_from') OR sqlc.narg('id_from') IS NULL) AND
+    ("id" <= sqlc.narg('id_to') OR sqlc.narg('id_to') IS NULL) AND
+    ("min_spend" = ANY(sqlc.slice('min_spend')) OR sqlc.slice('min_spend') IS NULL) AND
+    ("min_spend" >= sqlc.narg('min_spend_from') OR sqlc.narg('min_spend_from') IS NULL) AND
+    ("min_spend" <= sqlc.narg('min_spend_to') OR sqlc.narg('min_spend_to') IS NULL) AND
+    ("max_cashback" = ANY(sqlc.slice('max_cashback')) OR sqlc.slice('max_cashback') IS NULL) AND
+    ("max_cashback" >= sqlc.narg('max_cashback_from') OR sqlc.narg('max_cashback_from') IS NULL) AND
+    ("max_cashback" <= sqlc.narg('max_cashback_to') OR sqlc.narg('max_cashback_to') IS NULL) AND
+    ("cashback_percent" = ANY(sqlc.slice('cashback_percent')) OR sqlc.slice('cashback_percent') IS NULL) AND
+    ("cashback_percent" >= sqlc.narg('cashback_percent_from') OR sqlc.narg('cashback_percent_from') IS NULL) AND
+    ("cashback_percent" <= sqlc.narg('cashback_percent_to') OR sqlc.narg('cashback_percent_to') IS NULL) AND
+    ("cashback_price" = ANY(sqlc.slice('cashback_price')) OR sqlc.slice('cashback_price') IS NULL) AND
+    ("cashback_price" >= sqlc.narg('cashback_price_from') OR sqlc.narg('cashback_price_from') IS NULL) AND
+    ("cashback_price" <= sqlc.narg('cashback_price_to') OR sqlc.narg('cashback_price_to') IS NULL)
+)
+ORDER BY "id"
+LIMIT sqlc.narg('limit')
+OFFSET sqlc.narg('offset');
+
+
+-- name: CreatePromotionCashback :copyfrom
+INSERT INTO "promotion"."cashback" ("id", "min_spend", "max_cashback", "cashback_percent", "cashback_price")
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: CreateDefaultPromotionCashback :copyfrom
+INSERT INTO "promotion"."cashback" ("id", "cashback_percent", "cashback_price")
+VALUES ($1, $2, $3);
+
+-- name: UpdatePromotionCashback :one
+UPDATE "promotion"."cashback"
+SET "min_spend" = COALESCE(sqlc.narg('min_spend'), "min_spend"),
+    "max_cashback" = COALESCE(sqlc.narg('max_cashback'), "max_cashback"),
+    "cashback_percent" = CASE WHEN sqlc.arg('null_cashback_percent')::bool = TRUE THEN NULL ELSE COALESCE(sqlc.narg('cashback_percent'), "cashback_percent") END,
+    "cashback_price" = CASE WHEN sqlc.arg('null_cashback_price')::bool = TRUE THEN NULL ELSE COALESCE(sqlc.narg('cashback_price'), "cashback_price") END
+WHERE ("id" = sqlc.narg('id'))
+RETURNING *;
+
+-- name: DeletePromotionCashback :exec
+DELETE FROM "promotion"."cashback"
+WHERE ("id" = sqlc.narg('id'));
+
+-- ========================================
+
+-- Queries for table: promotion.cashback_credit
+
+-- ========================================
+
+-- name: GetPromotionCashbackCredit :one
+SELECT *
+FROM "promotion"."cashback_credit"
+WHERE ("id" = sqlc.narg('id')) OR ("order_id" = sqlc.narg('order_id') AND "promotion_id" = sqlc.narg('promotion_id'));
+
+-- name: ExistsPromotionCashbackCredit :one
+SELECT EXISTS (
+SELECT 1
+FROM "promotion"."cashback_credit"
+WHERE (
+    ("id" = ANY(sqlc.slice('id')) OR sqlc.slice('id') IS NULL) AND
+    ("id" >= sqlc.narg('id_from') OR sqlc.narg('id_from') IS NULL) AND
+    ("id" <= sqlc.narg('id_to') OR sqlc.narg('id_to') IS NULL) AND
+    ("account_id" = ANY(sqlc.slice('account_id')) OR sqlc.slice('account_id') IS NULL) AND
+    ("account_id" >= sqlc.narg('account_id_from') OR sqlc.narg('account_id_from') IS NULL) AND
+    ("account_id" <= sqlc.narg('account_id_to') OR sqlc.narg('account_id_to') IS NULL) AND
+    ("order_id" = ANY(sqlc.slice('order_id')) OR sqlc.slice('order_id') IS NULL) AND
+    ("order_id" >= sqlc.narg('order_id_from') OR sqlc.narg('order_id_from') IS NULL) AND
+    ("order_id" <= sqlc.narg('order_id_to') OR sqlc.narg('order_id_to') IS NULL) AND
+    ("promotion_id" = ANY(sqlc.slice('promotion_id')) OR sqlc.slice('promotion_id') IS NULL) AND
+    ("promotion_id" >= sqlc.narg('promotion_id_from') OR sqlc.narg('promotion_id_from') IS NULL) AND
+    ("promotion_id" <= sqlc.narg('promotion_id_to') OR sqlc.narg('promotion_id_to') IS NULL) AND
+    ("amount" = ANY(sqlc.slice('amount')) OR sqlc.slice('amount') IS NULL) AND
+    ("amount" >= sqlc.narg('amount_from') OR sqlc.narg('amount_from') IS NULL) AND
+    ("amount" <= sqlc.narg('amount_to') OR sqlc.narg('amount_to') IS NULL) AND
+    ("status" = ANY(sqlc.slice('status')) OR sqlc.slice('status') IS NULL) AND
+    ("date_created" = ANY(sqlc.slice('date_created')) OR sqlc.slice('date_created') IS NULL) AND
+    ("date_created" >= sqlc.narg('date_created_from') OR sqlc.narg('date_created_from') IS NULL) AND
+    ("date_created" <= sqlc.narg('date_created_to') OR sqlc.narg('date_created_to') IS NULL) AND
+    ("date_updated" = ANY(sqlc.slice('date_updated')) OR sqlc.slice('date_updated') IS NULL) AND
+    ("date_updated" >= sqlc.narg('date_updated_from') OR sqlc.narg('date_updated_from') IS NULL) AND
+    ("date_updated" <= sqlc.narg('date_updated_to') OR sqlc.narg('date_updated_to') IS NULL)
+)
+) as exists;
+
+-- name: CountPromotionCashbackCredit :one
+SELECT COUNT(*)
+FROM "promotion"."cashback_credit"
+WHERE (
+    ("id" = ANY(sqlc.slice('id')) OR sqlc.slice('id') IS NULL) AND
+    ("id" >= sqlc.narg('id_from') OR sqlc.narg('id_from') IS NULL) AND
+    ("id" <= sqlc.narg('id_to') OR sqlc.narg('id_to') IS NULL) AND
+    ("account_id" = ANY(sqlc.slice('account_id')) OR sqlc.slice('account_id') IS NULL) AND
+    ("account_id" >= sqlc.narg('account_id_from') OR sqlc.narg('account_id_from') IS NULL) AND
+    ("account_id" <= sqlc.narg('account_id_to') OR sqlc.narg('account_id_to') IS NULL) AND
+    ("order_id" = ANY(sqlc.slice('order_id')) OR sqlc.slice('order_id') IS NULL) AND
+    ("order_id" >= sqlc.narg('order_id_from') OR sqlc.narg('order_id_from') IS NULL) AND
+    ("order_id" <= sqlc.narg('order_id_to') OR sqlc.narg('order_id_to') IS NULL) AND
+    ("promotion_id" = ANY(sqlc.slice('promotion_id')) OR sqlc.slice('promotion_id') IS NULL) AND
+    ("promotion_id" >= sqlc.narg('promotion_id_from') OR sqlc.narg('promotion_id_from') IS NULL) AND
+    ("promotion_id" <= sqlc.narg('promotion_id_to') OR sqlc.narg('promotion_id_to') IS NULL) AND
+    ("amount" = ANY(sqlc.slice('amount')) OR sqlc.slice('amount') IS NULL) AND
+    ("amount" >= sqlc.narg('amount_from') OR sqlc.narg('amount_from') IS NULL) AND
+    ("amount" <= sqlc.narg('amount_to') OR sqlc.narg('amount_to') IS NULL) AND
+    ("status" = ANY(sqlc.slice('status')) OR sqlc.slice('status') IS NULL) AND
+    ("date_created" = ANY(sqlc.slice('date_created')) OR sqlc.slice('date_created') IS NULL) AND
+    ("date_created" >= sqlc.narg('date_created_from') OR sqlc.narg('date_created_from') IS NULL) AND
+    ("date_created" <= sqlc.narg('date_created_to') OR sqlc.narg('date_created_to') IS NULL) AND
+    ("date_updated" = ANY(sqlc.slice('date_updated')) OR sqlc.slice('date_updated') IS NULL) AND
+    ("date_updated" >= sqlc.narg('date_updated_from') OR sqlc.narg('date_updated_from') IS NULL) AND
+    ("date_updated" <= sqlc.narg('date_updated_to') OR sqlc.narg('date_updated_to') IS NULL)
+);
+
+-- name: ListPromotionCashbackCredit :many
+SELECT *
+FROM "promotion"."cashback_credit"
+WHERE (
+    ("id" = ANY(sqlc.slice('id')) OR sqlc.slice('id') IS NULL) AND
+    ("id" >= sqlc.narg('id_from') OR sqlc.narg('id_from') IS NULL) AND
+    ("id" <= sqlc.narg('id_to') OR sqlc.narg('id_to') IS NULL) AND
+    ("account_id" = ANY(sqlc.slice('account_id')) OR sqlc.slice('account_id') IS NULL) AND
+    ("account_id" >= sqlc.narg('account_id_from') OR sqlc.narg('account_id_from') IS NULL) AND
+    ("account_id" <= sqlc.narg('account_id_to') OR sqlc.narg('account_id_to') IS NULL) AND
+    ("order_id" = ANY(sqlc.slice('order_id')) OR sqlc.slice('order_id') IS NULL) AND
+    ("order_id" >= sqlc.narg('order_id_from') OR sqlc.narg('order_id_from') IS NULL) AND
+    ("order_id" <= sqlc.narg('order_id_to') OR sqlc.narg('order_id_to') IS NULL) AND
+    ("promotion_id" = ANY(sqlc.slice('promotion_id')) OR sqlc.slice('promotion_id') IS NULL) AND
+    ("promotion_id" >= sqlc.narg('promotion_id_from') OR sqlc.narg('promotion_id_from') IS NULL) AND
+    ("promotion_id" <= sqlc.narg('promotion_id_to') OR sqlc.narg('promotion_id_to') IS NULL) AND
+    ("amount" = ANY(sqlc.slice('amount')) OR sqlc.slice('amount') IS NULL) AND
+    ("amount" >= sqlc.narg('amount_from') OR sqlc.narg('amount_from') IS NULL) AND
+    ("amount" <= sqlc.narg('amount_to') OR sqlc.narg('amount_to') IS NULL) AND
+    ("status" = ANY(sqlc.slice('status')) OR sqlc.slice('status') IS NULL) AND
+    ("date_created" = ANY(sqlc.slice('date_created')) OR sqlc.slice('date_created') IS NULL) AND
+    ("date_created" >= sqlc.narg('date_created_from') OR sqlc.narg('date_created_from') IS NULL) AND
+    ("date_created" <= sqlc.narg('date_created_to') OR sqlc.narg('date_created_to') IS NULL) AND
+    ("date_updated" = ANY(sqlc.slice('date_updated')) OR sqlc.slice('date_updated') IS NULL) AND
+    ("date_updated" >= sqlc.narg('date_updated_from') OR sqlc.narg('date_updated_from') IS NULL) AND
+    ("date_updated" <= sqlc.narg('date_updated_to') OR sqlc.narg('date_updated_to') IS NULL)
+)
+ORDER BY "id"
+LIMIT sqlc.narg('limit')
+OFFSET sqlc.narg('offset');
+
+
+-- name: CreatePromotionCashbackCredit :copyfrom
+INSERT INTO "promotion"."cashback_credit" ("account_id", "order_id", "promotion_id", "amount", "status", "date_created", "date_updated")
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: CreateDefaultPromotionCashbackCredit :copyfrom
+INSERT INTO "promotion"."cashback_credit" ("account_id", "order_id", "promotion_id", "amount")
+VALUES ($1, $2, $3, $4);
+
+-- name: UpdatePromotionCashbackCredit :one
+UPDATE "promotion"."cashback_credit"
+SET "account_id" = COALESCE(sqlc.narg('account_id'), "account_id"),
+    "order_id" = COALESCE(sqlc.narg('order_id'), "order_id"),
+    "promotion_id" = COALESCE(sqlc.narg('promotion_id'), "promotion_id"),
+    "amount" = COALESCE(sqlc.narg('amount'), "amount"),
+    "status" = COALESCE(sqlc.narg('status'), "status"),
+    "date_created" = COALESCE(sqlc.narg('date_created'), "date_created"),
+    "date_updated" = COALESCE(sqlc.narg('date_updated'), "date_updated")
+WHERE ("id" = sqlc.narg('id')) OR ("order_id" = sqlc.narg('order_id') AND "promotion_id" = sqlc.narg('promotion_id'))
+RETURNING *;
+
+-- name: DeletePromotionCashbackCredit :exec
+DELETE FROM "promotion"."cashback_credit"
+WHERE ("id" = sqlc.narg('id')) OR ("order_id" = sqlc.narg('order_id') AND "promotion_id" = sqlc.narg('promotion_id'));
+
+-- ========================================
+
+-- Queries for table: promotion.voucher
+
+-- ========================================
+
+-- name: GetPromotionVoucher :one
+SELECT *
+FROM "promotion"."voucher"
+WHERE ("id" = sqlc.narg('id'));
+
+-- name: ExistsPromotionVoucher :one
+SELECT EXISTS (
+SELECT 1
+FROM "promotion"."voucher"
+WHERE (
+    ("id" = ANY(sqlc.slice('id')) OR sqlc.slice('id') IS NULL) AND
+    ("id" >= sqlc.narg('id_from') OR sqlc.narg('id_from') IS NULL) AND
+    ("id" <= sqlc.narg('id_to') OR sqlc.narg('id_to') IS NULL) AND
+    ("usage_limit" = ANY(sqlc.slice('usage_limit')) OR sqlc.slice('usage_limit') IS NULL) AND
+    ("usage_limit" >= sqlc.narg('usage_limit_from') OR sqlc.narg('usage_limit_from') IS NULL) AND
+    ("usage_limit" <= sqlc.narg('usage_limit_to') OR sqlc.narg('usage_limit_to') IS NULL) AND
+    ("per_customer_limit" = ANY(sqlc.slice('per_customer_limit')) OR sqlc.slice('per_customer_limit') IS NULL) AND
+    ("per_customer_limit" >= sqlc.narg('per_customer_limit_from') OR sqlc.narg('per_customer_limit_from') IS NULL) AND
+    ("per_customer_limit" <= sqlc.narg('per_customer_limit_to') OR sqlc.narg('per_customer_limit_to') IS NULL) AND
+    ("first_order_only" = ANY(sqlc.slice('first_order_only')) OR sqlc.slice('first_order_only') IS NULL) AND
+    ("min_spend" = ANY(sqlc.slice('min_spend')) OR sqlc.slice('min_spend') IS NULL) AND
+    ("min_spend" >= sqlc.narg('min_spend_from') OR sqlc.narg('min_spend_from') IS NULL) AND
+    ("min_spend" <= sqlc.narg('min_spend_to') OR sqlc.narg('min_spend_to') IS NULL) AND
+    ("used" = ANY(sqlc.slice('used')) OR sqlc.slice('used') IS NULL) AND
+    ("used" >= sqlc.narg('used_from') OR sqlc.narg('used_from') IS NULL) AND
+    ("used" <= sqlc.narg('used_to') OR sqlc.narg('used_to') IS NULL)
+)
+) as exists;
+
+-- name: CountPromotionVoucher :one
+SELECT COUNT(*)
+FROM "promotion"."voucher"
+WHERE (
+    ("id" = ANY(sqlc.slice('id')) OR sqlc.slice('id') IS NULL) AND
+    ("id" >= sqlc.narg('id_from') OR sqlc.narg('id_from') IS NULL) AND
+    ("id" <= sqlc.narg('id_to') OR sqlc.narg('id_to') IS NULL) AND
+    ("usage_limit" = ANY(sqlc.slice('usage_limit')) OR sqlc.slice('usage_limit') IS NULL) AND
+    ("usage_limit" >= sqlc.narg('usage_limit_from') OR sqlc.narg('usage_limit_from') IS NULL) AND
+    ("usage_limit" <= sqlc.narg('usage_limit_to') OR sqlc.narg('usage_limit_to') IS NULL) AND
+    ("per_customer_limit" = ANY(sqlc.slice('per_customer_limit')) OR sqlc.slice('per_customer_limit') IS NULL) AND
+    ("per_customer_limit" >= sqlc.narg('per_customer_limit_from') OR sqlc.narg('per_customer_limit_from') IS NULL) AND
+    ("per_customer_limit" <= sqlc.narg('per_customer_limit_to') OR sqlc.narg('per_customer_limit_to') IS NULL) AND
+    ("first_order_only" = ANY(sqlc.slice('first_order_only')) OR sqlc.slice('first_order_only') IS NULL) AND
+    ("min_spend" = ANY(sqlc.slice('min_spend')) OR sqlc.slice('min_spend') IS NULL) AND
+    ("min_spend" >= sqlc.narg('min_spend_from') OR sqlc.narg('min_spend_from') IS NULL) AND
+    ("min_spend" <= sqlc.narg('min_spend_to') OR sqlc.narg('min_spend_to') IS NULL) AND
+    ("used" = ANY(sqlc.slice('used')) OR sqlc.slice('used') IS NULL) AND
+    ("used" >= sqlc.narg('used_from') OR sqlc.narg('used_from') IS NULL) AND
+    ("used" <= sqlc.narg('used_to') OR sqlc.narg('used_to') IS NULL)
+);
+
+-- name: ListPromotionVoucher :many
+SELECT *
+FROM "promotion"."voucher"
+WHERE (
+    ("id" = ANY(sqlc.slice('id')) OR sqlc.slice('id') IS NULL) AND
+    ("id" >= sqlc.narg('id_from') OR sqlc.narg('id_from') IS NULL) AND
+    ("id" <= sqlc.narg('id_to') OR sqlc.narg('id_to') IS NULL) AND
+    ("usage_limit" = ANY(sqlc.slice('usage_limit')) OR sqlc.slice('usage_limit') IS NULL) AND
+    ("usage_limit" >= sqlc.narg('usage_limit_from') OR sqlc.narg('usage_limit_from') IS NULL) AND
+    ("usage_limit" <= sqlc.narg('usage_limit_to') OR sqlc.narg('usage_limit_to') IS NULL) AND
+    ("per_customer_limit" = ANY(sqlc.slice('per_customer_limit')) OR sqlc.slice('per_customer_limit') IS NULL) AND
+    ("per_customer_limit" >= sqlc.narg('per_customer_limit_from') OR sqlc.narg('per_customer_limit_from') IS NULL) AND
+    ("per_customer_limit" <= sqlc.narg('per_customer_limit_to') OR sqlc.narg('per_customer_limit_to') IS NULL) AND
+    ("first_order_only" = ANY(sqlc.slice('first_order_only')) OR sqlc.slice('first_order_only') IS NULL) AND
+    ("min_spend" = ANY(sqlc.slice('min_spend')) OR sqlc.slice('min_spend') IS NULL) AND
+    ("min_spend" >= sqlc.narg('min_spend_from') OR sqlc.narg('min_spend_from') IS NULL) AND
+    ("min_spend" <= sqlc.narg('min_spend_to') OR sqlc.narg('min_spend_to') IS NULL) AND
+    ("used" = ANY(sqlc.slice('used')) OR sqlc.slice('used') IS NULL) AND
+    ("used" >= sqlc.narg('used_from') OR sqlc.narg('used_from') IS NULL) AND
+    ("used" <= sqlc.narg('used_to') OR sqlc.narg('used_to') IS NULL)
+)
+ORDER BY "id"
+LIMIT sqlc.narg('limit')
+OFFSET sqlc.narg('offset');
+
+
+-- name: CreatePromotionVoucher :copyfrom
+INSERT INTO "promotion"."voucher" ("id", "usage_limit", "per_customer_limit", "first_order_only", "min_spend", "used")
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: CreateDefaultPromotionVoucher :copyfrom
+INSERT INTO "promotion"."voucher" ("id")
+VALUES ($1);
+
+-- name: UpdatePromotionVoucher :one
+UPDATE "promotion"."voucher"
+SET "usage_limit" = COALESCE(sqlc.narg('usage_limit'), "usage_limit"),
+    "per_customer_limit" = COALESCE(sqlc.narg('per_customer_limit'), "per_customer_limit"),
+    "first_order_only" = COALESCE(sqlc.narg('first_order_only'), "first_order_only"),
+    "min_spend" = COALESCE(sqlc.narg('min_spend'), "min_spend"),
+    "used" = COALESCE(sqlc.narg('used'), "used")
+WHERE ("id" = sqlc.narg('id'))
+RETURNING *;
+
+-- name: DeletePromotionVoucher :exec
+DELETE FROM "promotion"."voucher"
+WHERE ("id" = sqlc.narg('id'));
+
+-- ========================================
+
+-- Queries for table: promotion.voucher_redemption
+
+-- ========================================
+
+-- name: GetPromotionVoucherRedemption :one
+SELECT *
+FROM "promotion"."voucher_redemption"
+WHERE ("id" = sqlc.narg('id')) OR ("voucher_id" = sqlc.narg('voucher_id') AND "order_id" = sqlc.narg('order_id'));
+
+-- name: ExistsPromotionVoucherRedemption :one
+SELECT EXISTS (
+SELECT 1
+FROM "promotion"."voucher_redemption"
+WHERE (
+    ("id" = ANY(sqlc.slice('id')) OR sqlc.slice('id') IS NULL) AND
+    ("id" >= sqlc.narg('id_from') OR sqlc.narg('id_from') IS NULL) AND
+    ("id" <= sqlc.narg('id_to') OR sqlc.narg('id_to') IS NULL) AND
+    ("voucher_id" = ANY(sqlc.slice('voucher_id')) OR sqlc.slice('voucher_id') IS NULL) AND
+    ("voucher_id" >= sqlc.narg('voucher_id_from') OR sqlc.narg('voucher_id_from') IS NULL) AND
+    ("voucher_id" <= sqlc.narg('voucher_id_to') OR sqlc.narg('voucher_id_to') IS NULL) AND
+    ("account_id" = ANY(sqlc.slice('account_id')) OR sqlc.slice('account_id') IS NULL) AND
+    ("account_id" >= sqlc.narg('account_id_from') OR sqlc.narg('account_id_from') IS NULL) AND
+    ("account_id" <= sqlc.narg('account_id_to') OR sqlc.narg('account_id_to') IS NULL) AND
+    ("order_id" = ANY(sqlc.slice('order_id')) OR sqlc.slice('order_id') IS NULL) AND
+    ("order_id" >= sqlc.narg('order_id_from') OR sqlc.narg('order_id_from') IS NULL) AND
+    ("order_id" <= sqlc.narg('order_id_to') OR sqlc.narg('order_id_to') IS NULL) AND
+    ("status" = ANY(sqlc.slice('status')) OR sqlc.slice('status') IS NULL) AND
+    ("date_created" = ANY(sqlc.slice('date_created')) OR sqlc.slice('date_created') IS NULL) AND
+    ("date_created" >= sqlc.narg('date_created_from') OR sqlc.narg('date_created_from') IS NULL) AND
+    ("date_created" <= sqlc.narg('date_created_to') OR sqlc.narg('date_created_to') IS NULL) AND
+    ("date_updated" = ANY(sqlc.slice('date_updated')) OR sqlc.slice('date_updated') IS NULL) AND
+    ("date_updated" >= sqlc.narg('date_updated_from') OR sqlc.narg('date_updated_from') IS NULL) AND
+    ("date_updated" <= sqlc.narg('date_updated_to') OR sqlc.narg('date_updated_to') IS NULL)
+)
+) as exists;
+
+-- name: CountPromotionVoucherRedemption :one
+SELECT COUNT(*)
+FROM "promotion"."voucher_redemption"
+WHERE (
+    ("id" = ANY(sqlc.slice('id')) OR sqlc.slice('id') IS NULL) AND
+    ("id" >= sqlc.narg('id_from') OR sqlc.narg('id_from') IS NULL) AND
+    ("id" <= sqlc.narg('id_to') OR sqlc.narg('id_to') IS NULL) AND
+    ("voucher_id" = ANY(sqlc.slice('voucher_id')) OR sqlc.slice('voucher_id') IS NULL) AND
+    ("voucher_id" >= sqlc.narg('voucher_id_from') OR sqlc.narg('voucher_id_from') IS NULL) AND
+    ("voucher_id" <= sqlc.narg('voucher_id_to') OR sqlc.narg('voucher_id_to') IS NULL) AND
+    ("account_id" = ANY(sqlc.slice('account_id')) OR sqlc.slice('account_id') IS NULL) AND
+    ("account_id" >= sqlc.narg('account_id_from') OR sqlc.narg('account_id_from') IS NULL) AND
+    ("account_id" <= sqlc.narg('account_id_to') OR sqlc.narg('account_id_to') IS NULL) AND
+    ("order_id" = ANY(sqlc.slice('order_id')) OR sqlc.slice('order_id') IS NULL) AND
+    ("order_id" >= sqlc.narg('order_id_from') OR sqlc.narg('order_id_from') IS NULL) AND
+    ("order_id" <= sqlc.narg('order_id_to') OR sqlc.narg('order_id_to') IS NULL) AND
+    ("status" = ANY(sqlc.slice('status')) OR sqlc.slice('status') IS NULL) AND
+    ("date_created" = ANY(sqlc.slice('date_created')) OR sqlc.slice('date_created') IS NULL) AND
+    ("date_created" >= sqlc.narg('date_created_from') OR sqlc.narg('date_created_from') IS NULL) AND
+    ("date_created" <= sqlc.narg('date_created_to') OR sqlc.narg('date_created_to') IS NULL) AND
+    ("date_updated" = ANY(sqlc.slice('date_updated')) OR sqlc.slice('date_updated') IS NULL) AND
+    ("date_updated" >= sqlc.narg('date_updated_from') OR sqlc.narg('date_updated_from') IS NULL) AND
+    ("date_updated" <= sqlc.narg('date_updated_to') OR sqlc.narg('date_updated_to') IS NULL)
+);
+
+-- name: ListPromotionVoucherRedemption :many
+SELECT *
+FROM "promotion"."voucher_redemption"
+WHERE (
+    ("id" = ANY(sqlc.slice('id')) OR sqlc.slice('id') IS NULL) AND
+    ("id" >= sqlc.narg('id_from') OR sqlc.narg('id_from') IS NULL) AND
+    ("id" <= sqlc.narg('id_to') OR sqlc.narg('id_to') IS NULL) AND
+    ("voucher_id" = ANY(sqlc.slice('voucher_id')) OR sqlc.slice('voucher_id') IS NULL) AND
+    ("voucher_id" >= sqlc.narg('voucher_id_from') OR sqlc.narg('voucher_id_from') IS NULL) AND
+    ("voucher_id" <= sqlc.narg('voucher_id_to') OR sqlc.narg('voucher_id_to') IS NULL) AND
+    ("account_id" = ANY(sqlc.slice('account_id')) OR sqlc.slice('account_id') IS NULL) AND
+    ("account_id" >= sqlc.narg('account_id_from') OR sqlc.narg('account_id_from') IS NULL) AND
+    ("account_id" <= sqlc.narg('account_id_to') OR sqlc.narg('account_id_to') IS NULL) AND
+    ("order_id" = ANY(sqlc.slice('order_id')) OR sqlc.slice('order_id') IS NULL) AND
+    ("order_id" >= sqlc.narg('order_id_from') OR sqlc.narg('order_id_from') IS NULL) AND
+    ("order_id" <= sqlc.narg('order_id_to') OR sqlc.narg('order_id_to') IS NULL) AND
+    ("status" = ANY(sqlc.slice('status')) OR sqlc.slice('status') IS NULL) AND
+    ("date_created" = ANY(sqlc.slice('date_created')) OR sqlc.slice('date_created') IS NULL) AND
+    ("date_created" >= sqlc.narg('date_created_from') OR sqlc.narg('date_created_from') IS NULL) AND
+    ("date_created" <= sqlc.narg('date_created_to') OR sqlc.narg('date_created_to') IS NULL) AND
+    ("date_updated" = ANY(sqlc.slice('date_updated')) OR sqlc.slice('date_updated') IS NULL) AND
+    ("date_updated" >= sqlc.narg('date_updated_from') OR sqlc.narg('date_updated_from') IS NULL) AND
+    ("date_updated" <= sqlc.narg('date_updated_to') OR sqlc.narg('date_updated_to') IS NULL)
+)
+ORDER BY "id"
+LIMIT sqlc.narg('limit')
+OFFSET sqlc.narg('offset');
+
+
+-- name: CreatePromotionVoucherRedemption :copyfrom
+INSERT INTO "promotion"."voucher_redemption" ("voucher_id", "account_id", "order_id", "status", "date_created", "date_updated")
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: CreateDefaultPromotionVoucherRedemption :copyfrom
+INSERT INTO "promotion"."voucher_redemption" ("voucher_id", "account_id", "order_id")
+VALUES ($1, $2, $3);
+
+-- name: UpdatePromotionVoucherRedemption :one
+UPDATE "promotion"."voucher_redemption"
+SET "voucher_id" = COALESCE(sqlc.narg('voucher_id'), "voucher_id"),
+    "account_id" = COALESCE(sqlc.narg('account_id'), "account_id"),
+    "order_id" = COALESCE(sqlc.narg('order_id'), "order_id"),
+    "status" = COALESCE(sqlc.narg('status'), "status"),
+    "date_created" = COALESCE(sqlc.narg('date_created'), "date_created"),
+    "date_updated" = COALESCE(sqlc.narg('date_updated'), "date_updated")
+WHERE ("id" = sqlc.narg('id')) OR ("voucher_id" = sqlc.narg('voucher_id') AND "order_id" = sqlc.narg('order_id'))
+RETURNING *;
+
+-- name: DeletePromotionVoucherRedemption :exec
+DELETE FROM "promotion"."voucher_redemption"
+WHERE ("id" = sqlc.narg('id')) OR ("voucher_id" = sqlc.narg('voucher_id') AND "order_id" = sqlc.narg('order_id'));
+
+-- ========================================
+
 -- Queries for table: shared.resource
 
 -- ========================================